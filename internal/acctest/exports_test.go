@@ -6,4 +6,18 @@ package acctest
 // Exports for use in tests only.
 var (
 	CloseVCRRecorder = closeVCRRecorder
+	VCRBodiesEqual   = vcrBodiesEqual
+	VCRURLsEqual     = vcrURLsEqual
 )
+
+// VCRRedact redacts each of the specified strings in turn using a single redactor.
+func VCRRedact(ss ...string) []string {
+	r := newVCRRedactor()
+
+	output := make([]string, len(ss))
+	for i, s := range ss {
+		output[i] = r.redact(s)
+	}
+
+	return output
+}
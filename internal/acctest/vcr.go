@@ -12,10 +12,13 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"mime"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
@@ -30,21 +33,24 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 	"gopkg.in/dnaeon/go-vcr.v3/recorder"
 )
 
-const (
-	envVarVCRMode = "VCR_MODE"
-	envVarVCRPath = "VCR_PATH"
-)
-
 type randomnessSource struct {
 	seed   int64
 	source rand.Source
 }
 
-type metaMap map[string]*conns.AWSClient
+// vcrProviderKey identifies a provider instance in a test.
+// Each of a test's providers (e.g. "aws" and "awsalternate") has its own configuration and so its own meta.
+type vcrProviderKey struct {
+	testName     string
+	providerName string
+}
+
+type metaMap map[vcrProviderKey]*conns.AWSClient
 
 func (m metaMap) Lock() {
 	conns.GlobalMutexKV.Lock(m.key())
@@ -58,6 +64,22 @@ func (m metaMap) key() string {
 	return "vcr-metas"
 }
 
+// recorderMap holds the VCR recorder for each test.
+// All of a test's providers share a single recorder, and so a single cassette.
+type recorderMap map[string]*recorder.Recorder
+
+func (m recorderMap) Lock() {
+	conns.GlobalMutexKV.Lock(m.key())
+}
+
+func (m recorderMap) Unlock() {
+	conns.GlobalMutexKV.Unlock(m.key())
+}
+
+func (m recorderMap) key() string {
+	return "vcr-recorders"
+}
+
 type randomnessSourceMap map[string]*randomnessSource
 
 func (m randomnessSourceMap) Lock() {
@@ -73,8 +95,9 @@ func (m randomnessSourceMap) key() string {
 }
 
 var (
-	providerMetas     = metaMap(make(map[string]*conns.AWSClient, 0))
+	providerMetas     = metaMap(make(map[vcrProviderKey]*conns.AWSClient, 0))
 	randomnessSources = randomnessSourceMap(make(map[string]*randomnessSource, 0))
+	vcrRecorders      = recorderMap(make(map[string]*recorder.Recorder, 0))
 )
 
 // ProviderMeta returns the current provider's state (AKA "meta" or "conns.AWSClient").
@@ -82,7 +105,7 @@ func ProviderMeta(_ context.Context, t *testing.T) *conns.AWSClient {
 	t.Helper()
 
 	providerMetas.Lock()
-	meta, ok := providerMetas[t.Name()]
+	meta, ok := providerMetas[vcrProviderKey{testName: t.Name(), providerName: ProviderName}]
 	defer providerMetas.Unlock()
 
 	if !ok {
@@ -93,21 +116,23 @@ func ProviderMeta(_ context.Context, t *testing.T) *conns.AWSClient {
 }
 
 func isVCREnabled() bool {
-	return os.Getenv(envVarVCRMode) != "" && os.Getenv(envVarVCRPath) != ""
+	return vcr.IsEnabled()
 }
 
 func vcrMode() (recorder.Mode, error) {
-	switch v := os.Getenv(envVarVCRMode); v {
-	case "RECORDING":
+	switch v := os.Getenv(vcr.EnvVarMode); v {
+	case vcr.ModeRecording:
 		return recorder.ModeRecordOnce, nil
-	case "REPLAYING":
+	case vcr.ModeReplaying:
 		return recorder.ModeReplayOnly, nil
 	default:
-		return recorder.ModePassthrough, fmt.Errorf("unsupported value for %s: %s", envVarVCRMode, v)
+		return recorder.ModePassthrough, fmt.Errorf("unsupported value for %s: %s", vcr.EnvVarMode, v)
 	}
 }
 
 // vcrEnabledProtoV5ProviderFactories returns ProtoV5ProviderFactories ready for use with VCR.
+// The Plugin Framework provider obtains its configured meta from the primary (Plugin SDK) provider,
+// so resources, data sources, ephemeral resources and functions implemented with either SDK share the VCR-enabled HTTP client.
 func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input map[string]func() (tfprotov5.ProviderServer, error)) map[string]func() (tfprotov5.ProviderServer, error) {
 	t.Helper()
 
@@ -121,7 +146,7 @@ func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input
 				return nil, err
 			}
 
-			primary.ConfigureContextFunc = vcrProviderConfigureContextFunc(primary, primary.ConfigureContextFunc, t.Name())
+			primary.ConfigureContextFunc = vcrProviderConfigureContextFunc(primary, primary.ConfigureContextFunc, vcrProviderKey{testName: t.Name(), providerName: name})
 
 			return providerServerFactory(), nil
 		}
@@ -133,160 +158,284 @@ func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input
 // vcrProviderConfigureContextFunc returns a provider configuration function returning cached provider instance state.
 // This is necessary as ConfigureContextFunc is called multiple times for a given test, each time creating a new HTTP client.
 // VCR requires a single HTTP client to handle all interactions.
-func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContextFunc schema.ConfigureContextFunc, testName string) schema.ConfigureContextFunc {
+func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContextFunc schema.ConfigureContextFunc, key vcrProviderKey) schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
 		var diags diag.Diagnostics
 
 		providerMetas.Lock()
-		meta, ok := providerMetas[testName]
+		meta, ok := providerMetas[key]
 		defer providerMetas.Unlock()
 
 		if ok {
 			return meta, nil
 		}
 
-		vcrMode, err := vcrMode()
+		httpClient, err := vcrHTTPClient(ctx, key.testName)
 
 		if err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
 
-		// Cribbed from aws-sdk-go-base.
-		httpClient := cleanhttp.DefaultPooledClient()
-		transport := httpClient.Transport.(*http.Transport)
-		transport.MaxIdleConnsPerHost = 10
-		tlsConfig := transport.TLSClientConfig
-		if tlsConfig == nil {
-			tlsConfig = &tls.Config{}
-			transport.TLSClientConfig = tlsConfig
+		// Use the wrapped HTTP Client for AWS APIs.
+		// As the HTTP client is used in the provider's ConfigureContextFunc
+		// we must do this setup before calling the ConfigureContextFunc.
+		if v, ok := provider.Meta().(*conns.AWSClient); ok {
+			meta = v
+		} else {
+			meta = new(conns.AWSClient)
 		}
-		tlsConfig.MinVersion = tls.VersionTLS12
+		meta.SetHTTPClient(ctx, httpClient)
+		provider.SetMeta(meta)
 
-		path := filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(testName))
+		if v, ds := configureContextFunc(ctx, d); ds.HasError() {
+			return nil, append(diags, ds...)
+		} else {
+			meta = v.(*conns.AWSClient)
+		}
 
-		// Create a VCR recorder around a default HTTP client.
-		r, err := recorder.NewWithOptions(&recorder.Options{
-			CassetteName:  path,
-			Mode:          vcrMode,
-			RealTransport: httpClient.Transport,
-		})
+		providerMetas[key] = meta
 
-		if err != nil {
-			return nil, sdkdiag.AppendFromErr(diags, err)
+		return meta, diags
+	}
+}
+
+// vcrHTTPClient returns an HTTP client whose transport is the test's VCR recorder, creating the recorder if necessary.
+func vcrHTTPClient(ctx context.Context, testName string) (*http.Client, error) {
+	vcrRecorders.Lock()
+	defer vcrRecorders.Unlock()
+
+	// Cribbed from aws-sdk-go-base.
+	httpClient := cleanhttp.DefaultPooledClient()
+
+	if r, ok := vcrRecorders[testName]; ok {
+		httpClient.Transport = r
+		return httpClient, nil
+	}
+
+	vcrMode, err := vcrMode()
+
+	if err != nil {
+		return nil, err
+	}
+
+	transport := httpClient.Transport.(*http.Transport)
+	transport.MaxIdleConnsPerHost = 10
+	tlsConfig := transport.TLSClientConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+		transport.TLSClientConfig = tlsConfig
+	}
+	tlsConfig.MinVersion = tls.VersionTLS12
+
+	path := filepath.Join(os.Getenv(vcr.EnvVarPath), vcrFileName(testName))
+
+	// Create a VCR recorder around a default HTTP client.
+	r, err := recorder.NewWithOptions(&recorder.Options{
+		CassetteName:       path,
+		Mode:               vcrMode,
+		RealTransport:      httpClient.Transport,
+		SkipRequestLatency: true,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	redactor := newVCRRedactor()
+
+	// Anonymize account IDs and remove secrets before the cassette is saved.
+	// This is not done as interactions are captured, as the provider must see the real responses while recording.
+	r.AddHook(redactor.redactInteraction, recorder.BeforeSaveHook)
+
+	// Defines how VCR will match requests to responses.
+	r.SetMatcher(vcrMatcher(ctx, redactor))
+
+	vcrRecorders[testName] = r
+	httpClient.Transport = r
+
+	return httpClient, nil
+}
+
+// vcrIdempotencyTokenNames are the lower-cased names of request parameters whose values are generated
+// afresh by the AWS SDK or the provider for each request, and so are ignored when matching requests.
+var vcrIdempotencyTokenNames = []string{
+	"callerreference",
+	"clientrequesttoken",
+	"clienttoken",
+	"idempotencytoken",
+}
+
+func isVCRIdempotencyTokenName(name string) bool {
+	// Query protocol parameter names may be qualified, e.g. "LaunchTemplateData.ClientToken".
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+
+	return slices.Contains(vcrIdempotencyTokenNames, strings.ToLower(name))
+}
+
+// vcrMatcher returns a function that matches requests to recorded interactions.
+// Requests are redacted in the same way as recorded interactions, and idempotency tokens are ignored.
+// Matching is independent of request order: each request is matched to the first equivalent interaction not yet replayed,
+// so requests made in parallel replay correctly irrespective of the order in which they were recorded.
+func vcrMatcher(ctx context.Context, redactor *vcrRedactor) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method {
+			return false
 		}
 
-		// Remove sensitive HTTP headers.
-		r.AddHook(func(i *cassette.Interaction) error {
-			delete(i.Request.Headers, "Authorization")
-			delete(i.Request.Headers, "X-Amz-Security-Token")
+		if !vcrURLsEqual(redactor.redact(r.URL.String()), i.URL) {
+			return false
+		}
 
-			return nil
-		}, recorder.AfterCaptureHook)
+		if r.Body == nil || r.Body == http.NoBody {
+			return true
+		}
 
-		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
-				return false
-			}
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]any{
+				"error": err,
+			})
+			return false
+		}
 
-			if r.Body == nil {
-				return true
-			}
+		r.Body = io.NopCloser(bytes.NewReader(b.Bytes()))
+		body := redactor.redact(b.String())
+		// If body matches identically, we are done.
+		if body == i.Body {
+			return true
+		}
 
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]any{
-					"error": err,
-				})
-				return false
-			}
+		return vcrBodiesEqual(ctx, r.Header.Get("Content-Type"), body, i.Body)
+	}
+}
 
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
-			}
+// vcrURLsEqual returns whether two request URLs are equal, ignoring query parameter order and idempotency tokens.
+func vcrURLsEqual(x, y string) bool {
+	if x == y {
+		return true
+	}
 
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson any
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]any{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]any{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml any
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]any{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]any{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
+	ux, err := url.Parse(x)
+	if err != nil {
+		return false
+	}
+
+	uy, err := url.Parse(y)
+	if err != nil {
+		return false
+	}
+
+	if ux.Scheme != uy.Scheme || ux.Host != uy.Host || ux.Path != uy.Path {
+		return false
+	}
+
+	return reflect.DeepEqual(withoutVCRIdempotencyTokens(ux.Query()), withoutVCRIdempotencyTokens(uy.Query()))
+}
 
+// vcrBodiesEqual returns whether two request bodies are semantically equal.
+func vcrBodiesEqual(ctx context.Context, contentType, x, y string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = contentType
+	}
+
+	// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
+	switch mediaType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		// JSON might be the same, but reordered. Try parsing and comparing.
+		var requestJson, cassetteJson any
+
+		if err := json.Unmarshal([]byte(x), &requestJson); err != nil {
+			tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]any{
+				"error": err,
+			})
 			return false
-		})
+		}
 
-		// Use the wrapped HTTP Client for AWS APIs.
-		// As the HTTP client is used in the provider's ConfigureContextFunc
-		// we must do this setup before calling the ConfigureContextFunc.
-		httpClient.Transport = r
-		if v, ok := provider.Meta().(*conns.AWSClient); ok {
-			meta = v
-		} else {
-			meta = new(conns.AWSClient)
+		if err := json.Unmarshal([]byte(y), &cassetteJson); err != nil {
+			tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]any{
+				"error": err,
+			})
+			return false
 		}
-		meta.SetHTTPClient(ctx, httpClient)
-		provider.SetMeta(meta)
 
-		if v, ds := configureContextFunc(ctx, d); ds.HasError() {
-			return nil, append(diags, ds...)
-		} else {
-			meta = v.(*conns.AWSClient)
+		return reflect.DeepEqual(withoutVCRIdempotencyTokens(requestJson), withoutVCRIdempotencyTokens(cassetteJson))
+
+	case "application/x-www-form-urlencoded":
+		// Query protocol parameters might be the same, but reordered.
+		requestForm, err := url.ParseQuery(x)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to parse request form", map[string]any{
+				"error": err,
+			})
+			return false
 		}
 
-		// Don't retry requests if a recorded interaction isn't found.
-		// TODO Need to loop through all API clients to do this.
-		// TODO Use []*client.Client?
-		// TODO AWS SDK for Go v2 API clients.
-		// meta.LogsConn(ctx).Handlers.AfterRetry.PushFront(func(r *request.Request) {
-		// 	// We have to use 'Contains' rather than 'errors.Is' because 'awserr.Error' doesn't implement 'Unwrap'.
-		// 	if errs.Contains(r.Error, cassette.ErrInteractionNotFound.Error()) {
-		// 		r.Retryable = aws.Bool(false)
-		// 	}
-		// })
+		cassetteForm, err := url.ParseQuery(y)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to parse cassette form", map[string]any{
+				"error": err,
+			})
+			return false
+		}
 
-		providerMetas[testName] = meta
+		return reflect.DeepEqual(withoutVCRIdempotencyTokens(requestForm), withoutVCRIdempotencyTokens(cassetteForm))
 
-		return meta, diags
+	case "application/xml":
+		// XML might be the same, but reordered. Try parsing and comparing.
+		var requestXml, cassetteXml any
+
+		if err := xml.Unmarshal([]byte(x), &requestXml); err != nil {
+			tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		if err := xml.Unmarshal([]byte(y), &cassetteXml); err != nil {
+			tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		return reflect.DeepEqual(requestXml, cassetteXml)
+	}
+
+	return false
+}
+
+// withoutVCRIdempotencyTokens returns v with any idempotency tokens removed.
+func withoutVCRIdempotencyTokens(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for k, v := range v {
+			if isVCRIdempotencyTokenName(k) {
+				continue
+			}
+			m[k] = withoutVCRIdempotencyTokens(v)
+		}
+		return m
+	case []any:
+		s := make([]any, len(v))
+		for i, v := range v {
+			s[i] = withoutVCRIdempotencyTokens(v)
+		}
+		return s
+	case url.Values:
+		m := make(url.Values, len(v))
+		for k, v := range v {
+			if isVCRIdempotencyTokenName(k) {
+				continue
+			}
+			m[k] = v
+		}
+		return m
+	default:
+		return v
 	}
 }
 
@@ -320,7 +469,7 @@ func vcrRandomnessSource(t *testing.T) (*randomnessSource, error) {
 			source: rand.NewSource(seed),
 		}
 	case recorder.ModeReplayOnly:
-		seed, err := readSeedFromFile(vcrSeedFile(os.Getenv(envVarVCRPath), testName))
+		seed, err := readSeedFromFile(vcrSeedFile(os.Getenv(vcr.EnvVarPath), testName))
 
 		if err != nil {
 			return nil, fmt.Errorf("no cassette found on disk for %s, please replay this testcase in recording mode - %w", testName, err)
@@ -383,7 +532,7 @@ func writeSeedToFile(seed int64, fileName string) error {
 }
 
 // closeVCRRecorder closes the VCR recorder, saving the cassette and randomness seed.
-func closeVCRRecorder(ctx context.Context, t *testing.T) {
+func closeVCRRecorder(_ context.Context, t *testing.T) {
 	t.Helper()
 
 	// Don't close the recorder if we're running because of a panic.
@@ -392,22 +541,28 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	}
 
 	testName := t.Name()
-	providerMetas.Lock()
-	meta, ok := providerMetas[testName]
-	defer providerMetas.Unlock()
+	vcrRecorders.Lock()
+	r, ok := vcrRecorders[testName]
+	defer vcrRecorders.Unlock()
 
 	if ok {
 		if !t.Failed() {
-			if v, ok := meta.HTTPClient(ctx).Transport.(*recorder.Recorder); ok {
-				t.Log("stopping VCR recorder")
-				if err := v.Stop(); err != nil {
-					t.Error(err)
-				}
+			t.Log("stopping VCR recorder")
+			if err := r.Stop(); err != nil {
+				t.Error(err)
 			}
 		}
 
-		delete(providerMetas, testName)
+		delete(vcrRecorders, testName)
+	}
+
+	providerMetas.Lock()
+	for key := range providerMetas {
+		if key.testName == testName {
+			delete(providerMetas, key)
+		}
 	}
+	providerMetas.Unlock()
 
 	// Save the randomness seed.
 	randomnessSources.Lock()
@@ -417,7 +572,7 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	if ok {
 		if !t.Failed() {
 			t.Log("persisting randomness seed")
-			if err := writeSeedToFile(s.seed, vcrSeedFile(os.Getenv(envVarVCRPath), t.Name())); err != nil {
+			if err := writeSeedToFile(s.seed, vcrSeedFile(os.Getenv(vcr.EnvVarPath), t.Name())); err != nil {
 				t.Error(err)
 			}
 		}
@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"sync"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+const (
+	vcrRedactedValue = "REDACTED"
+
+	// vcrFirstRedactedAccountID is the replacement for the first account ID seen in an interaction.
+	// Subsequent account IDs are replaced by consecutive values so that cross-account tests remain distinguishable.
+	vcrFirstRedactedAccountID = 123456789012
+)
+
+// VCRRedaction replaces matches of Pattern with Replacement in recorded AWS API interactions.
+// Replacement can reference submatches as described in regexp.Regexp.Expand.
+type VCRRedaction struct {
+	Pattern     *regexp.Regexp
+	Replacement string
+}
+
+// vcrSensitiveName matches the names of fields and parameters containing secrets, e.g. `MasterUserPassword` or `SecretAccessKey`.
+const vcrSensitiveName = `[a-z0-9]*(?:authtoken|clientsecret|passphrase|password|passworddata|privatekey|secretaccesskey|secretbinary|secretkey|secretstring|sessiontoken)`
+
+var (
+	vcrRedactionsLock sync.Mutex
+	vcrRedactions     = []VCRRedaction{
+		// JSON protocols, e.g. `"SecretString":"..."`.
+		{
+			Pattern:     regexp.MustCompile(`(?i)("` + vcrSensitiveName + `"\s*:\s*)"(?:[^"\\]|\\.)*"`),
+			Replacement: `${1}"` + vcrRedactedValue + `"`,
+		},
+		// XML protocols, e.g. `<SecretAccessKey>...</SecretAccessKey>`.
+		{
+			Pattern:     regexp.MustCompile(`(?i)(<` + vcrSensitiveName + `>)[^<]*(</)`),
+			Replacement: `${1}` + vcrRedactedValue + `${2}`,
+		},
+		// Query protocols, e.g. `MasterUserPassword=...`.
+		{
+			Pattern:     regexp.MustCompile(`(?i)((?:^|[&?])(?:[a-z0-9.]*\.)?` + vcrSensitiveName + `=)[^&]*`),
+			Replacement: `${1}` + vcrRedactedValue,
+		},
+	}
+	vcrSensitiveNamePattern = regexp.MustCompile(`(?i)^(?:[a-z0-9.]*\.)?` + vcrSensitiveName + `$`)
+)
+
+// AddVCRRedactions adds redactions that are applied to all subsequently recorded AWS API interactions,
+// and to requests before they are matched against recorded interactions.
+// It is typically called from a service package's TestMain or init function.
+func AddVCRRedactions(redactions ...VCRRedaction) {
+	vcrRedactionsLock.Lock()
+	defer vcrRedactionsLock.Unlock()
+
+	vcrRedactions = append(vcrRedactions, redactions...)
+}
+
+var (
+	// vcrAccountIDPattern matches the account ID in ARNs and in `Account`, `AccountId` and `OwnerId` fields
+	// in JSON, XML and query protocol messages, e.g. STS GetCallerIdentity responses.
+	vcrAccountIDPattern = regexp.MustCompile(`(?i)arn:aws[a-z-]*:[a-z0-9-]*:[a-z0-9-]*:(\d{12}):` +
+		`|<(?:account|accountid|ownerid)>(\d{12})</` +
+		`|"(?:account|accountid|ownerid)"\s*:\s*"(\d{12})"` +
+		`|(?:^|[&?])(?:[a-z0-9.]*\.)?(?:accountid|ownerid)(?:\.\d+)?=(\d{12})\b`)
+	// vcrSensitiveHeaders are removed from recorded requests.
+	vcrSensitiveHeaders = []string{
+		"Authorization",
+		"X-Amz-Security-Token",
+	}
+)
+
+// vcrRedactor anonymizes account IDs and redacts secrets in AWS API interactions.
+// Account IDs are only replaced where they match vcrAccountIDPattern, so that unrelated 12-digit numbers are preserved.
+type vcrRedactor struct {
+	accountIDs map[string]string
+	lock       sync.Mutex
+	redactions []VCRRedaction
+}
+
+func newVCRRedactor() *vcrRedactor {
+	vcrRedactionsLock.Lock()
+	defer vcrRedactionsLock.Unlock()
+
+	return &vcrRedactor{
+		accountIDs: make(map[string]string),
+		redactions: append([]VCRRedaction(nil), vcrRedactions...),
+	}
+}
+
+// redact returns s with account IDs anonymized and secrets redacted.
+func (r *vcrRedactor) redact(s string) string {
+	if s == "" {
+		return s
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	s = vcrAccountIDPattern.ReplaceAllStringFunc(s, r.anonymizeAccountID)
+
+	for _, redaction := range r.redactions {
+		s = redaction.Pattern.ReplaceAllString(s, redaction.Replacement)
+	}
+
+	return s
+}
+
+// anonymizeAccountID replaces the account ID in a match of vcrAccountIDPattern.
+func (r *vcrRedactor) anonymizeAccountID(match string) string {
+	loc := vcrAccountIDPattern.FindStringSubmatchIndex(match)
+	if loc == nil {
+		return match
+	}
+
+	for i := 2; i < len(loc); i += 2 {
+		start, end := loc[i], loc[i+1]
+		if start < 0 {
+			continue
+		}
+
+		accountID := match[start:end]
+		replacement, ok := r.accountIDs[accountID]
+		if !ok {
+			replacement = r.nextAccountID(accountID)
+			r.accountIDs[accountID] = replacement
+		}
+
+		return match[:start] + replacement + match[end:]
+	}
+
+	return match
+}
+
+// nextAccountID returns the replacement for a newly discovered account ID.
+// Replacement values are never themselves replaced.
+func (r *vcrRedactor) nextAccountID(accountID string) string {
+	for _, replacement := range r.accountIDs {
+		if replacement == accountID {
+			return accountID
+		}
+	}
+
+	return strconv.Itoa(vcrFirstRedactedAccountID + len(r.accountIDs))
+}
+
+// redactInteraction anonymizes and redacts a recorded interaction in place.
+func (r *vcrRedactor) redactInteraction(i *cassette.Interaction) error {
+	for _, header := range vcrSensitiveHeaders {
+		delete(i.Request.Headers, header)
+	}
+
+	// Redact the response first, as it is most likely to contain the account ID, e.g. sts:GetCallerIdentity.
+	i.Response.Body = r.redact(i.Response.Body)
+	for k, vs := range i.Response.Headers {
+		for j, v := range vs {
+			i.Response.Headers[k][j] = r.redact(v)
+		}
+	}
+
+	u, err := url.Parse(r.redact(i.Request.URL))
+	if err != nil {
+		return fmt.Errorf("redacting request URL: %w", err)
+	}
+	i.Request.URL = u.String()
+	i.Request.Host = r.redact(i.Request.Host)
+	i.Request.Body = r.redact(i.Request.Body)
+	for k, vs := range i.Request.Headers {
+		for j, v := range vs {
+			i.Request.Headers[k][j] = r.redact(v)
+		}
+	}
+	for k, vs := range i.Request.Form {
+		for j, v := range vs {
+			if vcrSensitiveNamePattern.MatchString(k) {
+				i.Request.Form[k][j] = vcrRedactedValue
+			} else {
+				i.Request.Form[k][j] = r.redact(v)
+			}
+		}
+	}
+
+	return nil
+}
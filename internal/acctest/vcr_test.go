@@ -6,6 +6,7 @@ package acctest_test
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
@@ -52,3 +53,183 @@ func TestRandomWithPrefix(t *testing.T) {
 		t.Errorf("REPLAYING: %s, RECORDING: %s", rep2, rec2)
 	}
 }
+
+func TestVCRRedact(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    []string
+		expected []string
+	}{
+		"no sensitive values": {
+			input:    []string{`{"Name":"test"}`},
+			expected: []string{`{"Name":"test"}`},
+		},
+		"caller identity": {
+			input: []string{
+				`<GetCallerIdentityResult><Arn>arn:aws:iam::111122223333:user/test</Arn><UserId>AIDAEXAMPLE</UserId><Account>111122223333</Account></GetCallerIdentityResult>`, //lintignore:AWSAT005
+				`Action=DescribeVpcs&OwnerId.1=111122223333`,
+			},
+			expected: []string{
+				`<GetCallerIdentityResult><Arn>arn:aws:iam::123456789012:user/test</Arn><UserId>AIDAEXAMPLE</UserId><Account>123456789012</Account></GetCallerIdentityResult>`, //lintignore:AWSAT005
+				`Action=DescribeVpcs&OwnerId.1=123456789012`,
+			},
+		},
+		"multiple accounts": {
+			input: []string{
+				`{"RoleArn":"arn:aws:iam::111122223333:role/a","Principal":"arn:aws:iam::444455556666:root"}`, //lintignore:AWSAT005
+				`{"AccountId":"444455556666"}`,
+			},
+			expected: []string{
+				`{"RoleArn":"arn:aws:iam::123456789012:role/a","Principal":"arn:aws:iam::123456789013:root"}`, //lintignore:AWSAT005
+				`{"AccountId":"123456789013"}`,
+			},
+		},
+		"unrelated numbers": {
+			input: []string{
+				`{"OwnerId":"111122223333","Size":111122223333}`,
+				`{"CreationTime":111122223333,"VolumeId":"vol-111122223333"}`,
+			},
+			expected: []string{
+				`{"OwnerId":"123456789012","Size":111122223333}`,
+				`{"CreationTime":111122223333,"VolumeId":"vol-111122223333"}`,
+			},
+		},
+		"EC2 owner": {
+			input:    []string{`<vpcSet><item><vpcId>vpc-12345678</vpcId><ownerId>111122223333</ownerId></item></vpcSet>`},
+			expected: []string{`<vpcSet><item><vpcId>vpc-12345678</vpcId><ownerId>123456789012</ownerId></item></vpcSet>`},
+		},
+		"already redacted": {
+			input:    []string{`arn:aws:sns:us-west-2:123456789012:test`}, //lintignore:AWSAT003,AWSAT005
+			expected: []string{`arn:aws:sns:us-west-2:123456789012:test`}, //lintignore:AWSAT003,AWSAT005
+		},
+		"JSON secrets": {
+			input:    []string{`{"SecretId":"test","SecretString":"s3cr3t \"quoted\"","MasterUserPassword":"hunter2"}`},
+			expected: []string{`{"SecretId":"test","SecretString":"REDACTED","MasterUserPassword":"REDACTED"}`},
+		},
+		"XML secrets": {
+			input:    []string{`<Credentials><AccessKeyId>AKIAEXAMPLE</AccessKeyId><SecretAccessKey>abc/def</SecretAccessKey><SessionToken>xyz</SessionToken></Credentials>`},
+			expected: []string{`<Credentials><AccessKeyId>AKIAEXAMPLE</AccessKeyId><SecretAccessKey>REDACTED</SecretAccessKey><SessionToken>REDACTED</SessionToken></Credentials>`},
+		},
+		"query secrets": {
+			input:    []string{`Action=CreateDBInstance&MasterUserPassword=hunter2&DBInstanceIdentifier=test`},
+			expected: []string{`Action=CreateDBInstance&MasterUserPassword=REDACTED&DBInstanceIdentifier=test`},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := acctest.VCRRedact(testCase.input...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestVCRURLsEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		x, y     string
+		expected bool
+	}{
+		"identical": {
+			x:        "https://ec2.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+			y:        "https://ec2.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+			expected: true,
+		},
+		"different path": {
+			x: "https://lambda.us-west-2.amazonaws.com/2015-03-31/functions/a", //lintignore:AWSAT003
+			y: "https://lambda.us-west-2.amazonaws.com/2015-03-31/functions/b", //lintignore:AWSAT003
+		},
+		"reordered query": {
+			x:        "https://s3.us-west-2.amazonaws.com/bucket?tagging=&versionId=1", //lintignore:AWSAT003
+			y:        "https://s3.us-west-2.amazonaws.com/bucket?versionId=1&tagging=", //lintignore:AWSAT003
+			expected: true,
+		},
+		"idempotency token": {
+			x:        "https://example.com/v1/widgets?clientToken=a&name=test",
+			y:        "https://example.com/v1/widgets?clientToken=b&name=test",
+			expected: true,
+		},
+		"different query": {
+			x: "https://example.com/v1/widgets?name=a",
+			y: "https://example.com/v1/widgets?name=b",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := acctest.VCRURLsEqual(testCase.x, testCase.y), testCase.expected; got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestVCRBodiesEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		contentType string
+		x, y        string
+		expected    bool
+	}{
+		"JSON reordered": {
+			contentType: "application/x-amz-json-1.1",
+			x:           `{"Name":"test","Tags":[{"Key":"k","Value":"v"}]}`,
+			y:           `{"Tags":[{"Value":"v","Key":"k"}],"Name":"test"}`,
+			expected:    true,
+		},
+		"JSON idempotency token": {
+			contentType: "application/x-amz-json-1.1",
+			x:           `{"Name":"test","ClientRequestToken":"a"}`,
+			y:           `{"ClientRequestToken":"b","Name":"test"}`,
+			expected:    true,
+		},
+		"JSON nested idempotency token": {
+			contentType: "application/json",
+			x:           `{"Input":{"clientToken":"a","Name":"test"}}`,
+			y:           `{"Input":{"clientToken":"b","Name":"test"}}`,
+			expected:    true,
+		},
+		"JSON different": {
+			contentType: "application/json",
+			x:           `{"Name":"a"}`,
+			y:           `{"Name":"b"}`,
+		},
+		"form reordered with idempotency token": {
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			x:           `Action=RunInstances&ClientToken=a&ImageId=ami-12345678&Version=2016-11-15`,
+			y:           `Action=RunInstances&ImageId=ami-12345678&ClientToken=b&Version=2016-11-15`,
+			expected:    true,
+		},
+		"form different": {
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			x:           `Action=DescribeVpcs&VpcId.1=vpc-1`,
+			y:           `Action=DescribeVpcs&VpcId.1=vpc-2`,
+		},
+		"unknown content type": {
+			contentType: "application/octet-stream",
+			x:           `a`,
+			y:           `b`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := acctest.Context(t)
+
+			if got, want := acctest.VCRBodiesEqual(ctx, testCase.contentType, testCase.x, testCase.y), testCase.expected; got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -5,11 +5,13 @@ package conns
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"iter"
 	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
@@ -23,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/dns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -30,21 +33,25 @@ import (
 type AWSClient struct {
 	accountID                 string
 	awsConfig                 *aws.Config
-	clients                   map[string]any
+	clients                   map[clientCacheKey]any
+	concurrencyLimiters       map[string]tfsync.Semaphore // Keyed by resource type name.
 	defaultTagsConfig         *tftags.DefaultConfig
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
+	ignoreAttributes          map[string][]string // Keyed by resource type name.
 	ignoreTagsConfig          *tftags.IgnoreConfig
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
+	rateLimiters              map[string]*rateLimiter // Keyed by service package name.
 	region                    string
 	servicePackages           map[string]ServicePackage
 	session                   *session_sdkv1.Session
-	s3ExpressClient           *s3.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	s3ExpressClients          map[string]*s3.Client // Keyed by Region.
+	s3UsePathStyle            bool                  // From provider configuration.
+	s3USEast1RegionalEndpoint string                // From provider configuration.
+	stsRegion                 string                // From provider configuration.
+	tagBatcher                *tagBatcher           // nil unless tag update batching is enabled.
 }
 
 func (c *AWSClient) SetServicePackages(_ context.Context, servicePackages map[string]ServicePackage) {
@@ -79,8 +86,36 @@ func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
 
-func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
-	return c.awsConfig.Copy()
+// IgnoreAttributes returns the dot-separated paths of attributes whose changes are ignored for the specified resource type.
+func (c *AWSClient) IgnoreAttributes(_ context.Context, typeName string) []string {
+	return c.ignoreAttributes[typeName]
+}
+
+// ConcurrencyLimiter returns any semaphore limiting the number of concurrent CRUD operations for the specified resource type.
+func (c *AWSClient) ConcurrencyLimiter(_ context.Context, typeName string) (tfsync.Semaphore, bool) {
+	v, ok := c.concurrencyLimiters[typeName]
+	return v, ok
+}
+
+// TagUpdateBatchingEnabled returns whether tag-only updates may be batched using the Resource Groups Tagging API.
+func (c *AWSClient) TagUpdateBatchingEnabled(context.Context) bool {
+	return c.tagBatcher != nil
+}
+
+// BatchUpdateTags updates the tags of the resource with the specified ARN using the Resource Groups Tagging API.
+// The change is combined with identical changes to other resources in the same Region into calls of up to 20 ARNs.
+func (c *AWSClient) BatchUpdateTags(ctx context.Context, arn string, removedTags, updatedTags tftags.KeyValueTags) error {
+	if c.tagBatcher == nil {
+		return errors.New("tag update batching is not enabled")
+	}
+
+	return c.tagBatcher.updateTags(ctx, c.Region(ctx), arn, removedTags, updatedTags)
+}
+
+func (c *AWSClient) AwsConfig(ctx context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
+	cfg := c.awsConfig.Copy()
+	cfg.Region = c.Region(ctx)
+	return cfg
 }
 
 // AwsSession and Endpoints can be removed once the simpledb service is removed.
@@ -98,15 +133,32 @@ func (c *AWSClient) AccountID(context.Context) string {
 }
 
 // Partition returns the ID of the configured AWS partition.
-func (c *AWSClient) Partition(context.Context) string {
-	return c.partition.ID()
+// If the Region is overridden for the current resource the partition of that Region is returned.
+func (c *AWSClient) Partition(ctx context.Context) string {
+	return c.partitionFor(ctx).ID()
 }
 
 // Region returns the ID of the configured AWS Region.
-func (c *AWSClient) Region(context.Context) string {
+// If the Region is overridden for the current resource that Region is returned.
+func (c *AWSClient) Region(ctx context.Context) string {
+	if v, ok := FromContext(ctx); ok {
+		if region := v.OverrideRegion(); region != "" {
+			return region
+		}
+	}
 	return c.region
 }
 
+// partitionFor returns the AWS partition for the Region in effect.
+func (c *AWSClient) partitionFor(ctx context.Context) endpoints.Partition {
+	if region := c.Region(ctx); region != c.region {
+		if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+			return partition
+		}
+	}
+	return c.partition
+}
+
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
 // e.g. PREFIX.amazonaws.com
 // The prefix should not contain a trailing period.
@@ -167,22 +219,29 @@ func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string
 // In that case the returned client uses the regional S3 endpoint.
 func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3.Client {
 	s3Client := c.S3Client(ctx)
+	region := c.Region(ctx)
 
 	c.lock.Lock() // OK since a non-default client is created.
 	defer c.lock.Unlock()
 
-	if c.s3ExpressClient == nil {
+	if c.s3ExpressClients == nil {
+		c.s3ExpressClients = make(map[string]*s3.Client)
+	}
+
+	s3ExpressClient, ok := c.s3ExpressClients[region]
+	if !ok {
 		if s3Client.Options().Region == endpoints.AwsGlobalRegionID {
 			// No global endpoint for S3 Express.
-			c.s3ExpressClient = errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
+			s3ExpressClient = errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
 				"s3_us_east_1_regional_endpoint": "regional",
 			}))
 		} else {
-			c.s3ExpressClient = s3Client
+			s3ExpressClient = s3Client
 		}
+		c.s3ExpressClients[region] = s3ExpressClient
 	}
 
-	return c.s3ExpressClient
+	return s3ExpressClient
 }
 
 // S3UsePathStyle returns the s3_force_path_style provider configuration value.
@@ -266,8 +325,8 @@ func (c *AWSClient) GlobalAcceleratorHostedZoneID(context.Context) string {
 }
 
 // DNSSuffix returns the domain suffix for the configured AWS partition.
-func (c *AWSClient) DNSSuffix(context.Context) string {
-	dnsSuffix := c.partition.DNSSuffix()
+func (c *AWSClient) DNSSuffix(ctx context.Context) string {
+	dnsSuffix := c.partitionFor(ctx).DNSSuffix()
 	if dnsSuffix == "" {
 		dnsSuffix = "amazonaws.com"
 	}
@@ -316,8 +375,20 @@ func convertIPToDashIP(ip string) string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig := c.awsConfig
+	if region := c.Region(ctx); region != c.region {
+		cfg := c.awsConfig.Copy()
+		cfg.Region = region
+		awsConfig = &cfg
+	}
+	if l, ok := c.rateLimiters[servicePackageName]; ok {
+		cfg := awsConfig.Copy()
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), addRateLimitMiddleware(l))
+		awsConfig = &cfg
+	}
+
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 	}
@@ -337,19 +408,29 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 	return m
 }
 
+// clientCacheKey is the key for cached AWS SDK for Go v2 API clients.
+type clientCacheKey struct {
+	region             string
+	servicePackageName string
+}
+
 // client returns the AWS SDK for Go v2 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty) is cached per Region. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
 	isDefault := len(extra) == 0
+	key := clientCacheKey{
+		region:             c.Region(ctx),
+		servicePackageName: servicePackageName,
+	}
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.clients[servicePackageName]; ok {
+		if raw, ok := c.clients[key]; ok {
 			if client, ok := raw.(T); ok {
 				return client, nil
 			} else {
@@ -384,7 +465,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		c.clients[servicePackageName] = client
+		c.clients[key] = client
 	}
 
 	return client, nil
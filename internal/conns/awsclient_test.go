@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 var (
@@ -184,3 +185,57 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestAWSClientRegionalARNOverrideRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		AWSClient      *AWSClient
+		OverrideRegion string
+		Expected       string
+	}{
+		{
+			Name: "no override",
+			AWSClient: &AWSClient{
+				accountID: "123456789012",
+				partition: standardPartition,
+				region:    "us-west-2", //lintignore:AWSAT003
+			},
+			Expected: "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name: "same partition",
+			AWSClient: &AWSClient{
+				accountID: "123456789012",
+				partition: standardPartition,
+				region:    "us-west-2", //lintignore:AWSAT003
+			},
+			OverrideRegion: "eu-west-1",                                           //lintignore:AWSAT003
+			Expected:       "arn:aws:ec2:eu-west-1:123456789012:vpc/vpc-12345678", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name: "other partition",
+			AWSClient: &AWSClient{
+				accountID: "123456789012",
+				partition: standardPartition,
+				region:    "us-west-2", //lintignore:AWSAT003
+			},
+			OverrideRegion: "cn-northwest-1",                                              //lintignore:AWSAT003
+			Expected:       "arn:aws-cn:ec2:cn-northwest-1:123456789012:vpc/vpc-12345678", //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewResourceContext(context.TODO(), names.EC2, "VPC", testCase.OverrideRegion)
+			got := testCase.AWSClient.RegionalARN(ctx, "ec2", "vpc/vpc-12345678")
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -48,6 +48,14 @@ func (c *v1CompatibleBackoff) BackoffDelay(attempt int, err error) (time.Duratio
 	return delay, nil
 }
 
+// noBackoff is a Backoff that retries immediately.
+// It is used when replaying recorded AWS API interactions.
+type noBackoff struct{}
+
+func (noBackoff) BackoffDelay(int, error) (time.Duration, error) {
+	return 0, nil
+}
+
 func getJitterDelay(duration time.Duration) time.Duration {
 	return time.Duration(seededRand.Int63n(int64(duration)) + int64(duration))
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/aws/smithy-go/middleware"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
 	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
@@ -21,7 +22,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
@@ -29,8 +32,11 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	APIOptions                     []func(*middleware.Stack) error // Additional AWS SDK for Go v2 API client options.
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	BatchTagUpdates                bool
+	ConcurrencyLimits              map[string]int // Keyed by resource type name.
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
@@ -40,11 +46,13 @@ type Config struct {
 	ForbiddenAccountIds            []string
 	HTTPProxy                      *string
 	HTTPSProxy                     *string
+	IgnoreAttributes               map[string][]string // Keyed by resource type name.
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	RateLimits                     map[string]RateLimit // Keyed by service package name.
 	Region                         string
 	RetryMode                      aws.RetryMode
 	S3UsePathStyle                 bool
@@ -115,6 +123,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:                c.UseFIPSEndpoint,
 	}
 
+	if vcr.IsReplaying() {
+		awsbaseConfig.Backoff = noBackoff{}
+	}
+
 	if c.CustomCABundle != "" {
 		awsbaseConfig.CustomCABundle = c.CustomCABundle
 	}
@@ -156,6 +168,8 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	cfg.APIOptions = append(cfg.APIOptions, c.APIOptions...)
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -209,6 +223,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	client.accountID = accountID
 	client.defaultTagsConfig = c.DefaultTagsConfig
+	client.ignoreAttributes = c.IgnoreAttributes
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
 	client.region = c.Region
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
@@ -216,12 +231,23 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
-	client.clients = make(map[string]any, 0)
+	client.clients = make(map[clientCacheKey]any, 0)
+	client.concurrencyLimiters = make(map[string]tfsync.Semaphore, len(c.ConcurrencyLimits))
+	for typeName, limit := range c.ConcurrencyLimits {
+		client.concurrencyLimiters[typeName] = tfsync.NewSemaphore(limit)
+	}
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.rateLimiters = make(map[string]*rateLimiter, len(c.RateLimits))
+	for servicePackageName, limit := range c.RateLimits {
+		client.rateLimiters[servicePackageName] = newRateLimiter(limit)
+	}
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
+	if c.BatchTagUpdates {
+		client.tagBatcher = newTagBatcher(client.applyTagBatch)
+	}
 
 	return client, diags
 }
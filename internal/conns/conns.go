@@ -26,6 +26,13 @@ type ServicePackageWithEphemeralResources interface {
 	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
 }
 
+// ServicePackageWithSDKListResources is an interface that extends ServicePackage with list resources for Plugin SDK resources.
+// List resources are used to discover existing resources, e.g. by `terraform query`.
+type ServicePackageWithSDKListResources interface {
+	ServicePackage
+	SDKListResources(context.Context) []*types.ServicePackageSDKListResource
+}
+
 type (
 	contextKeyType int
 )
@@ -38,6 +45,7 @@ var (
 type InContext struct {
 	isDataSource        bool   // Data source?
 	isEphemeralResource bool   // Ephemeral resource?
+	overrideRegion      string // Per-resource Region override, e.g. "us-west-2"
 	resourceName        string // Friendly resource name, e.g. "Subnet"
 	servicePackageName  string // Canonical name defined as a constant in names package
 }
@@ -52,6 +60,12 @@ func (c *InContext) IsEphemeralResource() bool {
 	return c.isEphemeralResource
 }
 
+// OverrideRegion returns any per-resource Region override.
+// An empty value indicates that the provider's configured Region is used.
+func (c *InContext) OverrideRegion() string {
+	return c.overrideRegion
+}
+
 // ResourceName returns the friendly resource name, e.g. "Subnet".
 func (c *InContext) ResourceName() string {
 	return c.resourceName
@@ -62,9 +76,10 @@ func (c *InContext) ServicePackageName() string {
 	return c.servicePackageName
 }
 
-func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName, overrideRegion string) context.Context {
 	v := InContext{
 		isDataSource:       true,
+		overrideRegion:     overrideRegion,
 		resourceName:       resourceName,
 		servicePackageName: servicePackageName,
 	}
@@ -72,9 +87,10 @@ func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName, overrideRegion string) context.Context {
 	v := InContext{
 		isEphemeralResource: true,
+		overrideRegion:      overrideRegion,
 		resourceName:        resourceName,
 		servicePackageName:  servicePackageName,
 	}
@@ -82,8 +98,9 @@ func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resour
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, overrideRegion string) context.Context {
 	v := InContext{
+		overrideRegion:     overrideRegion,
 		resourceName:       resourceName,
 		servicePackageName: servicePackageName,
 	}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RateLimit is a client-side API request rate limit for a single service.
+type RateLimit struct {
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// rateLimiter is a token bucket rate limiter.
+// A single rateLimiter is shared by all API clients for a service, irrespective of Region.
+type rateLimiter struct {
+	burst  float64
+	lock   sync.Mutex
+	last   time.Time
+	now    func() time.Time
+	rate   float64 // Tokens per second.
+	tokens float64
+}
+
+func newRateLimiter(limit RateLimit) *rateLimiter {
+	burst := float64(limit.Burst)
+	if burst < 1 {
+		burst = math.Max(1, math.Ceil(limit.RequestsPerSecond))
+	}
+
+	return &rateLimiter{
+		burst:  burst,
+		now:    time.Now,
+		rate:   limit.RequestsPerSecond,
+		tokens: burst,
+	}
+}
+
+// reserve takes a token from the bucket, returning how long the caller must wait before the token is available.
+func (l *rateLimiter) reserve() time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns an unused token to the bucket.
+func (l *rateLimiter) cancel() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.tokens = math.Min(l.burst, l.tokens+1)
+}
+
+// wait blocks until a token is available or the context is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	tflog.Debug(ctx, "Waiting for client-side rate limit", map[string]any{
+		"tf_aws.rate_limit.delay": delay.String(),
+	})
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+const rateLimitMiddlewareID = "TF_AWS_RateLimit"
+
+// addRateLimitMiddleware adds middleware that paces each API request attempt, including retries, through the rate limiter.
+func addRateLimitMiddleware(l *rateLimiter) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		m := middleware.FinalizeMiddlewareFunc(rateLimitMiddlewareID, func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			if err := l.wait(ctx); err != nil {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, fmt.Errorf("waiting for client-side rate limit: %w", err)
+			}
+
+			return next.HandleFinalize(ctx, in)
+		})
+
+		// Insert after the retry middleware so that every attempt is rate limited.
+		if err := stack.Finalize.Insert(m, "Retry", middleware.After); err != nil {
+			return stack.Finalize.Add(m, middleware.Before)
+		}
+
+		return nil
+	}
+}
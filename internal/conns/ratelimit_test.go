@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		limit         RateLimit
+		elapsed       []time.Duration // Time elapsed before each reservation.
+		expectedDelay []time.Duration
+	}{
+		"burst": {
+			limit:         RateLimit{RequestsPerSecond: 5, Burst: 2},
+			elapsed:       []time.Duration{0, 0, 0, 0},
+			expectedDelay: []time.Duration{0, 0, 200 * time.Millisecond, 400 * time.Millisecond},
+		},
+		"refill": {
+			limit:         RateLimit{RequestsPerSecond: 5, Burst: 1},
+			elapsed:       []time.Duration{0, 200 * time.Millisecond, 100 * time.Millisecond},
+			expectedDelay: []time.Duration{0, 0, 100 * time.Millisecond},
+		},
+		"refill capped at burst": {
+			limit:         RateLimit{RequestsPerSecond: 10, Burst: 1},
+			elapsed:       []time.Duration{0, 10 * time.Second, 0},
+			expectedDelay: []time.Duration{0, 0, 100 * time.Millisecond},
+		},
+		"default burst": {
+			limit:         RateLimit{RequestsPerSecond: 2},
+			elapsed:       []time.Duration{0, 0, 0},
+			expectedDelay: []time.Duration{0, 0, 500 * time.Millisecond},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			now := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+			l := newRateLimiter(testCase.limit)
+			l.now = func() time.Time { return now }
+
+			for i, elapsed := range testCase.elapsed {
+				now = now.Add(elapsed)
+
+				if got, want := l.reserve(), testCase.expectedDelay[i]; got != want {
+					t.Errorf("reservation %d: got %s, want %s", i, got, want)
+				}
+			}
+		})
+	}
+}
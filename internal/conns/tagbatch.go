@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+const (
+	// TagResources and UntagResources accept at most 20 ARNs and 50 tags or tag keys.
+	tagBatchMaxResources = 20
+	tagBatchMaxTags      = 50
+	// How long a batch waits for identical tag changes to other resources before it is applied.
+	tagBatchWait = 2 * time.Second
+)
+
+// tagBatch is an identical tag change to a number of resources.
+type tagBatch struct {
+	arns        []string
+	done        chan struct{}
+	errs        map[string]error // Keyed by ARN.
+	removedTags tftags.KeyValueTags
+	updatedTags tftags.KeyValueTags
+}
+
+// tagBatcher combines identical tag changes to different resources so that they can be applied together.
+// A single tagBatcher is shared by all resources, irrespective of Region.
+type tagBatcher struct {
+	apply   func(context.Context, *tagBatch) // Sets errs for each ARN that failed.
+	lock    sync.Mutex
+	pending map[string]*tagBatch // Keyed by Region and tag change.
+	wait    time.Duration
+}
+
+func newTagBatcher(apply func(context.Context, *tagBatch)) *tagBatcher {
+	return &tagBatcher{
+		apply:   apply,
+		pending: make(map[string]*tagBatch),
+		wait:    tagBatchWait,
+	}
+}
+
+// updateTags adds the resource to the pending batch for the tag change and waits for the batch to be applied.
+// A batch is applied once it contains the maximum number of resources or its wait has elapsed.
+func (b *tagBatcher) updateTags(ctx context.Context, region, arn string, removedTags, updatedTags tftags.KeyValueTags) error {
+	key := tagBatchKey(region, removedTags, updatedTags)
+
+	b.lock.Lock()
+	batch, ok := b.pending[key]
+	if !ok {
+		batch = &tagBatch{
+			done:        make(chan struct{}),
+			errs:        make(map[string]error),
+			removedTags: removedTags,
+			updatedTags: updatedTags,
+		}
+		b.pending[key] = batch
+		// The batch is applied using the context of its first resource, which determines the Region.
+		time.AfterFunc(b.wait, func() {
+			b.flush(ctx, key, batch)
+		})
+	}
+	batch.arns = append(batch.arns, arn)
+	full := len(batch.arns) == tagBatchMaxResources
+	b.lock.Unlock()
+
+	if full {
+		b.flush(ctx, key, batch)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-batch.done:
+		return batch.errs[arn]
+	}
+}
+
+// flush applies the batch if it is still pending.
+func (b *tagBatcher) flush(ctx context.Context, key string, batch *tagBatch) {
+	b.lock.Lock()
+	if b.pending[key] != batch {
+		b.lock.Unlock()
+		return
+	}
+	delete(b.pending, key)
+	b.lock.Unlock()
+
+	tflog.Debug(ctx, "Applying batched tag update", map[string]any{
+		"tf_aws.tag_batch.resources": len(batch.arns),
+	})
+
+	b.apply(context.WithoutCancel(ctx), batch)
+	close(batch.done)
+}
+
+func tagBatchKey(region string, removedTags, updatedTags tftags.KeyValueTags) string {
+	removedKeys := removedTags.Keys()
+	slices.Sort(removedKeys)
+
+	// JSON encoding of maps is sorted by key.
+	v, _ := json.Marshal([]any{region, removedKeys, updatedTags.Map()})
+
+	return string(v)
+}
+
+// applyTagBatch applies the batch's tag change using the Resource Groups Tagging API.
+func (c *AWSClient) applyTagBatch(ctx context.Context, batch *tagBatch) {
+	conn := c.ResourceGroupsTaggingAPIClient(ctx)
+
+	// arns returns the ARNs of the resources that haven't yet failed.
+	arns := func() []string {
+		return slices.DeleteFunc(slices.Clone(batch.arns), func(arn string) bool {
+			return batch.errs[arn] != nil
+		})
+	}
+	setErr := func(arns []string, err error) {
+		for _, arn := range arns {
+			batch.errs[arn] = err
+		}
+	}
+	setFailures := func(failures map[string]awstypes.FailureInfo) {
+		for arn, v := range failures {
+			batch.errs[arn] = fmt.Errorf("%s: %s", v.ErrorCode, aws.ToString(v.ErrorMessage))
+		}
+	}
+
+	for _, tags := range batch.removedTags.Chunks(tagBatchMaxTags) {
+		arns := arns()
+		if len(arns) == 0 {
+			return
+		}
+
+		input := resourcegroupstaggingapi.UntagResourcesInput{
+			ResourceARNList: arns,
+			TagKeys:         tags.Keys(),
+		}
+		output, err := conn.UntagResources(ctx, &input)
+
+		if err != nil {
+			setErr(arns, fmt.Errorf("untagging resources: %w", err))
+			return
+		}
+
+		setFailures(output.FailedResourcesMap)
+	}
+
+	for _, tags := range batch.updatedTags.Chunks(tagBatchMaxTags) {
+		arns := arns()
+		if len(arns) == 0 {
+			return
+		}
+
+		input := resourcegroupstaggingapi.TagResourcesInput{
+			ResourceARNList: arns,
+			Tags:            tags.Map(),
+		}
+		output, err := conn.TagResources(ctx, &input)
+
+		if err != nil {
+			setErr(arns, fmt.Errorf("tagging resources: %w", err))
+			return
+		}
+
+		setFailures(output.FailedResourcesMap)
+	}
+}
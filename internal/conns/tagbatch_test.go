@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestTagBatcherUpdateTags(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		region      string
+		arn         string
+		removedTags []string
+		updatedTags map[string]string
+	}
+
+	manyRequests := func(n int) []request {
+		var requests []request
+		for i := range n {
+			requests = append(requests, request{region: "r1", arn: fmt.Sprintf("arn:%02d", i), updatedTags: map[string]string{"k1": "v1"}})
+		}
+		return requests
+	}
+
+	testCases := map[string]struct {
+		requests        []request
+		wait            time.Duration
+		failARNs        []string
+		expectedBatches []string // Sorted ARNs of each batch, comma-separated.
+	}{
+		"identical changes": {
+			requests: []request{
+				{region: "r1", arn: "arn:1", updatedTags: map[string]string{"k1": "v1"}},
+				{region: "r1", arn: "arn:2", updatedTags: map[string]string{"k1": "v1"}},
+				{region: "r1", arn: "arn:3", updatedTags: map[string]string{"k1": "v1"}},
+			},
+			wait:            100 * time.Millisecond,
+			expectedBatches: []string{"arn:1,arn:2,arn:3"},
+		},
+		"different changes": {
+			requests: []request{
+				{region: "r1", arn: "arn:1", updatedTags: map[string]string{"k1": "v1"}},
+				{region: "r1", arn: "arn:2", updatedTags: map[string]string{"k1": "v2"}},
+				{region: "r1", arn: "arn:3", removedTags: []string{"k1"}},
+				{region: "r1", arn: "arn:4", removedTags: []string{"k1"}, updatedTags: map[string]string{"k1": "v1"}},
+				{region: "r1", arn: "arn:5", updatedTags: map[string]string{"k1": "v1"}},
+			},
+			wait:            100 * time.Millisecond,
+			expectedBatches: []string{"arn:1,arn:5", "arn:2", "arn:3", "arn:4"},
+		},
+		"different Regions": {
+			requests: []request{
+				{region: "r1", arn: "arn:1", updatedTags: map[string]string{"k1": "v1"}},
+				{region: "r2", arn: "arn:2", updatedTags: map[string]string{"k1": "v1"}},
+			},
+			wait:            100 * time.Millisecond,
+			expectedBatches: []string{"arn:1", "arn:2"},
+		},
+		"full batch applied immediately": {
+			requests: manyRequests(tagBatchMaxResources),
+			wait:     time.Hour,
+			expectedBatches: []string{
+				"arn:00,arn:01,arn:02,arn:03,arn:04,arn:05,arn:06,arn:07,arn:08,arn:09,arn:10,arn:11,arn:12,arn:13,arn:14,arn:15,arn:16,arn:17,arn:18,arn:19",
+			},
+		},
+		"failures": {
+			requests: []request{
+				{region: "r1", arn: "arn:1", updatedTags: map[string]string{"k1": "v1"}},
+				{region: "r1", arn: "arn:2", updatedTags: map[string]string{"k1": "v1"}},
+			},
+			wait:            100 * time.Millisecond,
+			failARNs:        []string{"arn:2"},
+			expectedBatches: []string{"arn:1,arn:2"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			var lock sync.Mutex
+			var batches []string
+			b := newTagBatcher(func(_ context.Context, batch *tagBatch) {
+				arns := slices.Clone(batch.arns)
+				slices.Sort(arns)
+
+				lock.Lock()
+				batches = append(batches, strings.Join(arns, ","))
+				lock.Unlock()
+
+				for _, arn := range testCase.failARNs {
+					if slices.Contains(batch.arns, arn) {
+						batch.errs[arn] = errors.New("failed")
+					}
+				}
+			})
+			b.wait = testCase.wait
+
+			errs := make([]error, len(testCase.requests))
+			var wg sync.WaitGroup
+			for i, request := range testCase.requests {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+
+					errs[i] = b.updateTags(ctx, request.region, request.arn, tftags.New(ctx, request.removedTags), tftags.New(ctx, request.updatedTags))
+				}()
+			}
+			wg.Wait()
+
+			for i, request := range testCase.requests {
+				if got, want := errs[i] != nil, slices.Contains(testCase.failARNs, request.arn); got != want {
+					t.Errorf("%s: err %t, want %t (%v)", request.arn, got, want, errs[i])
+				}
+			}
+
+			slices.Sort(batches)
+			if diff := cmp.Diff(batches, testCase.expectedBatches); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
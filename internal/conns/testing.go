@@ -7,6 +7,11 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
+// SetAccountID is only intended for use in tests
+func SetAccountID(client *AWSClient, accountID string) {
+	client.accountID = accountID
+}
+
 // SetDefaultTagsConfig is only intended for use in tests
 func SetDefaultTagsConfig(client *AWSClient, d *tftags.DefaultConfig) {
 	client.defaultTagsConfig = d
@@ -16,3 +21,8 @@ func SetDefaultTagsConfig(client *AWSClient, d *tftags.DefaultConfig) {
 func SetIgnoreTagsConfig(client *AWSClient, i *tftags.IgnoreConfig) {
 	client.ignoreTagsConfig = i
 }
+
+// SetRegion is only intended for use in tests
+func SetRegion(client *AWSClient, region string) {
+	client.region = region
+}
@@ -4,6 +4,7 @@
 package sync
 
 import (
+	"context"
 	"os"
 	"strconv"
 	"sync"
@@ -15,6 +16,11 @@ import (
 // This can be used to work with resources with low quotas.
 type Semaphore chan struct{}
 
+// NewSemaphore returns a new semaphore with the specified capacity.
+func NewSemaphore(limit int) Semaphore {
+	return make(Semaphore, limit)
+}
+
 var semaphoreKV = &struct {
 	lock  sync.Locker
 	store map[string]Semaphore
@@ -51,8 +57,27 @@ func (s Semaphore) Wait() {
 	s <- struct{}{}
 }
 
+// TryWait acquires a semaphore without blocking, returning whether the semaphore was acquired.
+func (s Semaphore) TryWait() bool {
+	select {
+	case s <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// WaitContext waits for a semaphore before continuing or until the context is done.
+func (s Semaphore) WaitContext(ctx context.Context) error {
+	select {
+	case s <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // Notify releases a semaphore
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func (s Semaphore) Notify() {
 	// Make the Notify non-blocking. This can happen if a Wait was never issued
 	select {
@@ -54,6 +54,23 @@ func GetWriteOnlyValue(d writeOnlyAttrGetter, path cty.Path, attrType cty.Type)
 	return valueWO, diags
 }
 
+// GetStringValueOrWriteOnlyStringValue returns the string value of the write-only attribute from the config if set,
+// otherwise the value of the corresponding (non-write-only) attribute.
+func GetStringValueOrWriteOnlyStringValue(d writeOnlyAttrGetter, attr, attrWO string) (string, diag.Diagnostics) {
+	valueWO, diags := GetWriteOnlyStringValue(d, cty.GetAttrPath(attrWO))
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if valueWO != "" {
+		return valueWO, diags
+	}
+
+	value, _ := d.Get(attr).(string)
+
+	return value, diags
+}
+
 // HasWriteOnlyValue returns true if the write-only attribute is present in the config.
 func HasWriteOnlyValue(d writeOnlyAttrGetter, attr string) bool {
 	hasAttr := fmt.Sprintf("has_%s", attr)
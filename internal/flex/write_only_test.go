@@ -13,12 +13,13 @@ import (
 )
 
 type mockWriteOnlyAttrGetter struct {
+	attrs map[string]any
 	path  cty.Path
 	value cty.Value
 }
 
-func (m *mockWriteOnlyAttrGetter) Get(string) any {
-	return nil
+func (m *mockWriteOnlyAttrGetter) Get(k string) any {
+	return m.attrs[k]
 }
 
 func (m *mockWriteOnlyAttrGetter) GetRawConfigAt(path cty.Path) (cty.Value, diag.Diagnostics) {
@@ -137,3 +138,54 @@ func TestGetWriteOnlyStringValue(t *testing.T) {
 		})
 	}
 }
+
+func TestGetStringValueOrWriteOnlyStringValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attrs         map[string]any
+		value         cty.Value
+		expectedValue string
+	}{
+		"write-only value": {
+			attrs:         map[string]any{"password": ""},
+			value:         cty.StringVal("write_only"),
+			expectedValue: "write_only",
+		},
+		"write-only value null": {
+			attrs:         map[string]any{"password": "value"},
+			value:         cty.NullVal(cty.String),
+			expectedValue: "value",
+		},
+		"both set": {
+			attrs:         map[string]any{"password": "value"},
+			value:         cty.StringVal("write_only"),
+			expectedValue: "write_only",
+		},
+		"neither set": {
+			value:         cty.NullVal(cty.String),
+			expectedValue: "",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m := mockWriteOnlyAttrGetter{
+				attrs: testCase.attrs,
+				path:  cty.GetAttrPath("password_wo"),
+				value: testCase.value,
+			}
+			value, diags := flex.GetStringValueOrWriteOnlyStringValue(&m, "password", "password_wo")
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if testCase.expectedValue != value {
+				t.Fatalf("expected value: %s, got: %s", testCase.expectedValue, value)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	exclusiveRelationshipDefaultTimeout = 2 * time.Minute
+)
+
+// ExclusiveRelationship is a shared diff and retry helper for resources that manage an authoritative ("exclusive")
+// relationship between a parent resource and its members, e.g. the inline policies of an IAM role.
+// Resources declare how to find, add and remove members one at a time and call Sync from Create and Update
+// to converge the parent's members on the configured members, or embed ExclusiveRelationshipResource.
+// Relationships whose members are changed in batches, e.g. Route 53 records, are not supported.
+type ExclusiveRelationship[T any] struct {
+	// Find returns the parent's current members.
+	// A tfresource.NotFound error indicates that the parent does not exist.
+	Find func(ctx context.Context, parent string) ([]T, error)
+	// Add adds a member to the parent.
+	Add func(ctx context.Context, parent string, member T) error
+	// Remove removes a member from the parent.
+	Remove func(ctx context.Context, parent string, member T) error
+	// Equal returns whether two members are the same member.
+	Equal func(T, T) bool
+	// MemberString, if set, returns a member's identifier for error messages.
+	MemberString func(T) string
+	// Retryable, if set, determines whether a failed Add or Remove is retried.
+	Retryable tfresource.Retryable
+	// Timeout is the maximum time that an Add or Remove is retried for.
+	// Defaults to 2 minutes.
+	Timeout time.Duration
+}
+
+// Diff returns the members that must be added to, and removed from, the parent to converge on the wanted members.
+func (r ExclusiveRelationship[T]) Diff(have, want []T) ([]T, []T) {
+	add, remove, _ := flex.DiffSlices(have, append([]T(nil), want...), r.Equal)
+
+	return add, remove
+}
+
+// Sync adds any wanted members missing from the parent and removes any members of the parent that are not wanted.
+func (r ExclusiveRelationship[T]) Sync(ctx context.Context, parent string, want []T) error {
+	have, err := r.Find(ctx, parent)
+	if err != nil {
+		return err
+	}
+
+	add, remove := r.Diff(have, want)
+
+	for _, member := range add {
+		if err := r.retry(ctx, func() error { return r.Add(ctx, parent, member) }); err != nil {
+			return fmt.Errorf("adding %s: %w", r.memberString(member), err)
+		}
+	}
+
+	for _, member := range remove {
+		if err := r.retry(ctx, func() error { return r.Remove(ctx, parent, member) }); err != nil {
+			return fmt.Errorf("removing %s: %w", r.memberString(member), err)
+		}
+	}
+
+	return nil
+}
+
+func (r ExclusiveRelationship[T]) retry(ctx context.Context, f func() error) error {
+	if r.Retryable == nil {
+		return f()
+	}
+
+	timeout := r.Timeout
+	if timeout == 0 {
+		timeout = exclusiveRelationshipDefaultTimeout
+	}
+
+	_, err := tfresource.RetryWhen(ctx, timeout, func() (any, error) { return nil, f() }, r.Retryable)
+
+	return err
+}
+
+func (r ExclusiveRelationship[T]) memberString(member T) string {
+	if r.MemberString != nil {
+		return r.MemberString(member)
+	}
+
+	return fmt.Sprintf("%v", member)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// ExclusiveRelationshipResource is intended to be embedded in resources that manage an exclusive relationship
+// between a parent, identified by a String attribute, and its members, held in a Set of String attribute.
+// It implements Create, Read, Update, ImportState and a no-op Delete.
+// Resources declare their Schema and call SetExclusiveRelationship from their constructor.
+type ExclusiveRelationshipResource struct {
+	ResourceWithConfigure
+	WithNoOpDelete
+
+	service, name                     string
+	parentAttribute, membersAttribute string
+	relationship                      func(context.Context, *conns.AWSClient) ExclusiveRelationship[string]
+}
+
+// SetExclusiveRelationship sets the resource's service and resource names, used in error messages,
+// the names of its parent and members attributes, and a function returning the relationship that it manages.
+func (r *ExclusiveRelationshipResource) SetExclusiveRelationship(service, name, parentAttribute, membersAttribute string, relationship func(context.Context, *conns.AWSClient) ExclusiveRelationship[string]) {
+	r.service = service
+	r.name = name
+	r.parentAttribute = parentAttribute
+	r.membersAttribute = membersAttribute
+	r.relationship = relationship
+}
+
+func (r *ExclusiveRelationshipResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var parent types.String
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(r.parentAttribute), &parent)...)
+	var members []string
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(r.membersAttribute), &members)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.relationship(ctx, r.Meta()).Sync(ctx, parent.ValueString(), members); err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(r.service, create.ErrActionCreating, r.name, parent.String(), err), err.Error())
+
+		return
+	}
+
+	response.State.Raw = request.Plan.Raw
+}
+
+// Read sets the members attribute to the parent's current members so that members added or removed outside of Terraform are shown as differences.
+func (r *ExclusiveRelationshipResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var parent types.String
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(r.parentAttribute), &parent)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := r.relationship(ctx, r.Meta()).Find(ctx, parent.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(r.service, create.ErrActionReading, r.name, parent.String(), err), err.Error())
+
+		return
+	}
+
+	elements := make([]attr.Value, 0, len(members))
+	for _, member := range members {
+		elements = append(elements, types.StringValue(member))
+	}
+	set, diags := types.SetValue(types.StringType, elements)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(r.membersAttribute), set)...)
+}
+
+func (r *ExclusiveRelationshipResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var parent types.String
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(r.parentAttribute), &parent)...)
+	var old, new types.Set
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(r.membersAttribute), &old)...)
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(r.membersAttribute), &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.Equal(old) {
+		var members []string
+		response.Diagnostics.Append(new.ElementsAs(ctx, &members, false)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if err := r.relationship(ctx, r.Meta()).Sync(ctx, parent.ValueString(), members); err != nil {
+			response.Diagnostics.AddError(create.ProblemStandardMessage(r.service, create.ErrActionUpdating, r.name, parent.String(), err), err.Error())
+
+			return
+		}
+	}
+
+	response.State.Raw = request.Plan.Raw
+}
+
+func (r *ExclusiveRelationshipResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(r.parentAttribute), request, response)
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestExclusiveRelationshipResourceRead(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		members     []string
+		notFound    bool
+		wantMembers []string
+		wantRemoved bool
+	}{
+		"no drift": {
+			members:     []string{"a", "b"},
+			wantMembers: []string{"a", "b"},
+		},
+		"member added": {
+			members:     []string{"a", "b", "c"},
+			wantMembers: []string{"a", "b", "c"},
+		},
+		"all members removed": {
+			wantMembers: []string{},
+		},
+		"parent not found": {
+			notFound:    true,
+			wantRemoved: true,
+		},
+	}
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"parent": schema.StringAttribute{
+				Required: true,
+			},
+			"members": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+	}
+	typ := s.Type().TerraformType(context.Background())
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			var r ExclusiveRelationshipResource
+			r.SetExclusiveRelationship("test", "Test", "parent", "members", func(context.Context, *conns.AWSClient) ExclusiveRelationship[string] {
+				return ExclusiveRelationship[string]{
+					Find: func(_ context.Context, parent string) ([]string, error) {
+						if parent != "p-1" {
+							t.Errorf("Find() parent = %q, want %q", parent, "p-1")
+						}
+						if testCase.notFound {
+							return nil, tfresource.NewEmptyResultError(nil)
+						}
+						return testCase.members, nil
+					},
+				}
+			})
+
+			state := tfsdk.State{
+				Raw: tftypes.NewValue(typ, map[string]tftypes.Value{
+					"parent": tftypes.NewValue(tftypes.String, "p-1"),
+					"members": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "a"),
+						tftypes.NewValue(tftypes.String, "b"),
+					}),
+				}),
+				Schema: s,
+			}
+			request := resource.ReadRequest{State: state}
+			response := resource.ReadResponse{State: state}
+
+			r.Read(ctx, request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", response.Diagnostics)
+			}
+
+			if got, want := response.State.Raw.IsNull(), testCase.wantRemoved; got != want {
+				t.Fatalf("resource removed %t, want %t", got, want)
+			}
+			if testCase.wantRemoved {
+				return
+			}
+
+			var members []string
+			response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root("members"), &members)...)
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", response.Diagnostics)
+			}
+
+			slices.Sort(members)
+			if diff := cmp.Diff(members, testCase.wantMembers, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("unexpected members diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExclusiveRelationshipSync(t *testing.T) {
+	t.Parallel()
+
+	errRetryable := errors.New("retryable")
+
+	testCases := map[string]struct {
+		have       []string
+		want       []string
+		failures   int
+		wantAdded  []string
+		wantRemain []string
+		wantErr    bool
+	}{
+		"no changes": {
+			have:       []string{"a", "b"},
+			want:       []string{"b", "a"},
+			wantRemain: []string{"a", "b"},
+		},
+		"add and remove": {
+			have:       []string{"a", "b"},
+			want:       []string{"b", "c"},
+			wantAdded:  []string{"c"},
+			wantRemain: []string{"b", "c"},
+		},
+		"remove all": {
+			have: []string{"a", "b"},
+		},
+		"retried": {
+			want:       []string{"a"},
+			failures:   2,
+			wantAdded:  []string{"a"},
+			wantRemain: []string{"a"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			members := slices.Clone(testCase.have)
+			failures := testCase.failures
+			var added []string
+
+			relationship := ExclusiveRelationship[string]{
+				Find: func(context.Context, string) ([]string, error) {
+					return slices.Clone(members), nil
+				},
+				Add: func(_ context.Context, _ string, member string) error {
+					if failures > 0 {
+						failures--
+						return errRetryable
+					}
+					added = append(added, member)
+					members = append(members, member)
+					return nil
+				},
+				Remove: func(_ context.Context, _ string, member string) error {
+					members = slices.DeleteFunc(members, func(v string) bool { return v == member })
+					return nil
+				},
+				Equal: func(s1, s2 string) bool { return s1 == s2 },
+				Retryable: func(err error) (bool, error) {
+					return errors.Is(err, errRetryable), err
+				},
+				Timeout: 10 * time.Second,
+			}
+
+			err := relationship.Sync(ctx, "parent", testCase.want)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("Sync() err %t, want %t", got, want)
+			}
+
+			if diff := cmp.Diff(added, testCase.wantAdded); diff != "" {
+				t.Errorf("unexpected added diff (+want, -got): %s", diff)
+			}
+
+			slices.Sort(members)
+			if len(members) == 0 {
+				members = nil
+			}
+			if diff := cmp.Diff(members, testCase.wantRemain); diff != "" {
+				t.Errorf("unexpected members diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -8,6 +8,9 @@ import (
 	"fmt"
 	"iter"
 	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -510,6 +513,10 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 					return diags
 				}
 			}
+			if fieldOpts.noexpandempty && len(v.ValueString()) == 0 {
+				tflog.SubsystemTrace(ctx, subsystemName, "Omitting empty value")
+				return diags
+			}
 			vTo.Set(reflect.ValueOf(v.ValueStringPointer()))
 			return diags
 
@@ -1152,6 +1159,13 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 	}
 
 	if valTo.Kind() == reflect.Interface {
+		opts := flexer.getOptions()
+		if memberTypes := opts.unionMemberTypesFor(valTo.Type()); len(memberTypes) > 0 {
+			tflog.SubsystemInfo(ctx, subsystemName, "Target is a union")
+			diags.Append(expandUnion(ctx, sourcePath, valFrom, targetPath, valTo, memberTypes, flexer)...)
+			return diags
+		}
+
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
 			"to":   valTo.Kind(),
@@ -1164,9 +1178,15 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 
 	for fromField := range expandSourceFields(ctx, typeFrom, flexer.getOptions()) {
 		fromFieldName := fromField.Name
-		_, fromFieldOpts := autoflexTags(fromField)
+		fromNameOverride, fromFieldOpts := autoflexTags(fromField)
 
-		toField, ok := findFieldFuzzy(ctx, fromFieldName, typeFrom, typeTo, flexer)
+		var toField reflect.StructField
+		var ok bool
+		if fromNameOverride != "" {
+			toField, ok = typeTo.FieldByName(fromNameOverride)
+		} else {
+			toField, ok = findFieldFuzzy(ctx, fromFieldName, typeFrom, typeTo, flexer)
+		}
 		if !ok {
 			// Corresponding field not found in to.
 			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
@@ -1175,6 +1195,16 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 			continue
 		}
 		toFieldName := toField.Name
+		if fromNameOverride == "" {
+			// A renamed source field takes precedence over a fuzzy match.
+			if v, ok := findFieldByTagName(toFieldName, typeFrom); ok && v.Name != fromFieldName {
+				tflog.SubsystemTrace(ctx, subsystemName, "Skipping target field of renamed source field", map[string]any{
+					logAttrKeySourceFieldname: fromFieldName,
+					logAttrKeyTargetFieldname: toFieldName,
+				})
+				continue
+			}
+		}
 		toFieldVal := valTo.FieldByIndex(toField.Index)
 		if !toFieldVal.CanSet() {
 			// Corresponding field value can't be changed.
@@ -1191,7 +1221,8 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 		})
 
 		opts := fieldOpts{
-			legacy: fromFieldOpts.Legacy(),
+			legacy:        fromFieldOpts.Legacy(),
+			noexpandempty: fromFieldOpts.NoExpandEmpty(),
 		}
 
 		diags.Append(flexer.convert(ctx, sourcePath.AtName(fromFieldName), valFrom.FieldByIndex(fromField.Index), targetPath.AtName(toFieldName), toFieldVal, opts)...)
@@ -1203,6 +1234,80 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 	return diags
 }
 
+// expandUnion copies the single set field of struct `from` to the corresponding AWS SDK for Go v2 union member.
+// The target is left nil if no field is set.
+func expandUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, memberTypes []reflect.Type, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var setFields []reflect.StructField
+	for field := range expandSourceFields(ctx, valFrom.Type(), flexer.getOptions()) {
+		if v, ok := valFrom.FieldByIndex(field.Index).Interface().(attr.Value); ok && isUnionMemberSet(v) {
+			setFields = append(setFields, field)
+		}
+	}
+
+	switch len(setFields) {
+	case 0:
+		tflog.SubsystemTrace(ctx, subsystemName, "Expanding union with no member set")
+		return diags
+
+	case 1:
+
+	default:
+		tflog.SubsystemError(ctx, subsystemName, "Expanding union with more than one member set")
+		diags.Append(diagExpandingMultipleUnionMembers(setFields))
+		return diags
+	}
+
+	fromField := setFields[0]
+	i := slices.IndexFunc(memberTypes, func(memberType reflect.Type) bool {
+		name, _ := unionMemberName(valTo.Type(), memberType)
+		return strings.EqualFold(name, fromField.Name)
+	})
+	if i == -1 {
+		tflog.SubsystemError(ctx, subsystemName, "No corresponding union member", map[string]any{
+			logAttrKeySourceFieldname: fromField.Name,
+		})
+		diags.Append(diagExpandingNoUnionMember(fromField.Name, valTo.Type()))
+		return diags
+	}
+
+	member := reflect.New(memberTypes[i])
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+		logAttrKeySourceFieldname: fromField.Name,
+		logAttrKeyTargetType:      fullTypeName(memberTypes[i]),
+	})
+
+	_, fromFieldOpts := autoflexTags(fromField)
+	opts := fieldOpts{
+		legacy: fromFieldOpts.Legacy(),
+	}
+
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(fromField.Name), valFrom.FieldByIndex(fromField.Index), targetPath, member.Elem().FieldByName(unionMemberValueFieldName), opts)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	valTo.Set(member)
+
+	return diags
+}
+
+// isUnionMemberSet returns whether a union member's Plugin Framework value is set.
+// Null, unknown and empty collection values are not set.
+func isUnionMemberSet(v attr.Value) bool {
+	if v.IsNull() || v.IsUnknown() {
+		return false
+	}
+
+	if v, ok := v.(valueWithElementsAs); ok {
+		return len(v.Elements()) > 0
+	}
+
+	return true
+}
+
 func expandSourceFields(ctx context.Context, typ reflect.Type, opts AutoFlexOptions) iter.Seq[reflect.StructField] {
 	return func(yield func(reflect.StructField) bool) {
 		for field := range tfreflect.ExportedStructFields(typ) {
@@ -1214,13 +1319,19 @@ func expandSourceFields(ctx context.Context, typ reflect.Type, opts AutoFlexOpti
 				continue
 			}
 
-			fromNameOverride, _ := autoflexTags(field)
+			fromNameOverride, fromOpts := autoflexTags(field)
 			if fromNameOverride == "-" {
 				tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored source field", map[string]any{
 					logAttrKeySourceFieldname: fieldName,
 				})
 				continue
 			}
+			if fromOpts.NoExpand() {
+				tflog.SubsystemTrace(ctx, subsystemName, "Skipping noexpand source field", map[string]any{
+					logAttrKeySourceFieldname: fieldName,
+				})
+				continue
+			}
 
 			if fieldName == mapBlockKeyFieldName {
 				tflog.SubsystemTrace(ctx, subsystemName, "Skipping map block key", map[string]any{
@@ -1422,6 +1533,32 @@ func diagExpandingNoMapBlockKey(sourceType reflect.Type) diag.ErrorDiagnostic {
 	)
 }
 
+func diagExpandingMultipleUnionMembers(fields []reflect.StructField) diag.ErrorDiagnostic {
+	names := make([]string, len(fields))
+	for i, field := range fields {
+		name := field.Name
+		if v, _, _ := strings.Cut(field.Tag.Get("tfsdk"), ","); v != "" {
+			name = v
+		}
+		names[i] = strconv.Quote(name)
+	}
+
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Combination",
+		fmt.Sprintf("Only one of %s can be configured.", strings.Join(names, ", ")),
+	)
+}
+
+func diagExpandingNoUnionMember(sourceFieldName string, unionType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Source field %q has no registered member of union %q.", sourceFieldName, fullTypeName(unionType)),
+	)
+}
+
 func diagExpandingIncompatibleTypes(sourceType, targetType reflect.Type) diag.ErrorDiagnostic {
 	return diag.NewErrorDiagnostic(
 		"Incompatible Types",
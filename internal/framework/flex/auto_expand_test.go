@@ -4607,6 +4607,88 @@ func TestExpandIgnoreStructTag(t *testing.T) {
 	runAutoExpandTestCases(t, testCases)
 }
 
+func TestExpandStructTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		Source     any
+		Target     any
+		WantTarget any
+	}{
+		"rename": {
+			Source: tfRenamedStringFields{
+				Field1: types.StringValue("value1"),
+				Name:   types.StringValue("value2"),
+			},
+			Target: &awsTwoStringFields{},
+			WantTarget: &awsTwoStringFields{
+				Field1: "value2",
+				Field2: aws.String("value1"),
+			},
+		},
+		"noexpand": {
+			Source: tfSingleStringFieldNoExpand{
+				Field1: types.StringValue("value1"),
+			},
+			Target:     &awsSingleStringValue{},
+			WantTarget: &awsSingleStringValue{},
+		},
+		"rename conflict": {
+			Source: tfRenamedStringFieldConflict{
+				Name:   types.StringValue("value1"),
+				Field1: types.StringValue("value2"),
+			},
+			Target: &awsSingleStringValue{},
+			WantTarget: &awsSingleStringValue{
+				Field1: "value1",
+			},
+		},
+		"omitempty empty": {
+			Source: tfSingleStringFieldOmitEmpty{
+				Field1: types.StringValue(""),
+			},
+			Target: &awsSingleStringPointer{},
+			WantTarget: &awsSingleStringPointer{
+				Field1: aws.String(""),
+			},
+		},
+		"noexpandempty empty": {
+			Source: tfSingleStringFieldNoExpandEmpty{
+				Field1: types.StringValue(""),
+			},
+			Target:     &awsSingleStringPointer{},
+			WantTarget: &awsSingleStringPointer{},
+		},
+		"noexpandempty not empty": {
+			Source: tfSingleStringFieldNoExpandEmpty{
+				Field1: types.StringValue("value1"),
+			},
+			Target: &awsSingleStringPointer{},
+			WantTarget: &awsSingleStringPointer{
+				Field1: aws.String("value1"),
+			},
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			diags := Expand(ctx, testCase.Source, testCase.Target)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(testCase.Target, testCase.WantTarget); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestExpandInterface(t *testing.T) {
 	t.Parallel()
 
@@ -4841,6 +4923,134 @@ func testFlexAWSInterfaceInterfacePtr(v awsInterfaceInterface) *awsInterfaceInte
 	return &v
 }
 
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		Source        any
+		Target        any
+		WantTarget    any
+		expectedDiags diag.Diagnostics
+	}{
+		"string member": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberStringValue{
+					Value: "value1",
+				},
+			},
+		},
+		"object member": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringNull(),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{Field1: types.StringValue("value1")},
+						}),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberObjectValue{
+					Value: awsSingleStringValue{
+						Field1: "value1",
+					},
+				},
+			},
+		},
+		"no member": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringNull(),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{}),
+					},
+				}),
+			},
+			Target:     &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{},
+		},
+		"multiple members": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{Field1: types.StringValue("value2")},
+						}),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Combination",
+					`Only one of "string_value", "object_value" can be configured.`,
+				),
+			},
+		},
+		"slice": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+					{
+						StringValue: types.StringNull(),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{Field1: types.StringValue("value2")},
+						}),
+					},
+				}),
+			},
+			Target: &awsUnionSlice{},
+			WantTarget: &awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberStringValue{
+						Value: "value1",
+					},
+					&awsUnionMemberObjectValue{
+						Value: awsSingleStringValue{
+							Field1: "value2",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			diags := Expand(ctx, testCase.Source, testCase.Target, WithUnionMembers(awsUnionMemberStringValue{}, &awsUnionMemberObjectValue{}))
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if !diags.HasError() {
+				if diff := cmp.Diff(testCase.Target, testCase.WantTarget); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
+
 func TestExpandExpander(t *testing.T) {
 	t.Parallel()
 
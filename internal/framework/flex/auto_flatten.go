@@ -192,7 +192,7 @@ func (flattener autoFlattener) convert(ctx context.Context, sourcePath path.Path
 		return diags
 
 	case reflect.Interface:
-		diags.Append(flattener.interface_(ctx, vFrom, tTo, vTo)...)
+		diags.Append(flattener.interface_(ctx, sourcePath, vFrom, targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -611,7 +611,7 @@ func (flattener autoFlattener) pointer(ctx context.Context, sourcePath path.Path
 	return diags
 }
 
-func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interface_(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	switch tTo := tTo.(type) {
@@ -655,7 +655,7 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 		//
 		// interface -> types.List(OfObject) or types.Object.
 		//
-		diags.Append(flattener.interfaceToNestedObject(ctx, vFrom, vFrom.IsNil(), tTo, vTo)...)
+		diags.Append(flattener.interfaceToNestedObject(ctx, sourcePath, vFrom, vFrom.IsNil(), targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -1217,7 +1217,7 @@ func (flattener autoFlattener) structToNestedObject(ctx context.Context, sourceP
 }
 
 // interfaceToNestedObject copies an AWS API interface value to a compatible Plugin Framework NestedObjectValue value.
-func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, isNullFrom bool, targetPath path.Path, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if isNullFrom {
@@ -1239,6 +1239,24 @@ func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFro
 
 	toFlattener, ok := to.(Flattener)
 	if !ok {
+		if vMember, ok := unionMemberValue(vFrom); ok {
+			tflog.SubsystemInfo(ctx, subsystemName, "Source is a union")
+			diags.Append(flattenUnion(ctx, sourcePath, vFrom.Type(), vMember, targetPath, to, flattener)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			// Set the target structure as a mapped Object.
+			val, d := tTo.ValueFromObjectPtr(ctx, to)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			vTo.Set(reflect.ValueOf(val))
+			return diags
+		}
+
 		val, d := tTo.NullValue(ctx)
 		diags.Append(d...)
 		if diags.HasError() {
@@ -1470,6 +1488,18 @@ func (flattener autoFlattener) sliceOfStructToNestedObjectCollection(ctx context
 			return diags
 		}
 
+		if _, ok := target.(Flattener); !ok {
+			if vMember, ok := unionMemberValue(vFrom.Index(i)); ok {
+				diags.Append(flattenUnion(ctx, sourcePath, vFrom.Index(i).Type(), vMember, targetPath, target, flattener)...)
+				if diags.HasError() {
+					return diags
+				}
+
+				t.Index(i).Set(reflect.ValueOf(target))
+				continue
+			}
+		}
+
 		diags.Append(flattenStruct(ctx, sourcePath, vFrom.Index(i).Interface(), targetPath, target, flattener)...)
 		if diags.HasError() {
 			return diags
@@ -1514,7 +1544,10 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 	for fromField := range flattenSourceFields(ctx, typeFrom, flexer.getOptions()) {
 		fromFieldName := fromField.Name
 
-		toField, ok := findFieldFuzzy(ctx, fromFieldName, typeFrom, typeTo, flexer)
+		toField, ok := findFieldByTagName(fromFieldName, typeTo)
+		if !ok {
+			toField, ok = findFieldFuzzy(ctx, fromFieldName, typeFrom, typeTo, flexer)
+		}
 		if !ok {
 			// Corresponding field not found in to.
 			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
@@ -1532,6 +1565,13 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 			})
 			continue
 		}
+		if toNameOverride != "" && toNameOverride != fromFieldName {
+			tflog.SubsystemTrace(ctx, subsystemName, "Skipping renamed target field", map[string]any{
+				logAttrKeySourceFieldname: fromFieldName,
+				logAttrKeyTargetFieldname: toFieldName,
+			})
+			continue
+		}
 		if toOpts.NoFlatten() {
 			tflog.SubsystemTrace(ctx, subsystemName, "Skipping noflatten target field", map[string]any{
 				logAttrKeySourceFieldname: fromFieldName,
@@ -1567,6 +1607,68 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 	return diags
 }
 
+// unionMemberValue returns the AWS SDK for Go v2 union member held by an interface value.
+func unionMemberValue(v reflect.Value) (reflect.Value, bool) {
+	if v.Kind() != reflect.Interface || v.IsNil() {
+		return reflect.Value{}, false
+	}
+
+	member := v.Elem()
+	if member.Kind() == reflect.Pointer {
+		if member.IsNil() {
+			return reflect.Value{}, false
+		}
+		member = member.Elem()
+	}
+
+	if !isUnionMemberType(v.Type(), member.Type()) {
+		return reflect.Value{}, false
+	}
+
+	return member, true
+}
+
+// flattenUnion copies the value of AWS SDK for Go v2 union member `vFrom` to the corresponding field of struct `to`.
+// All other fields of `to` are null.
+func flattenUnion(ctx context.Context, sourcePath path.Path, union reflect.Type, vFrom reflect.Value, targetPath path.Path, to any, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	valTo := reflect.ValueOf(to)
+	if valTo.Kind() == reflect.Pointer {
+		valTo = valTo.Elem()
+	}
+
+	diags.Append(flattenPrePopulate(ctx, valTo)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	name, _ := unionMemberName(union, vFrom.Type())
+	toField, ok := findFieldFuzzy(ctx, name, vFrom.Type(), valTo.Type(), flexer)
+	if !ok {
+		tflog.SubsystemError(ctx, subsystemName, "No corresponding field for union member", map[string]any{
+			logAttrKeySourceFieldname: name,
+		})
+		diags.Append(diagFlatteningNoUnionMemberField(vFrom.Type(), valTo.Type()))
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+		logAttrKeySourceType:      fullTypeName(vFrom.Type()),
+		logAttrKeyTargetFieldname: toField.Name,
+	})
+
+	_, toOpts := autoflexTags(toField)
+	opts := fieldOpts{
+		legacy:    toOpts.Legacy(),
+		omitempty: toOpts.OmitEmpty(),
+	}
+
+	diags.Append(flexer.convert(ctx, sourcePath, vFrom.FieldByName(unionMemberValueFieldName), targetPath.AtName(toField.Name), valTo.FieldByIndex(toField.Index), opts)...)
+
+	return diags
+}
+
 func flattenSourceFields(ctx context.Context, typ reflect.Type, opts AutoFlexOptions) iter.Seq[reflect.StructField] {
 	return func(yield func(reflect.StructField) bool) {
 		for field := range tfreflect.ExportedStructFields(typ) {
@@ -1762,6 +1864,16 @@ func diagFlatteningMarshalSmithyDocument(sourceType reflect.Type, err error) dia
 	)
 }
 
+func diagFlatteningNoUnionMemberField(memberType, targetType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while flattening configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Target type %q has no field for union member %q.", fullTypeName(targetType), fullTypeName(memberType)),
+	)
+}
+
 func DiagFlatteningIncompatibleTypes(sourceType, targetType reflect.Type) diag.ErrorDiagnostic {
 	return diag.NewErrorDiagnostic(
 		"Incompatible Types",
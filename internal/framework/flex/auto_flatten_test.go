@@ -4335,6 +4335,64 @@ func TestFlattenIgnoreStructTag(t *testing.T) {
 	runAutoFlattenTestCases(t, testCases)
 }
 
+func TestFlattenStructTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		Source     any
+		Target     any
+		WantTarget any
+	}{
+		"rename": {
+			Source: awsTwoStringFields{
+				Field1: "value2",
+				Field2: aws.String("value1"),
+			},
+			Target: &tfRenamedStringFields{},
+			WantTarget: &tfRenamedStringFields{
+				Field1: types.StringValue("value1"),
+				Name:   types.StringValue("value2"),
+			},
+		},
+		"rename conflict": {
+			Source: awsSingleStringValue{
+				Field1: "value1",
+			},
+			Target: &tfRenamedStringFieldConflict{},
+			WantTarget: &tfRenamedStringFieldConflict{
+				Name: types.StringValue("value1"),
+			},
+		},
+		"noexpand": {
+			Source: awsSingleStringValue{
+				Field1: "value1",
+			},
+			Target: &tfSingleStringFieldNoExpand{},
+			WantTarget: &tfSingleStringFieldNoExpand{
+				Field1: types.StringValue("value1"),
+			},
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			diags := Flatten(ctx, testCase.Source, testCase.Target)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(testCase.Target, testCase.WantTarget); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestFlattenInterfaceToStringTypable(t *testing.T) {
 	t.Parallel()
 
@@ -4781,6 +4839,107 @@ func TestFlattenInterface(t *testing.T) {
 	runAutoFlattenTestCases(t, testCases)
 }
 
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		Source     any
+		Target     any
+		WantTarget any
+	}{
+		"string member": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberStringValue{
+					Value: "value1",
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+				}),
+			},
+		},
+		"object member": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberObjectValue{
+					Value: awsSingleStringValue{
+						Field1: "value1",
+					},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringNull(),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{Field1: types.StringValue("value1")},
+						}),
+					},
+				}),
+			},
+		},
+		"nil": {
+			Source: awsUnionSingle{},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+		},
+		"slice": {
+			Source: awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberStringValue{
+						Value: "value1",
+					},
+					&awsUnionMemberObjectValue{
+						Value: awsSingleStringValue{
+							Field1: "value2",
+						},
+					},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+					{
+						StringValue: types.StringNull(),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{Field1: types.StringValue("value2")},
+						}),
+					},
+				}),
+			},
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			diags := Flatten(ctx, testCase.Source, testCase.Target)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(testCase.Target, testCase.WantTarget); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestFlattenFlattener(t *testing.T) {
 	t.Parallel()
 
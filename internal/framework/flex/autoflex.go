@@ -144,18 +144,58 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, typeFrom reflect.
 	return reflect.StructField{}, false
 }
 
+// findFieldByTagName returns the field of typeTo renamed by its `autoflex` struct tag to fieldNameFrom.
+func findFieldByTagName(fieldNameFrom string, typeTo reflect.Type) (reflect.StructField, bool) {
+	for field := range tfreflect.ExportedStructFields(typeTo) {
+		if name, _ := autoflexTags(field); name == fieldNameFrom {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
 func fieldExistsInStruct(field string, structType reflect.Type) bool {
 	_, ok := structType.FieldByName(field)
 	return ok
 }
 
+// unionMemberValueFieldName is the name of the field holding an AWS SDK for Go v2 union member's value.
+const unionMemberValueFieldName = "Value"
+
+// isUnionMemberType returns whether the specified type is a member of the specified AWS SDK for Go v2 union.
+// Union members are structs named `<Union>Member<Name>` with a `Value` field, a pointer to which implements the union interface.
+func isUnionMemberType(union, member reflect.Type) bool {
+	if union.Kind() != reflect.Interface || member.Kind() != reflect.Struct {
+		return false
+	}
+
+	if _, ok := unionMemberName(union, member); !ok {
+		return false
+	}
+
+	if _, ok := member.FieldByName(unionMemberValueFieldName); !ok {
+		return false
+	}
+
+	return reflect.PointerTo(member).Implements(union)
+}
+
+// unionMemberName returns the name of an AWS SDK for Go v2 union member, e.g. `And` for `RuleMemberAnd`.
+func unionMemberName(union, member reflect.Type) (string, bool) {
+	name, ok := strings.CutPrefix(member.Name(), union.Name()+"Member")
+
+	return name, ok && name != ""
+}
+
 func autoflexTags(field reflect.StructField) (string, tagOptions) {
 	return parseTag(field.Tag.Get("autoflex"))
 }
 
 type fieldOpts struct {
-	legacy    bool
-	omitempty bool
+	legacy        bool
+	noexpandempty bool
+	omitempty     bool
 }
 
 // valueWithElementsAs extends the Value interface for values that have an ElementsAs method.
@@ -33,6 +33,24 @@ type tfSingleStringFieldOmitEmpty struct {
 	Field1 types.String `tfsdk:"field1" autoflex:",omitempty"`
 }
 
+type tfSingleStringFieldNoExpandEmpty struct {
+	Field1 types.String `tfsdk:"field1" autoflex:",noexpandempty"`
+}
+
+type tfSingleStringFieldNoExpand struct {
+	Field1 types.String `tfsdk:"field1" autoflex:",noexpand"`
+}
+
+type tfRenamedStringFields struct {
+	Field1 types.String `tfsdk:"field1" autoflex:"Field2"`
+	Name   types.String `tfsdk:"name" autoflex:"Field1"`
+}
+
+type tfRenamedStringFieldConflict struct {
+	Name   types.String `tfsdk:"name" autoflex:"Field1"`
+	Field1 types.String `tfsdk:"field1"`
+}
+
 type tfSingleStringFieldLegacy struct {
 	Field1 types.String `tfsdk:"field1" autoflex:",legacy"`
 }
@@ -238,6 +256,11 @@ type awsSingleStringValue struct {
 	Field1 string
 }
 
+type awsTwoStringFields struct {
+	Field1 string
+	Field2 *string
+}
+
 type awsSingleStringPointer struct {
 	Field1 *string
 }
@@ -558,6 +581,39 @@ var _ awsInterfaceInterface = &awsInterfaceInterfaceImpl{}
 
 func (t *awsInterfaceInterfaceImpl) isAWSInterfaceInterface() {} // nosemgrep:ci.aws-in-func-name
 
+type tfUnion struct {
+	StringValue types.String                                         `tfsdk:"string_value"`
+	ObjectValue fwtypes.ListNestedObjectValueOf[tfSingleStringField] `tfsdk:"object_value"`
+}
+
+type awsUnionSingle struct {
+	Field1 awsUnion
+}
+
+type awsUnionSlice struct {
+	Field1 []awsUnion
+}
+
+type awsUnion interface {
+	isAWSUnion()
+}
+
+type awsUnionMemberStringValue struct {
+	Value string
+}
+
+var _ awsUnion = &awsUnionMemberStringValue{}
+
+func (*awsUnionMemberStringValue) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionMemberObjectValue struct {
+	Value awsSingleStringValue
+}
+
+var _ awsUnion = &awsUnionMemberObjectValue{}
+
+func (*awsUnionMemberObjectValue) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
 type tfFlexer struct {
 	Field1 types.String `tfsdk:"field1"`
 }
@@ -3,7 +3,10 @@
 
 package flex
 
-import "slices"
+import (
+	"reflect"
+	"slices"
+)
 
 var (
 	DefaultIgnoredFieldNames = []string{
@@ -27,6 +30,10 @@ type AutoFlexOptions struct {
 	// ignoredFieldNames stores names which expanders and flatteners will
 	// not read from or write to
 	ignoredFieldNames []string
+
+	// unionMemberTypes stores the AWS SDK for Go v2 union member types
+	// which expanders can create
+	unionMemberTypes []reflect.Type
 }
 
 // WithFieldNamePrefix specifies a prefix to be accounted for when
@@ -77,6 +84,38 @@ func WithNoIgnoredFieldNames() AutoFlexOptionsFunc {
 	}
 }
 
+// WithUnionMembers registers AWS SDK for Go v2 union member types, e.g.
+// `awstypes.RuleMemberAnd{}`, that an expander can create
+//
+// Use this option to expand a nested object to a union (interface-typed)
+// field. The nested object has one field per union member, named after the
+// member; at most one of them may be set. Union members do not need to be
+// registered to be flattened.
+func WithUnionMembers(members ...any) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		for _, member := range members {
+			typ := reflect.TypeOf(member)
+			if typ.Kind() == reflect.Pointer {
+				typ = typ.Elem()
+			}
+			o.unionMemberTypes = append(o.unionMemberTypes, typ)
+		}
+	}
+}
+
+// unionMemberTypesFor returns the registered union member types for the specified union
+func (o *AutoFlexOptions) unionMemberTypesFor(union reflect.Type) []reflect.Type {
+	var members []reflect.Type
+
+	for _, member := range o.unionMemberTypes {
+		if isUnionMemberType(union, member) {
+			members = append(members, member)
+		}
+	}
+
+	return members
+}
+
 // isIgnoredField returns true if s is in the list of ignored field names
 func (o *AutoFlexOptions) isIgnoredField(s string) bool {
 	return slices.Contains(o.ignoredFieldNames, s)
@@ -49,3 +49,11 @@ func (o tagOptions) OmitEmpty() bool {
 func (o tagOptions) NoFlatten() bool {
 	return o.Contains("noflatten")
 }
+
+func (o tagOptions) NoExpandEmpty() bool {
+	return o.Contains("noexpandempty")
+}
+
+func (o tagOptions) NoExpand() bool {
+	return o.Contains("noexpand")
+}
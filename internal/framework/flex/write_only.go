@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetWriteOnlyStringValue returns the string value of the write-only attribute at the specified path in the config.
+// Write-only attribute values are never present in the plan or state.
+// A null or unknown value is returned as an empty string.
+func GetWriteOnlyStringValue(ctx context.Context, config tfsdk.Config, path path.Path) (string, diag.Diagnostics) {
+	var value types.String
+	diags := config.GetAttribute(ctx, path, &value)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	return value.ValueString(), diags
+}
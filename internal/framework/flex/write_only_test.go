@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+func TestGetWriteOnlyStringValue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"password_wo": schema.StringAttribute{
+				Optional:  true,
+				WriteOnly: true,
+			},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"password_wo": tftypes.String}}
+
+	testCases := map[string]struct {
+		value         tftypes.Value
+		expectedValue string
+	}{
+		"value": {
+			value:         tftypes.NewValue(tftypes.String, "secret"),
+			expectedValue: "secret",
+		},
+		"null": {
+			value: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			value: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := tfsdk.Config{
+				Schema: s,
+				Raw:    tftypes.NewValue(objectType, map[string]tftypes.Value{"password_wo": testCase.value}),
+			}
+
+			value, diags := flex.GetWriteOnlyStringValue(ctx, config, path.Root("password_wo"))
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, want := value, testCase.expectedValue; got != want {
+				t.Errorf("expected value: %s, got: %s", want, got)
+			}
+		})
+	}
+
+	t.Run("invalid path", func(t *testing.T) {
+		t.Parallel()
+
+		config := tfsdk.Config{
+			Schema: s,
+			Raw:    tftypes.NewValue(objectType, map[string]tftypes.Value{"password_wo": tftypes.NewValue(tftypes.String, "secret")}),
+		}
+
+		if _, diags := flex.GetWriteOnlyStringValue(ctx, config, path.Root("password")); !diags.HasError() {
+			t.Fatal("expected error, got none")
+		}
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 var (
@@ -170,5 +171,11 @@ func (v IAMPolicy) ValidateAttribute(ctx context.Context, req xattr.ValidateAttr
 				"Path: "+req.Path.String()+"\n"+
 				"Value: "+v.ValueString(),
 		)
+
+		return
+	}
+
+	for _, finding := range verify.LintIAMPolicy(v.ValueString()) {
+		resp.Diagnostics.AddAttributeWarning(req.Path, "IAM Policy Lint", finding.String())
 	}
 }
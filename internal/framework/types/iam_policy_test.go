@@ -15,8 +15,9 @@ func TestIAMPolicyValidateAttribute(t *testing.T) {
 	t.Parallel()
 
 	type testCase struct {
-		val         fwtypes.IAMPolicy
-		expectError bool
+		val           fwtypes.IAMPolicy
+		expectError   bool
+		expectWarning bool
 	}
 	tests := map[string]testCase{
 		"unknown": {
@@ -32,6 +33,10 @@ func TestIAMPolicyValidateAttribute(t *testing.T) {
 			val:         fwtypes.IAMPolicyValue("not ok"),
 			expectError: true,
 		},
+		"lint": {
+			val:           fwtypes.IAMPolicyValue(`{"Statement": [{"Effect": "Allow", "Action": "sqs:SendMessages", "Resource": "*"}]}`),
+			expectWarning: true,
+		},
 	}
 
 	for name, test := range tests {
@@ -47,6 +52,9 @@ func TestIAMPolicyValidateAttribute(t *testing.T) {
 			if resp.Diagnostics.HasError() != test.expectError {
 				t.Errorf("resp.Diagnostics.HasError() = %t, want = %t", resp.Diagnostics.HasError(), test.expectError)
 			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != test.expectWarning {
+				t.Errorf("resp.Diagnostics.WarningsCount() > 0 = %t, want = %t", got, test.expectWarning)
+			}
 		})
 	}
 }
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"cmp"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// Subnet size constraints enforced by EC2 (and so by IPAM allocations used for subnets).
+// See https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html.
+const (
+	ipv4SubnetMinPrefixLength  = 16
+	ipv4SubnetMaxPrefixLength  = 28
+	ipv6SubnetMinPrefixLength  = 44
+	ipv6SubnetMaxPrefixLength  = 64
+	ipv6SubnetPrefixLengthStep = 4
+)
+
+// parseCIDRBlock parses a CIDR block, which must be the CIDR block for its network.
+func parseCIDRBlock(s string) (netip.Prefix, error) {
+	if err := itypes.ValidateCIDRBlock(s); err != nil {
+		return netip.Prefix{}, err
+	}
+
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%q is not a valid CIDR block: %w", s, err)
+	}
+
+	return prefix, nil
+}
+
+// parseCIDRBlocks parses a list of CIDR blocks.
+func parseCIDRBlocks(ss []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(ss))
+
+	for _, s := range ss {
+		prefix, err := parseCIDRBlock(s)
+		if err != nil {
+			return nil, err
+		}
+
+		prefixes = append(prefixes, prefix)
+	}
+
+	return prefixes, nil
+}
+
+// parseIPAddressOrCIDRBlock parses an IP address or a CIDR block.
+// An IP address is returned as a single-address prefix.
+func parseIPAddressOrCIDRBlock(s string) (netip.Prefix, error) {
+	if addr, err := netip.ParseAddr(s); err == nil {
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+
+	prefix, err := parseCIDRBlock(s)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%q is not a valid IP address or CIDR block", s)
+	}
+
+	return prefix, nil
+}
+
+// cidrBlockContains returns whether CIDR block x contains all the addresses in y.
+func cidrBlockContains(x, y netip.Prefix) bool {
+	return x.Addr().BitLen() == y.Addr().BitLen() && x.Bits() <= y.Bits() && x.Contains(y.Addr())
+}
+
+// cidrBlockLastAddr returns the last address in a CIDR block.
+func cidrBlockLastAddr(prefix netip.Prefix) netip.Addr {
+	b := prefix.Masked().Addr().AsSlice()
+
+	for i := prefix.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 0x80 >> (i % 8)
+	}
+
+	addr, _ := netip.AddrFromSlice(b)
+
+	return addr
+}
+
+// compareCIDRBlocks orders CIDR blocks by address family, then address, then prefix length.
+func compareCIDRBlocks(x, y netip.Prefix) int {
+	if c := cmp.Compare(x.Addr().BitLen(), y.Addr().BitLen()); c != 0 {
+		return c
+	}
+
+	if c := x.Addr().Compare(y.Addr()); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(x.Bits(), y.Bits())
+}
+
+// summarizeCIDRBlocks returns the smallest list of CIDR blocks that covers exactly the same addresses as the specified CIDR blocks.
+// IPv4 CIDR blocks precede IPv6 CIDR blocks and each are sorted by address.
+func summarizeCIDRBlocks(prefixes []netip.Prefix) []netip.Prefix {
+	prefixes = slices.Clone(prefixes)
+	slices.SortFunc(prefixes, compareCIDRBlocks)
+
+	var summary []netip.Prefix
+	for _, prefix := range prefixes {
+		// Sorting ensures that any CIDR block containing this one has already been seen.
+		if n := len(summary); n > 0 && cidrBlockContains(summary[n-1], prefix) {
+			continue
+		}
+
+		summary = append(summary, prefix)
+
+		// Merge adjacent sibling CIDR blocks into their parent.
+		for n := len(summary); n >= 2; n = len(summary) {
+			x, y := summary[n-2], summary[n-1]
+			if x.Addr().BitLen() != y.Addr().BitLen() || x.Bits() != y.Bits() || x.Bits() == 0 {
+				break
+			}
+
+			parent, _ := x.Addr().Prefix(x.Bits() - 1)
+			if !parent.Contains(y.Addr()) {
+				break
+			}
+
+			summary = append(summary[:n-2], parent)
+		}
+	}
+
+	return summary
+}
+
+// validateSubnetPrefixLength validates a subnet prefix length against EC2's constraints for the address family.
+func validateSubnetPrefixLength(addr netip.Addr, prefixLength int) error {
+	if addr.Is4() {
+		if prefixLength < ipv4SubnetMinPrefixLength || prefixLength > ipv4SubnetMaxPrefixLength {
+			return fmt.Errorf("IPv4 subnet prefix length must be between %d and %d, got %d", ipv4SubnetMinPrefixLength, ipv4SubnetMaxPrefixLength, prefixLength)
+		}
+
+		return nil
+	}
+
+	if prefixLength < ipv6SubnetMinPrefixLength || prefixLength > ipv6SubnetMaxPrefixLength || prefixLength%ipv6SubnetPrefixLengthStep != 0 {
+		return fmt.Errorf("IPv6 subnet prefix length must be a multiple of %d between %d and %d, got %d", ipv6SubnetPrefixLengthStep, ipv6SubnetMinPrefixLength, ipv6SubnetMaxPrefixLength, prefixLength)
+	}
+
+	return nil
+}
+
+// maxSubnetsAvailable limits the number of candidate subnets considered by availableSubnets.
+const maxSubnetsAvailable = 1 << 16
+
+// availableSubnets returns, in address order, the CIDR blocks with the specified prefix length within the network
+// that do not overlap any of the used CIDR blocks.
+func availableSubnets(network netip.Prefix, used []netip.Prefix, prefixLength int) ([]netip.Prefix, error) {
+	if err := validateSubnetPrefixLength(network.Addr(), prefixLength); err != nil {
+		return nil, err
+	}
+
+	if prefixLength < network.Bits() {
+		return nil, fmt.Errorf("subnet prefix length (%d) must not be less than the network prefix length (%d)", prefixLength, network.Bits())
+	}
+
+	if n := prefixLength - network.Bits(); n >= 32 || 1<<n > maxSubnetsAvailable {
+		return nil, fmt.Errorf("network %s contains more than %d /%d subnets", network, maxSubnetsAvailable, prefixLength)
+	}
+
+	var overlapping []netip.Prefix
+	for _, u := range used {
+		if u.Addr().BitLen() != network.Addr().BitLen() {
+			return nil, fmt.Errorf("used CIDR block %s is not in the same address family as network %s", u, network)
+		}
+
+		if u.Overlaps(network) {
+			overlapping = append(overlapping, u)
+		}
+	}
+
+	var subnets []netip.Prefix
+	last := cidrBlockLastAddr(network)
+	for addr := network.Addr(); ; {
+		subnet := netip.PrefixFrom(addr, prefixLength)
+
+		if !slices.ContainsFunc(overlapping, subnet.Overlaps) {
+			subnets = append(subnets, subnet)
+		}
+
+		end := cidrBlockLastAddr(subnet)
+		if end == last {
+			break
+		}
+		addr = end.Next()
+	}
+
+	return subnets, nil
+}
+
+func cidrBlockStrings(prefixes []netip.Prefix) []string {
+	ss := make([]string, len(prefixes))
+
+	for i, prefix := range prefixes {
+		ss[i] = prefix.String()
+	}
+
+	return ss
+}
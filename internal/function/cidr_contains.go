@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = cidrContainsFunction{}
+
+func NewCIDRContainsFunction() function.Function {
+	return &cidrContainsFunction{}
+}
+
+type cidrContainsFunction struct{}
+
+func (f cidrContainsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_contains"
+}
+
+func (f cidrContainsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "cidr_contains Function",
+		MarkdownDescription: "Checks whether a CIDR block contains an IP address or all the addresses in another CIDR block",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "containing_cidr",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block",
+			},
+			function.StringParameter{
+				Name:                "ip_or_cidr",
+				MarkdownDescription: "IP address or CIDR block to check",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f cidrContainsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var containingCIDR, ipOrCIDR string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &containingCIDR, &ipOrCIDR))
+	if resp.Error != nil {
+		return
+	}
+
+	x, err := parseCIDRBlock(containingCIDR)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	y, err := parseIPAddressOrCIDRBlock(ipOrCIDR)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, cidrBlockContains(x, y)))
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRContainsFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		containingCIDR string
+		ipOrCIDR       string
+		expected       string
+	}{
+		{"10.0.0.0/16", "10.0.1.5", "true"},
+		{"10.0.0.0/16", "10.1.0.5", "false"},
+		{"10.0.0.0/16", "10.0.128.0/17", "true"},
+		{"10.0.0.0/16", "10.0.0.0/8", "false"},
+		{"2600:1f14:abc:de00::/56", "2600:1f14:abc:de01::/64", "true"},
+		{"2600:1f14:abc:de00::/56", "2600:1f14:abc:df00::/64", "false"},
+		{"10.0.0.0/16", "::ffff:10.0.0.1", "false"},
+	}
+
+	for _, testCase := range testCases {
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: testCIDRContainsFunctionConfig(testCase.containingCIDR, testCase.ipOrCIDR),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckOutput("test", testCase.expected),
+					),
+				},
+			},
+		})
+	}
+}
+
+func TestCIDRContainsFunction_invalidCIDR(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRContainsFunctionConfig("10.0.0.1/16", "10.0.0.5"),
+				ExpectError: regexache.MustCompile(`did[\s\n]*you[\s\n]*mean`),
+			},
+		},
+	})
+}
+
+func TestCIDRContainsFunction_invalidIPAddress(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRContainsFunctionConfig("10.0.0.0/16", "10.0.0.256"),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*IP[\s\n]*address`),
+			},
+		},
+	})
+}
+
+func testCIDRContainsFunctionConfig(containingCIDR, ipOrCIDR string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::cidr_contains(%[1]q, %[2]q)
+}
+`, containingCIDR, ipOrCIDR)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = cidrOverlapsFunction{}
+
+func NewCIDROverlapsFunction() function.Function {
+	return &cidrOverlapsFunction{}
+}
+
+type cidrOverlapsFunction struct{}
+
+func (f cidrOverlapsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_overlaps"
+}
+
+func (f cidrOverlapsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "cidr_overlaps Function",
+		MarkdownDescription: "Checks whether two CIDR blocks have any addresses in common",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_a",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block",
+			},
+			function.StringParameter{
+				Name:                "cidr_b",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f cidrOverlapsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrA, cidrB string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrA, &cidrB))
+	if resp.Error != nil {
+		return
+	}
+
+	x, err := parseCIDRBlock(cidrA)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	y, err := parseCIDRBlock(cidrB)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, x.Overlaps(y)))
+}
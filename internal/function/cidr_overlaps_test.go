@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDROverlapsFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		cidrA    string
+		cidrB    string
+		expected string
+	}{
+		{"10.0.0.0/16", "10.0.255.0/24", "true"},
+		{"10.0.0.0/24", "10.0.0.0/16", "true"},
+		{"10.0.0.0/24", "10.0.1.0/24", "false"},
+		{"2600:1f14:abc:de00::/56", "2600:1f14:abc:de00::/64", "true"},
+		{"2600:1f14:abc:de00::/64", "2600:1f14:abc:de01::/64", "false"},
+		{"0.0.0.0/0", "::/0", "false"},
+	}
+
+	for _, testCase := range testCases {
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: testCIDROverlapsFunctionConfig(testCase.cidrA, testCase.cidrB),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckOutput("test", testCase.expected),
+					),
+				},
+			},
+		})
+	}
+}
+
+func TestCIDROverlapsFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDROverlapsFunctionConfig("10.0.0.0/16", "invalid"),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*CIDR[\s\n]*block`),
+			},
+		},
+	})
+}
+
+func testCIDROverlapsFunctionConfig(cidrA, cidrB string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::cidr_overlaps(%[1]q, %[2]q)
+}
+`, cidrA, cidrB)
+}
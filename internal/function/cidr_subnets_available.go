@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = cidrSubnetsAvailableFunction{}
+
+func NewCIDRSubnetsAvailableFunction() function.Function {
+	return &cidrSubnetsAvailableFunction{}
+}
+
+type cidrSubnetsAvailableFunction struct{}
+
+func (f cidrSubnetsAvailableFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_subnets_available"
+}
+
+func (f cidrSubnetsAvailableFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_subnets_available Function",
+		MarkdownDescription: "Returns the CIDR blocks of the specified prefix length within a VPC CIDR block that do not overlap " +
+			"any used CIDR blocks. IPv4 prefix lengths must be between 16 and 28. IPv6 prefix lengths must be a multiple of 4 " +
+			"between 44 and 64, matching the subnet sizes accepted by EC2 and IPAM.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "vpc_cidr",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block of the VPC",
+			},
+			function.ListParameter{
+				Name:                "used_cidrs",
+				ElementType:         types.StringType,
+				MarkdownDescription: "CIDR blocks already in use, e.g. by existing subnets",
+			},
+			function.Int64Parameter{
+				Name:                "prefix",
+				MarkdownDescription: "Prefix length of the subnet CIDR blocks to return",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f cidrSubnetsAvailableFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var vpcCIDR string
+	var usedCIDRs []string
+	var prefixLength int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &vpcCIDR, &usedCIDRs, &prefixLength))
+	if resp.Error != nil {
+		return
+	}
+
+	network, err := parseCIDRBlock(vpcCIDR)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	used, err := parseCIDRBlocks(usedCIDRs)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	subnets, err := availableSubnets(network, used, int(prefixLength))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, cidrBlockStrings(subnets)))
+}
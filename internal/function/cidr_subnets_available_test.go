@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRSubnetsAvailableFunction_ipv4(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsAvailableFunctionConfig("10.0.0.0/24", `["10.0.0.0/27", "10.0.0.96/28", "192.168.0.0/16"]`, 26),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "10.0.0.128/26,10.0.0.192/26"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsAvailableFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsAvailableFunctionConfig("2600:1f14:abc:de00::/56", `["2600:1f14:abc:de00::/60", "2600:1f14:abc:de10::/64"]`, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "14"),
+					resource.TestCheckOutput("first", "2600:1f14:abc:de20::/60"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsAvailableFunction_invalidIPv6PrefixLength(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsAvailableFunctionConfig("2600:1f14:abc:de00::/56", `[]`, 62),
+				ExpectError: regexache.MustCompile(`must[\s\n]*be[\s\n]*a[\s\n]*multiple[\s\n]*of[\s\n]*4`),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsAvailableFunction_invalidIPv4PrefixLength(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsAvailableFunctionConfig("10.0.0.0/16", `[]`, 30),
+				ExpectError: regexache.MustCompile(`must[\s\n]*be[\s\n]*between[\s\n]*16[\s\n]*and[\s\n]*28`),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsAvailableFunction_mixedAddressFamilies(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsAvailableFunctionConfig("10.0.0.0/16", `["2600:1f14:abc:de00::/56"]`, 24),
+				ExpectError: regexache.MustCompile(`same[\s\n]*address[\s\n]*family`),
+			},
+		},
+	})
+}
+
+func testCIDRSubnetsAvailableFunctionConfig(vpcCIDR, usedCIDRs string, prefixLength int) string {
+	return fmt.Sprintf(`
+locals {
+  subnets = provider::aws::cidr_subnets_available(%[1]q, %[2]s, %[3]d)
+}
+
+output "test" {
+  value = join(",", local.subnets)
+}
+
+output "count" {
+  value = length(local.subnets)
+}
+
+output "first" {
+  value = try(local.subnets[0], "")
+}
+`, vpcCIDR, usedCIDRs, prefixLength)
+}
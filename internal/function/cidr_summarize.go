@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = cidrSummarizeFunction{}
+
+func NewCIDRSummarizeFunction() function.Function {
+	return &cidrSummarizeFunction{}
+}
+
+type cidrSummarizeFunction struct{}
+
+func (f cidrSummarizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_summarize"
+}
+
+func (f cidrSummarizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_summarize Function",
+		MarkdownDescription: "Summarizes a list of CIDR blocks into the smallest list of CIDR blocks covering exactly the same " +
+			"addresses. Contained CIDR blocks are removed and adjacent CIDR blocks are merged.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "cidrs",
+				ElementType:         types.StringType,
+				MarkdownDescription: "IPv4 and IPv6 CIDR blocks to summarize",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f cidrSummarizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrs []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrs))
+	if resp.Error != nil {
+		return
+	}
+
+	prefixes, err := parseCIDRBlocks(cidrs)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, cidrBlockStrings(summarizeCIDRBlocks(prefixes))))
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRSummarizeFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		cidrs    string
+		expected string
+	}{
+		{`[]`, ""},
+		{`["10.0.0.0/24", "10.0.1.0/24"]`, "10.0.0.0/23"},
+		{`["10.0.1.0/24", "10.0.0.0/24", "10.0.2.0/23"]`, "10.0.0.0/22"},
+		{`["10.0.0.0/16", "10.0.5.0/24"]`, "10.0.0.0/16"},
+		{`["10.0.1.0/24", "10.0.2.0/24"]`, "10.0.1.0/24,10.0.2.0/24"},
+		{`["2600:1f14:abc:de01::/64", "2600:1f14:abc:de00::/64", "10.0.0.0/24"]`, "10.0.0.0/24,2600:1f14:abc:de00::/63"},
+	}
+
+	for _, testCase := range testCases {
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: testCIDRSummarizeFunctionConfig(testCase.cidrs),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckOutput("test", testCase.expected),
+					),
+				},
+			},
+		})
+	}
+}
+
+func TestCIDRSummarizeFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSummarizeFunctionConfig(`["10.0.0.0/24", "invalid"]`),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*CIDR[\s\n]*block`),
+			},
+		},
+	})
+}
+
+func testCIDRSummarizeFunctionConfig(cidrs string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = join(",", provider::aws::cidr_summarize(%[1]s))
+}
+`, cidrs)
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = iamPolicyEquivalentFunction{}
+
+func NewIAMPolicyEquivalentFunction() function.Function {
+	return &iamPolicyEquivalentFunction{}
+}
+
+type iamPolicyEquivalentFunction struct{}
+
+func (f iamPolicyEquivalentFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_equivalent"
+}
+
+func (f iamPolicyEquivalentFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_equivalent Function",
+		MarkdownDescription: "Checks whether two IAM policy documents are semantically equivalent, ignoring differences such as " +
+			"element order and single-element arrays versus strings",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy_a",
+				MarkdownDescription: "IAM policy document (JSON)",
+			},
+			function.StringParameter{
+				Name:                "policy_b",
+				MarkdownDescription: "IAM policy document (JSON)",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f iamPolicyEquivalentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policyA, policyB string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policyA, &policyB))
+	if resp.Error != nil {
+		return
+	}
+
+	for i, policy := range []string{policyA, policyB} {
+		if strings.TrimSpace(policy) != "" && !json.Valid([]byte(policy)) {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(int64(i), fmt.Sprintf("policy (%s) is invalid JSON", policy)))
+		}
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, verify.PolicyStringsEquivalent(policyA, policyB)))
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyEquivalentFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		policyA  string
+		policyB  string
+		expected string
+	}{
+		{
+			`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":"*"}]}`,
+			`{"Statement":{"Resource":"*","Action":"s3:GetObject","Effect":"Allow"},"Version":"2012-10-17"}`,
+			"true",
+		},
+		{
+			`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`,
+			"false",
+		},
+		{"", "{}", "true"},
+	}
+
+	for _, testCase := range testCases {
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: testIAMPolicyEquivalentFunctionConfig(testCase.policyA, testCase.policyB),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckOutput("test", testCase.expected),
+					),
+				},
+			},
+		})
+	}
+}
+
+func TestIAMPolicyEquivalentFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyEquivalentFunctionConfig(`{}`, `{`),
+				ExpectError: regexache.MustCompile(`is[\s\n]*invalid[\s\n]*JSON`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyEquivalentFunctionConfig(policyA, policyB string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_equivalent(%[1]q, %[2]q)
+}
+`, policyA, policyB)
+}
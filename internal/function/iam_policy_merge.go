@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = iamPolicyMergeFunction{}
+
+func NewIAMPolicyMergeFunction() function.Function {
+	return &iamPolicyMergeFunction{}
+}
+
+type iamPolicyMergeFunction struct{}
+
+func (f iamPolicyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f iamPolicyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_merge Function",
+		MarkdownDescription: "Merges IAM policy documents in order. Statements with a Sid replace any earlier statement with the " +
+			"same Sid, as with the override_policy_documents argument of the aws_iam_policy_document data source.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "policies",
+				ElementType:         types.StringType,
+				MarkdownDescription: "IAM policy documents (JSON) to merge",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policies []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policies))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := mergeIAMPolicies(policies)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// mergeIAMPolicies merges IAM policy documents in order, returning the merged document as minified JSON.
+func mergeIAMPolicies(policies []string) (string, error) {
+	mergedDoc := &verify.IAMPolicyDoc{}
+
+	for i, policy := range policies {
+		if strings.TrimSpace(policy) == "" {
+			continue
+		}
+
+		doc := &verify.IAMPolicyDoc{}
+		if err := json.Unmarshal([]byte(policy), doc); err != nil {
+			return "", fmt.Errorf("merging policy document %d: %w", i, err)
+		}
+
+		mergedDoc.Merge(doc)
+	}
+
+	output, err := json.Marshal(mergedDoc)
+	if err != nil {
+		return "", fmt.Errorf("formatting JSON: %w", err)
+	}
+
+	return string(output), nil
+}
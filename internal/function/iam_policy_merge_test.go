@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyMergeFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig(`[
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [
+        { Sid = "Read", Effect = "Allow", Action = "s3:GetObject", Resource = "*" },
+        { Effect = "Allow", Action = "s3:ListBucket", Resource = "*" },
+      ]
+    }),
+    "",
+    jsonencode({
+      Statement = [
+        { Sid = "Read", Effect = "Deny", Action = "s3:GetObject", Resource = "*" },
+      ]
+    }),
+  ]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Deny","Action":"s3:GetObject","Resource":"*"},{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*"}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyMergeFunctionConfig(`["{"]`),
+				ExpectError: regexache.MustCompile(`merging[\s\n]*policy[\s\n]*document[\s\n]*0`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyMergeFunctionConfig(policies string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_merge(%[1]s)
+}
+`, policies)
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+const (
+	filename = `iam_policy_catalog.json`
+
+	// policyGeneratorURL is the AWS Policy Generator's service catalog.
+	// The catalog is a JavaScript assignment of a JSON object.
+	policyGeneratorURL = `https://awspolicygen.s3.amazonaws.com/js/policies.js`
+)
+
+// arnRegexes are the regular expressions that resource ARNs must match, keyed by action prefix.
+// Every service in the Policy Generator's catalog is included, but only services listed here have their ARNs checked.
+// The Policy Generator's own ARN regular expressions match any ARN of the service, so aren't used.
+var arnRegexes = map[string]string{
+	"kms":            `^arn:aws:kms:[^:]*:[^:]*:(key|alias)/.+`,
+	"secretsmanager": `^arn:aws:secretsmanager:[^:]*:[^:]*:secret:.+`,
+	"sqs":            `^arn:aws:sqs:[^:]*:[^:]*:[^:/]+$`,
+}
+
+// requiredServices are services that must be present in the catalog, as the linter's sensitive actions refer to them.
+var requiredServices = []string{
+	"ec2",
+	"iam",
+	"kms",
+	"lambda",
+	"s3",
+	"secretsmanager",
+	"sqs",
+	"ssm",
+	"sts",
+}
+
+// conditionKeyVariableRegexp matches a variable in a condition key, e.g. `${TagKey}` in `aws:RequestTag/${TagKey}`.
+var conditionKeyVariableRegexp = regexp.MustCompile(`\$\{[^}]+\}`)
+
+type policyGeneratorConfig struct {
+	ServiceMap map[string]policyGeneratorService `json:"serviceMap"`
+}
+
+type policyGeneratorService struct {
+	Actions       []string `json:"Actions"`
+	ConditionKeys []string `json:"conditionKeys"`
+	StringPrefix  string   `json:"StringPrefix"`
+}
+
+type catalog struct {
+	Services map[string]*catalogService `json:"services"`
+}
+
+type catalogService struct {
+	Actions       []string `json:"actions,omitempty"`
+	ARNRegex      string   `json:"arnRegex,omitempty"`
+	ConditionKeys []string `json:"conditionKeys,omitempty"`
+}
+
+func main() {
+	g := common.NewGenerator()
+
+	g.Infof("Generating internal/verify/%s", filename)
+
+	resp, err := http.Get(policyGeneratorURL)
+	if err != nil {
+		g.Fatalf("downloading %s: %s", policyGeneratorURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		g.Fatalf("downloading %s: %s", policyGeneratorURL, resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		g.Fatalf("reading %s: %s", policyGeneratorURL, err)
+	}
+
+	_, body, ok := bytes.Cut(body, []byte("="))
+	if !ok {
+		g.Fatalf("parsing %s: no assignment found", policyGeneratorURL)
+	}
+
+	var config policyGeneratorConfig
+	if err := json.Unmarshal(bytes.TrimRight(bytes.TrimSpace(body), ";"), &config); err != nil {
+		g.Fatalf("parsing %s: %s", policyGeneratorURL, err)
+	}
+
+	// Several services in the Policy Generator's catalog can share an action prefix.
+	c := catalog{Services: make(map[string]*catalogService)}
+	for _, v := range config.ServiceMap {
+		prefix := strings.ToLower(v.StringPrefix)
+		if prefix == "" {
+			continue
+		}
+
+		service, ok := c.Services[prefix]
+		if !ok {
+			service = &catalogService{ARNRegex: arnRegexes[prefix]}
+			c.Services[prefix] = service
+		}
+
+		service.Actions = append(service.Actions, v.Actions...)
+		for _, key := range v.ConditionKeys {
+			// Variables are replaced by a trailing wildcard, e.g. `aws:RequestTag/*`.
+			service.ConditionKeys = append(service.ConditionKeys, conditionKeyVariableRegexp.ReplaceAllString(key, "*"))
+		}
+	}
+
+	for _, prefix := range slices.Concat(requiredServices, slices.Collect(maps.Keys(arnRegexes))) {
+		if _, ok := c.Services[prefix]; !ok {
+			g.Fatalf("service %s not found in %s", prefix, policyGeneratorURL)
+		}
+	}
+
+	for _, service := range c.Services {
+		slices.Sort(service.Actions)
+		service.Actions = slices.Compact(service.Actions)
+		slices.Sort(service.ConditionKeys)
+		service.ConditionKeys = slices.Compact(service.ConditionKeys)
+	}
+
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	d := g.NewUnformattedFileDestination(filename)
+
+	if err := d.BufferBytes(append(b, '\n')); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
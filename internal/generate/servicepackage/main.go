@@ -62,11 +62,20 @@ func main() {
 			frameworkDataSources: make(map[string]ResourceDatum, 0),
 			frameworkResources:   make(map[string]ResourceDatum, 0),
 			sdkDataSources:       make(map[string]ResourceDatum),
+			sdkListResources:     make(map[string]ResourceDatum),
 			sdkResources:         make(map[string]ResourceDatum),
 		}
 
 		v.processDir(".")
 
+		for typeName := range v.sdkListResources {
+			if d, ok := v.sdkResources[typeName]; !ok {
+				v.errs = append(v.errs, fmt.Errorf("no SDK Resource for SDK List Resource: %s", typeName))
+			} else if !d.ARNIdentity && d.IdentityAttribute == "" {
+				v.errs = append(v.errs, fmt.Errorf("no identity for SDK List Resource: %s", typeName))
+			}
+		}
+
 		if err := errors.Join(v.errs...); err != nil {
 			g.Fatalf("%s", err.Error())
 		}
@@ -81,6 +90,7 @@ func main() {
 			FrameworkDataSources:    v.frameworkDataSources,
 			FrameworkResources:      v.frameworkResources,
 			SDKDataSources:          v.sdkDataSources,
+			SDKListResources:        v.sdkListResources,
 			SDKResources:            v.sdkResources,
 		}
 		templateFuncMap := template.FuncMap{
@@ -120,6 +130,8 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	ARNIdentity             bool
+	IdentityAttribute       string
 }
 
 type ServiceDatum struct {
@@ -132,6 +144,7 @@ type ServiceDatum struct {
 	FrameworkDataSources    map[string]ResourceDatum
 	FrameworkResources      map[string]ResourceDatum
 	SDKDataSources          map[string]ResourceDatum
+	SDKListResources        map[string]ResourceDatum
 	SDKResources            map[string]ResourceDatum
 }
 
@@ -159,6 +172,7 @@ type visitor struct {
 	frameworkDataSources map[string]ResourceDatum
 	frameworkResources   map[string]ResourceDatum
 	sdkDataSources       map[string]ResourceDatum
+	sdkListResources     map[string]ResourceDatum
 	sdkResources         map[string]ResourceDatum
 }
 
@@ -201,12 +215,27 @@ func (v *visitor) processFile(file *ast.File) {
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
-	// Look first for tagging annotations.
+	// Look first for tagging and identity annotations.
 	d := ResourceDatum{}
 
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "ArnIdentity" {
+			d.ARNIdentity = true
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "IdentityAttribute" {
+			args := common.ParseArgs(m[3])
+
+			if len(args.Positional) == 0 {
+				v.errs = append(v.errs, fmt.Errorf("no identity attribute: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			d.IdentityAttribute = namesgen.ConstOrQuote(args.Positional[0])
+		}
+
 		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Tags" {
 			args := common.ParseArgs(m[3])
 
@@ -226,6 +255,10 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 		}
 	}
 
+	if d.ARNIdentity && d.IdentityAttribute != "" {
+		v.errs = append(v.errs, fmt.Errorf("both ArnIdentity and IdentityAttribute annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+	}
+
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
@@ -331,6 +364,29 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkDataSources[typeName] = d
 				}
+			case "SDKListResource":
+				if len(args.Positional) == 0 {
+					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				typeName := args.Positional[0]
+
+				if !validTypeName.MatchString(typeName) {
+					v.errs = append(v.errs, fmt.Errorf("invalid type name (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				if d.Name == "" {
+					v.errs = append(v.errs, fmt.Errorf("no friendly name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				if _, ok := v.sdkListResources[typeName]; ok {
+					v.errs = append(v.errs, fmt.Errorf("duplicate SDK List Resource (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.sdkListResources[typeName] = d
+				}
 			case "SDKResource":
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -354,7 +410,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "ArnIdentity", "IdentityAttribute", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
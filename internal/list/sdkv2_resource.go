@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package list
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ListResourceWithSDKv2Resource is a structure to be embedded within a ListResource that lists instances of a Plugin SDK resource.
+type ListResourceWithSDKv2Resource struct {
+	framework.ListResourceWithConfigure
+	resource *schema.Resource
+}
+
+// SetResourceSchema sets the Plugin SDK resource whose instances are listed.
+// The resource must be the one registered with the provider, which includes any identity schema.
+func (l *ListResourceWithSDKv2Resource) SetResourceSchema(resource *schema.Resource) {
+	l.resource = resource
+}
+
+// ListResourceConfigSchema returns the schema for list blocks.
+// By default there are no list arguments.
+func (*ListResourceWithSDKv2Resource) ListResourceConfigSchema(_ context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{}
+}
+
+// RawV5Schemas returns the Protocol Version 5 resource and identity schemas of the Plugin SDK resource.
+func (l *ListResourceWithSDKv2Resource) RawV5Schemas(ctx context.Context, request list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	if l.resource == nil {
+		return
+	}
+
+	response.ProtoV5Schema = l.resource.ProtoSchema(ctx)()
+	if f := l.resource.ProtoIdentitySchema(ctx); f != nil {
+		response.ProtoV5IdentitySchema = f()
+	}
+}
+
+// ResourceData returns a new, empty ResourceData for the Plugin SDK resource.
+func (l *ListResourceWithSDKv2Resource) ResourceData() *schema.ResourceData {
+	return l.resource.Data(&terraform.InstanceState{})
+}
+
+// SetResult sets the list result's identity from the ResourceData, whose ID must already be set.
+// If the request includes the resource, the resource is read and the list result's resource is set.
+// Any errors are added to the list result's diagnostics.
+// Returns false if the resource no longer exists.
+func (l *ListResourceWithSDKv2Resource) SetResult(ctx context.Context, request list.ListRequest, d *schema.ResourceData, result *list.ListResult) bool {
+	if request.IncludeResource {
+		if diags := l.resource.ReadWithoutTimeout(ctx, d, l.Meta()); diags.HasError() {
+			result.Diagnostics.AddError(fmt.Sprintf("reading %s", d.Id()), sdkdiag.DiagnosticsError(diags).Error())
+			return true
+		}
+
+		if d.Id() == "" {
+			return false
+		}
+	}
+
+	if err := l.setIdentity(ctx, d); err != nil {
+		result.Diagnostics.AddError(fmt.Sprintf("setting %s identity", d.Id()), err.Error())
+		return true
+	}
+
+	identity, err := d.TfTypeIdentityState()
+	if err != nil {
+		result.Diagnostics.AddError(fmt.Sprintf("converting %s identity", d.Id()), err.Error())
+		return true
+	}
+	result.Identity.Raw = *identity
+
+	if request.IncludeResource {
+		resource, err := d.TfTypeResourceState()
+		if err != nil {
+			result.Diagnostics.AddError(fmt.Sprintf("converting %s", d.Id()), err.Error())
+			return true
+		}
+		result.Resource.Raw = *resource
+	}
+
+	return true
+}
+
+// setIdentity sets each identity attribute from the provider configuration or the ResourceData.
+func (l *ListResourceWithSDKv2Resource) setIdentity(ctx context.Context, d *schema.ResourceData) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+
+	c := l.Meta()
+	for attribute := range l.resource.Identity.SchemaMap() {
+		var v any
+		switch attribute {
+		case names.AttrAccountID:
+			v = c.AccountID(ctx)
+		case names.AttrRegion:
+			v = c.Region(ctx)
+		case names.AttrID:
+			v = d.Id()
+		default:
+			v = d.Get(attribute)
+		}
+
+		if err := identity.Set(attribute, v); err != nil {
+			return fmt.Errorf("setting %s: %w", attribute, err)
+		}
+	}
+
+	return nil
+}
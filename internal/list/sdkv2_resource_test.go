@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package list
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	testAccountID = "123456789012"
+	testRegion    = "us-west-2" //lintignore:AWSAT003
+)
+
+func newTestListResource(ctx context.Context, t *testing.T) *ListResourceWithSDKv2Resource {
+	t.Helper()
+
+	r := &schema.Resource{
+		ReadWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			if d.Id() == "gone" {
+				d.SetId("")
+				return nil
+			}
+
+			d.Set(names.AttrName, d.Id())
+			return nil
+		},
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					names.AttrAccountID: {
+						Type:              schema.TypeString,
+						OptionalForImport: true,
+					},
+					names.AttrID: {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+					},
+					names.AttrRegion: {
+						Type:              schema.TypeString,
+						OptionalForImport: true,
+					},
+				}
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+
+	client := &conns.AWSClient{}
+	conns.SetAccountID(client, testAccountID)
+	conns.SetRegion(client, testRegion)
+
+	l := &ListResourceWithSDKv2Resource{}
+	l.SetResourceSchema(r)
+	var response resource.ConfigureResponse
+	l.Configure(ctx, resource.ConfigureRequest{ProviderData: client}, &response)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", fwdiag.DiagnosticsString(response.Diagnostics))
+	}
+
+	return l
+}
+
+func TestListResourceWithSDKv2ResourceRawV5Schemas(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := newTestListResource(ctx, t)
+
+	var response list.RawV5SchemaResponse
+	l.RawV5Schemas(ctx, list.RawV5SchemaRequest{}, &response)
+
+	if response.ProtoV5Schema == nil {
+		t.Error("ProtoV5Schema is nil")
+	}
+	if response.ProtoV5IdentitySchema == nil {
+		t.Error("ProtoV5IdentitySchema is nil")
+	}
+}
+
+func TestListResourceWithSDKv2ResourceSetResult(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	identityType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			names.AttrAccountID: tftypes.String,
+			names.AttrID:        tftypes.String,
+			names.AttrRegion:    tftypes.String,
+		},
+	}
+	resourceType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			names.AttrID:   tftypes.String,
+			names.AttrName: tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		id               string
+		includeResource  bool
+		expectedOK       bool
+		expectedResource tftypes.Value
+	}{
+		"identity": {
+			id:               "test",
+			expectedOK:       true,
+			expectedResource: tftypes.NewValue(resourceType, nil),
+		},
+		"include resource": {
+			id:              "test",
+			includeResource: true,
+			expectedOK:      true,
+			expectedResource: tftypes.NewValue(resourceType, map[string]tftypes.Value{
+				names.AttrID:   tftypes.NewValue(tftypes.String, "test"),
+				names.AttrName: tftypes.NewValue(tftypes.String, "test"),
+			}),
+		},
+		"include resource not found": {
+			id:              "gone",
+			includeResource: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			l := newTestListResource(ctx, t)
+			d := l.ResourceData()
+			d.SetId(testCase.id)
+			result := list.ListResult{
+				Identity: &tfsdk.ResourceIdentity{
+					Raw: tftypes.NewValue(identityType, nil),
+				},
+				Resource: &tfsdk.Resource{
+					Raw: tftypes.NewValue(resourceType, nil),
+				},
+			}
+
+			ok := l.SetResult(ctx, list.ListRequest{IncludeResource: testCase.includeResource}, d, &result)
+			if result.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", fwdiag.DiagnosticsString(result.Diagnostics))
+			}
+			if got, want := ok, testCase.expectedOK; got != want {
+				t.Fatalf("SetResult = %t, want %t", got, want)
+			}
+			if !ok {
+				return
+			}
+
+			expectedIdentity := tftypes.NewValue(identityType, map[string]tftypes.Value{
+				names.AttrAccountID: tftypes.NewValue(tftypes.String, testAccountID),
+				names.AttrID:        tftypes.NewValue(tftypes.String, testCase.id),
+				names.AttrRegion:    tftypes.NewValue(tftypes.String, testRegion),
+			})
+			if got, want := result.Identity.Raw, expectedIdentity; !got.Equal(want) {
+				t.Errorf("identity = %s, want %s", got, want)
+			}
+			if got, want := result.Resource.Raw, testCase.expectedResource; !got.Equal(want) {
+				t.Errorf("resource = %s, want %s", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+)
+
+// concurrencyLimitInterceptor limits the number of concurrent CRUD operations for a resource type.
+// It must be the last interceptor in the chain so that no other Before interceptor can short circuit after capacity is acquired.
+func concurrencyLimitInterceptor(typeName string) interceptor {
+	return interceptorFunc(func(ctx context.Context, opts interceptorOptions) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		switch c, when := opts.c, opts.when; when {
+		case Before:
+			if err := interceptors.AcquireConcurrencyLimit(ctx, c, typeName); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting on %s concurrency limit: %s", typeName, err)
+			}
+		case Finally:
+			interceptors.ReleaseConcurrencyLimit(ctx, c, typeName)
+		}
+
+		return diags
+	})
+}
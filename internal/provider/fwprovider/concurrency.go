@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+)
+
+// concurrencyLimitResourceInterceptor limits the number of concurrent CRUD operations for a resource type.
+// It must be the last interceptor in the chain so that no other Before interceptor can short circuit after capacity is acquired.
+type concurrencyLimitResourceInterceptor struct {
+	typeName string
+}
+
+func newConcurrencyLimitResourceInterceptor(typeName string) resourceInterceptor {
+	return &concurrencyLimitResourceInterceptor{
+		typeName: typeName,
+	}
+}
+
+func (r concurrencyLimitResourceInterceptor) create(ctx context.Context, opts interceptorOptions[resource.CreateRequest, resource.CreateResponse]) diag.Diagnostics {
+	return concurrencyLimit(ctx, opts, r.typeName)
+}
+
+func (r concurrencyLimitResourceInterceptor) read(ctx context.Context, opts interceptorOptions[resource.ReadRequest, resource.ReadResponse]) diag.Diagnostics {
+	return concurrencyLimit(ctx, opts, r.typeName)
+}
+
+func (r concurrencyLimitResourceInterceptor) update(ctx context.Context, opts interceptorOptions[resource.UpdateRequest, resource.UpdateResponse]) diag.Diagnostics {
+	return concurrencyLimit(ctx, opts, r.typeName)
+}
+
+func (r concurrencyLimitResourceInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) diag.Diagnostics {
+	return concurrencyLimit(ctx, opts, r.typeName)
+}
+
+func concurrencyLimit[Request, Response any](ctx context.Context, opts interceptorOptions[Request, Response], typeName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch c, when := opts.c, opts.when; when {
+	case Before:
+		if err := interceptors.AcquireConcurrencyLimit(ctx, c, typeName); err != nil {
+			diags.AddError("waiting on "+typeName+" concurrency limit", err.Error())
+		}
+	case Finally:
+		interceptors.ReleaseConcurrencyLimit(ctx, c, typeName)
+	}
+
+	return diags
+}
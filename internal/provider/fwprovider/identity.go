@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// resourceIdentity describes the attributes that make up a resource's identity.
+type resourceIdentity struct {
+	attribute string // The attribute whose value is the resource's import ID.
+	account   bool   // Whether the identity includes the AWS account ID.
+	region    bool   // Whether the identity includes the AWS Region.
+}
+
+func newResourceIdentity(v *itypes.ServicePackageResourceIdentity, servicePackageName string) resourceIdentity {
+	if v.ARN {
+		return resourceIdentity{
+			attribute: names.AttrARN,
+		}
+	}
+
+	return resourceIdentity{
+		attribute: v.IdentityAttribute,
+		account:   true,
+		region:    !names.IsGlobal(servicePackageName),
+	}
+}
+
+// schema returns the resource's identity schema.
+func (ri resourceIdentity) schema() identityschema.Schema {
+	attributes := map[string]identityschema.Attribute{
+		ri.attribute: identityschema.StringAttribute{
+			RequiredForImport: true,
+		},
+	}
+	if ri.account {
+		attributes[names.AttrAccountID] = identityschema.StringAttribute{
+			OptionalForImport: true,
+		}
+	}
+	if ri.region {
+		attributes[names.AttrRegion] = identityschema.StringAttribute{
+			OptionalForImport: true,
+		}
+	}
+
+	return identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// set sets the resource's identity from its state.
+func (ri resourceIdentity) set(ctx context.Context, c *conns.AWSClient, state tfsdk.State, identity *tfsdk.ResourceIdentity) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+	if identity == nil || state.Raw.IsNull() {
+		return diags
+	}
+
+	// The identifying attribute may be of a custom String type.
+	v, _, err := tftypes.WalkAttributePath(state.Raw, tftypes.NewAttributePath().WithAttributeName(ri.attribute))
+	if err != nil {
+		diags.AddError("reading resource identity attribute", fmt.Sprintf("%s: %s", ri.attribute, err))
+		return diags
+	}
+	var value string
+	if err := v.(tftypes.Value).As(&value); err != nil {
+		diags.AddError("reading resource identity attribute", fmt.Sprintf("%s: %s", ri.attribute, err))
+		return diags
+	}
+
+	diags.Append(identity.SetAttribute(ctx, path.Root(ri.attribute), value)...)
+	if ri.account {
+		diags.Append(identity.SetAttribute(ctx, path.Root(names.AttrAccountID), c.AccountID(ctx))...)
+	}
+	if ri.region {
+		diags.Append(identity.SetAttribute(ctx, path.Root(names.AttrRegion), c.Region(ctx))...)
+	}
+
+	return diags
+}
+
+// importID returns the import ID of a resource imported by identity.
+// Any Region is appended to the import ID using the per-resource Region override syntax.
+func (ri resourceIdentity) importID(ctx context.Context, c *conns.AWSClient, identity tfsdk.ResourceIdentity, regionOverride bool) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var id types.String
+	diags.Append(identity.GetAttribute(ctx, path.Root(ri.attribute), &id)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if ri.account {
+		var accountID types.String
+		diags.Append(identity.GetAttribute(ctx, path.Root(names.AttrAccountID), &accountID)...)
+		if diags.HasError() {
+			return "", diags
+		}
+
+		if v := accountID.ValueString(); v != "" && v != c.AccountID(ctx) {
+			diags.AddAttributeError(path.Root(names.AttrAccountID), "Invalid Resource Identity",
+				fmt.Sprintf("Provider configured with account ID %q, got %q", c.AccountID(ctx), v))
+			return "", diags
+		}
+	}
+
+	var region string
+	if ri.region {
+		var v types.String
+		diags.Append(identity.GetAttribute(ctx, path.Root(names.AttrRegion), &v)...)
+		if diags.HasError() {
+			return "", diags
+		}
+		region = v.ValueString()
+	} else if ri.attribute == names.AttrARN {
+		arn, err := arn.Parse(id.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root(ri.attribute), "Invalid Resource Identity", err.Error())
+			return "", diags
+		}
+		region = arn.Region
+	}
+
+	if regionOverride && region != "" {
+		return id.ValueString() + importIDRegionSeparator + region, diags
+	}
+
+	return id.ValueString(), diags
+}
+
+// identityResourceInterceptor sets a resource's identity after a Create, Read or Update.
+type identityResourceInterceptor struct {
+	identity resourceIdentity
+}
+
+func newIdentityResourceInterceptor(identity resourceIdentity) resourceInterceptor {
+	return &identityResourceInterceptor{
+		identity: identity,
+	}
+}
+
+func (r identityResourceInterceptor) create(ctx context.Context, opts interceptorOptions[resource.CreateRequest, resource.CreateResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		diags.Append(r.identity.set(ctx, opts.c, response.State, response.Identity)...)
+	}
+
+	return diags
+}
+
+func (r identityResourceInterceptor) read(ctx context.Context, opts interceptorOptions[resource.ReadRequest, resource.ReadResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		diags.Append(r.identity.set(ctx, opts.c, response.State, response.Identity)...)
+	}
+
+	return diags
+}
+
+func (r identityResourceInterceptor) update(ctx context.Context, opts interceptorOptions[resource.UpdateRequest, resource.UpdateResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		diags.Append(r.identity.set(ctx, opts.c, response.State, response.Identity)...)
+	}
+
+	return diags
+}
+
+func (r identityResourceInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+	return diags
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const identityTestAccountID = "123456789012"
+
+// newIdentityTestResource returns a configured wrapped resource identified by its `id` attribute,
+// with the per-resource Region override attribute injected, as the provider registers it.
+func newIdentityTestResource(ctx context.Context, t *testing.T) (*regionTestResource, resource.ResourceWithIdentity, regionTestSchema) {
+	t.Helper()
+
+	inner := &regionTestResource{}
+	var schemaResponse resource.SchemaResponse
+	inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+	outer, ok := resourceSchemaWithRegion(schemaResponse.Schema)
+	if !ok {
+		t.Fatal("region attribute not injected")
+	}
+
+	identity := resourceIdentity{
+		attribute: names.AttrID,
+		account:   true,
+		region:    true,
+	}
+	w, ok := newWrappedResource(inner, wrappedResourceOptions{
+		bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+			region, diags := overrideRegion(ctx, getAttribute)
+			if diags.HasError() {
+				return ctx, diags
+			}
+
+			return conns.NewResourceContext(ctx, "test", "Test", region), diags
+		},
+		identity:        &identity,
+		interceptors:    resourceInterceptors{newRegionResourceInterceptor(), newIdentityResourceInterceptor(identity)},
+		modifyPlanFuncs: []modifyPlanFunc{defaultRegion},
+		regionSchemas: &resourceRegionSchemas{
+			inner: schemaResponse.Schema,
+			outer: outer,
+		},
+		typeName: "aws_test",
+	}).(resource.ResourceWithIdentity)
+	if !ok {
+		t.Fatal("wrapped resource does not implement ResourceWithIdentity")
+	}
+
+	client := &conns.AWSClient{}
+	conns.SetAccountID(client, identityTestAccountID)
+	conns.SetRegion(client, regionTestProviderRegion)
+	var configureResponse resource.ConfigureResponse
+	w.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: client}, &configureResponse)
+	if configureResponse.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", fwdiag.DiagnosticsString(configureResponse.Diagnostics))
+	}
+
+	return inner, w, regionTestSchema{outer}
+}
+
+// identityTestValue returns an identity object value. Each argument is a string or nil for null.
+func identityTestValue(ctx context.Context, t *testing.T, w resource.ResourceWithIdentity, id, accountID, region any) tfsdk.ResourceIdentity {
+	t.Helper()
+
+	var response resource.IdentitySchemaResponse
+	w.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &response)
+
+	return tfsdk.ResourceIdentity{
+		Raw: tftypes.NewValue(response.IdentitySchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			names.AttrID:        tftypes.NewValue(tftypes.String, id),
+			names.AttrAccountID: tftypes.NewValue(tftypes.String, accountID),
+			names.AttrRegion:    tftypes.NewValue(tftypes.String, region),
+		}),
+		Schema: response.IdentitySchema,
+	}
+}
+
+func TestNewWrappedResourceIdentity(t *testing.T) {
+	t.Parallel()
+
+	if _, ok := newWrappedResource(&regionTestResource{}, wrappedResourceOptions{}).(resource.ResourceWithIdentity); ok {
+		t.Error("resource without identity implements ResourceWithIdentity")
+	}
+}
+
+func TestWrappedResourceIdentityCreate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	_, w, s := newIdentityTestResource(ctx, t)
+
+	identity := identityTestValue(ctx, t, w, nil, nil, nil)
+	request := resource.CreateRequest{
+		Config: s.config(s.value(ctx, nil, "test", regionTestOverrideRegion)),
+		Plan:   s.plan(s.value(ctx, tftypes.UnknownValue, "test", regionTestOverrideRegion)),
+	}
+	response := resource.CreateResponse{
+		State:    s.state(s.null(ctx)),
+		Identity: &identity,
+	}
+	w.Create(ctx, request, &response)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", fwdiag.DiagnosticsString(response.Diagnostics))
+	}
+
+	if got, want := response.Identity.Raw, identityTestValue(ctx, t, w, "test", identityTestAccountID, regionTestOverrideRegion).Raw; !got.Equal(want) {
+		t.Errorf("identity = %s, want %s", got, want)
+	}
+}
+
+func TestWrappedResourceIdentityImportState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		accountID           any
+		region              any
+		expectError         bool
+		expectedRegion      string
+		expectedStateRegion any
+	}{
+		"no Region": {
+			expectedRegion:      regionTestProviderRegion,
+			expectedStateRegion: nil,
+		},
+		"Region": {
+			region:              regionTestOverrideRegion,
+			expectedRegion:      regionTestOverrideRegion,
+			expectedStateRegion: regionTestOverrideRegion,
+		},
+		"account ID": {
+			accountID:           identityTestAccountID,
+			expectedRegion:      regionTestProviderRegion,
+			expectedStateRegion: nil,
+		},
+		"other account ID": {
+			accountID:   "210987654321",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			inner, w, s := newIdentityTestResource(ctx, t)
+
+			identity := identityTestValue(ctx, t, w, "test", testCase.accountID, testCase.region)
+			request := resource.ImportStateRequest{
+				Identity: &identity,
+			}
+			response := resource.ImportStateResponse{
+				State:    s.state(s.null(ctx)),
+				Identity: &identity,
+			}
+
+			w.(resource.ResourceWithImportState).ImportState(ctx, request, &response)
+			if got, want := response.Diagnostics.HasError(), testCase.expectError; got != want {
+				t.Fatalf("error %t, want %t: %s", got, want, fwdiag.DiagnosticsString(response.Diagnostics))
+			}
+			if testCase.expectError {
+				return
+			}
+
+			if got, want := response.State.Raw, s.value(ctx, "test", nil, testCase.expectedStateRegion); !got.Equal(want) {
+				t.Errorf("state = %s, want %s", got, want)
+			}
+
+			if diff := cmp.Diff(inner.regions, []string{testCase.expectedRegion}); diff != "" {
+				t.Errorf("unexpected Regions diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ignoreAttributesResourceInterceptor keeps the provider-configured ignored attributes of a resource type
+// at their prior state values after a resource Read, so that changes made outside Terraform are not reported as drift.
+type ignoreAttributesResourceInterceptor struct {
+	typeName string
+}
+
+func newIgnoreAttributesResourceInterceptor(typeName string) resourceInterceptor {
+	return &ignoreAttributesResourceInterceptor{
+		typeName: typeName,
+	}
+}
+
+func (r ignoreAttributesResourceInterceptor) create(ctx context.Context, opts interceptorOptions[resource.CreateRequest, resource.CreateResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+	return diags
+}
+
+func (r ignoreAttributesResourceInterceptor) read(ctx context.Context, opts interceptorOptions[resource.ReadRequest, resource.ReadResponse]) diag.Diagnostics {
+	c := opts.c
+	var diags diag.Diagnostics
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+		if response.State.Raw.IsNull() {
+			return diags
+		}
+
+		for _, attribute := range c.IgnoreAttributes(ctx, r.typeName) {
+			raw, err := keepPriorValue(request.State.Raw, response.State.Raw, strings.Split(attribute, "."))
+			if err != nil {
+				diags.AddError("ignoring changes to "+attribute, err.Error())
+				return diags
+			}
+
+			response.State.Raw = raw
+		}
+	}
+
+	return diags
+}
+
+func (r ignoreAttributesResourceInterceptor) update(ctx context.Context, opts interceptorOptions[resource.UpdateRequest, resource.UpdateResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+	return diags
+}
+
+func (r ignoreAttributesResourceInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+	return diags
+}
+
+// keepPriorValue returns the current value with the attribute at the specified path steps set to its value in prior state.
+// Attributes that are not in prior or current state, e.g. on import, are left unchanged.
+func keepPriorValue(prior, current tftypes.Value, steps []string) (tftypes.Value, error) {
+	path, ok := attributePath(current.Type(), steps)
+	if !ok {
+		return current, nil
+	}
+
+	v, _, err := tftypes.WalkAttributePath(prior, path)
+	if err != nil {
+		return current, nil
+	}
+	priorValue, ok := v.(tftypes.Value)
+	if !ok || priorValue.IsNull() {
+		return current, nil
+	}
+
+	if _, _, err := tftypes.WalkAttributePath(current, path); err != nil {
+		return current, nil
+	}
+
+	return tftypes.Transform(current, func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if p.Equal(path) {
+			return priorValue, nil
+		}
+
+		return v, nil
+	})
+}
+
+// attributePath returns the attribute path corresponding to the specified path steps in a value of the specified type.
+// Sets cannot be traversed, so a set can only be the last step in the path.
+func attributePath(typ tftypes.Type, steps []string) (*tftypes.AttributePath, bool) {
+	path := tftypes.NewAttributePath()
+
+	for _, step := range steps {
+		switch t := typ.(type) {
+		case tftypes.Object:
+			v, ok := t.AttributeTypes[step]
+			if !ok {
+				return nil, false
+			}
+			path, typ = path.WithAttributeName(step), v
+		case tftypes.List:
+			i, err := strconv.Atoi(step)
+			if err != nil || i < 0 {
+				return nil, false
+			}
+			path, typ = path.WithElementKeyInt(i), t.ElementType
+		case tftypes.Map:
+			path, typ = path.WithElementKeyString(step), t.ElementType
+		default:
+			return nil, false
+		}
+	}
+
+	return path, true
+}
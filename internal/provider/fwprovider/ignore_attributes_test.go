@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	ignoreAttributesTestRuleType = tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"enabled": tftypes.Bool,
+			"ports":   tftypes.Set{ElementType: tftypes.Number},
+		},
+	}
+	ignoreAttributesTestType = tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"rule": tftypes.List{ElementType: ignoreAttributesTestRuleType},
+			"tags": tftypes.Map{ElementType: tftypes.String},
+		},
+	}
+)
+
+func ignoreAttributesTestValue(id string, enabled []bool, tags map[string]string) tftypes.Value {
+	rules := make([]tftypes.Value, 0, len(enabled))
+	for _, v := range enabled {
+		rules = append(rules, tftypes.NewValue(ignoreAttributesTestRuleType, map[string]tftypes.Value{
+			"enabled": tftypes.NewValue(tftypes.Bool, v),
+			"ports":   tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, nil),
+		}))
+	}
+
+	var tagsValue tftypes.Value
+	if tags == nil {
+		tagsValue = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil)
+	} else {
+		m := make(map[string]tftypes.Value, len(tags))
+		for k, v := range tags {
+			m[k] = tftypes.NewValue(tftypes.String, v)
+		}
+		tagsValue = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, m)
+	}
+
+	return tftypes.NewValue(ignoreAttributesTestType, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, id),
+		"rule": tftypes.NewValue(tftypes.List{ElementType: ignoreAttributesTestRuleType}, rules),
+		"tags": tagsValue,
+	})
+}
+
+func TestAttributePath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		steps        []string
+		expectedPath *tftypes.AttributePath
+		expectedOK   bool
+	}{
+		"attribute": {
+			steps:        []string{"id"},
+			expectedPath: tftypes.NewAttributePath().WithAttributeName("id"),
+			expectedOK:   true,
+		},
+		"nested list attribute": {
+			steps:        []string{"rule", "1", "enabled"},
+			expectedPath: tftypes.NewAttributePath().WithAttributeName("rule").WithElementKeyInt(1).WithAttributeName("enabled"),
+			expectedOK:   true,
+		},
+		"map element": {
+			steps:        []string{"tags", "Owner"},
+			expectedPath: tftypes.NewAttributePath().WithAttributeName("tags").WithElementKeyString("Owner"),
+			expectedOK:   true,
+		},
+		"set": {
+			steps:        []string{"rule", "0", "ports"},
+			expectedPath: tftypes.NewAttributePath().WithAttributeName("rule").WithElementKeyInt(0).WithAttributeName("ports"),
+			expectedOK:   true,
+		},
+		"set element": {
+			steps: []string{"rule", "0", "ports", "0"},
+		},
+		"missing attribute": {
+			steps: []string{"name"},
+		},
+		"missing nested attribute": {
+			steps: []string{"rule", "0", "name"},
+		},
+		"invalid list index": {
+			steps: []string{"rule", "first", "enabled"},
+		},
+		"negative list index": {
+			steps: []string{"rule", "-1", "enabled"},
+		},
+		"step past primitive": {
+			steps: []string{"id", "value"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path, ok := attributePath(ignoreAttributesTestType, testCase.steps)
+
+			if got, want := ok, testCase.expectedOK; got != want {
+				t.Fatalf("ok = %t, want %t", got, want)
+			}
+			if ok && !path.Equal(testCase.expectedPath) {
+				t.Errorf("path = %s, want %s", path, testCase.expectedPath)
+			}
+		})
+	}
+}
+
+func TestKeepPriorValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		prior    tftypes.Value
+		current  tftypes.Value
+		steps    []string
+		expected tftypes.Value
+	}{
+		"attribute": {
+			prior:    ignoreAttributesTestValue("prior", nil, nil),
+			current:  ignoreAttributesTestValue("current", nil, nil),
+			steps:    []string{"id"},
+			expected: ignoreAttributesTestValue("prior", nil, nil),
+		},
+		"nested list attribute": {
+			prior:    ignoreAttributesTestValue("current", []bool{true, true}, nil),
+			current:  ignoreAttributesTestValue("current", []bool{false, false}, nil),
+			steps:    []string{"rule", "1", "enabled"},
+			expected: ignoreAttributesTestValue("current", []bool{false, true}, nil),
+		},
+		"nested list attribute not in prior state": {
+			prior:    ignoreAttributesTestValue("current", []bool{true}, nil),
+			current:  ignoreAttributesTestValue("current", []bool{false, false}, nil),
+			steps:    []string{"rule", "1", "enabled"},
+			expected: ignoreAttributesTestValue("current", []bool{false, false}, nil),
+		},
+		"nested list attribute not in current state": {
+			prior:    ignoreAttributesTestValue("current", []bool{true, true}, nil),
+			current:  ignoreAttributesTestValue("current", []bool{false}, nil),
+			steps:    []string{"rule", "1", "enabled"},
+			expected: ignoreAttributesTestValue("current", []bool{false}, nil),
+		},
+		"map element": {
+			prior:    ignoreAttributesTestValue("current", nil, map[string]string{"Name": "prior", "Owner": "prior"}),
+			current:  ignoreAttributesTestValue("current", nil, map[string]string{"Name": "current", "Owner": "current"}),
+			steps:    []string{"tags", "Owner"},
+			expected: ignoreAttributesTestValue("current", nil, map[string]string{"Name": "current", "Owner": "prior"}),
+		},
+		"map element not in current state": {
+			prior:    ignoreAttributesTestValue("current", nil, map[string]string{"Owner": "prior"}),
+			current:  ignoreAttributesTestValue("current", nil, map[string]string{"Name": "current"}),
+			steps:    []string{"tags", "Owner"},
+			expected: ignoreAttributesTestValue("current", nil, map[string]string{"Name": "current"}),
+		},
+		"whole map": {
+			prior:    ignoreAttributesTestValue("current", nil, map[string]string{"Owner": "prior"}),
+			current:  ignoreAttributesTestValue("current", nil, map[string]string{"Name": "current"}),
+			steps:    []string{"tags"},
+			expected: ignoreAttributesTestValue("current", nil, map[string]string{"Owner": "prior"}),
+		},
+		"null in prior state": {
+			prior:    ignoreAttributesTestValue("current", nil, nil),
+			current:  ignoreAttributesTestValue("current", nil, map[string]string{"Name": "current"}),
+			steps:    []string{"tags"},
+			expected: ignoreAttributesTestValue("current", nil, map[string]string{"Name": "current"}),
+		},
+		"missing path": {
+			prior:    ignoreAttributesTestValue("prior", nil, nil),
+			current:  ignoreAttributesTestValue("current", nil, nil),
+			steps:    []string{"name"},
+			expected: ignoreAttributesTestValue("current", nil, nil),
+		},
+		"import": {
+			prior:    tftypes.NewValue(ignoreAttributesTestType, nil),
+			current:  ignoreAttributesTestValue("current", []bool{false}, map[string]string{"Name": "current"}),
+			steps:    []string{"rule", "0", "enabled"},
+			expected: ignoreAttributesTestValue("current", []bool{false}, map[string]string{"Name": "current"}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := keepPriorValue(testCase.prior, testCase.current, testCase.steps)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !got.Equal(testCase.expected) {
+				t.Errorf("got %s, want %s", got, testCase.expected)
+			}
+		})
+	}
+}
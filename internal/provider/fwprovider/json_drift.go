@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	jsonDriftWarningSummary = "JSON Document Differences"
+	jsonDriftWarningDetail  = "The planned value differs semantically from the prior value:\n\n"
+)
+
+// jsonDocumentDiffsFunc returns the semantic differences between two JSON documents.
+type jsonDocumentDiffsFunc func(string, string) ([]string, error)
+
+// jsonDocumentDiffsFuncForType returns the jsonDocumentDiffsFunc used for values of the specified type.
+func jsonDocumentDiffsFuncForType(t attr.Type) (jsonDocumentDiffsFunc, bool) {
+	switch {
+	case t.Equal(fwtypes.IAMPolicyType):
+		return verify.PolicyDiffs, true
+	case t.Equal(jsontypes.NormalizedType{}):
+		return verify.JSONDiffs, true
+	default:
+		return nil, false
+	}
+}
+
+// containsJSONDocumentType returns whether the specified type is, or contains, a JSON document type.
+func containsJSONDocumentType(t attr.Type) bool {
+	if _, ok := jsonDocumentDiffsFuncForType(t); ok {
+		return true
+	}
+
+	switch t := t.(type) {
+	case attr.TypeWithAttributeTypes:
+		for _, t := range t.AttributeTypes() {
+			if containsJSONDocumentType(t) {
+				return true
+			}
+		}
+	case attr.TypeWithElementType:
+		return containsJSONDocumentType(t.ElementType())
+	}
+
+	return false
+}
+
+// jsonDriftResourceInterceptor explains JSON document differences when planning.
+// Terraform plans each resource again during apply, and the warning should only be shown once.
+// Resources are only refreshed when planning, so warnings are only emitted by a provider instance that has read a resource.
+type jsonDriftResourceInterceptor struct {
+	resourceRead atomic.Bool
+}
+
+// newJSONDriftResourceInterceptor returns an interceptor that is shared by all of a provider instance's resources.
+func newJSONDriftResourceInterceptor() *jsonDriftResourceInterceptor {
+	return &jsonDriftResourceInterceptor{}
+}
+
+func (r *jsonDriftResourceInterceptor) create(ctx context.Context, opts interceptorOptions[resource.CreateRequest, resource.CreateResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+	return diags
+}
+
+func (r *jsonDriftResourceInterceptor) read(ctx context.Context, opts interceptorOptions[resource.ReadRequest, resource.ReadResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch opts.when {
+	case After:
+		r.resourceRead.Store(true)
+	}
+
+	return diags
+}
+
+func (r *jsonDriftResourceInterceptor) update(ctx context.Context, opts interceptorOptions[resource.UpdateRequest, resource.UpdateResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+	return diags
+}
+
+func (r *jsonDriftResourceInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+	return diags
+}
+
+// modifyPlan is a modifyPlanFunc that emits a warning listing the semantic differences
+// between the prior and planned values of any JSON document attributes.
+// Textual differences that are semantically equivalent are suppressed elsewhere and are not reported.
+func (r *jsonDriftResourceInterceptor) modifyPlan(ctx context.Context, _ *conns.AWSClient, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Only updates are of interest.
+	if request.State.Raw.IsNull() || response.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Not planning, e.g. Terraform is planning the resource again during apply.
+	if !r.resourceRead.Load() {
+		return
+	}
+
+	err := tftypes.Walk(response.Plan.Raw, func(p *tftypes.AttributePath, planned tftypes.Value) (bool, error) {
+		if !planned.Type().Is(tftypes.String) {
+			return true, nil
+		}
+
+		t, err := response.Plan.Schema.TypeAtTerraformPath(ctx, p)
+		if err != nil {
+			return false, nil
+		}
+
+		f, ok := jsonDocumentDiffsFuncForType(t)
+		if !ok {
+			return false, nil
+		}
+
+		attributePath, ok := attributePathFromTerraformPath(p)
+		if !ok {
+			return false, nil
+		}
+
+		v, _, err := tftypes.WalkAttributePath(request.State.Raw, p)
+		if err != nil {
+			return false, nil
+		}
+		prior, ok := v.(tftypes.Value)
+		if !ok {
+			return false, nil
+		}
+
+		var old, new string
+		if !planned.IsKnown() || planned.IsNull() || prior.IsNull() || !prior.IsKnown() {
+			return false, nil
+		}
+		if err := planned.As(&new); err != nil {
+			return false, nil
+		}
+		if err := prior.As(&old); err != nil {
+			return false, nil
+		}
+		if old == new {
+			return false, nil
+		}
+
+		diffs, err := f(old, new)
+		if err != nil || len(diffs) == 0 {
+			return false, nil
+		}
+
+		response.Diagnostics.AddAttributeWarning(attributePath, jsonDriftWarningSummary, jsonDriftWarningDetail+strings.Join(diffs, "\n"))
+
+		return false, nil
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError("explaining JSON document differences", err.Error())
+	}
+}
+
+// attributePathFromTerraformPath converts a terraform-plugin-go attribute path to a Plugin Framework path.
+// Paths through set elements cannot be converted.
+func attributePathFromTerraformPath(p *tftypes.AttributePath) (path.Path, bool) {
+	var result path.Path
+
+	for i, step := range p.Steps() {
+		switch step := step.(type) {
+		case tftypes.AttributeName:
+			if i == 0 {
+				result = path.Root(string(step))
+			} else {
+				result = result.AtName(string(step))
+			}
+		case tftypes.ElementKeyInt:
+			result = result.AtListIndex(int(step))
+		case tftypes.ElementKeyString:
+			result = result.AtMapKey(string(step))
+		default:
+			return path.Empty(), false
+		}
+	}
+
+	return result, true
+}
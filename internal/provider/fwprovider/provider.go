@@ -13,12 +13,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -31,6 +33,7 @@ import (
 var _ provider.Provider = &fwprovider{}
 var _ provider.ProviderWithFunctions = &fwprovider{}
 var _ provider.ProviderWithEphemeralResources = &fwprovider{}
+var _ provider.ProviderWithListResources = &fwprovider{}
 
 // New returns a new, initialized Terraform Plugin Framework-style provider instance.
 // The provider instance is fully configured once the `Configure` method has been called.
@@ -61,6 +64,10 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"batch_tag_updates": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Batch tag-only updates, such as those caused by changes to `default_tags`, into Resource Groups Tagging API calls of up to 20 resources.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -254,6 +261,21 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 					},
 				},
 			},
+			"concurrency_limits": schema.ListNestedBlock{
+				Description: "Configuration block with settings to limit the number of concurrent operations on individual resource types.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"limit": schema.Int64Attribute{
+							Required:    true,
+							Description: "The maximum number of concurrent create, read, update and delete operations on the resource type.",
+						},
+						"resource_type": schema.StringAttribute{
+							Required:    true,
+							Description: "The resource type to limit, e.g. `aws_organizations_account`.",
+						},
+					},
+				},
+			},
 			"default_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -271,6 +293,23 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				},
 			},
 			"endpoints": endpointsBlock(),
+			"ignore_attributes": schema.ListNestedBlock{
+				Description: "Configuration block with settings to ignore changes to attributes of individual resource types.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"attributes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Description: "Attributes whose changes are ignored, as dot-separated paths, " +
+								"e.g. `description` or `ebs_block_device.0.throughput`.",
+						},
+						"resource_type": schema.StringAttribute{
+							Required:    true,
+							Description: "The resource type whose attribute changes are ignored, e.g. `aws_instance`.",
+						},
+					},
+				},
+			},
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -293,6 +332,25 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 					},
 				},
 			},
+			"rate_limits": schema.ListNestedBlock{
+				Description: "Configuration block with settings to limit the rate of AWS API requests made by the provider to individual services.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of requests that can be made in a single burst. Defaults to `requests_per_second` rounded up.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Required:    true,
+							Description: "The sustained rate, in requests per second, at which API requests are made to the service.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service to rate limit, e.g. `route53`. Any of the service names or aliases supported in the `endpoints` block.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -306,6 +364,7 @@ func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRe
 	response.DataSourceData = v
 	response.ResourceData = v
 	response.EphemeralResourceData = v
+	response.ListResourceData = v
 }
 
 // DataSources returns a slice of functions to instantiate each DataSource
@@ -333,12 +392,11 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 			typeName := v.TypeName
 			interceptors := dataSourceInterceptors{}
+			schemaResponse := datasource.SchemaResponse{}
+			inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := datasource.SchemaResponse{}
-				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if !v.IsComputed() {
 						errs = append(errs, fmt.Errorf("`%s` attribute must be Computed: %s", names.AttrTags, typeName))
@@ -352,12 +410,28 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 				interceptors = append(interceptors, newTagsDataSourceInterceptor(v.Tags))
 			}
 
+			var regionSchemas *dataSourceRegionSchemas
+			if s, ok := dataSourceSchemaWithRegion(schemaResponse.Schema); ok && !names.IsGlobal(servicePackageName) {
+				regionSchemas = &dataSourceRegionSchemas{
+					inner: schemaResponse.Schema,
+					outer: s,
+				}
+				interceptors = append(interceptors, newRegionDataSourceInterceptor())
+			}
+
 			opts := wrappedDataSourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
-				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
-					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+					var region string
+					if regionSchemas != nil {
+						region, diags = overrideRegion(ctx, getAttribute)
+						if diags.HasError() {
+							return ctx, diags
+						}
+					}
+					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, region)
 					if c != nil {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -366,8 +440,9 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 					return ctx, diags
 				},
-				interceptors: interceptors,
-				typeName:     typeName,
+				interceptors:  interceptors,
+				regionSchemas: regionSchemas,
+				typeName:      typeName,
 			}
 			dataSources = append(dataSources, func() datasource.DataSource {
 				return newWrappedDataSource(inner, opts)
@@ -391,6 +466,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	var errs []error
 	var resources []func() resource.Resource
+	jsonDrift := newJSONDriftResourceInterceptor()
 
 	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages(ctx) {
 		servicePackageName := sp.ServicePackageName()
@@ -405,13 +481,13 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 			typeName := v.TypeName
 			var modifyPlanFuncs []modifyPlanFunc
-			interceptors := resourceInterceptors{}
+			// Must be first so that it is the last interceptor run after Read.
+			interceptors := resourceInterceptors{newIgnoreAttributesResourceInterceptor(typeName)}
+			schemaResponse := resource.SchemaResponse{}
+			inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := resource.SchemaResponse{}
-				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if v.IsComputed() {
 						errs = append(errs, fmt.Errorf("`%s` attribute cannot be Computed: %s", names.AttrTags, typeName))
@@ -435,12 +511,44 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, newTagsResourceInterceptor(v.Tags))
 			}
 
+			var regionSchemas *resourceRegionSchemas
+			if s, ok := resourceSchemaWithRegion(schemaResponse.Schema); ok && !names.IsGlobal(servicePackageName) {
+				regionSchemas = &resourceRegionSchemas{
+					inner: schemaResponse.Schema,
+					outer: s,
+				}
+				modifyPlanFuncs = append(modifyPlanFuncs, defaultRegion)
+				interceptors = append(interceptors, newRegionResourceInterceptor())
+			}
+
+			var identity *resourceIdentity
+			if v.Identity != nil {
+				v := newResourceIdentity(v.Identity, servicePackageName)
+				identity = &v
+				interceptors = append(interceptors, newIdentityResourceInterceptor(v))
+			}
+
+			if containsJSONDocumentType(schemaResponse.Schema.Type()) {
+				modifyPlanFuncs = append(modifyPlanFuncs, jsonDrift.modifyPlan)
+				interceptors = append(interceptors, jsonDrift)
+			}
+
+			// Must be last.
+			interceptors = append(interceptors, newConcurrencyLimitResourceInterceptor(typeName))
+
 			opts := wrappedResourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
-				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+					var region string
+					if regionSchemas != nil {
+						region, diags = overrideRegion(ctx, getAttribute)
+						if diags.HasError() {
+							return ctx, diags
+						}
+					}
+					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, region)
 					if c != nil {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -449,8 +557,10 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 					return ctx, diags
 				},
+				identity:        identity,
 				interceptors:    interceptors,
 				modifyPlanFuncs: modifyPlanFuncs,
+				regionSchemas:   regionSchemas,
 				typeName:        typeName,
 			}
 			resources = append(resources, func() resource.Resource {
@@ -498,7 +608,7 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 					bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 						var diags diag.Diagnostics
 
-						ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name)
+						ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name, "")
 						if c != nil {
 							ctx = c.RegisterLogger(ctx)
 							ctx = flex.RegisterLogger(ctx)
@@ -525,6 +635,76 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 	return ephemeralResources
 }
 
+// ListResources returns a slice of functions to instantiate each List Resource
+// implementation.
+//
+// All list resources must have unique type names.
+func (p *fwprovider) ListResources(ctx context.Context) []func() list.ListResource {
+	var errs []error
+	var listResources []func() list.ListResource
+
+	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages(ctx) {
+		if data, ok := sp.(conns.ServicePackageWithSDKListResources); ok {
+			servicePackageName := data.ServicePackageName()
+
+			for _, v := range data.SDKListResources(ctx) {
+				inner, err := v.Factory(ctx)
+
+				if err != nil {
+					errs = append(errs, fmt.Errorf("creating list resource: %w", err))
+					continue
+				}
+
+				typeName := v.TypeName
+				if l, ok := inner.(interface {
+					SetResourceSchema(*sdkschema.Resource)
+				}); ok {
+					// The list resource's raw schemas are those of the registered Plugin SDK resource.
+					primary, ok := p.Primary.(*sdkschema.Provider)
+					if !ok {
+						errs = append(errs, fmt.Errorf("no Plugin SDK provider: %s", typeName))
+						continue
+					}
+					r, ok := primary.ResourcesMap[typeName]
+					if !ok {
+						errs = append(errs, fmt.Errorf("no Plugin SDK resource: %s", typeName))
+						continue
+					}
+					l.SetResourceSchema(r)
+				}
+
+				opts := wrappedListResourceOptions{
+					// bootstrapContext is run on all wrapped methods.
+					// List resources always use the provider's configured Region.
+					bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+						var diags diag.Diagnostics
+
+						ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, "")
+						if c != nil {
+							ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
+							ctx = c.RegisterLogger(ctx)
+						}
+
+						return ctx, diags
+					},
+					typeName: typeName,
+				}
+				listResources = append(listResources, func() list.ListResource {
+					return newWrappedListResource(inner, opts)
+				})
+			}
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		tflog.Warn(ctx, "registering list resources", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	return listResources
+}
+
 // Functions returns a slice of functions to instantiate each Function
 // implementation.
 //
@@ -534,6 +714,13 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRContainsFunction,
+		tffunction.NewCIDROverlapsFunction,
+		tffunction.NewCIDRSubnetsAvailableFunction,
+		tffunction.NewCIDRSummarizeFunction,
+		tffunction.NewIAMPolicyEquivalentFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
@@ -0,0 +1,442 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"maps"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// importIDRegionSeparator separates a resource's import ID from any per-resource Region override,
+// e.g. `terraform import aws_example.test example-id@eu-west-1`.
+const importIDRegionSeparator = "@"
+
+var regionRegexp = regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d{1,2}$`)
+
+func regionValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.RegexMatches(regionRegexp, "must be a valid AWS Region Code"),
+	}
+}
+
+// dataSourceSchemaWithRegion returns the specified data source schema with the per-resource Region override attribute injected.
+// The returned boolean is false if the schema already defines a top-level `region` attribute or block.
+func dataSourceSchemaWithRegion(s datasourceschema.Schema) (datasourceschema.Schema, bool) {
+	if _, ok := s.Attributes[names.AttrRegion]; ok {
+		return s, false
+	}
+	if _, ok := s.Blocks[names.AttrRegion]; ok {
+		return s, false
+	}
+
+	s.Attributes = maps.Clone(s.Attributes)
+	if s.Attributes == nil {
+		s.Attributes = make(map[string]datasourceschema.Attribute)
+	}
+	s.Attributes[names.AttrRegion] = datasourceschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Validators:  regionValidators(),
+		Description: "The AWS Region to read from. Defaults to the Region set in the provider configuration.",
+	}
+
+	return s, true
+}
+
+// resourceSchemaWithRegion returns the specified resource schema with the per-resource Region override attribute injected.
+// The returned boolean is false if the schema already defines a top-level `region` attribute or block.
+func resourceSchemaWithRegion(s resourceschema.Schema) (resourceschema.Schema, bool) {
+	if _, ok := s.Attributes[names.AttrRegion]; ok {
+		return s, false
+	}
+	if _, ok := s.Blocks[names.AttrRegion]; ok {
+		return s, false
+	}
+
+	s.Attributes = maps.Clone(s.Attributes)
+	if s.Attributes == nil {
+		s.Attributes = make(map[string]resourceschema.Attribute)
+	}
+	// Replacement on change is handled by defaultRegion.
+	s.Attributes[names.AttrRegion] = resourceschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Validators:  regionValidators(),
+		Description: "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+
+	return s, true
+}
+
+// overrideRegion returns any per-resource Region override value.
+func overrideRegion(ctx context.Context, getAttribute getAttributeFunc) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if getAttribute == nil {
+		return "", diags
+	}
+
+	var region types.String
+	diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	return region.ValueString(), diags
+}
+
+// importRegion splits any per-resource Region override from the resource's import ID.
+func importRegion(id string) (string, string) {
+	i := strings.LastIndex(id, importIDRegionSeparator)
+	if i < 0 {
+		return id, ""
+	}
+
+	// The separator may legitimately appear in some import IDs.
+	region := id[i+len(importIDRegionSeparator):]
+	if !regionRegexp.MatchString(region) {
+		return id, ""
+	}
+
+	return id[:i], region
+}
+
+// regionAttribute returns the value of the top-level `region` attribute in the specified object value.
+func regionAttribute(v tftypes.Value) tftypes.Value {
+	null := tftypes.NewValue(tftypes.String, nil)
+
+	if v.IsNull() || !v.IsKnown() {
+		return null
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		return null
+	}
+
+	if v, ok := attrs[names.AttrRegion]; ok {
+		return v
+	}
+
+	return null
+}
+
+// withoutRegion returns the specified object value without the top-level `region` attribute, conformed to the specified type.
+func withoutRegion(v tftypes.Value, typ tftypes.Type) (tftypes.Value, error) {
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		return tftypes.Value{}, err
+	}
+	// As doesn't copy the value's attributes.
+	attrs = maps.Clone(attrs)
+
+	delete(attrs, names.AttrRegion)
+
+	if err := tftypes.ValidateValue(typ, attrs); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	return tftypes.NewValue(typ, attrs), nil
+}
+
+// withRegion returns the specified object value with the top-level `region` attribute set, conformed to the specified type.
+func withRegion(v tftypes.Value, typ tftypes.Type, region tftypes.Value) (tftypes.Value, error) {
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		return tftypes.Value{}, err
+	}
+	// As doesn't copy the value's attributes.
+	attrs = maps.Clone(attrs)
+
+	attrs[names.AttrRegion] = region
+
+	if err := tftypes.ValidateValue(typ, attrs); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	return tftypes.NewValue(typ, attrs), nil
+}
+
+// dataSourceRegionSchemas translates data source values between the inner data source's schema
+// and the schema with the per-resource Region override attribute injected.
+type dataSourceRegionSchemas struct {
+	inner, outer datasourceschema.Schema
+}
+
+func (s dataSourceRegionSchemas) innerConfig(ctx context.Context, config tfsdk.Config) (tfsdk.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, err := withoutRegion(config.Raw, s.inner.Type().TerraformType(ctx))
+	if err != nil {
+		diags.AddError("removing region from config", err.Error())
+		return config, diags
+	}
+
+	return tfsdk.Config{Raw: raw, Schema: s.inner}, diags
+}
+
+func (s dataSourceRegionSchemas) innerState(ctx context.Context, state tfsdk.State) (tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, err := withoutRegion(state.Raw, s.inner.Type().TerraformType(ctx))
+	if err != nil {
+		diags.AddError("removing region from state", err.Error())
+		return state, diags
+	}
+
+	return tfsdk.State{Raw: raw, Schema: s.inner}, diags
+}
+
+func (s dataSourceRegionSchemas) outerState(ctx context.Context, state tfsdk.State, region tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, err := withRegion(state.Raw, s.outer.Type().TerraformType(ctx), region)
+	if err != nil {
+		diags.AddError("adding region to state", err.Error())
+		return state, diags
+	}
+
+	return tfsdk.State{Raw: raw, Schema: s.outer}, diags
+}
+
+// resourceRegionSchemas translates resource values between the inner resource's schema
+// and the schema with the per-resource Region override attribute injected.
+type resourceRegionSchemas struct {
+	inner, outer resourceschema.Schema
+}
+
+func (s resourceRegionSchemas) innerConfig(ctx context.Context, config tfsdk.Config) (tfsdk.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, err := withoutRegion(config.Raw, s.inner.Type().TerraformType(ctx))
+	if err != nil {
+		diags.AddError("removing region from config", err.Error())
+		return config, diags
+	}
+
+	return tfsdk.Config{Raw: raw, Schema: s.inner}, diags
+}
+
+func (s resourceRegionSchemas) innerPlan(ctx context.Context, plan tfsdk.Plan) (tfsdk.Plan, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, err := withoutRegion(plan.Raw, s.inner.Type().TerraformType(ctx))
+	if err != nil {
+		diags.AddError("removing region from plan", err.Error())
+		return plan, diags
+	}
+
+	return tfsdk.Plan{Raw: raw, Schema: s.inner}, diags
+}
+
+func (s resourceRegionSchemas) innerState(ctx context.Context, state tfsdk.State) (tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, err := withoutRegion(state.Raw, s.inner.Type().TerraformType(ctx))
+	if err != nil {
+		diags.AddError("removing region from state", err.Error())
+		return state, diags
+	}
+
+	return tfsdk.State{Raw: raw, Schema: s.inner}, diags
+}
+
+func (s resourceRegionSchemas) outerPlan(ctx context.Context, plan tfsdk.Plan, region tftypes.Value) (tfsdk.Plan, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, err := withRegion(plan.Raw, s.outer.Type().TerraformType(ctx), region)
+	if err != nil {
+		diags.AddError("adding region to plan", err.Error())
+		return plan, diags
+	}
+
+	return tfsdk.Plan{Raw: raw, Schema: s.outer}, diags
+}
+
+func (s resourceRegionSchemas) outerState(ctx context.Context, state tfsdk.State, region tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, err := withRegion(state.Raw, s.outer.Type().TerraformType(ctx), region)
+	if err != nil {
+		diags.AddError("adding region to state", err.Error())
+		return state, diags
+	}
+
+	return tfsdk.State{Raw: raw, Schema: s.outer}, diags
+}
+
+// regionDataSourceInterceptor sets the `region` attribute in state after a data source Read.
+type regionDataSourceInterceptor struct{}
+
+func newRegionDataSourceInterceptor() dataSourceInterceptor {
+	return &regionDataSourceInterceptor{}
+}
+
+func (r regionDataSourceInterceptor) read(ctx context.Context, opts interceptorOptions[datasource.ReadRequest, datasource.ReadResponse]) diag.Diagnostics {
+	c := opts.c
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), c.Region(ctx))...)
+	}
+
+	return diags
+}
+
+// regionResourceInterceptor sets the `region` attribute in state after a resource Create or Read.
+type regionResourceInterceptor struct{}
+
+func newRegionResourceInterceptor() resourceInterceptor {
+	return &regionResourceInterceptor{}
+}
+
+func (r regionResourceInterceptor) create(ctx context.Context, opts interceptorOptions[resource.CreateRequest, resource.CreateResponse]) diag.Diagnostics {
+	c := opts.c
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), c.Region(ctx))...)
+	}
+
+	return diags
+}
+
+func (r regionResourceInterceptor) read(ctx context.Context, opts interceptorOptions[resource.ReadRequest, resource.ReadResponse]) diag.Diagnostics {
+	c := opts.c
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+		if response.State.Raw.IsNull() {
+			return diags
+		}
+
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), c.Region(ctx))...)
+	}
+
+	return diags
+}
+
+func (r regionResourceInterceptor) update(ctx context.Context, opts interceptorOptions[resource.UpdateRequest, resource.UpdateResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+	return diags
+}
+
+func (r regionResourceInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+	return diags
+}
+
+// defaultRegion is a modifyPlanFunc that sets the planned value of the `region` attribute
+// to the provider's configured Region if the attribute is not configured and the resource is being created,
+// and requires replacement if the configured value changes.
+func defaultRegion(ctx context.Context, c *conns.AWSClient, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configRegion types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &configRegion)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	planRegion := configRegion
+	if configRegion.IsNull() {
+		// Context was bootstrapped from configuration so this is the provider's configured Region.
+		planRegion = types.StringValue(c.Region(ctx))
+	}
+
+	// If the entire state is null, the resource is planned for creation.
+	if !request.State.Raw.IsNull() {
+		var stateRegion types.String
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &stateRegion)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		switch {
+		case configRegion.IsNull():
+			// An unconfigured Region keeps any prior state value, so changing the provider's Region
+			// or upgrading state that predates the `region` attribute doesn't force replacement.
+			planRegion = stateRegion
+		case !stateRegion.Equal(planRegion):
+			response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
+		}
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), planRegion)...)
+}
+
+// plannedRegionAttribute returns a getAttributeFunc that returns the configured `region` attribute,
+// falling back to any prior state value. It is used to bootstrap Context during plan.
+func plannedRegionAttribute(config tfsdk.Config, state tfsdk.State) getAttributeFunc {
+	return func(ctx context.Context, p path.Path, target any) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		var region types.String
+		diags.Append(config.GetAttribute(ctx, p, &region)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if region.IsNull() && !state.Raw.IsNull() {
+			diags.Append(state.GetAttribute(ctx, p, &region)...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		return tfsdk.ValueAs(ctx, region, target)
+	}
+}
+
+// fixedRegionAttribute returns a getAttributeFunc that returns the specified Region for the `region` attribute.
+// It is used to bootstrap Context during import, before any state exists.
+func fixedRegionAttribute(region string) getAttributeFunc {
+	return func(ctx context.Context, p path.Path, target any) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		if !p.Equal(path.Root(names.AttrRegion)) {
+			diags.AddError("unexpected attribute path", p.String())
+			return diags
+		}
+
+		return tfsdk.ValueAs(ctx, types.StringValue(region), target)
+	}
+}
@@ -0,0 +1,550 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	regionTestProviderRegion = "us-west-2"    //lintignore:AWSAT003
+	regionTestOverrideRegion = "eu-west-1"    //lintignore:AWSAT003
+	regionTestOtherRegion    = "eu-central-1" //lintignore:AWSAT003
+)
+
+type regionTestResourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+// regionTestResource is a resource without a `region` attribute.
+// Each handler reads its request into the model, which fails if the request still has the `region` attribute,
+// and records the Region in effect.
+type regionTestResource struct {
+	client  *conns.AWSClient
+	regions []string
+}
+
+func (r *regionTestResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_test"
+}
+
+func (r *regionTestResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *regionTestResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	r.client = request.ProviderData.(*conns.AWSClient)
+}
+
+func (r *regionTestResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data regionTestResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	r.regions = append(r.regions, r.client.Region(ctx))
+	data.ID = types.StringValue("test")
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *regionTestResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data regionTestResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	r.regions = append(r.regions, r.client.Region(ctx))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *regionTestResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new regionTestResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	r.regions = append(r.regions, r.client.Region(ctx))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *regionTestResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data regionTestResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	r.regions = append(r.regions, r.client.Region(ctx))
+}
+
+func (r *regionTestResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	r.regions = append(r.regions, r.client.Region(ctx))
+
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+func (r *regionTestResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data regionTestResourceModel
+	response.Diagnostics.Append(response.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	r.regions = append(r.regions, r.client.Region(ctx))
+}
+
+func (r *regionTestResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+				r.regions = append(r.regions, r.client.Region(ctx))
+
+				data := regionTestResourceModel{
+					ID:   types.StringValue("test"),
+					Name: types.StringValue("upgraded"),
+				}
+				response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+			},
+		},
+	}
+}
+
+func (r *regionTestResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+				r.regions = append(r.regions, r.client.Region(ctx))
+
+				data := regionTestResourceModel{
+					ID:   types.StringValue("test"),
+					Name: types.StringValue("moved"),
+				}
+				response.Diagnostics.Append(response.TargetState.Set(ctx, &data)...)
+			},
+		},
+	}
+}
+
+// newRegionTestResource returns a configured wrapped resource with the per-resource Region override attribute injected,
+// as the provider registers it.
+func newRegionTestResource(ctx context.Context, t *testing.T) (*regionTestResource, *wrappedResource, regionTestSchema) {
+	t.Helper()
+
+	inner := &regionTestResource{}
+	var schemaResponse resource.SchemaResponse
+	inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+	outer, ok := resourceSchemaWithRegion(schemaResponse.Schema)
+	if !ok {
+		t.Fatal("region attribute not injected")
+	}
+
+	w := newWrappedResource(inner, wrappedResourceOptions{
+		bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+			region, diags := overrideRegion(ctx, getAttribute)
+			if diags.HasError() {
+				return ctx, diags
+			}
+
+			return conns.NewResourceContext(ctx, "test", "Test", region), diags
+		},
+		interceptors:    resourceInterceptors{newRegionResourceInterceptor()},
+		modifyPlanFuncs: []modifyPlanFunc{defaultRegion},
+		regionSchemas: &resourceRegionSchemas{
+			inner: schemaResponse.Schema,
+			outer: outer,
+		},
+		typeName: "aws_test",
+	}).(*wrappedResource)
+
+	client := &conns.AWSClient{}
+	conns.SetRegion(client, regionTestProviderRegion)
+	var configureResponse resource.ConfigureResponse
+	w.Configure(ctx, resource.ConfigureRequest{ProviderData: client}, &configureResponse)
+	if configureResponse.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", fwdiag.DiagnosticsString(configureResponse.Diagnostics))
+	}
+
+	return inner, w, regionTestSchema{outer}
+}
+
+// regionTestSchema builds values of the wrapped resource's schema.
+type regionTestSchema struct {
+	schema.Schema
+}
+
+func (s regionTestSchema) null(ctx context.Context) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(ctx), nil)
+}
+
+// value returns an object value. Each argument is a string, nil for null or tftypes.UnknownValue.
+func (s regionTestSchema) value(ctx context.Context, id, name, region any) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+		names.AttrID:     tftypes.NewValue(tftypes.String, id),
+		names.AttrName:   tftypes.NewValue(tftypes.String, name),
+		names.AttrRegion: tftypes.NewValue(tftypes.String, region),
+	})
+}
+
+func (s regionTestSchema) state(v tftypes.Value) tfsdk.State {
+	return tfsdk.State{Raw: v, Schema: s.Schema}
+}
+
+func (s regionTestSchema) plan(v tftypes.Value) tfsdk.Plan {
+	return tfsdk.Plan{Raw: v, Schema: s.Schema}
+}
+
+func (s regionTestSchema) config(v tftypes.Value) tfsdk.Config {
+	return tfsdk.Config{Raw: v, Schema: s.Schema}
+}
+
+func TestWrappedResourceRegionCRUD(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	inner, w, s := newRegionTestResource(ctx, t)
+
+	// Create.
+	config := s.value(ctx, nil, "test", regionTestOverrideRegion)
+	createRequest := resource.CreateRequest{
+		Config: s.config(config),
+		Plan:   s.plan(s.value(ctx, tftypes.UnknownValue, "test", regionTestOverrideRegion)),
+	}
+	createResponse := resource.CreateResponse{
+		State: s.state(s.null(ctx)),
+	}
+	w.Create(ctx, createRequest, &createResponse)
+	if createResponse.Diagnostics.HasError() {
+		t.Fatalf("Create: unexpected error: %s", fwdiag.DiagnosticsString(createResponse.Diagnostics))
+	}
+	if got, want := createResponse.State.Raw, s.value(ctx, "test", "test", regionTestOverrideRegion); !got.Equal(want) {
+		t.Errorf("Create: state = %s, want %s", got, want)
+	}
+
+	// Read.
+	readRequest := resource.ReadRequest{
+		State: createResponse.State,
+	}
+	readResponse := resource.ReadResponse{
+		State: createResponse.State,
+	}
+	w.Read(ctx, readRequest, &readResponse)
+	if readResponse.Diagnostics.HasError() {
+		t.Fatalf("Read: unexpected error: %s", fwdiag.DiagnosticsString(readResponse.Diagnostics))
+	}
+	if got, want := readResponse.State.Raw, createResponse.State.Raw; !got.Equal(want) {
+		t.Errorf("Read: state = %s, want %s", got, want)
+	}
+
+	// Update.
+	config = s.value(ctx, nil, "updated", regionTestOverrideRegion)
+	updateRequest := resource.UpdateRequest{
+		Config: s.config(config),
+		Plan:   s.plan(s.value(ctx, "test", "updated", regionTestOverrideRegion)),
+		State:  readResponse.State,
+	}
+	updateResponse := resource.UpdateResponse{
+		State: readResponse.State,
+	}
+	w.Update(ctx, updateRequest, &updateResponse)
+	if updateResponse.Diagnostics.HasError() {
+		t.Fatalf("Update: unexpected error: %s", fwdiag.DiagnosticsString(updateResponse.Diagnostics))
+	}
+	if got, want := updateResponse.State.Raw, s.value(ctx, "test", "updated", regionTestOverrideRegion); !got.Equal(want) {
+		t.Errorf("Update: state = %s, want %s", got, want)
+	}
+
+	// Delete.
+	deleteRequest := resource.DeleteRequest{
+		State: updateResponse.State,
+	}
+	deleteResponse := resource.DeleteResponse{
+		State: updateResponse.State,
+	}
+	w.Delete(ctx, deleteRequest, &deleteResponse)
+	if deleteResponse.Diagnostics.HasError() {
+		t.Fatalf("Delete: unexpected error: %s", fwdiag.DiagnosticsString(deleteResponse.Diagnostics))
+	}
+
+	if diff := cmp.Diff(inner.regions, []string{regionTestOverrideRegion, regionTestOverrideRegion, regionTestOverrideRegion, regionTestOverrideRegion}); diff != "" {
+		t.Errorf("unexpected Regions diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestWrappedResourceRegionModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		configRegion          any
+		stateRegion           any
+		create                bool
+		expectedPlanRegion    types.String
+		expectedRegion        string
+		expectRequiresReplace bool
+	}{
+		"create, not configured": {
+			create:             true,
+			expectedPlanRegion: types.StringValue(regionTestProviderRegion),
+			expectedRegion:     regionTestProviderRegion,
+		},
+		"create, configured": {
+			create:             true,
+			configRegion:       regionTestOverrideRegion,
+			expectedPlanRegion: types.StringValue(regionTestOverrideRegion),
+			expectedRegion:     regionTestOverrideRegion,
+		},
+		"update, not configured": {
+			stateRegion:        regionTestProviderRegion,
+			expectedPlanRegion: types.StringValue(regionTestProviderRegion),
+			expectedRegion:     regionTestProviderRegion,
+		},
+		"update, not configured, provider Region changed": {
+			stateRegion:        regionTestOtherRegion,
+			expectedPlanRegion: types.StringValue(regionTestOtherRegion),
+			expectedRegion:     regionTestOtherRegion,
+		},
+		"update, configured, unchanged": {
+			configRegion:       regionTestOverrideRegion,
+			stateRegion:        regionTestOverrideRegion,
+			expectedPlanRegion: types.StringValue(regionTestOverrideRegion),
+			expectedRegion:     regionTestOverrideRegion,
+		},
+		"update, configured, changed": {
+			configRegion:          regionTestOtherRegion,
+			stateRegion:           regionTestOverrideRegion,
+			expectedPlanRegion:    types.StringValue(regionTestOtherRegion),
+			expectedRegion:        regionTestOtherRegion,
+			expectRequiresReplace: true,
+		},
+		"update, no Region in state": {
+			expectedPlanRegion: types.StringNull(),
+			expectedRegion:     regionTestProviderRegion,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			inner, w, s := newRegionTestResource(ctx, t)
+
+			config := s.value(ctx, nil, "test", testCase.configRegion)
+			state := s.null(ctx)
+			plan := s.value(ctx, tftypes.UnknownValue, "test", tftypes.UnknownValue)
+			if !testCase.create {
+				state = s.value(ctx, "test", "test", testCase.stateRegion)
+				plan = s.value(ctx, "test", "test", tftypes.UnknownValue)
+			}
+			request := resource.ModifyPlanRequest{
+				Config: s.config(config),
+				Plan:   s.plan(plan),
+				State:  s.state(state),
+			}
+			response := resource.ModifyPlanResponse{
+				Plan: request.Plan,
+			}
+
+			w.ModifyPlan(ctx, request, &response)
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", fwdiag.DiagnosticsString(response.Diagnostics))
+			}
+
+			var planRegion types.String
+			response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &planRegion)...)
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", fwdiag.DiagnosticsString(response.Diagnostics))
+			}
+			if got, want := planRegion, testCase.expectedPlanRegion; !got.Equal(want) {
+				t.Errorf("planned region = %s, want %s", got, want)
+			}
+
+			if got, want := response.RequiresReplace.Contains(path.Root(names.AttrRegion)), testCase.expectRequiresReplace; got != want {
+				t.Errorf("requires replace = %t, want %t", got, want)
+			}
+
+			if diff := cmp.Diff(inner.regions, []string{testCase.expectedRegion}); diff != "" {
+				t.Errorf("unexpected Regions diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestWrappedResourceRegionModifyPlanDestroy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	inner, w, s := newRegionTestResource(ctx, t)
+
+	request := resource.ModifyPlanRequest{
+		Config: s.config(s.null(ctx)),
+		Plan:   s.plan(s.null(ctx)),
+		State:  s.state(s.value(ctx, "test", "test", regionTestOverrideRegion)),
+	}
+	response := resource.ModifyPlanResponse{
+		Plan: request.Plan,
+	}
+
+	w.ModifyPlan(ctx, request, &response)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", fwdiag.DiagnosticsString(response.Diagnostics))
+	}
+
+	if !response.Plan.Raw.IsNull() {
+		t.Errorf("plan = %s, want null", response.Plan.Raw)
+	}
+	if len(response.RequiresReplace) > 0 {
+		t.Errorf("requires replace = %v, want none", response.RequiresReplace)
+	}
+	if len(inner.regions) > 0 {
+		t.Errorf("inner ModifyPlan called for destroy")
+	}
+}
+
+func TestWrappedResourceRegionImportState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		importID            string
+		expectedRegion      string
+		expectedStateRegion any
+	}{
+		"no Region": {
+			importID:            "test",
+			expectedRegion:      regionTestProviderRegion,
+			expectedStateRegion: nil,
+		},
+		"Region": {
+			importID:            "test@" + regionTestOverrideRegion,
+			expectedRegion:      regionTestOverrideRegion,
+			expectedStateRegion: regionTestOverrideRegion,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			inner, w, s := newRegionTestResource(ctx, t)
+
+			request := resource.ImportStateRequest{
+				ID: testCase.importID,
+			}
+			response := resource.ImportStateResponse{
+				State: s.state(s.null(ctx)),
+			}
+
+			w.ImportState(ctx, request, &response)
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", fwdiag.DiagnosticsString(response.Diagnostics))
+			}
+
+			if got, want := response.State.Raw, s.value(ctx, "test", nil, testCase.expectedStateRegion); !got.Equal(want) {
+				t.Errorf("state = %s, want %s", got, want)
+			}
+
+			if diff := cmp.Diff(inner.regions, []string{testCase.expectedRegion}); diff != "" {
+				t.Errorf("unexpected Regions diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestWrappedResourceRegionUpgradeState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	inner, w, s := newRegionTestResource(ctx, t)
+
+	upgraders := w.UpgradeState(ctx)
+	upgrader, ok := upgraders[0]
+	if !ok {
+		t.Fatal("no state upgrader for version 0")
+	}
+
+	response := resource.UpgradeStateResponse{
+		State: s.state(s.null(ctx)),
+	}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{}, &response)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", fwdiag.DiagnosticsString(response.Diagnostics))
+	}
+
+	// The `region` attribute is set by the next Read.
+	if got, want := response.State.Raw, s.value(ctx, "test", "upgraded", nil); !got.Equal(want) {
+		t.Errorf("state = %s, want %s", got, want)
+	}
+
+	if diff := cmp.Diff(inner.regions, []string{regionTestProviderRegion}); diff != "" {
+		t.Errorf("unexpected Regions diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestWrappedResourceRegionMoveState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	inner, w, s := newRegionTestResource(ctx, t)
+
+	movers := w.MoveState(ctx)
+	if got, want := len(movers), 1; got != want {
+		t.Fatalf("state movers = %d, want %d", got, want)
+	}
+
+	response := resource.MoveStateResponse{
+		TargetState: s.state(s.null(ctx)),
+	}
+	movers[0].StateMover(ctx, resource.MoveStateRequest{}, &response)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", fwdiag.DiagnosticsString(response.Diagnostics))
+	}
+
+	// The `region` attribute is set by the next Read.
+	if got, want := response.TargetState.Raw, s.value(ctx, "test", "moved", nil); !got.Equal(want) {
+		t.Errorf("target state = %s, want %s", got, want)
+	}
+
+	if diff := cmp.Diff(inner.regions, []string{regionTestProviderRegion}); diff != "" {
+		t.Errorf("unexpected Regions diff (+wanted, -got): %s", diff)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
@@ -236,7 +237,13 @@ func (r tagsResourceInterceptor) update(ctx context.Context, opts interceptorOpt
 			// Some old resources may not have the required attribute set after Read:
 			// https://github.com/hashicorp/terraform-provider-aws/issues/31180
 			if identifier := r.getIdentifier(ctx, request.Plan); identifier != "" {
-				if err := r.UpdateTags(ctx, sp, c, identifier, oldTagsAll, newTagsAll); err != nil {
+				updateTags := r.UpdateTags
+				// Tag-only changes, e.g. to the provider's default_tags, can be batched with identical changes to other resources.
+				if !hasChangesExceptTags(request.Plan.Raw, request.State.Raw) {
+					updateTags = r.BatchUpdateTags
+				}
+
+				if err := updateTags(ctx, sp, c, identifier, oldTagsAll, newTagsAll); err != nil {
 					diags.AddError(fmt.Sprintf("updating tags for %s %s (%s)", serviceName, resourceName, identifier), err.Error())
 
 					return diags
@@ -272,6 +279,29 @@ func (r tagsInterceptor) getIdentifier(ctx context.Context, d interface {
 	return identifier
 }
 
+// hasChangesExceptTags returns whether any attribute other than tags and tags_all differs between the plan and the prior state.
+func hasChangesExceptTags(plan, state tftypes.Value) bool {
+	var planAttributes, stateAttributes map[string]tftypes.Value
+	if err := plan.As(&planAttributes); err != nil {
+		return true
+	}
+	if err := state.As(&stateAttributes); err != nil {
+		return true
+	}
+
+	for k, v := range planAttributes {
+		if k == names.AttrTags || k == names.AttrTagsAll {
+			continue
+		}
+
+		if !v.Equal(stateAttributes[k]) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // setTagsAll is a plan modifier that calculates the new value for the `tags_all` attribute.
 func setTagsAll(ctx context.Context, meta *conns.AWSClient, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	// If the entire plan is null, the resource is planned for destruction.
@@ -9,8 +9,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
@@ -26,7 +28,9 @@ type wrappedDataSourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     dataSourceInterceptors
-	typeName         string
+	// regionSchemas is non-nil if the per-resource Region override attribute has been injected.
+	regionSchemas *dataSourceRegionSchemas
+	typeName      string
 }
 
 // wrappedDataSource represents an interceptor dispatcher for a Plugin Framework data source.
@@ -55,6 +59,11 @@ func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.Schem
 		return
 	}
 
+	if v := w.opts.regionSchemas; v != nil {
+		response.Schema = v.outer
+		return
+	}
+
 	w.inner.Schema(ctx, request, response)
 }
 
@@ -66,7 +75,29 @@ func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadReq
 	}
 
 	f := func(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		v := w.opts.regionSchemas
+		if v == nil {
+			w.inner.Read(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		var diags diag.Diagnostics
+		region := regionAttribute(request.Config.Raw)
+		innerRequest, innerResponse := request, *response
+		innerRequest.Config, diags = v.innerConfig(ctx, request.Config)
+		response.Diagnostics.Append(diags...)
+		innerResponse.State, diags = v.innerState(ctx, response.State)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+
+		w.inner.Read(ctx, innerRequest, &innerResponse)
+
+		*response = innerResponse
+		response.State, diags = v.outerState(ctx, innerResponse.State, region)
+		response.Diagnostics.Append(diags...)
+
 		return response.Diagnostics
 	}
 	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.read(), f, w.meta)(ctx, request, response)...)
@@ -112,6 +143,14 @@ func (w *wrappedDataSource) ValidateConfig(ctx context.Context, request datasour
 			return
 		}
 
+		if w.opts.regionSchemas != nil {
+			request.Config, diags = w.opts.regionSchemas.innerConfig(ctx, request.Config)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
 		v.ValidateConfig(ctx, request, response)
 	}
 }
@@ -242,15 +281,124 @@ func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request e
 	}
 }
 
+type wrappedListResourceOptions struct {
+	// bootstrapContext is run on all wrapped methods.
+	bootstrapContext contextFunc
+	typeName         string
+}
+
+// wrappedListResource represents a dispatcher for a Plugin Framework list resource.
+type wrappedListResource struct {
+	inner list.ListResourceWithConfigure
+	meta  *conns.AWSClient
+	opts  wrappedListResourceOptions
+}
+
+func newWrappedListResource(inner list.ListResourceWithConfigure, opts wrappedListResourceOptions) list.ListResourceWithConfigure {
+	return &wrappedListResource{
+		inner: inner,
+		opts:  opts,
+	}
+}
+
+func (w *wrappedListResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	// This method does not call down to the inner list resource.
+	response.TypeName = w.opts.typeName
+}
+
+func (w *wrappedListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.ListResourceConfigSchema(ctx, request, response)
+}
+
+func (w *wrappedListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	ctx, diags := w.opts.bootstrapContext(ctx, request.Config.GetAttribute, w.meta)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	w.inner.List(ctx, request, stream)
+}
+
+func (w *wrappedListResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+
+	ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Configure(ctx, request, response)
+}
+
+func (w *wrappedListResource) RawV5Schemas(ctx context.Context, request list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	if v, ok := w.inner.(list.ListResourceWithRawV5Schemas); ok {
+		ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+		if diags.HasError() {
+			tflog.Warn(ctx, "wrapping RawV5Schemas", map[string]any{
+				"list resource":          w.opts.typeName,
+				"bootstrapContext error": fwdiag.DiagnosticsString(diags),
+			})
+
+			return
+		}
+
+		v.RawV5Schemas(ctx, request, response)
+	}
+}
+
+func (w *wrappedListResource) ListResourceConfigValidators(ctx context.Context) []list.ConfigValidator {
+	if v, ok := w.inner.(list.ListResourceWithConfigValidators); ok {
+		ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+		if diags.HasError() {
+			tflog.Warn(ctx, "wrapping ListResourceConfigValidators", map[string]any{
+				"list resource":          w.opts.typeName,
+				"bootstrapContext error": fwdiag.DiagnosticsString(diags),
+			})
+
+			return nil
+		}
+
+		return v.ListResourceConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+func (w *wrappedListResource) ValidateListResourceConfig(ctx context.Context, request list.ValidateConfigRequest, response *list.ValidateConfigResponse) {
+	if v, ok := w.inner.(list.ListResourceWithValidateConfig); ok {
+		ctx, diags := w.opts.bootstrapContext(ctx, request.Config.GetAttribute, w.meta)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		v.ValidateListResourceConfig(ctx, request, response)
+	}
+}
+
 // modifyPlanFunc modifies a Terraform plan.
 type modifyPlanFunc func(context.Context, *conns.AWSClient, resource.ModifyPlanRequest, *resource.ModifyPlanResponse)
 
 type wrappedResourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
-	interceptors     resourceInterceptors
-	modifyPlanFuncs  []modifyPlanFunc
-	typeName         string
+	// identity is non-nil if the resource has an identity schema.
+	identity        *resourceIdentity
+	interceptors    resourceInterceptors
+	modifyPlanFuncs []modifyPlanFunc
+	// regionSchemas is non-nil if the per-resource Region override attribute has been injected.
+	regionSchemas *resourceRegionSchemas
+	typeName      string
 }
 
 // wrappedResource represents an interceptor dispatcher for a Plugin Framework resource.
@@ -261,10 +409,27 @@ type wrappedResource struct {
 }
 
 func newWrappedResource(inner resource.ResourceWithConfigure, opts wrappedResourceOptions) resource.ResourceWithConfigure {
-	return &wrappedResource{
+	w := &wrappedResource{
 		inner: inner,
 		opts:  opts,
 	}
+
+	// Plugin Framework detects identity support by type assertion.
+	if opts.identity != nil {
+		return &wrappedResourceWithIdentity{w}
+	}
+
+	return w
+}
+
+// wrappedResourceWithIdentity represents an interceptor dispatcher for a Plugin Framework resource with an identity schema.
+type wrappedResourceWithIdentity struct {
+	*wrappedResource
+}
+
+func (w *wrappedResourceWithIdentity) IdentitySchema(ctx context.Context, request resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	// This method does not call down to the inner resource.
+	response.IdentitySchema = w.opts.identity.schema()
 }
 
 func (w *wrappedResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -279,6 +444,11 @@ func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaReq
 		return
 	}
 
+	if v := w.opts.regionSchemas; v != nil {
+		response.Schema = v.outer
+		return
+	}
+
 	w.inner.Schema(ctx, request, response)
 }
 
@@ -290,7 +460,31 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 	}
 
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
-		w.inner.Create(ctx, request, response)
+		v := w.opts.regionSchemas
+		if v == nil {
+			w.inner.Create(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		var diags diag.Diagnostics
+		region := regionAttribute(request.Plan.Raw)
+		innerRequest, innerResponse := request, *response
+		innerRequest.Config, diags = v.innerConfig(ctx, request.Config)
+		response.Diagnostics.Append(diags...)
+		innerRequest.Plan, diags = v.innerPlan(ctx, request.Plan)
+		response.Diagnostics.Append(diags...)
+		innerResponse.State, diags = v.innerState(ctx, response.State)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+
+		w.inner.Create(ctx, innerRequest, &innerResponse)
+
+		*response = innerResponse
+		response.State, diags = v.outerState(ctx, innerResponse.State, region)
+		response.Diagnostics.Append(diags...)
+
 		return response.Diagnostics
 	}
 	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.create(), f, w.meta)(ctx, request, response)...)
@@ -304,7 +498,29 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 	}
 
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		v := w.opts.regionSchemas
+		if v == nil {
+			w.inner.Read(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		var diags diag.Diagnostics
+		region := regionAttribute(request.State.Raw)
+		innerRequest, innerResponse := request, *response
+		innerRequest.State, diags = v.innerState(ctx, request.State)
+		response.Diagnostics.Append(diags...)
+		innerResponse.State, diags = v.innerState(ctx, response.State)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+
+		w.inner.Read(ctx, innerRequest, &innerResponse)
+
+		*response = innerResponse
+		response.State, diags = v.outerState(ctx, innerResponse.State, region)
+		response.Diagnostics.Append(diags...)
+
 		return response.Diagnostics
 	}
 	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.read(), f, w.meta)(ctx, request, response)...)
@@ -318,7 +534,33 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 	}
 
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
-		w.inner.Update(ctx, request, response)
+		v := w.opts.regionSchemas
+		if v == nil {
+			w.inner.Update(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		var diags diag.Diagnostics
+		region := regionAttribute(request.Plan.Raw)
+		innerRequest, innerResponse := request, *response
+		innerRequest.Config, diags = v.innerConfig(ctx, request.Config)
+		response.Diagnostics.Append(diags...)
+		innerRequest.Plan, diags = v.innerPlan(ctx, request.Plan)
+		response.Diagnostics.Append(diags...)
+		innerRequest.State, diags = v.innerState(ctx, request.State)
+		response.Diagnostics.Append(diags...)
+		innerResponse.State, diags = v.innerState(ctx, response.State)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+
+		w.inner.Update(ctx, innerRequest, &innerResponse)
+
+		*response = innerResponse
+		response.State, diags = v.outerState(ctx, innerResponse.State, region)
+		response.Diagnostics.Append(diags...)
+
 		return response.Diagnostics
 	}
 	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.update(), f, w.meta)(ctx, request, response)...)
@@ -332,7 +574,29 @@ func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteReq
 	}
 
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
-		w.inner.Delete(ctx, request, response)
+		v := w.opts.regionSchemas
+		if v == nil {
+			w.inner.Delete(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		var diags diag.Diagnostics
+		region := regionAttribute(request.State.Raw)
+		innerRequest, innerResponse := request, *response
+		innerRequest.State, diags = v.innerState(ctx, request.State)
+		response.Diagnostics.Append(diags...)
+		innerResponse.State, diags = v.innerState(ctx, response.State)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+
+		w.inner.Delete(ctx, innerRequest, &innerResponse)
+
+		*response = innerResponse
+		response.State, diags = v.outerState(ctx, innerResponse.State, region)
+		response.Diagnostics.Append(diags...)
+
 		return response.Diagnostics
 	}
 	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.delete(), f, w.meta)(ctx, request, response)...)
@@ -354,13 +618,60 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
-		ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+		rs := w.opts.regionSchemas
+
+		// A resource imported by identity has no import ID.
+		if ri := w.opts.identity; ri != nil && request.ID == "" && request.Identity != nil {
+			var diags diag.Diagnostics
+			request.ID, diags = ri.importID(ctx, w.meta, *request.Identity, rs != nil)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		if rs == nil {
+			ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			v.ImportState(ctx, request, response)
+
+			return
+		}
+
+		var getAttribute getAttributeFunc
+		id, region := importRegion(request.ID)
+		if region != "" {
+			getAttribute = fixedRegionAttribute(region)
+		}
+
+		ctx, diags := w.opts.bootstrapContext(ctx, getAttribute, w.meta)
 		response.Diagnostics.Append(diags...)
 		if response.Diagnostics.HasError() {
 			return
 		}
 
-		v.ImportState(ctx, request, response)
+		innerRequest, innerResponse := request, *response
+		innerRequest.ID = id
+		innerResponse.State, diags = rs.innerState(ctx, response.State)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		v.ImportState(ctx, innerRequest, &innerResponse)
+
+		regionValue := tftypes.NewValue(tftypes.String, nil)
+		if region != "" {
+			regionValue = tftypes.NewValue(tftypes.String, region)
+		}
+
+		*response = innerResponse
+		response.State, diags = rs.outerState(ctx, innerResponse.State, regionValue)
+		response.Diagnostics.Append(diags...)
 
 		return
 	}
@@ -372,7 +683,11 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	ctx, diags := w.opts.bootstrapContext(ctx, request.Config.GetAttribute, w.meta)
+	getAttribute := request.Config.GetAttribute
+	if w.opts.regionSchemas != nil {
+		getAttribute = plannedRegionAttribute(request.Config, request.State)
+	}
+	ctx, diags := w.opts.bootstrapContext(ctx, getAttribute, w.meta)
 	response.Diagnostics.Append(diags...)
 	if response.Diagnostics.HasError() {
 		return
@@ -386,7 +701,31 @@ func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.Modif
 	}
 
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		v.ModifyPlan(ctx, request, response)
+		rs := w.opts.regionSchemas
+		if rs == nil {
+			v.ModifyPlan(ctx, request, response)
+			return
+		}
+
+		region := regionAttribute(response.Plan.Raw)
+		innerRequest, innerResponse := request, *response
+		innerRequest.Config, diags = rs.innerConfig(ctx, request.Config)
+		response.Diagnostics.Append(diags...)
+		innerRequest.Plan, diags = rs.innerPlan(ctx, request.Plan)
+		response.Diagnostics.Append(diags...)
+		innerRequest.State, diags = rs.innerState(ctx, request.State)
+		response.Diagnostics.Append(diags...)
+		innerResponse.Plan, diags = rs.innerPlan(ctx, response.Plan)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		v.ModifyPlan(ctx, innerRequest, &innerResponse)
+
+		*response = innerResponse
+		response.Plan, diags = rs.outerPlan(ctx, innerResponse.Plan, region)
+		response.Diagnostics.Append(diags...)
 	}
 }
 
@@ -416,6 +755,14 @@ func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.V
 			return
 		}
 
+		if w.opts.regionSchemas != nil {
+			request.Config, diags = w.opts.regionSchemas.innerConfig(ctx, request.Config)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
 		v.ValidateConfig(ctx, request, response)
 	}
 }
@@ -432,7 +779,32 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 			return nil
 		}
 
-		return v.UpgradeState(ctx)
+		upgraders := v.UpgradeState(ctx)
+		if rs := w.opts.regionSchemas; rs != nil {
+			for k, v := range upgraders {
+				if f := v.StateUpgrader; f != nil {
+					v.StateUpgrader = func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+						var diags diag.Diagnostics
+						innerResponse := *response
+						innerResponse.State, diags = rs.innerState(ctx, response.State)
+						response.Diagnostics.Append(diags...)
+						if response.Diagnostics.HasError() {
+							return
+						}
+
+						f(ctx, request, &innerResponse)
+
+						// The `region` attribute is set by the next Read.
+						*response = innerResponse
+						response.State, diags = rs.outerState(ctx, innerResponse.State, tftypes.NewValue(tftypes.String, nil))
+						response.Diagnostics.Append(diags...)
+					}
+					upgraders[k] = v
+				}
+			}
+		}
+
+		return upgraders
 	}
 
 	return nil
@@ -450,7 +822,31 @@ func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
 			return nil
 		}
 
-		return v.MoveState(ctx)
+		movers := v.MoveState(ctx)
+		if rs := w.opts.regionSchemas; rs != nil {
+			for i, v := range movers {
+				if f := v.StateMover; f != nil {
+					movers[i].StateMover = func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+						var diags diag.Diagnostics
+						innerResponse := *response
+						innerResponse.TargetState, diags = rs.innerState(ctx, response.TargetState)
+						response.Diagnostics.Append(diags...)
+						if response.Diagnostics.HasError() {
+							return
+						}
+
+						f(ctx, request, &innerResponse)
+
+						// The `region` attribute is set by the next Read.
+						*response = innerResponse
+						response.TargetState, diags = rs.outerState(ctx, innerResponse.TargetState, tftypes.NewValue(tftypes.String, nil))
+						response.Diagnostics.Append(diags...)
+					}
+				}
+			}
+		}
+
+		return movers
 	}
 
 	return nil
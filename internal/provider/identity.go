@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// resourceIdentity describes the attributes that make up a resource's identity.
+type resourceIdentity struct {
+	attribute string // The attribute whose value is the resource's import ID.
+	account   bool   // Whether the identity includes the AWS account ID.
+	region    bool   // Whether the identity includes the AWS Region.
+}
+
+func newResourceIdentity(v *types.ServicePackageResourceIdentity, servicePackageName string) resourceIdentity {
+	if v.ARN {
+		return resourceIdentity{
+			attribute: names.AttrARN,
+		}
+	}
+
+	return resourceIdentity{
+		attribute: v.IdentityAttribute,
+		account:   true,
+		region:    !names.IsGlobal(servicePackageName),
+	}
+}
+
+// schema returns the resource's identity schema.
+func (ri resourceIdentity) schema() *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			s := map[string]*schema.Schema{
+				ri.attribute: {
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+				},
+			}
+			if ri.account {
+				s[names.AttrAccountID] = &schema.Schema{
+					Type:              schema.TypeString,
+					OptionalForImport: true,
+				}
+			}
+			if ri.region {
+				s[names.AttrRegion] = &schema.Schema{
+					Type:              schema.TypeString,
+					OptionalForImport: true,
+				}
+			}
+			return s
+		},
+	}
+}
+
+// set sets the resource's identity from its state.
+func (ri resourceIdentity) set(ctx context.Context, c *conns.AWSClient, d schemaResourceData) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+
+	v := d.Get(ri.attribute)
+	if ri.attribute == names.AttrID {
+		v = d.Id()
+	}
+	if err := identity.Set(ri.attribute, v); err != nil {
+		return fmt.Errorf("setting %s: %w", ri.attribute, err)
+	}
+	if ri.account {
+		if err := identity.Set(names.AttrAccountID, c.AccountID(ctx)); err != nil {
+			return fmt.Errorf("setting %s: %w", names.AttrAccountID, err)
+		}
+	}
+	if ri.region {
+		if err := identity.Set(names.AttrRegion, c.Region(ctx)); err != nil {
+			return fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+		}
+	}
+
+	return nil
+}
+
+// importIdentity sets the ID, and any per-resource Region override, of a resource imported by identity.
+func (ri resourceIdentity) importIdentity(ctx context.Context, c *conns.AWSClient, d *schema.ResourceData, regionOverride bool) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+
+	id, ok := identity.GetOk(ri.attribute)
+	if !ok {
+		return fmt.Errorf("identity attribute %q is required", ri.attribute)
+	}
+
+	if ri.account {
+		if v, ok := identity.GetOk(names.AttrAccountID); ok && v.(string) != c.AccountID(ctx) {
+			return fmt.Errorf("identity attribute %q: provider configured with account ID %q, got %q", names.AttrAccountID, c.AccountID(ctx), v)
+		}
+	}
+
+	var region string
+	if ri.region {
+		if v, ok := identity.GetOk(names.AttrRegion); ok {
+			region = v.(string)
+		}
+	} else if ri.attribute == names.AttrARN {
+		arn, err := arn.Parse(id.(string))
+		if err != nil {
+			return fmt.Errorf("identity attribute %q: %w", ri.attribute, err)
+		}
+		region = arn.Region
+	}
+
+	d.SetId(id.(string))
+	if regionOverride && region != "" {
+		if err := d.Set(names.AttrRegion, region); err != nil {
+			return fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+		}
+	}
+
+	return nil
+}
+
+// identityInterceptor sets a resource's identity after a Create, Read or Update.
+func identityInterceptor(ri resourceIdentity) interceptor {
+	return interceptorFunc(func(ctx context.Context, opts interceptorOptions) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case After:
+			switch why {
+			case Create, Read, Update:
+				// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+				if d.Id() == "" {
+					return diags
+				}
+
+				if err := ri.set(ctx, opts.c, d); err != nil {
+					return sdkdiag.AppendErrorf(diags, "setting resource identity: %s", err)
+				}
+			}
+		}
+
+		return diags
+	})
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestResourceIdentityImportIdentity(t *testing.T) {
+	t.Parallel()
+
+	const accountID = "123456789012"
+
+	testCases := []struct {
+		name           string
+		identity       resourceIdentity
+		raw            map[string]string
+		regionOverride bool
+		expectError    bool
+		expectedID     string
+		expectedRegion string
+	}{
+		{
+			name:       "name",
+			identity:   resourceIdentity{attribute: names.AttrName, account: true, region: true},
+			raw:        map[string]string{names.AttrName: "example"},
+			expectedID: "example",
+		},
+		{
+			name:           "name and Region",
+			identity:       resourceIdentity{attribute: names.AttrName, account: true, region: true},
+			raw:            map[string]string{names.AttrName: "example", names.AttrAccountID: accountID, names.AttrRegion: "eu-west-1"}, //lintignore:AWSAT003
+			regionOverride: true,
+			expectedID:     "example",
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		{
+			name:       "name and Region without override",
+			identity:   resourceIdentity{attribute: names.AttrName, account: true, region: true},
+			raw:        map[string]string{names.AttrName: "example", names.AttrRegion: "eu-west-1"}, //lintignore:AWSAT003
+			expectedID: "example",
+		},
+		{
+			name:        "other account ID",
+			identity:    resourceIdentity{attribute: names.AttrName, account: true, region: true},
+			raw:         map[string]string{names.AttrName: "example", names.AttrAccountID: "210987654321"},
+			expectError: true,
+		},
+		{
+			name:        "missing attribute",
+			identity:    resourceIdentity{attribute: names.AttrName, account: true, region: true},
+			raw:         map[string]string{names.AttrAccountID: accountID},
+			expectError: true,
+		},
+		{
+			name:           "ARN",
+			identity:       resourceIdentity{attribute: names.AttrARN},
+			raw:            map[string]string{names.AttrARN: "arn:aws:sqs:eu-west-1:123456789012:example"}, //lintignore:AWSAT003,AWSAT005
+			regionOverride: true,
+			expectedID:     "arn:aws:sqs:eu-west-1:123456789012:example", //lintignore:AWSAT003,AWSAT005
+			expectedRegion: "eu-west-1",                                  //lintignore:AWSAT003
+		},
+		{
+			name:        "invalid ARN",
+			identity:    resourceIdentity{attribute: names.AttrARN},
+			raw:         map[string]string{names.AttrARN: "example"},
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			client := &conns.AWSClient{}
+			conns.SetAccountID(client, accountID)
+
+			d := schema.TestResourceDataWithIdentityRaw(t, map[string]*schema.Schema{
+				names.AttrARN: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				names.AttrRegion: regionResourceSchema(),
+			}, testCase.identity.schema().SchemaFunc(), testCase.raw)
+
+			err := testCase.identity.importIdentity(ctx, client, d, testCase.regionOverride)
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, want error %t", err, want)
+			}
+			if testCase.expectError {
+				return
+			}
+
+			if got, want := d.Id(), testCase.expectedID; got != want {
+				t.Errorf("ID = %v, want %v", got, want)
+			}
+			if got, want := d.Get(names.AttrRegion).(string), testCase.expectedRegion; got != want {
+				t.Errorf("region = %v, want %v", got, want)
+			}
+		})
+	}
+}
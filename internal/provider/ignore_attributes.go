@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// ignoreAttributesInterceptor keeps the provider-configured ignored attributes of a resource type
+// at their prior state values after a resource Read, so that changes made outside Terraform are not reported as drift.
+func ignoreAttributesInterceptor(typeName string) interceptor {
+	return interceptorFunc(func(ctx context.Context, opts interceptorOptions) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case After:
+			switch why {
+			case Read:
+				// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+				if d.Id() == "" {
+					return diags
+				}
+
+				for _, attribute := range opts.c.IgnoreAttributes(ctx, typeName) {
+					if err := keepPriorValue(d, attribute); err != nil {
+						return sdkdiag.AppendErrorf(diags, "ignoring changes to %s: %s", attribute, err)
+					}
+				}
+			}
+		}
+
+		return diags
+	})
+}
+
+// keepPriorValue sets the attribute at the specified dot-separated path to its value in prior state.
+// Attributes that are not in prior state, e.g. on import, are left unchanged.
+func keepPriorValue(d schemaResourceData, attribute string) error {
+	steps := strings.Split(attribute, ".")
+
+	if v, ok := valueAtPath(d.GetRawState(), steps); !ok || v.IsNull() {
+		return nil
+	}
+
+	// The old value is read from prior state only.
+	prior, _ := d.GetChange(attribute)
+
+	if len(steps) == 1 {
+		return d.Set(attribute, prior)
+	}
+
+	v, ok := replaceAtPath(d.Get(steps[0]), steps[1:], prior)
+	if !ok {
+		return nil
+	}
+
+	return d.Set(steps[0], v)
+}
+
+// validIgnoreAttributePath returns whether the specified string is a valid dot-separated attribute path.
+func validIgnoreAttributePath(attribute string) bool {
+	for _, step := range strings.Split(attribute, ".") {
+		if step == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// valueAtPath returns the value at the specified path steps.
+// Sets cannot be traversed, so a set can only be the last step in the path.
+func valueAtPath(v cty.Value, steps []string) (cty.Value, bool) {
+	for _, step := range steps {
+		if v.IsNull() || !v.IsKnown() {
+			return cty.NilVal, false
+		}
+
+		switch typ := v.Type(); {
+		case typ.IsObjectType():
+			if !typ.HasAttribute(step) {
+				return cty.NilVal, false
+			}
+			v = v.GetAttr(step)
+		case typ.IsListType(), typ.IsTupleType():
+			i, err := strconv.Atoi(step)
+			if err != nil || i < 0 || i >= v.LengthInt() {
+				return cty.NilVal, false
+			}
+			v = v.Index(cty.NumberIntVal(int64(i)))
+		case typ.IsMapType():
+			k := cty.StringVal(step)
+			if !v.HasIndex(k).True() {
+				return cty.NilVal, false
+			}
+			v = v.Index(k)
+		default:
+			return cty.NilVal, false
+		}
+	}
+
+	return v, true
+}
+
+// replaceAtPath replaces the value at the specified path steps within a value returned by ResourceData.Get.
+// It returns false if the path does not exist in the value.
+func replaceAtPath(v any, steps []string, replacement any) (any, bool) {
+	if len(steps) == 0 {
+		return replacement, true
+	}
+
+	switch v := v.(type) {
+	case []any:
+		i, err := strconv.Atoi(steps[0])
+		if err != nil || i < 0 || i >= len(v) {
+			return nil, false
+		}
+		e, ok := replaceAtPath(v[i], steps[1:], replacement)
+		if !ok {
+			return nil, false
+		}
+		v[i] = e
+
+		return v, true
+	case map[string]any:
+		if len(steps) == 1 {
+			v[steps[0]] = replacement
+
+			return v, true
+		}
+		e, ok := replaceAtPath(v[steps[0]], steps[1:], replacement)
+		if !ok {
+			return nil, false
+		}
+		v[steps[0]] = e
+
+		return v, true
+	default:
+		return nil, false
+	}
+}
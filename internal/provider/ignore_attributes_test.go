@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestValidIgnoreAttributePath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"description":                   true,
+		"ebs_block_device.0.throughput": true,
+		"tags.Name":                     true,
+		"":                              false,
+		"description.":                  false,
+		".description":                  false,
+		"ebs_block_device..throughput":  false,
+	}
+
+	for attribute, want := range testCases {
+		if got := validIgnoreAttributePath(attribute); got != want {
+			t.Errorf("validIgnoreAttributePath(%q) = %t, want %t", attribute, got, want)
+		}
+	}
+}
+
+func TestValueAtPath(t *testing.T) {
+	t.Parallel()
+
+	v := cty.ObjectVal(map[string]cty.Value{
+		"description": cty.StringVal("managed by Terraform"),
+		"ebs_block_device": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"throughput": cty.NumberIntVal(125),
+			}),
+		}),
+		"notes": cty.NullVal(cty.String),
+		"security_groups": cty.SetVal([]cty.Value{
+			cty.StringVal("sg-12345678"),
+		}),
+		"tags": cty.MapVal(map[string]cty.Value{
+			"Name": cty.StringVal("test"),
+		}),
+	})
+
+	testCases := []struct {
+		name       string
+		attribute  []string
+		expected   cty.Value
+		expectedOK bool
+	}{
+		{
+			name:       "top-level attribute",
+			attribute:  []string{"description"},
+			expected:   cty.StringVal("managed by Terraform"),
+			expectedOK: true,
+		},
+		{
+			name:       "null attribute",
+			attribute:  []string{"notes"},
+			expected:   cty.NullVal(cty.String),
+			expectedOK: true,
+		},
+		{
+			name:       "nested attribute",
+			attribute:  []string{"ebs_block_device", "0", "throughput"},
+			expected:   cty.NumberIntVal(125),
+			expectedOK: true,
+		},
+		{
+			name:      "list index out of range",
+			attribute: []string{"ebs_block_device", "1", "throughput"},
+		},
+		{
+			name:      "list index not a number",
+			attribute: []string{"ebs_block_device", "first", "throughput"},
+		},
+		{
+			name:       "map element",
+			attribute:  []string{"tags", "Name"},
+			expected:   cty.StringVal("test"),
+			expectedOK: true,
+		},
+		{
+			name:      "missing map element",
+			attribute: []string{"tags", "Owner"},
+		},
+		{
+			name:       "whole set",
+			attribute:  []string{"security_groups"},
+			expected:   cty.SetVal([]cty.Value{cty.StringVal("sg-12345678")}),
+			expectedOK: true,
+		},
+		{
+			name:      "set element",
+			attribute: []string{"security_groups", "0"},
+		},
+		{
+			name:      "unknown attribute",
+			attribute: []string{"name"},
+		},
+		{
+			name:      "traverse null",
+			attribute: []string{"notes", "0"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := valueAtPath(v, testCase.attribute)
+
+			if ok != testCase.expectedOK {
+				t.Fatalf("ok = %t, want %t", ok, testCase.expectedOK)
+			}
+			if ok && !got.RawEquals(testCase.expected) {
+				t.Errorf("value = %#v, want %#v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestReplaceAtPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		value      any
+		attribute  []string
+		expected   any
+		expectedOK bool
+	}{
+		{
+			name: "nested attribute",
+			value: []any{
+				map[string]any{"throughput": 250, "volume_size": 10},
+			},
+			attribute: []string{"0", "throughput"},
+			expected: []any{
+				map[string]any{"throughput": 125, "volume_size": 10},
+			},
+			expectedOK: true,
+		},
+		{
+			name:       "map element",
+			value:      map[string]any{"Name": "test"},
+			attribute:  []string{"Owner"},
+			expected:   map[string]any{"Name": "test", "Owner": 125},
+			expectedOK: true,
+		},
+		{
+			name: "list index out of range",
+			value: []any{
+				map[string]any{"throughput": 250},
+			},
+			attribute: []string{"1", "throughput"},
+		},
+		{
+			name:      "primitive",
+			value:     "test",
+			attribute: []string{"0"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := replaceAtPath(testCase.value, testCase.attribute, 125)
+
+			if ok != testCase.expectedOK {
+				t.Fatalf("ok = %t, want %t", ok, testCase.expectedOK)
+			}
+			if !ok {
+				return
+			}
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -16,6 +16,8 @@ import (
 // schemaResourceData is an interface that implements a subset of schema.ResourceData's public methods.
 type schemaResourceData interface {
 	sdkv2.ResourceDiffer
+	HasChangesExcept(...string) bool
+	Identity() (*schema.IdentityData, error)
 	Set(string, any) error
 }
 
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// AcquireConcurrencyLimit waits until the specified resource type's concurrency limiter, if any, has capacity.
+func AcquireConcurrencyLimit(ctx context.Context, c *conns.AWSClient, typeName string) error {
+	semaphore, ok := c.ConcurrencyLimiter(ctx, typeName)
+	if !ok {
+		return nil
+	}
+
+	if semaphore.TryWait() {
+		return nil
+	}
+
+	fields := map[string]any{
+		"tf_aws.concurrency_limit": cap(semaphore),
+		"tf_aws.resource_type":     typeName,
+	}
+	tflog.Info(ctx, "Waiting on concurrency limit", fields)
+
+	start := time.Now()
+	if err := semaphore.WaitContext(ctx); err != nil {
+		return err
+	}
+
+	fields["tf_aws.concurrency_limit.wait"] = time.Since(start).String()
+	tflog.Info(ctx, "Acquired concurrency limit", fields)
+
+	return nil
+}
+
+// ReleaseConcurrencyLimit releases capacity acquired by AcquireConcurrencyLimit.
+func ReleaseConcurrencyLimit(ctx context.Context, c *conns.AWSClient, typeName string) {
+	if semaphore, ok := c.ConcurrencyLimiter(ctx, typeName); ok {
+		semaphore.Notify()
+	}
+}
@@ -6,6 +6,7 @@ package interceptors
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -89,3 +90,31 @@ func (w WithTaggingMethods) UpdateTags(ctx context.Context, sp conns.ServicePack
 
 	return err
 }
+
+// BatchUpdateTags updates tags like UpdateTags, but if tag update batching is enabled and the identifier is an ARN,
+// the change is combined with identical changes to other resources using the Resource Groups Tagging API.
+// If the batched update fails, for example because the Resource Groups Tagging API doesn't support the resource type, the service package's method is used.
+func (w WithTaggingMethods) BatchUpdateTags(ctx context.Context, sp conns.ServicePackage, c *conns.AWSClient, identifier string, oldTags, newTags any) error {
+	if c.TagUpdateBatchingEnabled(ctx) && arn.IsARN(identifier) {
+		o, n := tftags.New(ctx, oldTags), tftags.New(ctx, newTags)
+		removedTags := o.Removed(n).IgnoreSystem(sp.ServicePackageName())
+		updatedTags := o.Updated(n).IgnoreSystem(sp.ServicePackageName())
+
+		if len(removedTags) == 0 && len(updatedTags) == 0 {
+			return nil
+		}
+
+		err := c.BatchUpdateTags(ctx, identifier, removedTags, updatedTags)
+
+		if err == nil {
+			return nil
+		}
+
+		tflog.Warn(ctx, "Batched tag update failed, updating tags individually", map[string]any{
+			"ServicePackage":         sp.ServicePackageName(),
+			"tf_aws.tag_batch.error": err.Error(),
+		})
+	}
+
+	return w.UpdateTags(ctx, sp, c, identifier, oldTags, newTags)
+}
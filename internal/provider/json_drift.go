@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	jsonDriftWarningSummary = "JSON Document Differences"
+	jsonDriftWarningDetail  = "The planned value differs semantically from the prior value:\n\n"
+)
+
+// jsonDocumentDiffsFunc returns the semantic differences between two JSON documents.
+type jsonDocumentDiffsFunc func(string, string) ([]string, error)
+
+// jsonDocumentDiffsFuncForDiffSuppressFunc returns the jsonDocumentDiffsFunc corresponding to
+// an attribute's JSON equivalence DiffSuppressFunc.
+func jsonDocumentDiffsFuncForDiffSuppressFunc(f schema.SchemaDiffSuppressFunc) (jsonDocumentDiffsFunc, bool) {
+	if f == nil {
+		return nil, false
+	}
+
+	switch reflect.ValueOf(f).Pointer() {
+	case reflect.ValueOf(verify.SuppressEquivalentPolicyDiffs).Pointer():
+		return verify.PolicyDiffs, true
+	case reflect.ValueOf(verify.SuppressEquivalentJSONDiffs).Pointer(), reflect.ValueOf(verify.SuppressEquivalentJSONWithEmptyDiffs).Pointer():
+		return verify.JSONDiffs, true
+	default:
+		return nil, false
+	}
+}
+
+// jsonDriftProviderServer wraps the Plugin SDK v2 provider server and adds a warning
+// listing the semantic differences between the prior and planned values of JSON document attributes.
+// Plugin SDK v2 CustomizeDiff functions cannot return warnings so this is done at the protocol level.
+// Terraform plans each resource again during apply, and the warning should only be shown once.
+// Resources are only refreshed when planning, so warnings are only emitted once a resource has been read.
+type jsonDriftProviderServer struct {
+	tfprotov5.ProviderServer
+
+	primary      *schema.Provider
+	resourceRead atomic.Bool
+
+	once sync.Once
+	// Keyed by resource type name, then by dot-separated attribute name path.
+	attributes map[string]map[string]jsonDocumentDiffsFunc
+	types      map[string]tftypes.Type
+}
+
+func newJSONDriftProviderServer(inner tfprotov5.ProviderServer, primary *schema.Provider) tfprotov5.ProviderServer {
+	return &jsonDriftProviderServer{
+		ProviderServer: inner,
+		primary:        primary,
+	}
+}
+
+func (s *jsonDriftProviderServer) init(ctx context.Context) {
+	s.attributes = make(map[string]map[string]jsonDocumentDiffsFunc)
+	for typeName, r := range s.primary.ResourcesMap {
+		attributes := make(map[string]jsonDocumentDiffsFunc)
+		jsonDocumentAttributes(r.SchemaMap(), "", attributes)
+		if len(attributes) > 0 {
+			s.attributes[typeName] = attributes
+		}
+	}
+
+	s.types = make(map[string]tftypes.Type)
+	if len(s.attributes) == 0 {
+		return
+	}
+
+	response, err := s.ProviderServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil || response == nil {
+		return
+	}
+
+	for typeName := range s.attributes {
+		if v, ok := response.ResourceSchemas[typeName]; ok && v != nil {
+			s.types[typeName] = v.ValueType()
+		}
+	}
+}
+
+// jsonDocumentAttributes records the JSON document attributes in the specified schema, recursing into nested blocks.
+func jsonDocumentAttributes(schemaMap map[string]*schema.Schema, prefix string, attributes map[string]jsonDocumentDiffsFunc) {
+	for k, v := range schemaMap {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+
+		if f, ok := jsonDocumentDiffsFuncForDiffSuppressFunc(v.DiffSuppressFunc); ok && v.Type == schema.TypeString {
+			attributes[key] = f
+			continue
+		}
+
+		if v.Type == schema.TypeList || v.Type == schema.TypeSet {
+			if elem, ok := v.Elem.(*schema.Resource); ok {
+				jsonDocumentAttributes(elem.SchemaMap(), key, attributes)
+			}
+		}
+	}
+}
+
+func (s *jsonDriftProviderServer) ReadResource(ctx context.Context, request *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	response, err := s.ProviderServer.ReadResource(ctx, request)
+	if err == nil {
+		s.resourceRead.Store(true)
+	}
+
+	return response, err
+}
+
+func (s *jsonDriftProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	// Not planning, e.g. Terraform is planning the resource again during apply.
+	if !s.resourceRead.Load() {
+		return response, nil
+	}
+
+	s.once.Do(func() { s.init(ctx) })
+
+	attributes, ok := s.attributes[request.TypeName]
+	if !ok || request.PriorState == nil || response.PlannedState == nil {
+		return response, nil
+	}
+
+	typ, ok := s.types[request.TypeName]
+	if !ok {
+		return response, nil
+	}
+
+	prior, err := request.PriorState.Unmarshal(typ)
+	if err != nil || prior.IsNull() {
+		return response, nil
+	}
+
+	planned, err := response.PlannedState.Unmarshal(typ)
+	if err != nil || planned.IsNull() {
+		return response, nil
+	}
+
+	_ = tftypes.Walk(planned, func(p *tftypes.AttributePath, v tftypes.Value) (bool, error) {
+		key, ok := attributeNamePath(p)
+		if !ok {
+			return false, nil
+		}
+
+		f, ok := attributes[key]
+		if !ok {
+			return true, nil
+		}
+
+		if detail, ok := jsonDrift(prior, p, v, f); ok {
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityWarning,
+				Summary:   jsonDriftWarningSummary,
+				Detail:    detail,
+				Attribute: p,
+			})
+		}
+
+		return false, nil
+	})
+
+	return response, nil
+}
+
+// attributeNamePath returns the dot-separated attribute names in the specified path, ignoring list and map element keys.
+// Paths through set elements are not supported.
+func attributeNamePath(p *tftypes.AttributePath) (string, bool) {
+	var attributeNames []string
+
+	for _, step := range p.Steps() {
+		switch step := step.(type) {
+		case tftypes.AttributeName:
+			attributeNames = append(attributeNames, string(step))
+		case tftypes.ElementKeyInt, tftypes.ElementKeyString:
+		default:
+			return "", false
+		}
+	}
+
+	return strings.Join(attributeNames, "."), true
+}
+
+// jsonDrift returns the warning detail for a JSON document attribute whose value has changed.
+func jsonDrift(prior tftypes.Value, p *tftypes.AttributePath, planned tftypes.Value, f jsonDocumentDiffsFunc) (string, bool) {
+	if !planned.IsKnown() || planned.IsNull() {
+		return "", false
+	}
+
+	v, _, err := tftypes.WalkAttributePath(prior, p)
+	if err != nil {
+		return "", false
+	}
+	old, ok := v.(tftypes.Value)
+	if !ok || !old.IsKnown() || old.IsNull() {
+		return "", false
+	}
+
+	var o, n string
+	if err := old.As(&o); err != nil {
+		return "", false
+	}
+	if err := planned.As(&n); err != nil {
+		return "", false
+	}
+	if o == n {
+		return "", false
+	}
+
+	diffs, err := f(o, n)
+	if err != nil || len(diffs) == 0 {
+		return "", false
+	}
+
+	return jsonDriftWarningDetail + strings.Join(diffs, "\n"), true
+}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestJSONDocumentAttributes(t *testing.T) {
+	t.Parallel()
+
+	schemaMap := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"policy": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+		},
+		"statement": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"definition": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+					},
+				},
+			},
+		},
+	}
+
+	attributes := make(map[string]jsonDocumentDiffsFunc)
+	jsonDocumentAttributes(schemaMap, "", attributes)
+
+	var got []string
+	for k := range attributes {
+		got = append(got, k)
+	}
+	slices.Sort(got)
+
+	if diff := cmp.Diff(got, []string{"policy", "statement.definition"}); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestJSONDrift(t *testing.T) {
+	t.Parallel()
+
+	typ := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"statement": tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"definition": tftypes.String,
+		}}},
+	}}
+	value := func(definition string) tftypes.Value {
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"statement": tftypes.NewValue(typ.AttributeTypes["statement"], []tftypes.Value{
+				tftypes.NewValue(typ.AttributeTypes["statement"].(tftypes.List).ElementType, map[string]tftypes.Value{
+					"definition": tftypes.NewValue(tftypes.String, definition),
+				}),
+			}),
+		})
+	}
+	p := tftypes.NewAttributePath().WithAttributeName("statement").WithElementKeyInt(0).WithAttributeName("definition")
+
+	if got, ok := attributeNamePath(p); !ok || got != "statement.definition" {
+		t.Errorf("attributeNamePath() = %q, %t", got, ok)
+	}
+
+	testCases := map[string]struct {
+		old, new   string
+		wantDetail string
+	}{
+		"equal": {
+			old: `{"a": 1}`,
+			new: `{"a": 1}`,
+		},
+		"equivalent": {
+			old: `{"a": 1, "b": 2}`,
+			new: `{"b": 2, "a": 1}`,
+		},
+		"changed": {
+			old:        `{"a": 1}`,
+			new:        `{"a": 2}`,
+			wantDetail: "~ a: 1 => 2",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v, _, err := tftypes.WalkAttributePath(value(testCase.new), p)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			detail, ok := jsonDrift(value(testCase.old), p, v.(tftypes.Value), verify.JSONDiffs)
+
+			if got, want := ok, testCase.wantDetail != ""; got != want {
+				t.Fatalf("jsonDrift() ok %t, want %t", got, want)
+			}
+
+			if ok && !strings.HasSuffix(detail, testCase.wantDetail) {
+				t.Errorf("jsonDrift() detail %q, want suffix %q", detail, testCase.wantDetail)
+			}
+		})
+	}
+}
+
+func TestJSONDriftProviderServerPlanResourceChange(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	primary := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"test_resource": {
+				Schema: map[string]*schema.Schema{
+					"policy": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+					},
+				},
+				ReadWithoutTimeout: func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+					return nil
+				},
+			},
+		},
+	}
+	server := newJSONDriftProviderServer(primary.GRPCProvider(), primary)
+
+	schemaResponse, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	typ := schemaResponse.ResourceSchemas["test_resource"].ValueType()
+	state := func(policy string) *tfprotov5.DynamicValue {
+		v, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, map[string]tftypes.Value{
+			names.AttrID:     tftypes.NewValue(tftypes.String, "test"),
+			names.AttrPolicy: tftypes.NewValue(tftypes.String, policy),
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		return &v
+	}
+	planRequest := &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         "test_resource",
+		PriorState:       state(`{"a": 1}`),
+		ProposedNewState: state(`{"a": 2}`),
+		Config:           state(`{"a": 2}`),
+	}
+	warnings := func(response *tfprotov5.PlanResourceChangeResponse) int {
+		return len(slices.DeleteFunc(response.Diagnostics, func(d *tfprotov5.Diagnostic) bool {
+			return d.Summary != jsonDriftWarningSummary
+		}))
+	}
+
+	// Terraform planning during apply: no resources have been read.
+	response, err := server.PlanResourceChange(ctx, planRequest)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := warnings(response), 0; got != want {
+		t.Errorf("warnings before ReadResource = %d, want %d", got, want)
+	}
+
+	if _, err := server.ReadResource(ctx, &tfprotov5.ReadResourceRequest{
+		TypeName:     "test_resource",
+		CurrentState: state(`{"a": 1}`),
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	response, err = server.PlanResourceChange(ctx, planRequest)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := warnings(response), 1; got != want {
+		t.Errorf("warnings after ReadResource = %d, want %d", got, want)
+	}
+}
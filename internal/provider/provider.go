@@ -10,6 +10,7 @@ import (
 	"log"
 	"maps"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -55,6 +56,31 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"batch_tag_updates": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Batch tag-only updates, such as those caused by changes to `default_tags`, " +
+					"into Resource Groups Tagging API calls of up to 20 resources.",
+			},
+			"concurrency_limits": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration block with settings to limit the number of concurrent operations on individual resource types.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"limit": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The maximum number of concurrent create, read, update and delete operations on the resource type.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The resource type to limit, e.g. `aws_organizations_account`.",
+						},
+					},
+				},
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -110,6 +136,27 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "URL of a proxy to use for HTTPS requests when accessing the AWS API. " +
 					"Can also be set using the `HTTPS_PROXY` or `https_proxy` environment variables.",
 			},
+			"ignore_attributes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration block with settings to ignore changes to attributes of individual resource types.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Description: "Attributes whose changes are ignored, as dot-separated paths, " +
+								"e.g. `description` or `ebs_block_device.0.throughput`.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The resource type whose attribute changes are ignored, e.g. `aws_instance`.",
+						},
+					},
+				},
+			},
 			"ignore_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -159,6 +206,30 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limits": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration block with settings to limit the rate of AWS API requests made by the provider to individual services.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"burst": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The maximum number of requests that can be made in a single burst. Defaults to `requests_per_second` rounded up.",
+						},
+						"requests_per_second": {
+							Type:        schema.TypeFloat,
+							Required:    true,
+							Description: "The sustained rate, in requests per second, at which API requests are made to the service.",
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The service to rate limit, e.g. `route53`. Any of the service names or aliases supported in the `endpoints` block.",
+						},
+					},
+				},
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -315,11 +386,25 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			regionOverride := isRegionOverrideEnabled(servicePackageName, r)
+			if regionOverride {
+				addRegionToSchema(r, regionDataSourceSchema())
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Read,
+					interceptor: regionDataSourceInterceptor(),
+				})
+			}
+
 			opts := wrappedDataSourceOptions{
-				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
+				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
-					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+					var region string
+					if regionOverride {
+						region = overrideRegion(getAttribute)
+					}
+					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, region)
 					if v, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 						ctx = v.RegisterLogger(ctx)
@@ -363,7 +448,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 
 			var customizeDiffFuncs []schema.CustomizeDiffFunc
-			interceptors := interceptorItems{}
+			// Must be first so that it is the last interceptor run after Read.
+			interceptors := interceptorItems{
+				{
+					when:        After,
+					why:         Read,
+					interceptor: ignoreAttributesInterceptor(typeName),
+				},
+			}
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -396,12 +488,46 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			regionOverride := isRegionOverrideEnabled(servicePackageName, r)
+			if regionOverride {
+				addRegionToSchema(r, regionResourceSchema())
+				customizeDiffFuncs = append(customizeDiffFuncs, defaultRegion)
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Create | Read,
+					interceptor: regionResourceInterceptor(),
+				})
+			}
+
+			var identity *resourceIdentity
+			if v.Identity != nil {
+				v := newResourceIdentity(v.Identity, servicePackageName)
+				identity = &v
+				r.Identity = v.schema()
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Create | Read | Update,
+					interceptor: identityInterceptor(v),
+				})
+			}
+
+			// Must be last.
+			interceptors = append(interceptors, interceptorItem{
+				when:        Before | Finally,
+				why:         AllOps,
+				interceptor: concurrencyLimitInterceptor(typeName),
+			})
+
 			opts := wrappedResourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
-				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
+				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+					var region string
+					if regionOverride {
+						region = overrideRegion(getAttribute)
+					}
+					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, region)
 					if v, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 						ctx = v.RegisterLogger(ctx)
@@ -410,7 +536,9 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					return ctx, diags
 				},
 				customizeDiffFuncs: customizeDiffFuncs,
+				identity:           identity,
 				interceptors:       interceptors,
+				regionOverride:     regionOverride,
 				typeName:           typeName,
 			}
 			wrapResource(r, opts)
@@ -450,6 +578,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		BatchTagUpdates:                d.Get("batch_tag_updates").(bool),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -528,6 +657,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		})
 	}
 
+	if v, ok := d.GetOk("concurrency_limits"); ok && len(v.([]any)) > 0 {
+		concurrencyLimits, dx := expandConcurrencyLimits(ctx, v.([]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.ConcurrencyLimits = concurrencyLimits
+	}
+
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]any)[0].(map[string]any))
 	} else {
@@ -561,6 +699,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.NoProxy = v
 	}
 
+	if v, ok := d.GetOk("ignore_attributes"); ok && len(v.([]any)) > 0 {
+		ignoreAttributes, dx := expandIgnoreAttributes(ctx, v.([]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.IgnoreAttributes = ignoreAttributes
+	}
+
 	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]any)[0].(map[string]any))
 	} else {
@@ -571,6 +718,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("rate_limits"); ok && len(v.([]any)) > 0 {
+		rateLimits, dx := expandRateLimits(ctx, v.([]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.RateLimits = rateLimits
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]any)) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]any))
 	}
@@ -909,6 +1065,157 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 	return ignoreConfig
 }
 
+func expandConcurrencyLimits(_ context.Context, tfList []any) (map[string]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	concurrencyLimitsPath := cty.GetAttrPath("concurrency_limits")
+	concurrencyLimits := make(map[string]int)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		elementPath := concurrencyLimitsPath.IndexInt(i)
+
+		typeName := tfMap["resource_type"].(string)
+		if _, ok := concurrencyLimits[typeName]; ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				elementPath.GetAttr("resource_type"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Duplicate concurrency limit for resource type %q.", typeName),
+			))
+			continue
+		}
+
+		limit := tfMap["limit"].(int)
+		if limit < 1 {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				elementPath.GetAttr("limit"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute %q must be at least 1, got: %d.", errs.PathString(elementPath.GetAttr("limit")), limit),
+			))
+			continue
+		}
+
+		concurrencyLimits[typeName] = limit
+	}
+
+	return concurrencyLimits, diags
+}
+
+func expandIgnoreAttributes(_ context.Context, tfList []any) (map[string][]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ignoreAttributesPath := cty.GetAttrPath("ignore_attributes")
+	ignoreAttributes := make(map[string][]string)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		elementPath := ignoreAttributesPath.IndexInt(i)
+
+		typeName := tfMap["resource_type"].(string)
+		if _, ok := ignoreAttributes[typeName]; ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				elementPath.GetAttr("resource_type"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Duplicate ignored attributes for resource type %q.", typeName),
+			))
+			continue
+		}
+
+		var attributes []string
+		for _, attribute := range flex.ExpandStringValueSet(tfMap["attributes"].(*schema.Set)) {
+			if !validIgnoreAttributePath(attribute) {
+				diags = append(diags, errs.NewAttributeErrorDiagnostic(
+					elementPath.GetAttr("attributes"),
+					"Invalid Attribute Value",
+					fmt.Sprintf("Invalid attribute path %q for resource type %q.", attribute, typeName),
+				))
+				continue
+			}
+			attributes = append(attributes, attribute)
+		}
+		slices.Sort(attributes)
+
+		ignoreAttributes[typeName] = attributes
+	}
+
+	return ignoreAttributes, diags
+}
+
+func expandRateLimits(_ context.Context, tfList []any) (map[string]conns.RateLimit, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rateLimitsPath := cty.GetAttrPath("rate_limits")
+	rateLimits := make(map[string]conns.RateLimit)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		elementPath := rateLimitsPath.IndexInt(i)
+
+		service := tfMap["service"].(string)
+		servicePackageName := service
+		if !slices.Contains(names.ProviderPackages(), servicePackageName) {
+			v, err := names.ProviderPackageForAlias(service)
+			if err != nil {
+				diags = append(diags, errs.NewAttributeErrorDiagnostic(
+					elementPath.GetAttr("service"),
+					"Invalid Attribute Value",
+					fmt.Sprintf("Unsupported service %q.", service),
+				))
+				continue
+			}
+			servicePackageName = v
+		}
+
+		if _, ok := rateLimits[servicePackageName]; ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				elementPath.GetAttr("service"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Duplicate rate limit for service %q.", service),
+			))
+			continue
+		}
+
+		rateLimit := conns.RateLimit{
+			RequestsPerSecond: tfMap["requests_per_second"].(float64),
+			Burst:             tfMap["burst"].(int),
+		}
+
+		if rateLimit.RequestsPerSecond <= 0 {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				elementPath.GetAttr("requests_per_second"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute %q must be greater than 0, got: %g.", errs.PathString(elementPath.GetAttr("requests_per_second")), rateLimit.RequestsPerSecond),
+			))
+			continue
+		}
+
+		if rateLimit.Burst < 0 {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				elementPath.GetAttr("burst"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute %q must be at least 0, got: %d.", errs.PathString(elementPath.GetAttr("burst")), rateLimit.Burst),
+			))
+			continue
+		}
+
+		rateLimits[servicePackageName] = rateLimit
+	}
+
+	return rateLimits, diags
+}
+
 func DeprecatedEnvVarDiag(envvar, replacement string) diag.Diagnostic {
 	return errs.NewWarningDiagnostic(
 		"Deprecated Environment Variable",
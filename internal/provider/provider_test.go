@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -438,3 +439,135 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestExpandRateLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		tfList             []any
+		expectedRateLimits map[string]conns.RateLimit
+		expectError        bool
+	}{
+		"empty": {
+			tfList:             []any{},
+			expectedRateLimits: map[string]conns.RateLimit{},
+		},
+		"service package name": {
+			tfList: []any{
+				map[string]any{"service": "route53", "requests_per_second": 5.0, "burst": 10},
+			},
+			expectedRateLimits: map[string]conns.RateLimit{
+				names.Route53: {RequestsPerSecond: 5, Burst: 10},
+			},
+		},
+		"service alias": {
+			tfList: []any{
+				map[string]any{"service": "prometheus", "requests_per_second": 0.5, "burst": 0},
+				map[string]any{"service": "ec2", "requests_per_second": 20.0, "burst": 0},
+			},
+			expectedRateLimits: map[string]conns.RateLimit{
+				names.AMP: {RequestsPerSecond: 0.5},
+				names.EC2: {RequestsPerSecond: 20},
+			},
+		},
+		"unknown service": {
+			tfList: []any{
+				map[string]any{"service": "notaservice", "requests_per_second": 5.0, "burst": 10},
+			},
+			expectError: true,
+		},
+		"duplicate service": {
+			tfList: []any{
+				map[string]any{"service": "amp", "requests_per_second": 5.0, "burst": 10},
+				map[string]any{"service": "prometheus", "requests_per_second": 1.0, "burst": 1},
+			},
+			expectError: true,
+		},
+		"invalid requests_per_second": {
+			tfList: []any{
+				map[string]any{"service": "route53", "requests_per_second": 0.0, "burst": 10},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := expandRateLimits(ctx, testcase.tfList)
+
+			if got, want := diags.HasError(), testcase.expectError; got != want {
+				t.Fatalf("expected error %t, got diagnostics: %v", want, diags)
+			}
+
+			if testcase.expectError {
+				return
+			}
+
+			if diff := cmp.Diff(testcase.expectedRateLimits, results); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandConcurrencyLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		tfList                    []any
+		expectedConcurrencyLimits map[string]int
+		expectError               bool
+	}{
+		"empty": {
+			tfList:                    []any{},
+			expectedConcurrencyLimits: map[string]int{},
+		},
+		"valid": {
+			tfList: []any{
+				map[string]any{"resource_type": "aws_organizations_account", "limit": 1},
+				map[string]any{"resource_type": "aws_lakeformation_permissions", "limit": 4},
+			},
+			expectedConcurrencyLimits: map[string]int{
+				"aws_organizations_account":     1,
+				"aws_lakeformation_permissions": 4,
+			},
+		},
+		"duplicate resource type": {
+			tfList: []any{
+				map[string]any{"resource_type": "aws_organizations_account", "limit": 1},
+				map[string]any{"resource_type": "aws_organizations_account", "limit": 2},
+			},
+			expectError: true,
+		},
+		"invalid limit": {
+			tfList: []any{
+				map[string]any{"resource_type": "aws_organizations_account", "limit": 0},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := expandConcurrencyLimits(ctx, testcase.tfList)
+
+			if got, want := diags.HasError(), testcase.expectError; got != want {
+				t.Fatalf("expected error %t, got diagnostics: %v", want, diags)
+			}
+
+			if testcase.expectError {
+				return
+			}
+
+			if diff := cmp.Diff(testcase.expectedConcurrencyLimits, results); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
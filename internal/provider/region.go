@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// importIDRegionSeparator separates a resource's import ID from any per-resource Region override,
+// e.g. `terraform import aws_vpc.example vpc-12345678@eu-west-1`.
+const importIDRegionSeparator = "@"
+
+// isRegionOverrideEnabled returns whether the per-resource Region override attribute can be injected into the specified schema.
+// Resources and data sources of global services, and those that already define a top-level `region` attribute, are left untouched.
+func isRegionOverrideEnabled(servicePackageName string, r *schema.Resource) bool {
+	if names.IsGlobal(servicePackageName) {
+		return false
+	}
+
+	_, ok := r.SchemaMap()[names.AttrRegion]
+	return !ok
+}
+
+// addRegionToSchema injects the per-resource Region override attribute into the specified schema.
+func addRegionToSchema(r *schema.Resource, s *schema.Schema) {
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			m := f()
+			m[names.AttrRegion] = s
+			return m
+		}
+	} else {
+		r.Schema[names.AttrRegion] = s
+	}
+}
+
+func regionDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The AWS Region to read from. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+func regionResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// overrideRegion returns any per-resource Region override value.
+func overrideRegion(getAttribute getAttributeFunc) string {
+	if getAttribute == nil {
+		return ""
+	}
+
+	if v, ok := getAttribute(names.AttrRegion); ok {
+		if v, ok := v.(string); ok {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// configuredAttribute returns a getAttributeFunc that reads top-level string attributes from raw configuration.
+func configuredAttribute(d *schema.ResourceDiff) getAttributeFunc {
+	return func(key string) (any, bool) {
+		config := d.GetRawConfig()
+		if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute(key) {
+			return nil, false
+		}
+
+		v := config.GetAttr(key)
+		if v.IsNull() || !v.IsKnown() || !v.Type().Equals(cty.String) {
+			return nil, false
+		}
+
+		return v.AsString(), true
+	}
+}
+
+// plannedAttribute returns a getAttributeFunc that reads top-level string attributes from raw configuration,
+// falling back to any prior state value. During plan an unconfigured Region means any Region already in state.
+func plannedAttribute(d *schema.ResourceDiff) getAttributeFunc {
+	configured := configuredAttribute(d)
+
+	return func(key string) (any, bool) {
+		if v, ok := configured(key); ok {
+			return v, true
+		}
+
+		if d.Id() == "" {
+			return nil, false
+		}
+
+		if o, _ := d.GetChange(key); o != nil && o != "" {
+			return o, true
+		}
+
+		return nil, false
+	}
+}
+
+// regionDataSourceInterceptor sets the `region` attribute in state after a data source Read.
+func regionDataSourceInterceptor() interceptor {
+	return interceptorFunc(func(ctx context.Context, opts interceptorOptions) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case After:
+			switch why {
+			case Read:
+				if err := d.Set(names.AttrRegion, opts.c.Region(ctx)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+				}
+			}
+		}
+
+		return diags
+	})
+}
+
+// regionResourceInterceptor sets the `region` attribute in state after a resource Create or Read.
+func regionResourceInterceptor() interceptor {
+	return interceptorFunc(func(ctx context.Context, opts interceptorOptions) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case After:
+			switch why {
+			case Read:
+				// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+				if d.Id() == "" {
+					return diags
+				}
+
+				fallthrough
+			case Create:
+				if err := d.Set(names.AttrRegion, opts.c.Region(ctx)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+				}
+			}
+		}
+
+		return diags
+	})
+}
+
+// defaultRegion is a CustomizeDiff function that sets the new value of the `region` attribute
+// to the provider's configured Region if the attribute is not configured and the resource is being created.
+func defaultRegion(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if _, ok := configuredAttribute(d)(names.AttrRegion); ok {
+		return nil
+	}
+
+	// An unconfigured Region keeps any prior state value, so changing the provider's Region
+	// or upgrading state that predates the `region` attribute doesn't force replacement.
+	if d.Id() != "" {
+		return nil
+	}
+
+	if region := meta.(*conns.AWSClient).Region(ctx); d.Get(names.AttrRegion).(string) != region {
+		if err := d.SetNew(names.AttrRegion, region); err != nil {
+			return fmt.Errorf("setting new %s diff: %w", names.AttrRegion, err)
+		}
+	}
+
+	return nil
+}
+
+// importRegion splits any per-resource Region override from the resource's import ID.
+func importRegion(d *schema.ResourceData) error {
+	i := strings.LastIndex(d.Id(), importIDRegionSeparator)
+	if i < 0 {
+		return nil
+	}
+	id, region := d.Id()[:i], d.Id()[i+len(importIDRegionSeparator):]
+
+	// The separator may legitimately appear in some import IDs.
+	if _, errs := verify.ValidRegionName(region, names.AttrRegion); len(errs) > 0 {
+		return nil
+	}
+
+	d.SetId(id)
+	if err := d.Set(names.AttrRegion, region); err != nil {
+		return fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+	}
+
+	return nil
+}
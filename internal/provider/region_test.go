@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestImportRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		importID       string
+		expectedID     string
+		expectedRegion string
+	}{
+		{
+			name:       "no separator",
+			importID:   "vpc-12345678",
+			expectedID: "vpc-12345678",
+		},
+		{
+			name:           "region",
+			importID:       "vpc-12345678@eu-west-1", //lintignore:AWSAT003
+			expectedID:     "vpc-12345678",
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		{
+			name:       "separator not followed by region",
+			importID:   "user@example.com",
+			expectedID: "user@example.com",
+		},
+		{
+			name:           "multiple separators",
+			importID:       "user@example.com@us-gov-west-1", //lintignore:AWSAT003
+			expectedID:     "user@example.com",
+			expectedRegion: "us-gov-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+				names.AttrRegion: regionResourceSchema(),
+			}, map[string]any{})
+			d.SetId(testCase.importID)
+
+			if err := importRegion(d); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := d.Id(), testCase.expectedID; got != want {
+				t.Errorf("ID = %v, want %v", got, want)
+			}
+			if got, want := d.Get(names.AttrRegion).(string), testCase.expectedRegion; got != want {
+				t.Errorf("region = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -68,7 +68,13 @@ func (r tagsResourceInterceptor) run(ctx context.Context, opts interceptorOption
 					if identifier := r.getIdentifier(d); identifier != "" {
 						o, n := d.GetChange(names.AttrTagsAll)
 
-						if err := r.UpdateTags(ctx, sp, c, identifier, o, n); err != nil {
+						updateTags := r.UpdateTags
+						// Tag-only changes, e.g. to the provider's default_tags, can be batched with identical changes to other resources.
+						if !d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+							updateTags = r.BatchUpdateTags
+						}
+
+						if err := updateTags(ctx, sp, c, identifier, o, n); err != nil {
 							return sdkdiag.AppendErrorf(diags, "updating tags for %s %s (%s): %s", serviceName, resourceName, identifier, err)
 						}
 					}
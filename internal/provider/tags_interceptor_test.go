@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -84,7 +85,7 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}))
 
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
-		ctx = conns.NewResourceContext(ctx, "Test", "aws_test")
+		ctx = conns.NewResourceContext(ctx, "Test", "aws_test", "")
 		if v, ok := meta.(*conns.AWSClient); ok {
 			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 		}
@@ -143,6 +144,10 @@ func (d *resourceData) Id() string {
 	return "id"
 }
 
+func (d *resourceData) Identity() (*schema.IdentityData, error) {
+	return nil, nil
+}
+
 func (d *resourceData) Set(string, any) error {
 	return nil
 }
@@ -158,3 +163,7 @@ func (d *resourceData) HasChange(key string) bool {
 func (d *resourceData) HasChanges(keys ...string) bool {
 	return false
 }
+
+func (d *resourceData) HasChangesExcept(keys ...string) bool {
+	return false
+}
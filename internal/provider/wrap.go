@@ -5,12 +5,15 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"slices"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // Implemented by (schema.ResourceData|schema.ResourceDiff).GetOk().
@@ -49,8 +52,11 @@ type wrappedResourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext   contextFunc
 	customizeDiffFuncs []schema.CustomizeDiffFunc
-	interceptors       interceptorItems
-	typeName           string
+	// identity is non-nil if the resource has an identity schema.
+	identity       *resourceIdentity
+	interceptors   interceptorItems
+	regionOverride bool // Whether the per-resource Region override attribute has been injected.
+	typeName       string
 }
 
 // wrappedResource represents an interceptor dispatcher for a Plugin SDK v2 resource.
@@ -114,12 +120,37 @@ func (w *wrappedResource) state(f schema.StateContextFunc) schema.StateContextFu
 	}
 
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		// A resource imported by identity has no import ID.
+		if v := w.opts.identity; v != nil && d.Id() == "" {
+			if err := v.importIdentity(ctx, meta.(*conns.AWSClient), d, w.opts.regionOverride); err != nil {
+				return nil, err
+			}
+		} else if w.opts.regionOverride {
+			if err := importRegion(d); err != nil {
+				return nil, err
+			}
+		}
+
 		ctx, diags := w.opts.bootstrapContext(ctx, d.GetOk, meta)
 		if diags.HasError() {
 			return nil, sdkdiag.DiagnosticsError(diags)
 		}
 
-		return f(ctx, d, meta)
+		results, err := f(ctx, d, meta)
+		if err != nil {
+			return nil, err
+		}
+
+		if w.opts.regionOverride {
+			region := meta.(*conns.AWSClient).Region(ctx)
+			for _, v := range results {
+				if err := v.Set(names.AttrRegion, region); err != nil {
+					return nil, fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+				}
+			}
+		}
+
+		return results, nil
 	}
 }
 
@@ -141,7 +172,11 @@ func (w *wrappedResource) customizeDiff(f schema.CustomizeDiffFunc) schema.Custo
 
 func (w *wrappedResource) customizeDiffWithBootstrappedContext(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
-		ctx, diags := w.opts.bootstrapContext(ctx, d.GetOk, meta)
+		getAttribute := d.GetOk
+		if w.opts.regionOverride {
+			getAttribute = plannedAttribute(d)
+		}
+		ctx, diags := w.opts.bootstrapContext(ctx, getAttribute, meta)
 		if diags.HasError() {
 			return sdkdiag.DiagnosticsError(diags)
 		}
@@ -161,6 +196,18 @@ func (w *wrappedResource) stateUpgrade(f schema.StateUpgradeFunc) schema.StateUp
 			return nil, sdkdiag.DiagnosticsError(diags)
 		}
 
-		return f(ctx, rawState, meta)
+		rawState, err := f(ctx, rawState, meta)
+		if err != nil {
+			return nil, err
+		}
+
+		// State written before the per-resource Region override attribute was added is in the provider's configured Region.
+		if w.opts.regionOverride && rawState != nil {
+			if v, ok := rawState[names.AttrRegion].(string); !ok || v == "" {
+				rawState[names.AttrRegion] = meta.(*conns.AWSClient).Region(ctx)
+			}
+		}
+
+		return rawState, nil
 	}
 }
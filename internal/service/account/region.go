@@ -210,7 +210,7 @@ func statusRegionOptStatus(ctx context.Context, conn *account.Client, accountID,
 }
 
 func waitRegionEnabled(ctx context.Context, conn *account.Client, accountID, region string, timeout time.Duration) (*account.GetRegionOptStatusOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:      enum.Slice(types.RegionOptStatusEnabling),
 		Target:       enum.Slice(types.RegionOptStatusEnabled),
 		Refresh:      statusRegionOptStatus(ctx, conn, accountID, region),
@@ -228,7 +228,7 @@ func waitRegionEnabled(ctx context.Context, conn *account.Client, accountID, reg
 }
 
 func waitRegionDisabled(ctx context.Context, conn *account.Client, accountID, region string, timeout time.Duration) (*account.GetRegionOptStatusOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:      enum.Slice(types.RegionOptStatusDisabling),
 		Target:       enum.Slice(types.RegionOptStatusDisabled),
 		Refresh:      statusRegionOptStatus(ctx, conn, accountID, region),
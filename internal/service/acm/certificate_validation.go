@@ -168,7 +168,7 @@ func statusCertificate(ctx context.Context, conn *acm.Client, arn string) retry.
 }
 
 func waitCertificateIssued(ctx context.Context, conn *acm.Client, arn string, timeout time.Duration) (*types.CertificateDetail, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.CertificateStatusPendingValidation),
 		Target:  enum.Slice(types.CertificateStatusIssued),
 		Refresh: statusCertificate(ctx, conn, arn),
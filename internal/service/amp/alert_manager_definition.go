@@ -187,7 +187,7 @@ func waitAlertManagerDefinitionCreated(ctx context.Context, conn *amp.Client, id
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.AlertManagerDefinitionStatusCodeCreating),
 		Target:  enum.Slice(types.AlertManagerDefinitionStatusCodeActive),
 		Refresh: statusAlertManagerDefinition(ctx, conn, id),
@@ -211,7 +211,7 @@ func waitAlertManagerDefinitionUpdated(ctx context.Context, conn *amp.Client, id
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.AlertManagerDefinitionStatusCodeUpdating),
 		Target:  enum.Slice(types.AlertManagerDefinitionStatusCodeActive),
 		Refresh: statusAlertManagerDefinition(ctx, conn, id),
@@ -235,7 +235,7 @@ func waitAlertManagerDefinitionDeleted(ctx context.Context, conn *amp.Client, id
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.AlertManagerDefinitionStatusCodeDeleting),
 		Target:  []string{},
 		Refresh: statusAlertManagerDefinition(ctx, conn, id),
@@ -242,7 +242,7 @@ func waitRuleGroupNamespaceCreated(ctx context.Context, conn *amp.Client, id str
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.RuleGroupsNamespaceStatusCodeCreating),
 		Target:  enum.Slice(types.RuleGroupsNamespaceStatusCodeActive),
 		Refresh: statusRuleGroupNamespace(ctx, conn, id),
@@ -262,7 +262,7 @@ func waitRuleGroupNamespaceUpdated(ctx context.Context, conn *amp.Client, id str
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.RuleGroupsNamespaceStatusCodeUpdating),
 		Target:  enum.Slice(types.RuleGroupsNamespaceStatusCodeActive),
 		Refresh: statusRuleGroupNamespace(ctx, conn, id),
@@ -282,7 +282,7 @@ func waitRuleGroupNamespaceDeleted(ctx context.Context, conn *amp.Client, id str
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.RuleGroupsNamespaceStatusCodeDeleting),
 		Target:  []string{},
 		Refresh: statusRuleGroupNamespace(ctx, conn, id),
@@ -463,7 +463,7 @@ func statusScraper(ctx context.Context, conn *amp.Client, id string) retry.State
 }
 
 func waitScraperCreated(ctx context.Context, conn *amp.Client, id string, timeout time.Duration) (*awstypes.ScraperDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ScraperStatusCodeCreating),
 		Target:  enum.Slice(awstypes.ScraperStatusCodeActive),
 		Refresh: statusScraper(ctx, conn, id),
@@ -480,7 +480,7 @@ func waitScraperCreated(ctx context.Context, conn *amp.Client, id string, timeou
 }
 
 func waitScraperDeleted(ctx context.Context, conn *amp.Client, id string, timeout time.Duration) (*awstypes.ScraperDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ScraperStatusCodeActive, awstypes.ScraperStatusCodeDeleting),
 		Target:  []string{},
 		Refresh: statusScraper(ctx, conn, id),
@@ -329,7 +329,7 @@ func waitWorkspaceCreated(ctx context.Context, conn *amp.Client, id string) (*ty
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.WorkspaceStatusCodeCreating),
 		Target:  enum.Slice(types.WorkspaceStatusCodeActive),
 		Refresh: statusWorkspace(ctx, conn, id),
@@ -349,7 +349,7 @@ func waitWorkspaceUpdated(ctx context.Context, conn *amp.Client, id string) (*ty
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.WorkspaceStatusCodeUpdating),
 		Target:  enum.Slice(types.WorkspaceStatusCodeActive),
 		Refresh: statusWorkspace(ctx, conn, id),
@@ -369,7 +369,7 @@ func waitWorkspaceDeleted(ctx context.Context, conn *amp.Client, id string) (*ty
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.WorkspaceStatusCodeDeleting),
 		Target:  []string{},
 		Refresh: statusWorkspace(ctx, conn, id),
@@ -430,7 +430,7 @@ func waitLoggingConfigurationCreated(ctx context.Context, conn *amp.Client, work
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.LoggingConfigurationStatusCodeCreating),
 		Target:  enum.Slice(types.LoggingConfigurationStatusCodeActive),
 		Refresh: statusLoggingConfiguration(ctx, conn, workspaceID),
@@ -454,7 +454,7 @@ func waitLoggingConfigurationUpdated(ctx context.Context, conn *amp.Client, work
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.LoggingConfigurationStatusCodeUpdating),
 		Target:  enum.Slice(types.LoggingConfigurationStatusCodeActive),
 		Refresh: statusLoggingConfiguration(ctx, conn, workspaceID),
@@ -478,7 +478,7 @@ func waitLoggingConfigurationDeleted(ctx context.Context, conn *amp.Client, work
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.LoggingConfigurationStatusCodeDeleting),
 		Target:  []string{},
 		Refresh: statusLoggingConfiguration(ctx, conn, workspaceID),
@@ -316,7 +316,7 @@ func waitDomainAssociationCreated(ctx context.Context, conn *amplify.Client, app
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			types.DomainStatusCreating,
 			types.DomainStatusInProgress,
@@ -350,7 +350,7 @@ func waitDomainAssociationVerified(ctx context.Context, conn *amplify.Client, ap
 	const (
 		timeout = 15 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			types.DomainStatusUpdating,
 			types.DomainStatusInProgress,
@@ -383,7 +383,7 @@ func waitDomainAssociationAvailable(ctx context.Context, conn *amplify.Client, a
 	const (
 		timeout = 15 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			types.DomainStatusUpdating,
 			types.DomainStatusInProgress,
@@ -552,7 +552,7 @@ func waitDomainNameUpdated(ctx context.Context, conn *apigateway.Client, domainN
 	const (
 		timeout = 15 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.DomainNameStatusUpdating),
 		Target:     enum.Slice(types.DomainNameStatusAvailable),
 		Refresh:    statusDomainName(ctx, conn, domainName, domainNameID),
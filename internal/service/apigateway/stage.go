@@ -474,7 +474,7 @@ func waitStageCacheAvailable(ctx context.Context, conn *apigateway.Client, apiID
 	const (
 		timeout = 90 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.CacheClusterStatusCreateInProgress, types.CacheClusterStatusDeleteInProgress, types.CacheClusterStatusFlushInProgress),
 		Target:  enum.Slice(types.CacheClusterStatusAvailable),
 		Refresh: stageCacheStatus(ctx, conn, apiID, name),
@@ -494,7 +494,7 @@ func waitStageCacheUpdated(ctx context.Context, conn *apigateway.Client, apiID,
 	const (
 		timeout = 30 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.CacheClusterStatusCreateInProgress, types.CacheClusterStatusFlushInProgress),
 		Target: enum.Slice(
 			types.CacheClusterStatusAvailable,
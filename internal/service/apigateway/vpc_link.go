@@ -233,7 +233,7 @@ func waitVPCLinkAvailable(ctx context.Context, conn *apigateway.Client, id strin
 	const (
 		timeout = 20 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.VpcLinkStatusPending),
 		Target:     enum.Slice(types.VpcLinkStatusAvailable),
 		Refresh:    vpcLinkStatus(ctx, conn, id),
@@ -256,7 +256,7 @@ func waitVPCLinkDeleted(ctx context.Context, conn *apigateway.Client, id string)
 	const (
 		timeout = 20 * time.Minute
 	)
-	stateConf := retry.StateChangeConf{
+	stateConf := tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.VpcLinkStatusPending, types.VpcLinkStatusAvailable, types.VpcLinkStatusDeleting),
 		Target:     []string{},
 		Timeout:    timeout,
@@ -298,7 +298,7 @@ func statusDomainName(ctx context.Context, conn *apigatewayv2.Client, name strin
 }
 
 func waitDomainNameAvailable(ctx context.Context, conn *apigatewayv2.Client, name string, timeout time.Duration) (*apigatewayv2.GetDomainNameOutput, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.DomainNameStatusUpdating),
 		Target:  enum.Slice(awstypes.DomainNameStatusAvailable),
 		Refresh: statusDomainName(ctx, conn, name),
@@ -445,7 +445,7 @@ func statusAppAuthorization(ctx context.Context, conn *appfabric.Client, appAuth
 }
 
 func waitAppAuthorizationCreated(ctx context.Context, conn *appfabric.Client, appAuthorizationARN, appBundleIdentifier string, timeout time.Duration) (*awstypes.AppAuthorization, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{},
 		Target:  enum.Slice(awstypes.AppAuthorizationStatusPendingConnect, awstypes.AppAuthorizationStatusConnected),
 		Refresh: statusAppAuthorization(ctx, conn, appAuthorizationARN, appBundleIdentifier),
@@ -462,7 +462,7 @@ func waitAppAuthorizationCreated(ctx context.Context, conn *appfabric.Client, ap
 }
 
 func waitAppAuthorizationUpdated(ctx context.Context, conn *appfabric.Client, appAuthorizationARN, appBundleIdentifier string, timeout time.Duration) (*awstypes.AppAuthorization, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{},
 		Target:  enum.Slice(awstypes.AppAuthorizationStatusConnected, awstypes.AppAuthorizationStatusPendingConnect),
 		Refresh: statusAppAuthorization(ctx, conn, appAuthorizationARN, appBundleIdentifier),
@@ -479,7 +479,7 @@ func waitAppAuthorizationUpdated(ctx context.Context, conn *appfabric.Client, ap
 }
 
 func waitAppAuthorizationDeleted(ctx context.Context, conn *appfabric.Client, appAuthorizationARN, appBundleIdentifier string, timeout time.Duration) (*awstypes.AppAuthorization, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.AppAuthorizationStatusConnected, awstypes.AppAuthorizationStatusPendingConnect),
 		Target:  []string{},
 		Refresh: statusAppAuthorization(ctx, conn, appAuthorizationARN, appBundleIdentifier),
@@ -242,7 +242,7 @@ func statusConnectAppAuthorization(ctx context.Context, conn *appfabric.Client,
 }
 
 func waitConnectAppAuthorizationCreated(ctx context.Context, conn *appfabric.Client, appAuthorizationARN, appBundleArn string, timeout time.Duration) (*awstypes.AppAuthorization, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.AppAuthorizationStatusPendingConnect),
 		Target:  enum.Slice(awstypes.AppAuthorizationStatusConnected),
 		Refresh: statusConnectAppAuthorization(ctx, conn, appAuthorizationARN, appBundleArn),
@@ -427,7 +427,7 @@ func statusIngestionDestination(ctx context.Context, conn *appfabric.Client, app
 }
 
 func waitIngestionDestinationActive(ctx context.Context, conn *appfabric.Client, appBundleARN, ingestionARN, arn string, timeout time.Duration) (*awstypes.IngestionDestination, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{},
 		Target:  enum.Slice(awstypes.IngestionDestinationStatusActive),
 		Refresh: statusIngestionDestination(ctx, conn, appBundleARN, ingestionARN, arn),
@@ -446,7 +446,7 @@ func waitIngestionDestinationActive(ctx context.Context, conn *appfabric.Client,
 }
 
 func waitIngestionDestinationDeleted(ctx context.Context, conn *appfabric.Client, appBundleARN, ingestionARN, arn string, timeout time.Duration) (*awstypes.IngestionDestination, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.IngestionDestinationStatusActive),
 		Target:  []string{},
 		Refresh: statusIngestionDestination(ctx, conn, appBundleARN, ingestionARN, arn),
@@ -1557,7 +1557,7 @@ func waitFlowDeleted(ctx context.Context, conn *appflow.Client, name string) (*t
 	const (
 		timeout = 2 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:  []string{},
 		Refresh: statusFlow(ctx, conn, name),
 		Timeout: timeout,
@@ -282,7 +282,7 @@ func waitAutoScalingConfigurationCreated(ctx context.Context, conn *apprunner.Cl
 	const (
 		timeout = 2 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{},
 		Target:  []string{autoScalingConfigurationStatusActive},
 		Refresh: statusAutoScalingConfiguration(ctx, conn, arn),
@@ -302,7 +302,7 @@ func waitAutoScalingConfigurationDeleted(ctx context.Context, conn *apprunner.Cl
 	const (
 		timeout = 2 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{autoScalingConfigurationStatusActive},
 		Target:  []string{},
 		Refresh: statusAutoScalingConfiguration(ctx, conn, arn),
@@ -216,7 +216,7 @@ func waitConnectionDeleted(ctx context.Context, conn *apprunner.Client, name str
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ConnectionStatusPendingHandshake, types.ConnectionStatusAvailable),
 		Target:  []string{},
 		Refresh: statusConnection(ctx, conn, name),
@@ -212,7 +212,7 @@ func statusOperation(ctx context.Context, conn *apprunner.Client, serviceARN, op
 }
 
 func waitDeploymentSucceeded(ctx context.Context, conn *apprunner.Client, serviceARN, operationID string, timeout time.Duration) (*awstypes.OperationSummary, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:        enum.Slice(awstypes.OperationStatusPending, awstypes.OperationStatusInProgress),
 		Target:         enum.Slice(awstypes.OperationStatusSucceeded),
 		Refresh:        statusOperation(ctx, conn, serviceARN, operationID),
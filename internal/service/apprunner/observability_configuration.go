@@ -220,7 +220,7 @@ func waitObservabilityConfigurationCreated(ctx context.Context, conn *apprunner.
 	const (
 		timeout = 2 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{},
 		Target:  enum.Slice(types.ObservabilityConfigurationStatusActive),
 		Refresh: statusObservabilityConfiguration(ctx, conn, arn),
@@ -240,7 +240,7 @@ func waitObservabilityConfigurationDeleted(ctx context.Context, conn *apprunner.
 	const (
 		timeout = 2 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ObservabilityConfigurationStatusActive),
 		Target:  []string{},
 		Refresh: statusObservabilityConfiguration(ctx, conn, arn),
@@ -674,7 +674,7 @@ func waitServiceCreated(ctx context.Context, conn *apprunner.Client, arn string)
 	const (
 		timeout = 20 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ServiceStatusOperationInProgress),
 		Target:  enum.Slice(types.ServiceStatusRunning),
 		Refresh: statusService(ctx, conn, arn),
@@ -694,7 +694,7 @@ func waitServiceUpdated(ctx context.Context, conn *apprunner.Client, arn string)
 	const (
 		timeout = 20 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ServiceStatusOperationInProgress),
 		Target:  enum.Slice(types.ServiceStatusRunning),
 		Refresh: statusService(ctx, conn, arn),
@@ -714,7 +714,7 @@ func waitServiceDeleted(ctx context.Context, conn *apprunner.Client, arn string)
 	const (
 		timeout = 20 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ServiceStatusRunning, types.ServiceStatusOperationInProgress),
 		Target:  []string{},
 		Refresh: statusService(ctx, conn, arn),
@@ -213,7 +213,7 @@ func waitVPCConnectorCreated(ctx context.Context, conn *apprunner.Client, arn st
 	const (
 		timeout = 2 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:  enum.Slice(types.VpcConnectorStatusActive),
 		Refresh: statusVPCConnector(ctx, conn, arn),
 		Timeout: timeout,
@@ -232,7 +232,7 @@ func waitVPCConnectorDeleted(ctx context.Context, conn *apprunner.Client, arn st
 	const (
 		timeout = 2 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.VpcConnectorStatusActive),
 		Target:  []string{},
 		Refresh: statusVPCConnector(ctx, conn, arn),
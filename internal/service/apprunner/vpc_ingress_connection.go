@@ -229,7 +229,7 @@ func waitVPCIngressConnectionCreated(ctx context.Context, conn *apprunner.Client
 	const (
 		timeout = 2 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.VpcIngressConnectionStatusPendingCreation),
 		Target:  enum.Slice(types.VpcIngressConnectionStatusAvailable),
 		Refresh: statusVPCIngressConnection(ctx, conn, arn),
@@ -249,7 +249,7 @@ func waitVPCIngressConnectionDeleted(ctx context.Context, conn *apprunner.Client
 	const (
 		timeout = 2 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.VpcIngressConnectionStatusAvailable, types.VpcIngressConnectionStatusPendingDeletion),
 		Target:  []string{},
 		Refresh: statusVPCIngressConnection(ctx, conn, arn),
@@ -622,7 +622,7 @@ func waitFleetRunning(ctx context.Context, conn *appstream.Client, id string) (*
 	const (
 		timeout = 180 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.FleetStateStarting),
 		Target:  enum.Slice(awstypes.FleetStateRunning),
 		Refresh: statusFleet(ctx, conn, id),
@@ -644,7 +644,7 @@ func waitFleetStopped(ctx context.Context, conn *appstream.Client, id string) (*
 	const (
 		timeout = 180 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.FleetStateStopping),
 		Target:  enum.Slice(awstypes.FleetStateStopped),
 		Refresh: statusFleet(ctx, conn, id),
@@ -393,7 +393,7 @@ func waitImageBuilderRunning(ctx context.Context, conn *appstream.Client, id str
 	const (
 		timeout = 60 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ImageBuilderStatePending),
 		Target:  enum.Slice(awstypes.ImageBuilderStateRunning),
 		Refresh: statusImageBuilder(ctx, conn, id),
@@ -415,7 +415,7 @@ func waitImageBuilderDeleted(ctx context.Context, conn *appstream.Client, id str
 	const (
 		timeout = 60 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ImageBuilderStatePending, awstypes.ImageBuilderStateDeleting),
 		Target:  []string{},
 		Refresh: statusImageBuilder(ctx, conn, id),
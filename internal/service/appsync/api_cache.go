@@ -231,7 +231,7 @@ func waitAPICacheAvailable(ctx context.Context, conn *appsync.Client, id string)
 	const (
 		timeout = 60 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ApiCacheStatusCreating, awstypes.ApiCacheStatusModifying),
 		Target:  enum.Slice(awstypes.ApiCacheStatusAvailable),
 		Refresh: statusAPICache(ctx, conn, id),
@@ -251,7 +251,7 @@ func waitAPICacheDeleted(ctx context.Context, conn *appsync.Client, id string) (
 	const (
 		timeout = 60 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ApiCacheStatusDeleting),
 		Target:  []string{},
 		Refresh: statusAPICache(ctx, conn, id),
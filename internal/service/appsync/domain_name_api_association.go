@@ -188,7 +188,7 @@ func waitDomainNameAPIAssociation(ctx context.Context, conn *appsync.Client, id
 	const (
 		domainNameAPIAssociationTimeout = 60 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.AssociationStatusProcessing),
 		Target:  enum.Slice(awstypes.AssociationStatusSuccess),
 		Refresh: statusDomainNameAPIAssociation(ctx, conn, id),
@@ -209,7 +209,7 @@ func waitDomainNameAPIDisassociation(ctx context.Context, conn *appsync.Client,
 	const (
 		timeout = 60 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.AssociationStatusProcessing),
 		Target:  []string{},
 		Refresh: statusDomainNameAPIAssociation(ctx, conn, id),
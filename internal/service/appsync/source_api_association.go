@@ -403,7 +403,7 @@ func statusSourceAPIAssociation(ctx context.Context, conn *appsync.Client, assoc
 }
 
 func waitSourceAPIAssociationCreated(ctx context.Context, conn *appsync.Client, associationID, mergedAPIID string, timeout time.Duration) (*awstypes.SourceApiAssociation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SourceApiAssociationStatusMergeInProgress, awstypes.SourceApiAssociationStatusMergeScheduled),
 		Target:  enum.Slice(awstypes.SourceApiAssociationStatusMergeSuccess),
 		Refresh: statusSourceAPIAssociation(ctx, conn, associationID, mergedAPIID),
@@ -422,7 +422,7 @@ func waitSourceAPIAssociationCreated(ctx context.Context, conn *appsync.Client,
 }
 
 func waitSourceAPIAssociationUpdated(ctx context.Context, conn *appsync.Client, associationID, mergedAPIID string, timeout time.Duration) (*awstypes.SourceApiAssociation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SourceApiAssociationStatusMergeInProgress, awstypes.SourceApiAssociationStatusMergeScheduled),
 		Target:  enum.Slice(awstypes.SourceApiAssociationStatusMergeSuccess),
 		Refresh: statusSourceAPIAssociation(ctx, conn, associationID, mergedAPIID),
@@ -441,7 +441,7 @@ func waitSourceAPIAssociationUpdated(ctx context.Context, conn *appsync.Client,
 }
 
 func waitSourceAPIAssociationDeleted(ctx context.Context, conn *appsync.Client, associationID, mergedAPIID string, timeout time.Duration) (*awstypes.SourceApiAssociation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SourceApiAssociationStatusMergeSuccess, awstypes.SourceApiAssociationStatusDeletionInProgress, awstypes.SourceApiAssociationStatusDeletionScheduled),
 		Target:  []string{},
 		Refresh: statusSourceAPIAssociation(ctx, conn, associationID, mergedAPIID),
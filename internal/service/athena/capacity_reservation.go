@@ -268,7 +268,7 @@ func (r *resourceCapacityReservation) buildARN(ctx context.Context, name string)
 }
 
 func waitCapacityReservationActive(ctx context.Context, conn *athena.Client, name string, timeout time.Duration) (*awstypes.CapacityReservation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.CapacityReservationStatusPending, awstypes.CapacityReservationStatusUpdatePending),
 		Target:  enum.Slice(awstypes.CapacityReservationStatusActive),
 		Refresh: statusCapacityReservation(ctx, conn, name),
@@ -284,7 +284,7 @@ func waitCapacityReservationActive(ctx context.Context, conn *athena.Client, nam
 }
 
 func waitCapacityReservationCancelled(ctx context.Context, conn *athena.Client, name string, timeout time.Duration) (*awstypes.CapacityReservation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.CapacityReservationStatusActive, awstypes.CapacityReservationStatusCancelling),
 		Target:  enum.Slice(awstypes.CapacityReservationStatusCancelled),
 		Refresh: statusCapacityReservation(ctx, conn, name),
@@ -298,7 +298,7 @@ func executeAndExpectNoRows(ctx context.Context, conn *athena.Client, qeid strin
 }
 
 func queryExecutionResult(ctx context.Context, conn *athena.Client, qeid string) (*types.ResultSet, error) {
-	executionStateConf := &retry.StateChangeConf{
+	executionStateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.QueryExecutionStateQueued, types.QueryExecutionStateRunning),
 		Target:     enum.Slice(types.QueryExecutionStateSucceeded),
 		Refresh:    queryExecutionStateRefreshFunc(ctx, conn, qeid),
@@ -2537,7 +2537,7 @@ func statusWarmPoolInstanceCount(ctx context.Context, conn *autoscaling.Client,
 }
 
 func waitGroupCapacitySatisfied(ctx context.Context, conn *autoscaling.Client, elbconn *elasticloadbalancing.Client, elbv2conn *elasticloadbalancingv2.Client, name string, cb func(int, int) error, startTime time.Time, ignoreFailedScalingActivities bool, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:  []string{"ok"},
 		Refresh: statusGroupCapacity(ctx, conn, elbconn, elbv2conn, name, cb, startTime, ignoreFailedScalingActivities),
 		Timeout: timeout,
@@ -2553,7 +2553,7 @@ func waitGroupCapacitySatisfied(ctx context.Context, conn *autoscaling.Client, e
 }
 
 func waitGroupDrained(ctx context.Context, conn *autoscaling.Client, name string, timeout time.Duration) (*awstypes.AutoScalingGroup, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:  []string{"0"},
 		Refresh: statusGroupInstanceCount(ctx, conn, name),
 		Timeout: timeout,
@@ -2569,7 +2569,7 @@ func waitGroupDrained(ctx context.Context, conn *autoscaling.Client, name string
 }
 
 func waitLoadBalancersAdded(ctx context.Context, conn *autoscaling.Client, name string, timeout time.Duration) ([]*awstypes.LoadBalancerState, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:  []string{"0"},
 		Refresh: statusLoadBalancerInStateCount(ctx, conn, name, LoadBalancerStateAdding),
 		Timeout: timeout,
@@ -2585,7 +2585,7 @@ func waitLoadBalancersAdded(ctx context.Context, conn *autoscaling.Client, name
 }
 
 func waitLoadBalancersRemoved(ctx context.Context, conn *autoscaling.Client, name string, timeout time.Duration) ([]*awstypes.LoadBalancerState, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:  []string{"0"},
 		Refresh: statusLoadBalancerInStateCount(ctx, conn, name, LoadBalancerStateRemoving),
 		Timeout: timeout,
@@ -2601,7 +2601,7 @@ func waitLoadBalancersRemoved(ctx context.Context, conn *autoscaling.Client, nam
 }
 
 func waitLoadBalancerTargetGroupsAdded(ctx context.Context, conn *autoscaling.Client, name string, timeout time.Duration) ([]*awstypes.LoadBalancerTargetGroupState, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:  []string{"0"},
 		Refresh: statusLoadBalancerTargetGroupInStateCount(ctx, conn, name, LoadBalancerTargetGroupStateAdding),
 		Timeout: timeout,
@@ -2617,7 +2617,7 @@ func waitLoadBalancerTargetGroupsAdded(ctx context.Context, conn *autoscaling.Cl
 }
 
 func waitLoadBalancerTargetGroupsRemoved(ctx context.Context, conn *autoscaling.Client, name string, timeout time.Duration) ([]*awstypes.LoadBalancerTargetGroupState, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:  []string{"0"},
 		Refresh: statusLoadBalancerTargetGroupInStateCount(ctx, conn, name, LoadBalancerTargetGroupStateRemoving),
 		Timeout: timeout,
@@ -2633,7 +2633,7 @@ func waitLoadBalancerTargetGroupsRemoved(ctx context.Context, conn *autoscaling.
 }
 
 func waitTrafficSourcesCreated(ctx context.Context, conn *autoscaling.Client, asgName, trafficSourceType string, timeout time.Duration) ([]*awstypes.TrafficSourceState, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:  []string{"0"},
 		Refresh: statusTrafficSourcesInStateCount(ctx, conn, asgName, trafficSourceType, TrafficSourceStateAdding),
 		Timeout: timeout,
@@ -2649,7 +2649,7 @@ func waitTrafficSourcesCreated(ctx context.Context, conn *autoscaling.Client, as
 }
 
 func waitTrafficSourcesDeleted(ctx context.Context, conn *autoscaling.Client, asgName, trafficSourceType string, timeout time.Duration) ([]*awstypes.TrafficSourceState, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:  []string{"0"},
 		Refresh: statusTrafficSourcesInStateCount(ctx, conn, asgName, trafficSourceType, TrafficSourceStateRemoving),
 		Timeout: timeout,
@@ -2675,7 +2675,7 @@ const (
 )
 
 func waitInstanceRefreshCancelled(ctx context.Context, conn *autoscaling.Client, name, id string, timeout time.Duration) (*awstypes.InstanceRefresh, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.InstanceRefreshStatusCancelling,
 			awstypes.InstanceRefreshStatusInProgress,
@@ -2700,7 +2700,7 @@ func waitInstanceRefreshCancelled(ctx context.Context, conn *autoscaling.Client,
 }
 
 func waitWarmPoolDeleted(ctx context.Context, conn *autoscaling.Client, name string, timeout time.Duration) (*awstypes.WarmPoolConfiguration, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.WarmPoolStatusPendingDelete),
 		Target:  []string{},
 		Refresh: statusWarmPool(ctx, conn, name),
@@ -2717,7 +2717,7 @@ func waitWarmPoolDeleted(ctx context.Context, conn *autoscaling.Client, name str
 }
 
 func waitWarmPoolDrained(ctx context.Context, conn *autoscaling.Client, name string, timeout time.Duration) (*autoscaling.DescribeWarmPoolOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:  []string{"0"},
 		Refresh: statusWarmPoolInstanceCount(ctx, conn, name),
 		Timeout: timeout,
@@ -863,7 +863,7 @@ const (
 )
 
 func waitScalingPlanCreated(ctx context.Context, conn *autoscalingplans.Client, scalingPlanName string, scalingPlanVersion int) (*awstypes.ScalingPlan, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ScalingPlanStatusCodeCreationInProgress),
 		Target:  enum.Slice(awstypes.ScalingPlanStatusCodeActive, awstypes.ScalingPlanStatusCodeActiveWithProblems),
 		Refresh: statusScalingPlanCode(ctx, conn, scalingPlanName, scalingPlanVersion),
@@ -885,7 +885,7 @@ func waitScalingPlanCreated(ctx context.Context, conn *autoscalingplans.Client,
 }
 
 func waitScalingPlanDeleted(ctx context.Context, conn *autoscalingplans.Client, scalingPlanName string, scalingPlanVersion int) (*awstypes.ScalingPlan, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ScalingPlanStatusCodeDeletionInProgress),
 		Target:  []string{},
 		Refresh: statusScalingPlanCode(ctx, conn, scalingPlanName, scalingPlanVersion),
@@ -907,7 +907,7 @@ func waitScalingPlanDeleted(ctx context.Context, conn *autoscalingplans.Client,
 }
 
 func waitScalingPlanUpdated(ctx context.Context, conn *autoscalingplans.Client, scalingPlanName string, scalingPlanVersion int) (*awstypes.ScalingPlan, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ScalingPlanStatusCodeUpdateInProgress),
 		Target:  enum.Slice(awstypes.ScalingPlanStatusCodeActive, awstypes.ScalingPlanStatusCodeActiveWithProblems),
 		Refresh: statusScalingPlanCode(ctx, conn, scalingPlanName, scalingPlanVersion),
@@ -236,7 +236,7 @@ func statusLogicallyAirGappedVault(ctx context.Context, conn *backup.Client, nam
 }
 
 func waitLogicallyAirGappedVaultCreated(ctx context.Context, conn *backup.Client, name string, timeout time.Duration) (*backup.DescribeBackupVaultOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.VaultStateCreating),
 		Target:                    enum.Slice(awstypes.VaultStateAvailable),
 		Refresh:                   statusLogicallyAirGappedVault(ctx, conn, name),
@@ -378,7 +378,7 @@ func (r *resourceExport) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func waitExportCreated(ctx context.Context, conn *bcmdataexports.Client, id string, timeout time.Duration) (*bcmdataexports.GetExportOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{},
 		Target:                    enum.Slice(awstypes.ExportStatusCodeHealthy),
 		Refresh:                   statusExport(ctx, conn, id),
@@ -396,7 +396,7 @@ func waitExportCreated(ctx context.Context, conn *bcmdataexports.Client, id stri
 }
 
 func waitExportUpdated(ctx context.Context, conn *bcmdataexports.Client, id string, timeout time.Duration) (*bcmdataexports.GetExportOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.ExportStatusCodeUnhealthy),
 		Target:                    enum.Slice(awstypes.ExportStatusCodeHealthy),
 		Refresh:                   statusExport(ctx, conn, id),
@@ -559,7 +559,7 @@ func statusModelCustomizationJob(ctx context.Context, conn *bedrock.Client, id s
 }
 
 func waitModelCustomizationJobCompleted(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetModelCustomizationJobOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ModelCustomizationJobStatusInProgress),
 		Target:  enum.Slice(awstypes.ModelCustomizationJobStatusCompleted),
 		Refresh: statusModelCustomizationJob(ctx, conn, id),
@@ -578,7 +578,7 @@ func waitModelCustomizationJobCompleted(ctx context.Context, conn *bedrock.Clien
 }
 
 func waitModelCustomizationJobStopped(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetModelCustomizationJobOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ModelCustomizationJobStatusStopping),
 		Target:  enum.Slice(awstypes.ModelCustomizationJobStatusStopped),
 		Refresh: statusModelCustomizationJob(ctx, conn, id),
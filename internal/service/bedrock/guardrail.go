@@ -548,7 +548,7 @@ func (r *resourceGuardrail) ImportState(ctx context.Context, req resource.Import
 }
 
 func waitGuardrailCreated(ctx context.Context, conn *bedrock.Client, id string, version string, timeout time.Duration) (*bedrock.GetGuardrailOutput, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.GuardrailStatusCreating),
 		Target:                    enum.Slice(awstypes.GuardrailStatusReady),
 		Refresh:                   statusGuardrail(ctx, conn, id, version),
@@ -566,7 +566,7 @@ func waitGuardrailCreated(ctx context.Context, conn *bedrock.Client, id string,
 }
 
 func waitGuardrailUpdated(ctx context.Context, conn *bedrock.Client, id string, version string, timeout time.Duration) (*bedrock.GetGuardrailOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.GuardrailStatusUpdating),
 		Target:                    enum.Slice(awstypes.GuardrailStatusReady),
 		Refresh:                   statusGuardrail(ctx, conn, id, version),
@@ -584,7 +584,7 @@ func waitGuardrailUpdated(ctx context.Context, conn *bedrock.Client, id string,
 }
 
 func waitGuardrailDeleted(ctx context.Context, conn *bedrock.Client, id string, version string, timeout time.Duration) (*bedrock.GetGuardrailOutput, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.GuardrailStatusDeleting, awstypes.GuardrailStatusReady),
 		Target:  []string{},
 		Refresh: statusGuardrail(ctx, conn, id, version),
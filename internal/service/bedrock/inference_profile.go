@@ -279,7 +279,7 @@ func (r *resourceInferenceProfile) ImportState(ctx context.Context, req resource
 }
 
 func waitInferenceProfileCreated(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetInferenceProfileOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{},
 		Target:                    enum.Slice(string(awstypes.InferenceProfileStatusActive)),
 		Refresh:                   statusInferenceProfile(ctx, conn, id),
@@ -297,7 +297,7 @@ func waitInferenceProfileCreated(ctx context.Context, conn *bedrock.Client, id s
 }
 
 func waitInferenceProfileDeleted(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetInferenceProfileOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(string(awstypes.InferenceProfileStatusActive)),
 		Target:  []string{},
 		Refresh: statusInferenceProfile(ctx, conn, id),
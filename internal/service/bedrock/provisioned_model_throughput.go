@@ -239,7 +239,7 @@ func statusProvisionedModelThroughput(ctx context.Context, conn *bedrock.Client,
 }
 
 func waitProvisionedModelThroughputCreated(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetProvisionedModelThroughputOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ProvisionedModelStatusCreating),
 		Target:  enum.Slice(awstypes.ProvisionedModelStatusInService),
 		Refresh: statusProvisionedModelThroughput(ctx, conn, id),
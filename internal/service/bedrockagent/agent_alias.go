@@ -310,7 +310,7 @@ func statusAgentAlias(ctx context.Context, conn *bedrockagent.Client, agentAlias
 }
 
 func waitAgentAliasCreated(ctx context.Context, conn *bedrockagent.Client, agentAliasID, agentID string, timeout time.Duration) (*awstypes.AgentAlias, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.AgentAliasStatusCreating),
 		Target:  enum.Slice(awstypes.AgentAliasStatusPrepared),
 		Refresh: statusAgentAlias(ctx, conn, agentAliasID, agentID),
@@ -327,7 +327,7 @@ func waitAgentAliasCreated(ctx context.Context, conn *bedrockagent.Client, agent
 }
 
 func waitAgentAliasUpdated(ctx context.Context, conn *bedrockagent.Client, agentAliasID, agentID string, timeout time.Duration) (*awstypes.AgentAlias, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.AgentAliasStatusUpdating),
 		Target:  enum.Slice(awstypes.AgentAliasStatusPrepared),
 		Refresh: statusAgentAlias(ctx, conn, agentAliasID, agentID),
@@ -690,7 +690,7 @@ func (r *knowledgeBaseResource) Delete(ctx context.Context, request resource.Del
 }
 
 func waitKnowledgeBaseCreated(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*awstypes.KnowledgeBase, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.KnowledgeBaseStatusCreating),
 		Target:  enum.Slice(awstypes.KnowledgeBaseStatusActive),
 		Refresh: statusKnowledgeBase(ctx, conn, id),
@@ -709,7 +709,7 @@ func waitKnowledgeBaseCreated(ctx context.Context, conn *bedrockagent.Client, id
 }
 
 func waitKnowledgeBaseUpdated(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*awstypes.KnowledgeBase, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.KnowledgeBaseStatusUpdating),
 		Target:  enum.Slice(awstypes.KnowledgeBaseStatusActive),
 		Refresh: statusKnowledgeBase(ctx, conn, id),
@@ -728,7 +728,7 @@ func waitKnowledgeBaseUpdated(ctx context.Context, conn *bedrockagent.Client, id
 }
 
 func waitKnowledgeBaseDeleted(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*awstypes.KnowledgeBase, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.KnowledgeBaseStatusActive, awstypes.KnowledgeBaseStatusDeleting),
 		Target:  []string{},
 		Refresh: statusKnowledgeBase(ctx, conn, id),
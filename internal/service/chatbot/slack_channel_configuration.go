@@ -361,7 +361,7 @@ func statusSlackChannelConfiguration(ctx context.Context, conn *chatbot.Client,
 }
 
 func waitSlackChannelConfigurationAvailable(ctx context.Context, conn *chatbot.Client, arn string, timeout time.Duration) (*awstypes.SlackChannelConfiguration, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{},
 		Target:     []string{slackChannelConfigurationAvailable},
 		Refresh:    statusSlackChannelConfiguration(ctx, conn, arn),
@@ -380,7 +380,7 @@ func waitSlackChannelConfigurationAvailable(ctx context.Context, conn *chatbot.C
 }
 
 func waitSlackChannelConfigurationDeleted(ctx context.Context, conn *chatbot.Client, arn string, timeout time.Duration) (*awstypes.SlackChannelConfiguration, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{slackChannelConfigurationAvailable},
 		Target:     []string{},
 		Refresh:    statusSlackChannelConfiguration(ctx, conn, arn),
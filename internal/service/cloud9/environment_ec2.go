@@ -313,7 +313,7 @@ func waitEnvironmentReady(ctx context.Context, conn *cloud9.Client, id string) (
 	const (
 		timeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.EnvironmentLifecycleStatusCreating),
 		Target:  enum.Slice(types.EnvironmentLifecycleStatusCreated),
 		Refresh: statusEnvironmentStatus(ctx, conn, id),
@@ -337,7 +337,7 @@ func waitEnvironmentDeleted(ctx context.Context, conn *cloud9.Client, id string)
 	const (
 		timeout = 20 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.EnvironmentLifecycleStatusDeleting),
 		Target:  []string{},
 		Refresh: statusEnvironmentStatus(ctx, conn, id),
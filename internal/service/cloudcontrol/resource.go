@@ -409,7 +409,7 @@ func statusProgressEventOperation(ctx context.Context, conn *cloudcontrol.Client
 }
 
 func waitProgressEventOperationStatusSuccess(ctx context.Context, conn *cloudcontrol.Client, requestToken string, timeout time.Duration) (*types.ProgressEvent, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.OperationStatusInProgress, types.OperationStatusPending),
 		Target:  enum.Slice(types.OperationStatusSuccess),
 		Refresh: statusProgressEventOperation(ctx, conn, requestToken),
@@ -63,7 +63,7 @@ func waitChangeSetCreated(ctx context.Context, conn *cloudformation.Client, stac
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := retry.StateChangeConf{
+	stateConf := tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ChangeSetStatusCreateInProgress, awstypes.ChangeSetStatusCreatePending),
 		Target:  enum.Slice(awstypes.ChangeSetStatusCreateComplete),
 		Timeout: timeout,
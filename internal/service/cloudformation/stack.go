@@ -449,7 +449,7 @@ func waitStackCreated(ctx context.Context, conn *cloudformation.Client, name, re
 	const (
 		minTimeout = 1 * time.Second
 	)
-	stateConf := retry.StateChangeConf{
+	stateConf := tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.StackStatusCreateInProgress, awstypes.StackStatusDeleteInProgress, awstypes.StackStatusRollbackInProgress),
 		Target:     enum.Slice(awstypes.StackStatusCreateComplete, awstypes.StackStatusCreateFailed, awstypes.StackStatusDeleteComplete, awstypes.StackStatusDeleteFailed, awstypes.StackStatusRollbackComplete, awstypes.StackStatusRollbackFailed),
 		Timeout:    timeout,
@@ -507,7 +507,7 @@ func waitStackUpdated(ctx context.Context, conn *cloudformation.Client, name, re
 	const (
 		minTimeout = 5 * time.Second
 	)
-	stateConf := retry.StateChangeConf{
+	stateConf := tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.StackStatusUpdateCompleteCleanupInProgress, awstypes.StackStatusUpdateInProgress, awstypes.StackStatusUpdateRollbackInProgress, awstypes.StackStatusUpdateRollbackCompleteCleanupInProgress),
 		Target:     enum.Slice(awstypes.StackStatusCreateComplete, awstypes.StackStatusUpdateComplete, awstypes.StackStatusUpdateRollbackComplete, awstypes.StackStatusUpdateRollbackFailed),
 		Timeout:    timeout,
@@ -548,7 +548,7 @@ func waitStackDeleted(ctx context.Context, conn *cloudformation.Client, name, re
 	const (
 		minTimeout = 5 * time.Second
 	)
-	stateConf := retry.StateChangeConf{
+	stateConf := tfresource.StateChangeConf{
 		Pending:        enum.Slice(awstypes.StackStatusDeleteInProgress, awstypes.StackStatusRollbackInProgress),
 		Target:         enum.Slice(awstypes.StackStatusDeleteComplete, awstypes.StackStatusDeleteFailed),
 		Timeout:        timeout,
@@ -520,7 +520,7 @@ func statusStackSet(ctx context.Context, conn *cloudformation.Client, name, call
 }
 
 func waitStackSetCreated(ctx context.Context, conn *cloudformation.Client, name, callAs string, timeout time.Duration) (*awstypes.StackSet, error) {
-	stateConf := retry.StateChangeConf{
+	stateConf := tfresource.StateChangeConf{
 		Pending: []string{},
 		Target:  enum.Slice(awstypes.StackSetStatusActive),
 		Timeout: timeout,
@@ -615,7 +615,7 @@ func waitStackSetOperationSucceeded(ctx context.Context, conn *cloudformation.Cl
 	const (
 		stackSetOperationDelay = 10 * time.Second
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.StackSetOperationStatusRunning, awstypes.StackSetOperationStatusQueued),
 		Target:  enum.Slice(awstypes.StackSetOperationStatusSucceeded),
 		Refresh: statusStackSetOperation(ctx, conn, stackSetName, operationID, callAs),
@@ -295,7 +295,7 @@ func statusKeyValueStore(ctx context.Context, conn *cloudfront.Client, name stri
 }
 
 func waitKeyValueStoreCreated(ctx context.Context, conn *cloudfront.Client, name string, timeout time.Duration) (*cloudfront.DescribeKeyValueStoreOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{keyValueStoreStatusProvisioning},
 		Target:  []string{keyValueStoreStatusReady},
 		Refresh: statusKeyValueStore(ctx, conn, name),
@@ -365,7 +365,7 @@ func vpcOriginStatus(ctx context.Context, conn *cloudfront.Client, id string) re
 }
 
 func waitVPCOriginDeployed(ctx context.Context, conn *cloudfront.Client, id string, timeout time.Duration) (*cloudfront.GetVpcOriginOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{vpcOriginStatusDeploying},
 		Target:  []string{vpcOriginStatusDeployed},
 		Refresh: vpcOriginStatus(ctx, conn, id),
@@ -382,7 +382,7 @@ func waitVPCOriginDeployed(ctx context.Context, conn *cloudfront.Client, id stri
 }
 
 func waitVPCOriginDeleted(ctx context.Context, conn *cloudfront.Client, id string, timeout time.Duration) (*cloudfront.GetVpcOriginOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{vpcOriginStatusDeployed, vpcOriginStatusDeploying},
 		Target:  []string{},
 		Refresh: vpcOriginStatus(ctx, conn, id),
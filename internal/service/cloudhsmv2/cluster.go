@@ -299,7 +299,7 @@ func statusCluster(ctx context.Context, conn *cloudhsmv2.Client, id string) retr
 }
 
 func waitClusterActive(ctx context.Context, conn *cloudhsmv2.Client, id string, timeout time.Duration) (*types.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.ClusterStateCreateInProgress, types.ClusterStateInitializeInProgress),
 		Target:     enum.Slice(types.ClusterStateActive),
 		Refresh:    statusCluster(ctx, conn, id),
@@ -320,7 +320,7 @@ func waitClusterActive(ctx context.Context, conn *cloudhsmv2.Client, id string,
 }
 
 func waitClusterDeleted(ctx context.Context, conn *cloudhsmv2.Client, id string, timeout time.Duration) (*types.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.ClusterStateDeleteInProgress),
 		Target:     []string{},
 		Refresh:    statusCluster(ctx, conn, id),
@@ -341,7 +341,7 @@ func waitClusterDeleted(ctx context.Context, conn *cloudhsmv2.Client, id string,
 }
 
 func waitClusterUninitialized(ctx context.Context, conn *cloudhsmv2.Client, id string, timeout time.Duration) (*types.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.ClusterStateCreateInProgress, types.ClusterStateInitializeInProgress),
 		Target:     enum.Slice(types.ClusterStateUninitialized),
 		Refresh:    statusCluster(ctx, conn, id),
@@ -224,7 +224,7 @@ func statusHSM(ctx context.Context, conn *cloudhsmv2.Client, id string) retry.St
 }
 
 func waitHSMCreated(ctx context.Context, conn *cloudhsmv2.Client, id string, timeout time.Duration) (*types.Hsm, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.HsmStateCreateInProgress),
 		Target:     enum.Slice(types.HsmStateActive),
 		Refresh:    statusHSM(ctx, conn, id),
@@ -245,7 +245,7 @@ func waitHSMCreated(ctx context.Context, conn *cloudhsmv2.Client, id string, tim
 }
 
 func waitHSMDeleted(ctx context.Context, conn *cloudhsmv2.Client, id string, timeout time.Duration) (*types.Hsm, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.HsmStateDeleteInProgress),
 		Target:     []string{},
 		Refresh:    statusHSM(ctx, conn, id),
@@ -703,7 +703,7 @@ func statusDomainProcessing(ctx context.Context, conn *cloudsearch.Client, name
 }
 
 func waitDomainActive(ctx context.Context, conn *cloudsearch.Client, name string, timeout time.Duration) (*types.DomainStatus, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{"true"},
 		Target:  []string{"false"},
 		Refresh: statusDomainProcessing(ctx, conn, name),
@@ -720,7 +720,7 @@ func waitDomainActive(ctx context.Context, conn *cloudsearch.Client, name string
 }
 
 func waitDomainDeleted(ctx context.Context, conn *cloudsearch.Client, name string, timeout time.Duration) (*types.DomainStatus, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{"true"},
 		Target:  []string{},
 		Refresh: statusDomainDeleting(ctx, conn, name),
@@ -207,7 +207,7 @@ func statusAccessPolicyState(ctx context.Context, conn *cloudsearch.Client, name
 }
 
 func waitAccessPolicyActive(ctx context.Context, conn *cloudsearch.Client, name string, timeout time.Duration) (*types.AccessPoliciesStatus, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.OptionStateProcessing),
 		Target:  enum.Slice(types.OptionStateActive),
 		Refresh: statusAccessPolicyState(ctx, conn, name),
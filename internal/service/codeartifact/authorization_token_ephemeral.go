@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeartifact
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameAuthorizationToken = "Ephemeral Resource Authorization Token"
+)
+
+// @EphemeralResource(aws_codeartifact_authorization_token, name="Authorization Token")
+func newEphemeralAuthorizationToken(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralAuthorizationToken{}, nil
+}
+
+type ephemeralAuthorizationToken struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralAuthorizationToken) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authorization_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrDomain: schema.StringAttribute{
+				Required: true,
+			},
+			"domain_owner": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.Between(900, 43200),
+						int64validator.OneOf(0),
+					),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralAuthorizationToken) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epAuthorizationTokenData
+	conn := e.Meta().CodeArtifactClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DomainOwner.IsNull() || data.DomainOwner.ValueString() == "" {
+		data.DomainOwner = types.StringValue(e.Meta().AccountID(ctx))
+	}
+
+	var input codeartifact.GetAuthorizationTokenInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.GetAuthorizationToken(ctx, &input)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CodeArtifact, create.ErrActionOpening, ERNameAuthorizationToken, data.Domain.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epAuthorizationTokenData struct {
+	AuthorizationToken types.String      `tfsdk:"authorization_token"`
+	Domain             types.String      `tfsdk:"domain"`
+	DomainOwner        types.String      `tfsdk:"domain_owner"`
+	DurationSeconds    types.Int64       `tfsdk:"duration_seconds"`
+	Expiration         timetypes.RFC3339 `tfsdk:"expiration"`
+}
@@ -13,6 +13,9 @@ func TestAccCodeArtifact_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"AuthorizationTokenEphemeral": {
+			acctest.CtBasic: testAccAuthorizationTokenEphemeral_basic,
+		},
 		"AuthorizationTokenDataSource": {
 			acctest.CtBasic: testAccAuthorizationTokenDataSource_basic,
 			"duration":      testAccAuthorizationTokenDataSource_duration,
@@ -510,7 +510,7 @@ func statusFleet(ctx context.Context, conn *codebuild.Client, arn string) retry.
 }
 
 func waitFleetCreated(ctx context.Context, conn *codebuild.Client, arn string, timeout time.Duration) (*types.Fleet, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.FleetStatusCodeCreating, types.FleetStatusCodeRotating),
 		Target:     enum.Slice(types.FleetStatusCodeActive),
 		Refresh:    statusFleet(ctx, conn, arn),
@@ -531,7 +531,7 @@ func waitFleetCreated(ctx context.Context, conn *codebuild.Client, arn string, t
 }
 
 func waitFleetUpdated(ctx context.Context, conn *codebuild.Client, arn string, timeout time.Duration) (*types.Fleet, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.FleetStatusCodeUpdating, types.FleetStatusCodeRotating),
 		Target:     enum.Slice(types.FleetStatusCodeActive),
 		Refresh:    statusFleet(ctx, conn, arn),
@@ -552,7 +552,7 @@ func waitFleetUpdated(ctx context.Context, conn *codebuild.Client, arn string, t
 }
 
 func waitFleetDeleted(ctx context.Context, conn *codebuild.Client, arn string, timeout time.Duration) (*types.Fleet, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.FleetStatusCodeDeleting),
 		Target:     []string{},
 		Refresh:    statusFleet(ctx, conn, arn),
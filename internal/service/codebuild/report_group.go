@@ -266,7 +266,7 @@ func waitReportGroupDeleted(ctx context.Context, conn *codebuild.Client, arn str
 	const (
 		timeout = 2 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ReportGroupStatusTypeDeleting),
 		Target:  []string{},
 		Refresh: statusReportGroup(ctx, conn, arn),
@@ -268,7 +268,7 @@ func resourceDevEnvironmentDelete(ctx context.Context, d *schema.ResourceData, m
 }
 
 func waitDevEnvironmentCreated(ctx context.Context, conn *codecatalyst.Client, id string, spaceName *string, projectName *string, timeout time.Duration) (*codecatalyst.GetDevEnvironmentOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(types.DevEnvironmentStatusPending, types.DevEnvironmentStatusStarting),
 		Target:                    enum.Slice(types.DevEnvironmentStatusRunning, types.DevEnvironmentStatusStopped, types.DevEnvironmentStatusStopping),
 		Refresh:                   statusDevEnvironment(ctx, conn, id, spaceName, projectName),
@@ -286,7 +286,7 @@ func waitDevEnvironmentCreated(ctx context.Context, conn *codecatalyst.Client, i
 }
 
 func waitDevEnvironmentUpdated(ctx context.Context, conn *codecatalyst.Client, id string, spaceName *string, projectName *string, timeout time.Duration) (*codecatalyst.GetDevEnvironmentOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(types.DevEnvironmentStatusStopping, types.DevEnvironmentStatusPending, types.DevEnvironmentStatusStopped),
 		Target:                    enum.Slice(types.DevEnvironmentStatusRunning),
 		Refresh:                   statusDevEnvironment(ctx, conn, id, spaceName, projectName),
@@ -270,7 +270,7 @@ func (r *connectionResource) ImportState(ctx context.Context, req resource.Impor
 }
 
 func waitConnectionCreated(ctx context.Context, conn *codeconnections.Client, id string, timeout time.Duration) (*awstypes.Connection, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{},
 		Target:                    enum.Slice(awstypes.ConnectionStatusPending, awstypes.ConnectionStatusAvailable),
 		Refresh:                   statusConnection(ctx, conn, id),
@@ -288,7 +288,7 @@ func waitConnectionCreated(ctx context.Context, conn *codeconnections.Client, id
 }
 
 func waitConnectionDeleted(ctx context.Context, conn *codeconnections.Client, id string, timeout time.Duration) (*awstypes.Connection, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ConnectionStatusPending, awstypes.ConnectionStatusAvailable, awstypes.ConnectionStatusError),
 		Target:  []string{},
 		Refresh: statusConnection(ctx, conn, id),
@@ -319,7 +319,7 @@ const (
 )
 
 func waitHostPendingOrAvailable(ctx context.Context, conn *codeconnections.Client, id string, timeout time.Duration) (*awstypes.Host, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{hostStatusVPCConfigInitializing},
 		Target:                    []string{hostStatusPending, hostStatusAvailable},
 		Refresh:                   statusHost(ctx, conn, id),
@@ -337,7 +337,7 @@ func waitHostPendingOrAvailable(ctx context.Context, conn *codeconnections.Clien
 }
 
 func waitHostDeleted(ctx context.Context, conn *codeconnections.Client, id string, timeout time.Duration) (*awstypes.Host, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{hostStatusVPCConfigDeleting},
 		Target:  []string{},
 		Refresh: statusHost(ctx, conn, id),
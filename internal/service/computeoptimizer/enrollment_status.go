@@ -215,7 +215,7 @@ func statusEnrollmentStatus(ctx context.Context, conn *computeoptimizer.Client)
 }
 
 func waitEnrollmentStatusUpdated(ctx context.Context, conn *computeoptimizer.Client, targetStatus string, timeout time.Duration) (*computeoptimizer.GetEnrollmentStatusOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.StatusPending),
 		Target:  []string{targetStatus},
 		Refresh: statusEnrollmentStatus(ctx, conn),
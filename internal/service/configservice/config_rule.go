@@ -386,7 +386,7 @@ func waitConfigRuleDeleted(ctx context.Context, conn *configservice.Client, name
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			types.ConfigRuleStateActive,
 			types.ConfigRuleStateDeleting,
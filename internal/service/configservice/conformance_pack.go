@@ -309,7 +309,7 @@ func statusConformancePack(ctx context.Context, conn *configservice.Client, name
 }
 
 func waitConformancePackCreated(ctx context.Context, conn *configservice.Client, name string, timeout time.Duration) (*types.ConformancePackStatusDetail, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ConformancePackStateCreateInProgress),
 		Target:  enum.Slice(types.ConformancePackStateCreateComplete),
 		Refresh: statusConformancePack(ctx, conn, name),
@@ -328,7 +328,7 @@ func waitConformancePackCreated(ctx context.Context, conn *configservice.Client,
 }
 
 func waitConformancePackDeleted(ctx context.Context, conn *configservice.Client, name string, timeout time.Duration) (*types.ConformancePackStatusDetail, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ConformancePackStateDeleteInProgress),
 		Target:  []string{},
 		Refresh: statusConformancePack(ctx, conn, name),
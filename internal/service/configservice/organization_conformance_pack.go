@@ -444,7 +444,7 @@ func statusOrganizationConformancePack(ctx context.Context, conn *configservice.
 }
 
 func waitOrganizationConformancePackCreated(ctx context.Context, conn *configservice.Client, name string, timeout time.Duration) (*types.OrganizationConformancePackStatus, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:        enum.Slice(types.OrganizationResourceStatusCreateInProgress),
 		Target:         enum.Slice(types.OrganizationResourceStatusCreateSuccessful),
 		Refresh:        statusOrganizationConformancePack(ctx, conn, name),
@@ -465,7 +465,7 @@ func waitOrganizationConformancePackCreated(ctx context.Context, conn *configser
 }
 
 func waitOrganizationConformancePackUpdated(ctx context.Context, conn *configservice.Client, name string, timeout time.Duration) (*types.OrganizationConformancePackStatus, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.OrganizationResourceStatusUpdateInProgress),
 		Target:  enum.Slice(types.OrganizationResourceStatusUpdateSuccessful),
 		Refresh: statusOrganizationConformancePack(ctx, conn, name),
@@ -485,7 +485,7 @@ func waitOrganizationConformancePackUpdated(ctx context.Context, conn *configser
 }
 
 func waitOrganizationConformancePackDeleted(ctx context.Context, conn *configservice.Client, name string, timeout time.Duration) (*types.OrganizationConformancePackStatus, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(types.OrganizationResourceStatusDeleteInProgress),
 		Target:                    []string{},
 		Refresh:                   statusOrganizationConformancePack(ctx, conn, name),
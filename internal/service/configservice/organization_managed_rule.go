@@ -471,7 +471,7 @@ func statusOrganizationConfigRule(ctx context.Context, conn *configservice.Clien
 }
 
 func waitOrganizationConfigRuleCreated(ctx context.Context, conn *configservice.Client, name string, timeout time.Duration) (*types.OrganizationConfigRuleStatus, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:        enum.Slice(types.OrganizationRuleStatusCreateInProgress),
 		Target:         enum.Slice(types.OrganizationRuleStatusCreateSuccessful),
 		Refresh:        statusOrganizationConfigRule(ctx, conn, name),
@@ -492,7 +492,7 @@ func waitOrganizationConfigRuleCreated(ctx context.Context, conn *configservice.
 }
 
 func waitOrganizationConfigRuleUpdated(ctx context.Context, conn *configservice.Client, name string, timeout time.Duration) (*types.OrganizationConfigRuleStatus, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.OrganizationRuleStatusUpdateInProgress),
 		Target:  enum.Slice(types.OrganizationRuleStatusUpdateSuccessful),
 		Refresh: statusOrganizationConfigRule(ctx, conn, name),
@@ -512,7 +512,7 @@ func waitOrganizationConfigRuleUpdated(ctx context.Context, conn *configservice.
 }
 
 func waitOrganizationConfigRuleDeleted(ctx context.Context, conn *configservice.Client, name string, timeout time.Duration) (*types.OrganizationConfigRuleStatus, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(types.OrganizationRuleStatusDeleteInProgress),
 		Target:                    []string{},
 		Refresh:                   statusOrganizationConfigRule(ctx, conn, name),
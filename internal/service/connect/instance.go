@@ -397,7 +397,7 @@ func statusInstance(ctx context.Context, conn *connect.Client, id string) retry.
 }
 
 func waitInstanceCreated(ctx context.Context, conn *connect.Client, id string, timeout time.Duration) (*awstypes.Instance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.InstanceStatusCreationInProgress),
 		Target:  enum.Slice(awstypes.InstanceStatusActive),
 		Refresh: statusInstance(ctx, conn, id),
@@ -418,7 +418,7 @@ func waitInstanceCreated(ctx context.Context, conn *connect.Client, id string, t
 }
 
 func waitInstanceDeleted(ctx context.Context, conn *connect.Client, id string, timeout time.Duration) (*awstypes.Instance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.InstanceStatusActive),
 		Target:  []string{},
 		Refresh: statusInstance(ctx, conn, id),
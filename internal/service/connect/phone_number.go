@@ -323,7 +323,7 @@ func statusPhoneNumber(ctx context.Context, conn *connect.Client, id string) ret
 }
 
 func waitPhoneNumberCreated(ctx context.Context, conn *connect.Client, id string, timeout time.Duration) (*awstypes.ClaimedPhoneNumberSummary, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.PhoneNumberWorkflowStatusInProgress),
 		Target:  enum.Slice(awstypes.PhoneNumberWorkflowStatusClaimed),
 		Refresh: statusPhoneNumber(ctx, conn, id),
@@ -344,7 +344,7 @@ func waitPhoneNumberCreated(ctx context.Context, conn *connect.Client, id string
 }
 
 func waitPhoneNumberUpdated(ctx context.Context, conn *connect.Client, id string, timeout time.Duration) (*awstypes.ClaimedPhoneNumberSummary, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.PhoneNumberWorkflowStatusInProgress),
 		Target:  enum.Slice(awstypes.PhoneNumberWorkflowStatusClaimed),
 		Refresh: statusPhoneNumber(ctx, conn, id),
@@ -365,7 +365,7 @@ func waitPhoneNumberUpdated(ctx context.Context, conn *connect.Client, id string
 }
 
 func waitPhoneNumberDeleted(ctx context.Context, conn *connect.Client, id string, timeout time.Duration) (*awstypes.ClaimedPhoneNumberSummary, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.PhoneNumberWorkflowStatusInProgress),
 		Target:  []string{},
 		Refresh: statusPhoneNumber(ctx, conn, id),
@@ -273,7 +273,7 @@ func statusVocabulary(ctx context.Context, conn *connect.Client, instanceID, voc
 }
 
 func waitVocabularyCreated(ctx context.Context, conn *connect.Client, instanceID, vocabularyID string, timeout time.Duration) (*awstypes.Vocabulary, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.VocabularyStateCreationInProgress),
 		Target:  enum.Slice(awstypes.VocabularyStateActive, awstypes.VocabularyStateCreationFailed),
 		Refresh: statusVocabulary(ctx, conn, instanceID, vocabularyID),
@@ -294,7 +294,7 @@ func waitVocabularyCreated(ctx context.Context, conn *connect.Client, instanceID
 }
 
 func waitVocabularyDeleted(ctx context.Context, conn *connect.Client, instanceID, vocabularyID string, timeout time.Duration) (*awstypes.Vocabulary, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.VocabularyStateDeleteInProgress),
 		Target:  []string{},
 		Refresh: statusVocabulary(ctx, conn, instanceID, vocabularyID),
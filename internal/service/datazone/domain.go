@@ -361,7 +361,7 @@ func (r *resourceDomain) ImportState(ctx context.Context, req resource.ImportSta
 }
 
 func waitDomainCreated(ctx context.Context, conn *datazone.Client, id string, timeout time.Duration) (*datazone.GetDomainOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.DomainStatusCreating),
 		Target:  enum.Slice(awstypes.DomainStatusAvailable),
 		Refresh: statusDomain(ctx, conn, id),
@@ -377,7 +377,7 @@ func waitDomainCreated(ctx context.Context, conn *datazone.Client, id string, ti
 }
 
 func waitDomainDeleted(ctx context.Context, conn *datazone.Client, id string, timeout time.Duration) (*datazone.GetDomainOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.DomainStatusAvailable, awstypes.DomainStatusDeleting),
 		Target:  []string{},
 		Refresh: statusDomain(ctx, conn, id),
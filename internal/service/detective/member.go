@@ -285,7 +285,7 @@ func waitMemberInvited(ctx context.Context, conn *detective.Client, graphARN, ad
 	const (
 		timeout = 4 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.MemberStatusVerificationInProgress),
 		Target:  enum.Slice(awstypes.MemberStatusInvited, awstypes.MemberStatusEnabled),
 		Refresh: statusMember(ctx, conn, graphARN, adminAccountID),
@@ -227,7 +227,7 @@ func statusBGPPeer(ctx context.Context, conn *directconnect.Client, vifID string
 }
 
 func waitBGPPeerCreated(ctx context.Context, conn *directconnect.Client, vifID string, addrFamily awstypes.AddressFamily, asn int32, timeout time.Duration) (*awstypes.BGPPeer, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.BGPPeerStatePending),
 		Target:     enum.Slice(awstypes.BGPPeerStateAvailable, awstypes.BGPPeerStateVerifying),
 		Refresh:    statusBGPPeer(ctx, conn, vifID, addrFamily, asn),
@@ -246,7 +246,7 @@ func waitBGPPeerCreated(ctx context.Context, conn *directconnect.Client, vifID s
 }
 
 func waitBGPPeerDeleted(ctx context.Context, conn *directconnect.Client, vifID string, addrFamily awstypes.AddressFamily, asn int32, timeout time.Duration) (*awstypes.BGPPeer, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.BGPPeerStateAvailable, awstypes.BGPPeerStateDeleting, awstypes.BGPPeerStatePending, awstypes.BGPPeerStateVerifying),
 		Target:     []string{},
 		Refresh:    statusBGPPeer(ctx, conn, vifID, addrFamily, asn),
@@ -234,7 +234,7 @@ func statusGateway(ctx context.Context, conn *directconnect.Client, id string) r
 }
 
 func waitGatewayCreated(ctx context.Context, conn *directconnect.Client, id string, timeout time.Duration) (*awstypes.DirectConnectGateway, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.DirectConnectGatewayStatePending),
 		Target:  enum.Slice(awstypes.DirectConnectGatewayStateAvailable),
 		Refresh: statusGateway(ctx, conn, id),
@@ -253,7 +253,7 @@ func waitGatewayCreated(ctx context.Context, conn *directconnect.Client, id stri
 }
 
 func waitGatewayDeleted(ctx context.Context, conn *directconnect.Client, id string, timeout time.Duration) (*awstypes.DirectConnectGateway, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.DirectConnectGatewayStatePending, awstypes.DirectConnectGatewayStateAvailable, awstypes.DirectConnectGatewayStateDeleting),
 		Target:  []string{},
 		Refresh: statusGateway(ctx, conn, id),
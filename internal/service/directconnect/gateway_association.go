@@ -385,7 +385,7 @@ func statusGatewayAssociation(ctx context.Context, conn *directconnect.Client, i
 }
 
 func waitGatewayAssociationCreated(ctx context.Context, conn *directconnect.Client, id string, timeout time.Duration) (*awstypes.DirectConnectGatewayAssociation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.DirectConnectGatewayAssociationStateAssociating),
 		Target:  enum.Slice(awstypes.DirectConnectGatewayAssociationStateAssociated),
 		Refresh: statusGatewayAssociation(ctx, conn, id),
@@ -404,7 +404,7 @@ func waitGatewayAssociationCreated(ctx context.Context, conn *directconnect.Clie
 }
 
 func waitGatewayAssociationUpdated(ctx context.Context, conn *directconnect.Client, id string, timeout time.Duration) (*awstypes.DirectConnectGatewayAssociation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.DirectConnectGatewayAssociationStateUpdating),
 		Target:  enum.Slice(awstypes.DirectConnectGatewayAssociationStateAssociated),
 		Refresh: statusGatewayAssociation(ctx, conn, id),
@@ -423,7 +423,7 @@ func waitGatewayAssociationUpdated(ctx context.Context, conn *directconnect.Clie
 }
 
 func waitGatewayAssociationDeleted(ctx context.Context, conn *directconnect.Client, id string, timeout time.Duration) (*awstypes.DirectConnectGatewayAssociation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.DirectConnectGatewayAssociationStateDisassociating),
 		Target:  []string{},
 		Refresh: statusGatewayAssociation(ctx, conn, id),
@@ -247,7 +247,7 @@ func waitHostedConnectionDeleted(ctx context.Context, conn *directconnect.Client
 	const (
 		timeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ConnectionStatePending, awstypes.ConnectionStateOrdering, awstypes.ConnectionStateAvailable, awstypes.ConnectionStateRequested, awstypes.ConnectionStateDeleting),
 		Target:  []string{},
 		Refresh: statusHostedConnection(ctx, conn, id),
@@ -309,7 +309,7 @@ func waitLagDeleted(ctx context.Context, conn *directconnect.Client, id string)
 	const (
 		timeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.LagStateAvailable, awstypes.LagStateRequested, awstypes.LagStatePending, awstypes.LagStateDeleting),
 		Target:  []string{},
 		Refresh: statusLag(ctx, conn, id),
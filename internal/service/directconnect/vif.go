@@ -141,7 +141,7 @@ func statusVirtualInterface(ctx context.Context, conn *directconnect.Client, id
 }
 
 func waitVirtualInterfaceAvailable(ctx context.Context, conn *directconnect.Client, id string, pending, target []string, timeout time.Duration) (*awstypes.VirtualInterface, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    pending,
 		Target:     target,
 		Refresh:    statusVirtualInterface(ctx, conn, id),
@@ -160,7 +160,7 @@ func waitVirtualInterfaceAvailable(ctx context.Context, conn *directconnect.Clie
 }
 
 func waitVirtualInterfaceDeleted(ctx context.Context, conn *directconnect.Client, id string, timeout time.Duration) (*awstypes.VirtualInterface, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.VirtualInterfaceStateAvailable,
 			awstypes.VirtualInterfaceStateConfirming,
@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -343,7 +344,19 @@ func resourceEndpoint() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"secrets_manager_access_role_arn", "secrets_manager_arn"},
+				ConflictsWith: []string{"password_wo", "secrets_manager_access_role_arn", "secrets_manager_arn"},
+			},
+			"password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				ConflictsWith: []string{names.AttrPassword, "secrets_manager_access_role_arn", "secrets_manager_arn"},
+				RequiredWith:  []string{"password_wo_version"},
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
 			},
 			"pause_replication_tasks": {
 				Type:     schema.TypeBool,
@@ -739,14 +752,14 @@ func resourceEndpoint() *schema.Resource {
 				Optional:      true,
 				ValidateFunc:  verify.ValidARN,
 				RequiredWith:  []string{"secrets_manager_arn"},
-				ConflictsWith: []string{names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort},
+				ConflictsWith: []string{names.AttrUsername, names.AttrPassword, "password_wo", "server_name", names.AttrPort},
 			},
 			"secrets_manager_arn": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ValidateFunc:  verify.ValidARN,
 				RequiredWith:  []string{"secrets_manager_access_role_arn"},
-				ConflictsWith: []string{names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort},
+				ConflictsWith: []string{names.AttrUsername, names.AttrPassword, "password_wo", "server_name", names.AttrPort},
 			},
 			"server_name": {
 				Type:          schema.TypeString,
@@ -785,6 +798,12 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DMSClient(ctx)
 
+	password, di := flex.GetStringValueOrWriteOnlyStringValue(d, names.AttrPassword, "password_wo")
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
 	endpointID := d.Get("endpoint_id").(string)
 	input := &dms.CreateEndpointInput{
 		EndpointIdentifier: aws.String(endpointID),
@@ -821,14 +840,14 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 		} else {
 			input.MySQLSettings = &awstypes.MySQLSettings{
 				Username:     aws.String(d.Get(names.AttrUsername).(string)),
-				Password:     aws.String(d.Get(names.AttrPassword).(string)),
+				Password:     aws.String(password),
 				ServerName:   aws.String(d.Get("server_name").(string)),
 				Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 				DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
 			}
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, input)
+			expandTopLevelConnectionInfo(d, input, password)
 		}
 	case engineNameAuroraPostgresql, engineNamePostgres:
 		settings := &awstypes.PostgreSQLSettings{}
@@ -842,13 +861,13 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 			settings.DatabaseName = aws.String(d.Get(names.AttrDatabaseName).(string))
 		} else {
 			settings.Username = aws.String(d.Get(names.AttrUsername).(string))
-			settings.Password = aws.String(d.Get(names.AttrPassword).(string))
+			settings.Password = aws.String(password)
 			settings.ServerName = aws.String(d.Get("server_name").(string))
 			settings.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
 			settings.DatabaseName = aws.String(d.Get(names.AttrDatabaseName).(string))
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, input)
+			expandTopLevelConnectionInfo(d, input, password)
 		}
 
 		input.PostgreSQLSettings = settings
@@ -876,12 +895,12 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 			settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
 		} else {
 			settings.Username = aws.String(d.Get(names.AttrUsername).(string))
-			settings.Password = aws.String(d.Get(names.AttrPassword).(string))
+			settings.Password = aws.String(password)
 			settings.ServerName = aws.String(d.Get("server_name").(string))
 			settings.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, input)
+			expandTopLevelConnectionInfo(d, input, password)
 		}
 
 		settings.DatabaseName = aws.String(d.Get(names.AttrDatabaseName).(string))
@@ -904,14 +923,14 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 		} else {
 			input.OracleSettings = &awstypes.OracleSettings{
 				Username:     aws.String(d.Get(names.AttrUsername).(string)),
-				Password:     aws.String(d.Get(names.AttrPassword).(string)),
+				Password:     aws.String(password),
 				ServerName:   aws.String(d.Get("server_name").(string)),
 				Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 				DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
 			}
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, input)
+			expandTopLevelConnectionInfo(d, input, password)
 		}
 	case engineNameRedis:
 		input.RedisSettings = expandRedisSettings(d.Get("redis_settings").([]any)[0].(map[string]any))
@@ -925,12 +944,12 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 			settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
 		} else {
 			settings.Username = aws.String(d.Get(names.AttrUsername).(string))
-			settings.Password = aws.String(d.Get(names.AttrPassword).(string))
+			settings.Password = aws.String(password)
 			settings.ServerName = aws.String(d.Get("server_name").(string))
 			settings.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, input)
+			expandTopLevelConnectionInfo(d, input, password)
 		}
 
 		if v, ok := d.GetOk("redshift_settings"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
@@ -968,14 +987,14 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 		} else {
 			input.MicrosoftSQLServerSettings = &awstypes.MicrosoftSQLServerSettings{
 				Username:     aws.String(d.Get(names.AttrUsername).(string)),
-				Password:     aws.String(d.Get(names.AttrPassword).(string)),
+				Password:     aws.String(password),
 				ServerName:   aws.String(d.Get("server_name").(string)),
 				Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 				DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
 			}
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, input)
+			expandTopLevelConnectionInfo(d, input, password)
 		}
 	case engineNameSybase:
 		if _, ok := d.GetOk("secrets_manager_arn"); ok {
@@ -987,14 +1006,14 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 		} else {
 			input.SybaseSettings = &awstypes.SybaseSettings{
 				Username:     aws.String(d.Get(names.AttrUsername).(string)),
-				Password:     aws.String(d.Get(names.AttrPassword).(string)),
+				Password:     aws.String(password),
 				ServerName:   aws.String(d.Get("server_name").(string)),
 				Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 				DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
 			}
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, input)
+			expandTopLevelConnectionInfo(d, input, password)
 		}
 	case engineNameDB2, engineNameDB2zOS:
 		if _, ok := d.GetOk("secrets_manager_arn"); ok {
@@ -1006,19 +1025,19 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 		} else {
 			input.IBMDb2Settings = &awstypes.IBMDb2Settings{
 				Username:     aws.String(d.Get(names.AttrUsername).(string)),
-				Password:     aws.String(d.Get(names.AttrPassword).(string)),
+				Password:     aws.String(password),
 				ServerName:   aws.String(d.Get("server_name").(string)),
 				Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 				DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
 			}
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, input)
+			expandTopLevelConnectionInfo(d, input, password)
 		}
 	case engineNameS3:
 		input.S3Settings = expandS3Settings(d.Get("s3_settings").([]any)[0].(map[string]any))
 	default:
-		expandTopLevelConnectionInfo(d, input)
+		expandTopLevelConnectionInfo(d, input, password)
 	}
 
 	_, err := tfresource.RetryWhenIsA[*awstypes.AccessDeniedFault](ctx, d.Timeout(schema.TimeoutCreate),
@@ -1062,6 +1081,12 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DMSClient(ctx)
 
+	password, di := flex.GetStringValueOrWriteOnlyStringValue(d, names.AttrPassword, "password_wo")
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
 	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
 		endpointARN := d.Get("endpoint_arn").(string)
 		pauseTasks := d.Get("pause_replication_tasks").(bool)
@@ -1110,7 +1135,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 			switch engineName := d.Get("engine_name").(string); engineName {
 			case engineNameAurora, engineNameMariadb, engineNameMySQL:
 				if d.HasChanges(
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
 					"secrets_manager_arn") {
 					if _, ok := d.GetOk("secrets_manager_arn"); ok {
 						input.MySQLSettings = &awstypes.MySQLSettings{
@@ -1120,7 +1145,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 					} else {
 						input.MySQLSettings = &awstypes.MySQLSettings{
 							Username:     aws.String(d.Get(names.AttrUsername).(string)),
-							Password:     aws.String(d.Get(names.AttrPassword).(string)),
+							Password:     aws.String(password),
 							ServerName:   aws.String(d.Get("server_name").(string)),
 							Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
@@ -1128,12 +1153,12 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 						input.EngineName = aws.String(engineName)
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, input)
+						expandTopLevelConnectionInfoModify(d, input, password)
 					}
 				}
 			case engineNameAuroraPostgresql, engineNamePostgres:
 				if d.HasChanges(
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
 					"secrets_manager_arn") {
 					if _, ok := d.GetOk("secrets_manager_arn"); ok {
 						input.PostgreSQLSettings = &awstypes.PostgreSQLSettings{
@@ -1144,7 +1169,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 					} else {
 						input.PostgreSQLSettings = &awstypes.PostgreSQLSettings{
 							Username:     aws.String(d.Get(names.AttrUsername).(string)),
-							Password:     aws.String(d.Get(names.AttrPassword).(string)),
+							Password:     aws.String(password),
 							ServerName:   aws.String(d.Get("server_name").(string)),
 							Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
@@ -1152,7 +1177,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 						input.EngineName = aws.String(engineName) // Must be included (should be 'postgres')
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, input)
+						expandTopLevelConnectionInfoModify(d, input, password)
 					}
 				}
 			case engineNameDynamoDB:
@@ -1189,7 +1214,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 				}
 			case engineNameMongodb:
 				if d.HasChanges(
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName, "mongodb_settings.0.auth_type",
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort, names.AttrDatabaseName, "mongodb_settings.0.auth_type",
 					"mongodb_settings.0.auth_mechanism", "mongodb_settings.0.nesting_level", "mongodb_settings.0.extract_doc_id",
 					"mongodb_settings.0.docs_to_investigate", "mongodb_settings.0.auth_source", "secrets_manager_access_role_arn",
 					"secrets_manager_arn") {
@@ -1210,7 +1235,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 					} else {
 						input.MongoDbSettings = &awstypes.MongoDbSettings{
 							Username:     aws.String(d.Get(names.AttrUsername).(string)),
-							Password:     aws.String(d.Get(names.AttrPassword).(string)),
+							Password:     aws.String(password),
 							ServerName:   aws.String(d.Get("server_name").(string)),
 							Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
@@ -1226,12 +1251,12 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 						input.EngineName = aws.String(engineName)
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, input)
+						expandTopLevelConnectionInfoModify(d, input, password)
 					}
 				}
 			case engineNameOracle:
 				if d.HasChanges(
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
 					"secrets_manager_arn") {
 					if _, ok := d.GetOk("secrets_manager_arn"); ok {
 						input.OracleSettings = &awstypes.OracleSettings{
@@ -1242,7 +1267,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 					} else {
 						input.OracleSettings = &awstypes.OracleSettings{
 							Username:     aws.String(d.Get(names.AttrUsername).(string)),
-							Password:     aws.String(d.Get(names.AttrPassword).(string)),
+							Password:     aws.String(password),
 							ServerName:   aws.String(d.Get("server_name").(string)),
 							Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
@@ -1250,7 +1275,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 						input.EngineName = aws.String(engineName) // Must be included (should be 'oracle')
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, input)
+						expandTopLevelConnectionInfoModify(d, input, password)
 					}
 				}
 			case engineNameRedis:
@@ -1260,7 +1285,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 				}
 			case engineNameRedshift:
 				if d.HasChanges(
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName,
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort, names.AttrDatabaseName,
 					"redshift_settings", "secrets_manager_access_role_arn",
 					"secrets_manager_arn") {
 					if _, ok := d.GetOk("secrets_manager_arn"); ok {
@@ -1272,7 +1297,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 					} else {
 						input.RedshiftSettings = &awstypes.RedshiftSettings{
 							Username:     aws.String(d.Get(names.AttrUsername).(string)),
-							Password:     aws.String(d.Get(names.AttrPassword).(string)),
+							Password:     aws.String(password),
 							ServerName:   aws.String(d.Get("server_name").(string)),
 							Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
@@ -1280,7 +1305,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 						input.EngineName = aws.String(engineName) // Must be included (should be 'redshift')
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, input)
+						expandTopLevelConnectionInfoModify(d, input, password)
 
 						if v, ok := d.GetOk("redshift_settings"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 							tfMap := v.([]any)[0].(map[string]any)
@@ -1309,7 +1334,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 				}
 			case engineNameSQLServer, engineNameBabelfish:
 				if d.HasChanges(
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
 					"secrets_manager_arn") {
 					if _, ok := d.GetOk("secrets_manager_arn"); ok {
 						input.MicrosoftSQLServerSettings = &awstypes.MicrosoftSQLServerSettings{
@@ -1320,7 +1345,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 					} else {
 						input.MicrosoftSQLServerSettings = &awstypes.MicrosoftSQLServerSettings{
 							Username:     aws.String(d.Get(names.AttrUsername).(string)),
-							Password:     aws.String(d.Get(names.AttrPassword).(string)),
+							Password:     aws.String(password),
 							ServerName:   aws.String(d.Get("server_name").(string)),
 							Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
@@ -1328,12 +1353,12 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 						input.EngineName = aws.String(engineName) // Must be included (should be 'postgres')
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, input)
+						expandTopLevelConnectionInfoModify(d, input, password)
 					}
 				}
 			case engineNameSybase:
 				if d.HasChanges(
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
 					"secrets_manager_arn") {
 					if _, ok := d.GetOk("secrets_manager_arn"); ok {
 						input.SybaseSettings = &awstypes.SybaseSettings{
@@ -1344,7 +1369,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 					} else {
 						input.SybaseSettings = &awstypes.SybaseSettings{
 							Username:     aws.String(d.Get(names.AttrUsername).(string)),
-							Password:     aws.String(d.Get(names.AttrPassword).(string)),
+							Password:     aws.String(password),
 							ServerName:   aws.String(d.Get("server_name").(string)),
 							Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
@@ -1352,12 +1377,12 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 						input.EngineName = aws.String(engineName) // Must be included (should be 'postgres')
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, input)
+						expandTopLevelConnectionInfoModify(d, input, password)
 					}
 				}
 			case engineNameDB2, engineNameDB2zOS:
 				if d.HasChanges(
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
 					"secrets_manager_arn") {
 					if _, ok := d.GetOk("secrets_manager_arn"); ok {
 						input.IBMDb2Settings = &awstypes.IBMDb2Settings{
@@ -1368,7 +1393,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 					} else {
 						input.IBMDb2Settings = &awstypes.IBMDb2Settings{
 							Username:     aws.String(d.Get(names.AttrUsername).(string)),
-							Password:     aws.String(d.Get(names.AttrPassword).(string)),
+							Password:     aws.String(password),
 							ServerName:   aws.String(d.Get("server_name").(string)),
 							Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
@@ -1376,7 +1401,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 						input.EngineName = aws.String(engineName) // Must be included (should be 'db2')
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, input)
+						expandTopLevelConnectionInfoModify(d, input, password)
 					}
 				}
 			case engineNameS3:
@@ -1389,8 +1414,8 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 					input.DatabaseName = aws.String(d.Get(names.AttrDatabaseName).(string))
 				}
 
-				if d.HasChange(names.AttrPassword) {
-					input.Password = aws.String(d.Get(names.AttrPassword).(string))
+				if d.HasChanges(names.AttrPassword, "password_wo_version") {
+					input.Password = aws.String(password)
 				}
 
 				if d.HasChange(names.AttrPort) {
@@ -2602,9 +2627,9 @@ func engineSettingsToSet(l []any) *schema.Set {
 	return s
 }
 
-func expandTopLevelConnectionInfo(d *schema.ResourceData, input *dms.CreateEndpointInput) {
+func expandTopLevelConnectionInfo(d *schema.ResourceData, input *dms.CreateEndpointInput, password string) {
 	input.Username = aws.String(d.Get(names.AttrUsername).(string))
-	input.Password = aws.String(d.Get(names.AttrPassword).(string))
+	input.Password = aws.String(password)
 	input.ServerName = aws.String(d.Get("server_name").(string))
 	input.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
 
@@ -2613,9 +2638,9 @@ func expandTopLevelConnectionInfo(d *schema.ResourceData, input *dms.CreateEndpo
 	}
 }
 
-func expandTopLevelConnectionInfoModify(d *schema.ResourceData, input *dms.ModifyEndpointInput) {
+func expandTopLevelConnectionInfoModify(d *schema.ResourceData, input *dms.ModifyEndpointInput, password string) {
 	input.Username = aws.String(d.Get(names.AttrUsername).(string))
-	input.Password = aws.String(d.Get(names.AttrPassword).(string))
+	input.Password = aws.String(password)
 	input.ServerName = aws.String(d.Get("server_name").(string))
 	input.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
 
@@ -2695,7 +2720,7 @@ func statusEndpoint(ctx context.Context, conn *dms.Client, id string) retry.Stat
 }
 
 func waitEndpointDeleted(ctx context.Context, conn *dms.Client, id string, timeout time.Duration) (*awstypes.Endpoint, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{endpointStatusDeleting},
 		Target:  []string{},
 		Refresh: statusEndpoint(ctx, conn, id),
@@ -474,7 +474,7 @@ func setLastReplicationError(err error, replication *awstypes.Replication) {
 }
 
 func waitReplicationRunning(ctx context.Context, conn *dms.Client, arn string, timeout time.Duration) (*awstypes.Replication, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			replicationStatusReady,
 			replicationStatusInitialising,
@@ -503,7 +503,7 @@ func waitReplicationRunning(ctx context.Context, conn *dms.Client, arn string, t
 }
 
 func waitReplicationStopped(ctx context.Context, conn *dms.Client, arn string, timeout time.Duration) (*awstypes.Replication, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{replicationStatusStopping, replicationStatusRunning},
 		Target:     []string{replicationStatusStopped},
 		Refresh:    statusReplication(ctx, conn, arn),
@@ -523,7 +523,7 @@ func waitReplicationStopped(ctx context.Context, conn *dms.Client, arn string, t
 }
 
 func waitReplicationDeleted(ctx context.Context, conn *dms.Client, arn string, timeout time.Duration) (*awstypes.Replication, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{replicationTaskStatusDeleting, replicationStatusStopped},
 		Target:     []string{},
 		Refresh:    statusReplication(ctx, conn, arn),
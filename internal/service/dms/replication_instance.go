@@ -396,7 +396,7 @@ func statusReplicationInstance(ctx context.Context, conn *dms.Client, id string)
 }
 
 func waitReplicationInstanceCreated(ctx context.Context, conn *dms.Client, id string, timeout time.Duration) (*awstypes.ReplicationInstance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{replicationInstanceStatusCreating, replicationInstanceStatusModifying},
 		Target:     []string{replicationInstanceStatusAvailable},
 		Refresh:    statusReplicationInstance(ctx, conn, id),
@@ -415,7 +415,7 @@ func waitReplicationInstanceCreated(ctx context.Context, conn *dms.Client, id st
 }
 
 func waitReplicationInstanceUpdated(ctx context.Context, conn *dms.Client, id string, timeout time.Duration) (*awstypes.ReplicationInstance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{replicationInstanceStatusModifying, replicationInstanceStatusUpgrading},
 		Target:     []string{replicationInstanceStatusAvailable},
 		Refresh:    statusReplicationInstance(ctx, conn, id),
@@ -434,7 +434,7 @@ func waitReplicationInstanceUpdated(ctx context.Context, conn *dms.Client, id st
 }
 
 func waitReplicationInstanceDeleted(ctx context.Context, conn *dms.Client, id string, timeout time.Duration) (*awstypes.ReplicationInstance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{replicationInstanceStatusDeleting},
 		Target:     []string{},
 		Refresh:    statusReplicationInstance(ctx, conn, id),
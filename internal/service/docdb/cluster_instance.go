@@ -453,7 +453,7 @@ func statusDBInstance(ctx context.Context, conn *docdb.Client, id string) retry.
 }
 
 func waitDBInstanceAvailable(ctx context.Context, conn *docdb.Client, id string, timeout time.Duration) (*awstypes.DBInstance, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			"backing-up",
 			"configuring-enhanced-monitoring",
@@ -487,7 +487,7 @@ func waitDBInstanceAvailable(ctx context.Context, conn *docdb.Client, id string,
 }
 
 func waitDBInstanceDeleted(ctx context.Context, conn *docdb.Client, id string, timeout time.Duration) (*awstypes.DBInstance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			"configuring-log-exports",
 			"modifying",
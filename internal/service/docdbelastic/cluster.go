@@ -392,7 +392,7 @@ type resourceClusterData struct {
 }
 
 func waitClusterCreated(ctx context.Context, conn *docdbelastic.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.StatusCreating),
 		Target:                    enum.Slice(awstypes.StatusActive),
 		Refresh:                   statusCluster(ctx, conn, id),
@@ -410,7 +410,7 @@ func waitClusterCreated(ctx context.Context, conn *docdbelastic.Client, id strin
 }
 
 func waitClusterUpdated(ctx context.Context, conn *docdbelastic.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.StatusUpdating),
 		Target:                    enum.Slice(awstypes.StatusActive),
 		Refresh:                   statusCluster(ctx, conn, id),
@@ -428,7 +428,7 @@ func waitClusterUpdated(ctx context.Context, conn *docdbelastic.Client, id strin
 }
 
 func waitClusterDeleted(ctx context.Context, conn *docdbelastic.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.StatusActive, awstypes.StatusDeleting),
 		Target:  []string{},
 		Refresh: statusCluster(ctx, conn, id),
@@ -365,7 +365,7 @@ func statusReplicationConfigurationTemplate(ctx context.Context, conn *drs.Clien
 }
 
 func waitReplicationConfigurationTemplateAvailable(ctx context.Context, conn *drs.Client, id string, timeout time.Duration) (*awstypes.ReplicationConfigurationTemplate, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{},
 		Target:     []string{replicationConfigurationTemplateAvailable},
 		Refresh:    statusReplicationConfigurationTemplate(ctx, conn, id),
@@ -384,7 +384,7 @@ func waitReplicationConfigurationTemplateAvailable(ctx context.Context, conn *dr
 }
 
 func waitReplicationConfigurationTemplateDeleted(ctx context.Context, conn *drs.Client, id string, timeout time.Duration) (*awstypes.ReplicationConfigurationTemplate, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{replicationConfigurationTemplateAvailable},
 		Target:     []string{},
 		Refresh:    statusReplicationConfigurationTemplate(ctx, conn, id),
@@ -698,7 +698,7 @@ func statusDirectoryStage(ctx context.Context, conn *directoryservice.Client, id
 }
 
 func waitDirectoryCreated(ctx context.Context, conn *directoryservice.Client, id string, timeout time.Duration) (*awstypes.DirectoryDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.DirectoryStageRequested, awstypes.DirectoryStageCreating, awstypes.DirectoryStageCreated),
 		Target:  enum.Slice(awstypes.DirectoryStageActive),
 		Refresh: statusDirectoryStage(ctx, conn, id),
@@ -724,7 +724,7 @@ func waitDirectoryCreated(ctx context.Context, conn *directoryservice.Client, id
 }
 
 func waitDirectoryDeleted(ctx context.Context, conn *directoryservice.Client, id string, timeout time.Duration) (*awstypes.DirectoryDescription, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.DirectoryStageActive, awstypes.DirectoryStageDeleting),
 		Target:     []string{},
 		Refresh:    statusDirectoryStage(ctx, conn, id),
@@ -824,7 +824,7 @@ func statusDomainController(ctx context.Context, conn *directoryservice.Client,
 }
 
 func waitDomainControllerCreated(ctx context.Context, conn *directoryservice.Client, directoryID, domainControllerID string, timeout time.Duration, optFns ...func(*directoryservice.Options)) (*awstypes.DomainController, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.DomainControllerStatusCreating),
 		Target:  enum.Slice(awstypes.DomainControllerStatusActive),
 		Refresh: statusDomainController(ctx, conn, directoryID, domainControllerID, optFns...),
@@ -843,7 +843,7 @@ func waitDomainControllerCreated(ctx context.Context, conn *directoryservice.Cli
 }
 
 func waitDomainControllerDeleted(ctx context.Context, conn *directoryservice.Client, directoryID, domainControllerID string, timeout time.Duration, optFns ...func(*directoryservice.Options)) (*awstypes.DomainController, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.DomainControllerStatusDeleting),
 		Target:  []string{},
 		Refresh: statusDomainController(ctx, conn, directoryID, domainControllerID, optFns...),
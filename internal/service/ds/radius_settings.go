@@ -239,7 +239,7 @@ func statusRadius(ctx context.Context, conn *directoryservice.Client, directoryI
 }
 
 func waitRadiusCompleted(ctx context.Context, conn *directoryservice.Client, directoryID string, timeout time.Duration) (*awstypes.DirectoryDescription, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.RadiusStatusCreating),
 		Target:  enum.Slice(awstypes.RadiusStatusCompleted),
 		Refresh: statusRadius(ctx, conn, directoryID),
@@ -342,7 +342,7 @@ func statusRegion(ctx context.Context, conn *directoryservice.Client, directoryI
 }
 
 func waitRegionCreated(ctx context.Context, conn *directoryservice.Client, directoryID, regionName string, timeout time.Duration, optFns ...func(*directoryservice.Options)) (*awstypes.RegionDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.DirectoryStageRequested, awstypes.DirectoryStageCreating, awstypes.DirectoryStageCreated),
 		Target:  enum.Slice(awstypes.DirectoryStageActive),
 		Refresh: statusRegion(ctx, conn, directoryID, regionName, optFns...),
@@ -359,7 +359,7 @@ func waitRegionCreated(ctx context.Context, conn *directoryservice.Client, direc
 }
 
 func waitRegionDeleted(ctx context.Context, conn *directoryservice.Client, directoryID, regionName string, timeout time.Duration, optFns ...func(*directoryservice.Options)) (*awstypes.RegionDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.DirectoryStageActive, awstypes.DirectoryStageDeleting),
 		Target:  []string{},
 		Refresh: statusRegion(ctx, conn, directoryID, regionName, optFns...),
@@ -272,7 +272,7 @@ func statusSharedDirectory(ctx context.Context, conn *directoryservice.Client, o
 }
 
 func waitSharedDirectoryDeleted(ctx context.Context, conn *directoryservice.Client, ownerDirectoryID, sharedDirectoryID string, timeout time.Duration) (*awstypes.SharedDirectory, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.ShareStatusDeleting,
 			awstypes.ShareStatusShared,
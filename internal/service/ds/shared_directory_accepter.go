@@ -170,7 +170,7 @@ func statusDirectoryShareStatus(ctx context.Context, conn *directoryservice.Clie
 }
 
 func waitSharedDirectoryAccepted(ctx context.Context, conn *directoryservice.Client, id string, timeout time.Duration) (*awstypes.SharedDirectory, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.ShareStatusPendingAcceptance, awstypes.ShareStatusSharing),
 		Target:                    enum.Slice(awstypes.ShareStatusShared),
 		Refresh:                   statusDirectoryShareStatus(ctx, conn, id),
@@ -484,7 +484,7 @@ func statusTrust(ctx context.Context, conn *directoryservice.Client, directoryID
 }
 
 func waitTrustCreated(ctx context.Context, conn *directoryservice.Client, directoryID, trustID string, timeout time.Duration) (*awstypes.Trust, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TrustStateCreating),
 		Target:  enum.Slice(awstypes.TrustStateCreated),
 		Refresh: statusTrust(ctx, conn, directoryID, trustID),
@@ -510,7 +510,7 @@ func waitTrustCreated(ctx context.Context, conn *directoryservice.Client, direct
 }
 
 func waitTrustVerified(ctx context.Context, conn *directoryservice.Client, directoryID, trustID string, timeout time.Duration) (*awstypes.Trust, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.TrustStateCreating,
 			awstypes.TrustStateCreated,
@@ -544,7 +544,7 @@ func waitTrustVerified(ctx context.Context, conn *directoryservice.Client, direc
 }
 
 func waitTrustUpdated(ctx context.Context, conn *directoryservice.Client, directoryID, trustID string, timeout time.Duration) (*awstypes.Trust, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.TrustStateUpdating,
 			awstypes.TrustStateUpdated,
@@ -577,7 +577,7 @@ func waitTrustUpdated(ctx context.Context, conn *directoryservice.Client, direct
 }
 
 func waitTrustDeleted(ctx context.Context, conn *directoryservice.Client, directoryID, trustID string, timeout time.Duration) (*awstypes.Trust, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.TrustStateCreated,
 			awstypes.TrustStateDeleting,
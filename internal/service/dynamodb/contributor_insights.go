@@ -237,7 +237,7 @@ func statusContributorInsights(ctx context.Context, conn *dynamodb.Client, table
 }
 
 func waitContributorInsightsCreated(ctx context.Context, conn *dynamodb.Client, tableName, indexName string, timeout time.Duration) (*dynamodb.DescribeContributorInsightsOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ContributorInsightsStatusEnabling),
 		Target:  enum.Slice(awstypes.ContributorInsightsStatusEnabled),
 		Timeout: timeout,
@@ -258,7 +258,7 @@ func waitContributorInsightsCreated(ctx context.Context, conn *dynamodb.Client,
 }
 
 func waitContributorInsightsDeleted(ctx context.Context, conn *dynamodb.Client, tableName, indexName string, timeout time.Duration) (*dynamodb.DescribeContributorInsightsOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ContributorInsightsStatusDisabling),
 		Target:  []string{},
 		Timeout: timeout,
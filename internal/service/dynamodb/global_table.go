@@ -224,7 +224,7 @@ func statusGlobalTable(ctx context.Context, conn *dynamodb.Client, name string)
 }
 
 func waitGlobalTableCreated(ctx context.Context, conn *dynamodb.Client, name string, timeout time.Duration) (*awstypes.GlobalTableDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.GlobalTableStatusCreating),
 		Target:     enum.Slice(awstypes.GlobalTableStatusActive),
 		Refresh:    statusGlobalTable(ctx, conn, name),
@@ -242,7 +242,7 @@ func waitGlobalTableCreated(ctx context.Context, conn *dynamodb.Client, name str
 }
 
 func waitGlobalTableUpdated(ctx context.Context, conn *dynamodb.Client, name string, timeout time.Duration) (*awstypes.GlobalTableDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.GlobalTableStatusUpdating),
 		Target:     enum.Slice(awstypes.GlobalTableStatusActive),
 		Refresh:    statusGlobalTable(ctx, conn, name),
@@ -260,7 +260,7 @@ func waitGlobalTableUpdated(ctx context.Context, conn *dynamodb.Client, name str
 }
 
 func waitGlobalTableDeleted(ctx context.Context, conn *dynamodb.Client, name string, timeout time.Duration) (*awstypes.GlobalTableDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.GlobalTableStatusActive, awstypes.GlobalTableStatusDeleting),
 		Target:     []string{},
 		Refresh:    statusGlobalTable(ctx, conn, name),
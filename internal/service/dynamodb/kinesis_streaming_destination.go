@@ -247,7 +247,7 @@ func waitKinesisStreamingDestinationActive(ctx context.Context, conn *dynamodb.C
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.DestinationStatusDisabled, awstypes.DestinationStatusEnabling),
 		Target:  enum.Slice(awstypes.DestinationStatusActive),
 		Timeout: timeout,
@@ -269,7 +269,7 @@ func waitKinesisStreamingDestinationDisabled(ctx context.Context, conn *dynamodb
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.DestinationStatusActive, awstypes.DestinationStatusDisabling),
 		Target:  enum.Slice(awstypes.DestinationStatusDisabled),
 		Timeout: timeout,
@@ -354,7 +354,7 @@ func waitTableExportCreated(ctx context.Context, conn *dynamodb.Client, id strin
 	const (
 		maxTimeout = 60 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ExportStatusInProgress),
 		Target:  enum.Slice(awstypes.ExportStatusCompleted, awstypes.ExportStatusFailed),
 		Refresh: statusTableExport(ctx, conn, id),
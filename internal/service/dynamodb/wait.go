@@ -9,8 +9,8 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -29,7 +29,7 @@ const (
 )
 
 func waitTableActive(ctx context.Context, conn *dynamodb.Client, tableName string, timeout time.Duration) (*awstypes.TableDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TableStatusCreating, awstypes.TableStatusUpdating),
 		Target:  enum.Slice(awstypes.TableStatusActive),
 		Refresh: statusTable(ctx, conn, tableName),
@@ -46,7 +46,7 @@ func waitTableActive(ctx context.Context, conn *dynamodb.Client, tableName strin
 }
 
 func waitTableDeleted(ctx context.Context, conn *dynamodb.Client, tableName string, timeout time.Duration) (*awstypes.TableDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TableStatusActive, awstypes.TableStatusDeleting),
 		Target:  []string{},
 		Refresh: statusTable(ctx, conn, tableName),
@@ -63,7 +63,7 @@ func waitTableDeleted(ctx context.Context, conn *dynamodb.Client, tableName stri
 }
 
 func waitImportComplete(ctx context.Context, conn *dynamodb.Client, importARN string, timeout time.Duration) (*awstypes.ImportTableDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ImportStatusInProgress),
 		Target:  enum.Slice(awstypes.ImportStatusCompleted),
 		Refresh: statusImport(ctx, conn, importARN),
@@ -80,7 +80,7 @@ func waitImportComplete(ctx context.Context, conn *dynamodb.Client, importARN st
 }
 
 func waitReplicaActive(ctx context.Context, conn *dynamodb.Client, tableName, region string, timeout time.Duration, delay time.Duration, optFns ...func(*dynamodb.Options)) (*awstypes.TableDescription, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Delay:   delay,
 		Pending: enum.Slice(awstypes.ReplicaStatusCreating, awstypes.ReplicaStatusUpdating, awstypes.ReplicaStatusDeleting),
 		Target:  enum.Slice(awstypes.ReplicaStatusActive),
@@ -98,7 +98,7 @@ func waitReplicaActive(ctx context.Context, conn *dynamodb.Client, tableName, re
 }
 
 func waitReplicaDeleted(ctx context.Context, conn *dynamodb.Client, tableName, region string, timeout time.Duration, optFns ...func(*dynamodb.Options)) (*awstypes.TableDescription, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.ReplicaStatusCreating,
 			awstypes.ReplicaStatusUpdating,
@@ -120,7 +120,7 @@ func waitReplicaDeleted(ctx context.Context, conn *dynamodb.Client, tableName, r
 }
 
 func waitGSIActive(ctx context.Context, conn *dynamodb.Client, tableName, indexName string, timeout time.Duration) (*awstypes.GlobalSecondaryIndexDescription, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.IndexStatusCreating, awstypes.IndexStatusUpdating),
 		Target:  enum.Slice(awstypes.IndexStatusActive),
 		Refresh: statusGSI(ctx, conn, tableName, indexName),
@@ -137,7 +137,7 @@ func waitGSIActive(ctx context.Context, conn *dynamodb.Client, tableName, indexN
 }
 
 func waitGSIDeleted(ctx context.Context, conn *dynamodb.Client, tableName, indexName string, timeout time.Duration) (*awstypes.GlobalSecondaryIndexDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.IndexStatusActive, awstypes.IndexStatusDeleting, awstypes.IndexStatusUpdating),
 		Target:  []string{},
 		Refresh: statusGSI(ctx, conn, tableName, indexName),
@@ -165,7 +165,7 @@ func waitPITRUpdated(ctx context.Context, conn *dynamodb.Client, tableName strin
 		target = enum.Slice(awstypes.PointInTimeRecoveryStatusEnabled)
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    pending,
 		Target:     target,
 		Refresh:    statusPITR(ctx, conn, tableName, optFns...),
@@ -191,7 +191,7 @@ func waitTTLUpdated(ctx context.Context, conn *dynamodb.Client, tableName string
 		target = enum.Slice(awstypes.TimeToLiveStatusEnabled)
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: pending,
 		Target:  target,
 		Timeout: max(ttlUpdateTimeout, timeout),
@@ -208,7 +208,7 @@ func waitTTLUpdated(ctx context.Context, conn *dynamodb.Client, tableName string
 }
 
 func waitSSEUpdated(ctx context.Context, conn *dynamodb.Client, tableName string, timeout time.Duration) (*awstypes.TableDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.SSEStatusDisabling, awstypes.SSEStatusEnabling, awstypes.SSEStatusUpdating),
 		Target:                    enum.Slice(awstypes.SSEStatusDisabled, awstypes.SSEStatusEnabled),
 		Refresh:                   statusSSE(ctx, conn, tableName),
@@ -227,7 +227,7 @@ func waitSSEUpdated(ctx context.Context, conn *dynamodb.Client, tableName string
 }
 
 func waitReplicaSSEUpdated(ctx context.Context, conn *dynamodb.Client, region, tableName string, timeout time.Duration) (*awstypes.TableDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SSEStatusDisabling, awstypes.SSEStatusEnabling, awstypes.SSEStatusUpdating),
 		Target:  enum.Slice(awstypes.SSEStatusDisabled, awstypes.SSEStatusEnabled),
 		Refresh: statusSSE(ctx, conn, tableName, func(o *dynamodb.Options) {
@@ -273,7 +273,7 @@ func stopVolumeAttachmentInstance(ctx context.Context, conn *ec2.Client, id stri
 }
 
 func waitVolumeAttachmentInstanceStopped(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Instance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.InstanceStateNamePending,
 			awstypes.InstanceStateNameRunning,
@@ -301,7 +301,7 @@ func waitVolumeAttachmentInstanceStopped(ctx context.Context, conn *ec2.Client,
 }
 
 func waitVolumeAttachmentInstanceReady(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Instance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.InstanceStateNamePending, awstypes.InstanceStateNameStopping),
 		Target:     enum.Slice(awstypes.InstanceStateNameRunning, awstypes.InstanceStateNameStopped),
 		Refresh:    statusVolumeAttachmentInstanceState(ctx, conn, id),
@@ -324,7 +324,7 @@ func waitVolumeAttachmentInstanceReady(ctx context.Context, conn *ec2.Client, id
 }
 
 func waitVolumeAttachmentDeleted(ctx context.Context, conn *ec2.Client, volumeID, instanceID, deviceName string, timeout time.Duration) (*awstypes.VolumeAttachment, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.VolumeAttachmentStateDetaching),
 		Target:     []string{},
 		Refresh:    statusVolumeAttachment(ctx, conn, volumeID, instanceID, deviceName),
@@ -33,7 +33,10 @@ func dataSourceEBSVolumes() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"limit":        limitSchema(),
+			"sort_by":      sortBySchema(),
 			names.AttrTags: tftags.TagsSchema(),
+			"where":        whereSchema(),
 		},
 	}
 }
@@ -62,6 +65,12 @@ func dataSourceEBSVolumesRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "reading EBS Volumes: %s", err)
 	}
 
+	output, err = filterByExpressionsFromResourceData(d, output)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EBS Volumes: %s", err)
+	}
+
 	var volumeIDs []string
 
 	for _, v := range output {
@@ -50,6 +50,7 @@ func dataSourceAMIIDs() *schema.Resource {
 				Default:  false,
 				Optional: true,
 			},
+			"limit": limitSchema(),
 			"name_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -69,6 +70,8 @@ func dataSourceAMIIDs() *schema.Resource {
 				Default:  false,
 				Optional: true,
 			},
+			"sort_by": sortBySchema(),
+			"where":   whereSchema(),
 		},
 	}
 }
@@ -128,6 +131,13 @@ func dataSourceAMIIDsRead(ctx context.Context, d *schema.ResourceData, meta any)
 		}
 		return -compare
 	})
+
+	filteredImages, err = filterByExpressionsFromResourceData(d, filteredImages)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 AMIs: %s", err)
+	}
+
 	for _, image := range filteredImages {
 		imageIDs = append(imageIDs, aws.ToString(image.ImageId))
 	}
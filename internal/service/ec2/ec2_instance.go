@@ -45,6 +45,7 @@ import (
 )
 
 // @SDKResource("aws_instance", name="Instance")
+// @IdentityAttribute("id")
 // @Tags(identifierAttribute="id")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.Instance")
 // @Testing(importIgnore="user_data_replace_on_change")
@@ -3305,7 +3306,7 @@ func terminateInstance(ctx context.Context, conn *ec2.Client, id string, timeout
 }
 
 func waitInstanceCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Instance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.InstanceStateNamePending),
 		Target:     enum.Slice(awstypes.InstanceStateNameRunning),
 		Refresh:    statusInstance(ctx, conn, id),
@@ -3328,7 +3329,7 @@ func waitInstanceCreated(ctx context.Context, conn *ec2.Client, id string, timeo
 }
 
 func waitInstanceDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Instance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.InstanceStateNamePending,
 			awstypes.InstanceStateNameRunning,
@@ -3357,7 +3358,7 @@ func waitInstanceDeleted(ctx context.Context, conn *ec2.Client, id string, timeo
 }
 
 func waitInstanceReady(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Instance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.InstanceStateNamePending, awstypes.InstanceStateNameStopping),
 		Target:     enum.Slice(awstypes.InstanceStateNameRunning, awstypes.InstanceStateNameStopped),
 		Refresh:    statusInstance(ctx, conn, id),
@@ -3380,7 +3381,7 @@ func waitInstanceReady(ctx context.Context, conn *ec2.Client, id string, timeout
 }
 
 func waitInstanceStarted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Instance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.InstanceStateNamePending, awstypes.InstanceStateNameStopped),
 		Target:     enum.Slice(awstypes.InstanceStateNameRunning),
 		Refresh:    statusInstance(ctx, conn, id),
@@ -3403,7 +3404,7 @@ func waitInstanceStarted(ctx context.Context, conn *ec2.Client, id string, timeo
 }
 
 func waitInstanceStopped(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Instance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.InstanceStateNamePending,
 			awstypes.InstanceStateNameRunning,
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	tflist "github.com/hashicorp/terraform-provider-aws/internal/list"
+)
+
+// @SDKListResource("aws_instance", name="Instance")
+func newInstanceListResource(_ context.Context) (list.ListResourceWithConfigure, error) {
+	return &instanceListResource{}, nil
+}
+
+type instanceListResource struct {
+	tflist.ListResourceWithSDKv2Resource
+}
+
+func (l *instanceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	conn := l.Meta().EC2Client(ctx)
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		input := ec2.DescribeInstancesInput{
+			Filters: []awstypes.Filter{
+				{
+					Name: aws.String("instance-state-name"),
+					Values: enum.Slice(
+						awstypes.InstanceStateNamePending,
+						awstypes.InstanceStateNameRunning,
+						awstypes.InstanceStateNameShuttingDown,
+						awstypes.InstanceStateNameStopping,
+						awstypes.InstanceStateNameStopped,
+					),
+				},
+			},
+		}
+		output, err := findInstances(ctx, conn, &input)
+
+		if err != nil {
+			var result list.ListResult
+			result.Diagnostics.AddError("listing EC2 Instances", err.Error())
+			yield(result)
+			return
+		}
+
+		for _, v := range output {
+			id := aws.ToString(v.InstanceId)
+			d := l.ResourceData()
+			d.SetId(id)
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = id
+			if v := keyValueTags(ctx, v.Tags).KeyValue("Name"); v != nil {
+				result.DisplayName = aws.ToString(v)
+			}
+			if !l.SetResult(ctx, request, d, &result) {
+				continue
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
@@ -50,6 +50,7 @@ func dataSourceInstances() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"limit": limitSchema(),
 			"private_ips": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -60,6 +61,8 @@ func dataSourceInstances() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"sort_by": sortBySchema(),
+			"where":   whereSchema(),
 		},
 	}
 }
@@ -100,6 +103,12 @@ func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, meta a
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Instances: %s", err)
 	}
 
+	output, err = filterByExpressionsFromResourceData(d, output)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Instances: %s", err)
+	}
+
 	var instanceIDs, privateIPs, publicIPs, ipv6Addresses []string
 
 	for _, v := range output {
@@ -1262,7 +1262,7 @@ func statusLaunchTemplate(ctx context.Context, conn *ec2.Client, id string, idIs
 }
 
 func waitLaunchTemplateReady(ctx context.Context, conn *ec2.Client, id string, idIsName bool, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{""},
 		Target:                    enum.Slice(LaunchTemplateFound),
 		Refresh:                   statusLaunchTemplate(ctx, conn, id, idIsName),
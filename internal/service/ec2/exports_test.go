@@ -71,6 +71,7 @@ var (
 	ResourceSecurityGroupEgressRule                       = newSecurityGroupEgressRuleResource
 	ResourceSecurityGroupIngressRule                      = newSecurityGroupIngressRuleResource
 	ResourceSecurityGroupRule                             = resourceSecurityGroupRule
+	ResourceSecurityGroupRulesExclusive                   = newSecurityGroupRulesExclusiveResource
 	ResourceSecurityGroupVPCAssociation                   = newResourceSecurityGroupVPCAssociation
 	ResourceSnapshotCreateVolumePermission                = resourceSnapshotCreateVolumePermission
 	ResourceSpotDataFeedSubscription                      = resourceSpotDataFeedSubscription
@@ -130,9 +131,11 @@ var (
 	CheckMostRecentAndMissingFilters                           = checkMostRecentAndMissingFilters
 	CustomFiltersSchema                                        = customFiltersSchema
 	CustomerGatewayConfigurationToTunnelInfo                   = customerGatewayConfigurationToTunnelInfo
+	DiffSecurityGroupExclusiveRules                            = diffSecurityGroupExclusiveRules
 	ErrCodeDefaultSubnetAlreadyExistsInAvailabilityZone        = errCodeDefaultSubnetAlreadyExistsInAvailabilityZone
 	ErrCodeInvalidSpotDatafeedNotFound                         = errCodeInvalidSpotDatafeedNotFound
 	ExpandIPPerms                                              = expandIPPerms
+	FilterByExpressions                                        = filterByExpressions[any]
 	FindAvailabilityZones                                      = findAvailabilityZones
 	FindCapacityReservationByID                                = findCapacityReservationByID
 	FindCarrierGatewayByID                                     = findCarrierGatewayByID
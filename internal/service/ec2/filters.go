@@ -4,7 +4,10 @@
 package ec2
 
 import (
+	"cmp"
 	"context"
+	"encoding/json"
+	"fmt"
 	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -13,11 +16,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/jmespath/go-jmespath"
 )
 
 func newFilter(name string, values []string) awstypes.Filter {
@@ -250,3 +255,202 @@ func newAttributeFilterList(m map[string]string) []awstypes.Filter {
 
 	return filters
 }
+
+// whereSchema returns a *schema.Schema that represents a JMESPath expression
+// evaluated locally against each object returned by one of the "Describe..."
+// API calls wrapped by a plural data source. Only objects for which the
+// expression's result is truthy are included in the data source's results.
+//
+// Expressions are evaluated against the JSON representation of the API object,
+// so field names are those in the EC2 API reference, e.g.:
+//
+//	where = "State.Name == 'running' && length(BlockDeviceMappings) > `1`"
+//
+// This complements the "filter" blocks, which are limited to the filters
+// supported by the EC2 API.
+func whereSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validJMESPathExpression,
+	}
+}
+
+// sortBySchema returns a *schema.Schema that represents a JMESPath expression
+// evaluated locally against each object to produce the key by which the data
+// source's results are sorted in ascending order.
+func sortBySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validJMESPathExpression,
+	}
+}
+
+// limitSchema returns a *schema.Schema that represents the maximum number of
+// results returned by a plural data source after any "where" and "sort_by"
+// expressions have been applied.
+func limitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+}
+
+// filterByExpressionsFromResourceData applies the "where", "sort_by" and "limit"
+// arguments of a plural data source to the API objects returned by the EC2 API.
+func filterByExpressionsFromResourceData[T any](d *schema.ResourceData, apiObjects []T) ([]T, error) {
+	return filterByExpressions(apiObjects, d.Get("where").(string), d.Get("sort_by").(string), d.Get("limit").(int))
+}
+
+// filterByExpressions returns the API objects for which the where JMESPath expression is truthy,
+// stably sorted in ascending order of the sortBy JMESPath expression's result and limited to limit objects.
+// Empty expressions and a zero limit are ignored.
+//
+// Sort keys must be numbers, strings or null. Numbers sort before strings and nulls sort last.
+func filterByExpressions[T any](apiObjects []T, where, sortBy string, limit int) ([]T, error) {
+	if where == "" && sortBy == "" && limit == 0 {
+		return apiObjects, nil
+	}
+
+	var whereExpr, sortByExpr *jmespath.JMESPath
+	var err error
+
+	if where != "" {
+		if whereExpr, err = jmespath.Compile(where); err != nil {
+			return nil, fmt.Errorf("compiling where expression: %w", err)
+		}
+	}
+
+	if sortBy != "" {
+		if sortByExpr, err = jmespath.Compile(sortBy); err != nil {
+			return nil, fmt.Errorf("compiling sort_by expression: %w", err)
+		}
+	}
+
+	type result struct {
+		apiObject T
+		sortKey   any
+	}
+	results := make([]result, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		r := result{
+			apiObject: apiObject,
+		}
+
+		if whereExpr != nil || sortByExpr != nil {
+			data, err := jmespathData(apiObject)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if whereExpr != nil {
+				v, err := whereExpr.Search(data)
+
+				if err != nil {
+					return nil, fmt.Errorf("evaluating where expression: %w", err)
+				}
+
+				if !jmespathTruthy(v) {
+					continue
+				}
+			}
+
+			if sortByExpr != nil {
+				v, err := sortByExpr.Search(data)
+
+				if err != nil {
+					return nil, fmt.Errorf("evaluating sort_by expression: %w", err)
+				}
+
+				switch v.(type) {
+				case nil, float64, string:
+				default:
+					return nil, fmt.Errorf("sort_by expression must evaluate to a number, string or null, got %T", v)
+				}
+
+				r.sortKey = v
+			}
+		}
+
+		results = append(results, r)
+	}
+
+	if sortByExpr != nil {
+		slices.SortStableFunc(results, func(a, b result) int {
+			return compareSortKeys(a.sortKey, b.sortKey)
+		})
+	}
+
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+
+	return tfslices.ApplyToAll(results, func(v result) T {
+		return v.apiObject
+	}), nil
+}
+
+// jmespathData returns the JSON representation of an API object as JMESPath search data.
+func jmespathData(apiObject any) (any, error) {
+	b, err := json.Marshal(apiObject)
+
+	if err != nil {
+		return nil, fmt.Errorf("marshalling %T: %w", apiObject, err)
+	}
+
+	var data any
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, fmt.Errorf("unmarshalling %T: %w", apiObject, err)
+	}
+
+	return data, nil
+}
+
+// jmespathTruthy returns whether a JMESPath result is truthy.
+// See https://jmespath.org/specification.html#or-expressions.
+func jmespathTruthy(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case []any:
+		return len(v) > 0
+	case map[string]any:
+		return len(v) > 0
+	default:
+		return true
+	}
+}
+
+func compareSortKeys(a, b any) int {
+	rank := func(v any) int {
+		switch v.(type) {
+		case float64:
+			return 0
+		case string:
+			return 1
+		default:
+			return 2
+		}
+	}
+
+	if c := cmp.Compare(rank(a), rank(b)); c != 0 {
+		return c
+	}
+
+	switch a := a.(type) {
+	case float64:
+		return cmp.Compare(a, b.(float64))
+	case string:
+		return cmp.Compare(a, b.(string))
+	default:
+		return 0
+	}
+}
@@ -156,3 +156,97 @@ func TestNewCustomFilterList(t *testing.T) {
 		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
 	}
 }
+
+func TestFilterByExpressions(t *testing.T) {
+	t.Parallel()
+
+	vpcs := []any{
+		awstypes.Vpc{
+			CidrBlock: aws.String("10.1.0.0/16"),
+			IsDefault: aws.Bool(false),
+			Tags:      []awstypes.Tag{{Key: aws.String("Name"), Value: aws.String("b")}},
+			VpcId:     aws.String("vpc-1"),
+		},
+		awstypes.Vpc{
+			CidrBlock: aws.String("172.31.0.0/16"),
+			IsDefault: aws.Bool(true),
+			VpcId:     aws.String("vpc-2"),
+		},
+		awstypes.Vpc{
+			CidrBlock: aws.String("10.0.0.0/16"),
+			IsDefault: aws.Bool(false),
+			Tags:      []awstypes.Tag{{Key: aws.String("Name"), Value: aws.String("a")}},
+			VpcId:     aws.String("vpc-3"),
+		},
+	}
+
+	testCases := map[string]struct {
+		where         string
+		sortBy        string
+		limit         int
+		expectedIDs   []string
+		expectedError bool
+	}{
+		"no expressions": {
+			expectedIDs: []string{"vpc-1", "vpc-2", "vpc-3"},
+		},
+		"where": {
+			where:       "!IsDefault",
+			expectedIDs: []string{"vpc-1", "vpc-3"},
+		},
+		"where no matches": {
+			where: "CidrBlock == '192.168.0.0/16'",
+		},
+		"where truthy": {
+			where:       "Tags",
+			expectedIDs: []string{"vpc-1", "vpc-3"},
+		},
+		"sort_by": {
+			sortBy:      "CidrBlock",
+			expectedIDs: []string{"vpc-3", "vpc-1", "vpc-2"},
+		},
+		"sort_by null last": {
+			sortBy:      "Tags[?Key == 'Name'] | [0].Value",
+			expectedIDs: []string{"vpc-3", "vpc-1", "vpc-2"},
+		},
+		"sort_by invalid type": {
+			sortBy:        "Tags",
+			expectedError: true,
+		},
+		"limit": {
+			limit:       2,
+			expectedIDs: []string{"vpc-1", "vpc-2"},
+		},
+		"where sort_by limit": {
+			where:       "starts_with(CidrBlock, '10.')",
+			sortBy:      "CidrBlock",
+			limit:       1,
+			expectedIDs: []string{"vpc-3"},
+		},
+		"invalid where": {
+			where:         "CidrBlock ==",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			output, err := tfec2.FilterByExpressions(vpcs, testCase.where, testCase.sortBy, testCase.limit)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("FilterByExpressions() err %t, want %t: %v", got, want, err)
+			}
+
+			var ids []string
+			for _, v := range output {
+				ids = append(ids, aws.ToString(v.(awstypes.Vpc).VpcId))
+			}
+
+			if diff := cmp.Diff(ids, testCase.expectedIDs); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
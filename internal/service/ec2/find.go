@@ -1785,6 +1785,16 @@ func findNetworkACLAssociationBySubnetID(ctx context.Context, conn *ec2.Client,
 	}))
 }
 
+func findNetworkACLBySubnetID(ctx context.Context, conn *ec2.Client, subnetID string) (*awstypes.NetworkAcl, error) {
+	input := ec2.DescribeNetworkAclsInput{
+		Filters: newAttributeFilterList(map[string]string{
+			"association.subnet-id": subnetID,
+		}),
+	}
+
+	return findNetworkACL(ctx, conn, &input)
+}
+
 func findNetworkACLEntryByThreePartKey(ctx context.Context, conn *ec2.Client, naclID string, egress bool, ruleNumber int) (*awstypes.NetworkAclEntry, error) {
 	input := ec2.DescribeNetworkAclsInput{
 		Filters: newAttributeFilterList(map[string]string{
@@ -1843,6 +1853,24 @@ func findVPCMainRouteTable(ctx context.Context, conn *ec2.Client, id string) (*a
 	return findRouteTable(ctx, conn, &input)
 }
 
+// findRouteTableBySubnetID returns the route table used by the specified subnet,
+// falling back to the VPC's main route table if the subnet has no explicit association.
+func findRouteTableBySubnetID(ctx context.Context, conn *ec2.Client, subnetID, vpcID string) (*awstypes.RouteTable, error) {
+	input := ec2.DescribeRouteTablesInput{
+		Filters: newAttributeFilterList(map[string]string{
+			"association.subnet-id": subnetID,
+		}),
+	}
+
+	output, err := findRouteTable(ctx, conn, &input)
+
+	if tfresource.NotFound(err) {
+		return findVPCMainRouteTable(ctx, conn, vpcID)
+	}
+
+	return output, err
+}
+
 func findRouteTable(ctx context.Context, conn *ec2.Client, input *ec2.DescribeRouteTablesInput) (*awstypes.RouteTable, error) {
 	output, err := findRouteTables(ctx, conn, input)
 
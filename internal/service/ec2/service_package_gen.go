@@ -37,6 +37,11 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			TypeName: "aws_vpc_ipams",
 			Name:     "IPAMs",
 		},
+		{
+			Factory:  newReachabilityCheckDataSource,
+			TypeName: "aws_vpc_reachability_check",
+			Name:     "Reachability Check",
+		},
 		{
 			Factory:  newSecurityGroupRuleDataSource,
 			TypeName: "aws_vpc_security_group_rule",
@@ -137,11 +142,21 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  newSecurityGroupRulesExclusiveResource,
+			TypeName: "aws_vpc_security_group_rules_exclusive",
+			Name:     "Security Group Rules Exclusive",
+		},
 		{
 			Factory:  newResourceSecurityGroupVPCAssociation,
 			TypeName: "aws_vpc_security_group_vpc_association",
 			Name:     "Security Group VPC Association",
 		},
+		{
+			Factory:  newSubnetCIDRPlanResource,
+			TypeName: "aws_vpc_subnet_cidr_plan",
+			Name:     "Subnet CIDR Plan",
+		},
 	}
 }
 
@@ -598,6 +613,21 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 	}
 }
 
+func (p *servicePackage) SDKListResources(ctx context.Context) []*types.ServicePackageSDKListResource {
+	return []*types.ServicePackageSDKListResource{
+		{
+			Factory:  newInstanceListResource,
+			TypeName: "aws_instance",
+			Name:     "Instance",
+		},
+		{
+			Factory:  newSecurityGroupListResource,
+			TypeName: "aws_security_group",
+			Name:     "Security Group",
+		},
+	}
+}
+
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
 		{
@@ -1044,6 +1074,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				IdentityAttribute: names.AttrID,
+			},
 		},
 		{
 			Factory:  resourceInternetGateway,
@@ -1156,6 +1189,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				IdentityAttribute: names.AttrID,
+			},
 		},
 		{
 			Factory:  resourceSecurityGroupRule,
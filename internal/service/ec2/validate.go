@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/YakDriver/regexache"
+	"github.com/jmespath/go-jmespath"
 )
 
 func validSecurityGroupRuleDescription(v any, k string) (ws []string, errors []error) {
@@ -28,6 +29,14 @@ func validSecurityGroupRuleDescription(v any, k string) (ws []string, errors []e
 	return
 }
 
+func validJMESPathExpression(v any, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := jmespath.Compile(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid JMESPath expression: %w", k, err))
+	}
+	return
+}
+
 // validNestedExactlyOneOf is called on the map representing a nested schema element
 // Once ExactlyOneOf is supported for nested elements, this should be deprecated.
 func validNestedExactlyOneOf(m map[string]any, valid []string) error {
@@ -37,3 +37,31 @@ func TestValidSecurityGroupRuleDescription(t *testing.T) {
 		}
 	}
 }
+
+func TestValidJMESPathExpression(t *testing.T) {
+	t.Parallel()
+
+	validExpressions := []string{
+		"State.Name == 'running'",
+		"Tags[?Key == 'Name'] | [0].Value",
+		"length(BlockDeviceMappings) > `1`",
+	}
+	for _, v := range validExpressions {
+		_, errors := validJMESPathExpression(v, "where")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid JMESPath expression: %q", v, errors)
+		}
+	}
+
+	invalidExpressions := []string{
+		"State.Name ==",
+		"Tags[?Key == 'Name'",
+		"length(",
+	}
+	for _, v := range invalidExpressions {
+		_, errors := validJMESPathExpression(v, "where")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid JMESPath expression", v)
+		}
+	}
+}
@@ -0,0 +1,358 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"cmp"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// Reachability check steps, in the order traffic passes through them.
+const (
+	reachabilityStepSourceSecurityGroup      = "source-security-group-egress"
+	reachabilityStepSourceNetworkACL         = "source-network-acl-egress"
+	reachabilityStepSourceRoute              = "source-route"
+	reachabilityStepDestinationNetworkACL    = "destination-network-acl-ingress"
+	reachabilityStepDestinationSecurityGroup = "destination-security-group-ingress"
+	reachabilityStepDestinationReturnACL     = "destination-network-acl-egress"
+	reachabilityStepDestinationReturnRoute   = "destination-return-route"
+	reachabilityStepSourceReturnACL          = "source-network-acl-ingress"
+)
+
+// Return traffic is sent to the source's ephemeral port, which isn't known, so network ACLs must allow the whole range.
+const (
+	reachabilityEphemeralPortFrom = 1024
+	reachabilityEphemeralPortTo   = 65535
+)
+
+// reachabilityEndpoint is the source or destination of a reachability check.
+// The source can be a network interface or a CIDR block; the destination is always a network interface.
+type reachabilityEndpoint struct {
+	networkACL         *awstypes.NetworkAcl
+	networkInterfaceID string
+	prefix             netip.Prefix // A single address for network interfaces.
+	routeTable         *awstypes.RouteTable
+	securityGroupIDs   []string
+	securityGroupRules []awstypes.SecurityGroupRule
+	subnetID           string
+	vpcID              string
+}
+
+func (e reachabilityEndpoint) isNetworkInterface() bool {
+	return e.networkInterfaceID != ""
+}
+
+// reachabilityCheck evaluates whether traffic from a source can reach a destination port
+// using the security groups, network ACLs, route tables and prefix lists that apply to the source and destination.
+// Only IPv4 traffic is evaluated. Security groups are stateful, but network ACLs are not, so
+// the return path through network ACLs to the source's ephemeral ports is also evaluated.
+type reachabilityCheck struct {
+	destination reachabilityEndpoint
+	port        int32
+	prefixLists map[string][]netip.Prefix // Keyed by prefix list ID.
+	protocol    string
+	source      reachabilityEndpoint
+}
+
+type reachabilityHop struct {
+	Allowed     bool
+	ComponentID string
+	Explanation string
+	Rule        string
+	Step        string
+}
+
+type reachabilityResult struct {
+	Hops      []reachabilityHop
+	Reachable bool
+}
+
+func (c *reachabilityCheck) evaluate() reachabilityResult {
+	var hops []reachabilityHop
+
+	src, dst := c.source, c.destination
+	// Network ACLs and route tables don't apply to traffic within a subnet.
+	sameSubnet := src.isNetworkInterface() && src.subnetID == dst.subnetID
+
+	if src.isNetworkInterface() {
+		hops = append(hops, c.securityGroupHop(reachabilityStepSourceSecurityGroup, src, dst, true))
+
+		if !sameSubnet {
+			hops = append(hops, c.networkACLHop(reachabilityStepSourceNetworkACL, src.networkACL, dst, true, c.port, c.port))
+			hops = append(hops, c.routeHop(reachabilityStepSourceRoute, src.routeTable, dst))
+		}
+	}
+
+	if !sameSubnet {
+		hops = append(hops, c.networkACLHop(reachabilityStepDestinationNetworkACL, dst.networkACL, src, false, c.port, c.port))
+	}
+
+	hops = append(hops, c.securityGroupHop(reachabilityStepDestinationSecurityGroup, dst, src, false))
+
+	if !sameSubnet {
+		hops = append(hops, c.networkACLHop(reachabilityStepDestinationReturnACL, dst.networkACL, src, true, reachabilityEphemeralPortFrom, reachabilityEphemeralPortTo))
+	}
+
+	if !src.isNetworkInterface() || src.vpcID != dst.vpcID {
+		hops = append(hops, c.routeHop(reachabilityStepDestinationReturnRoute, dst.routeTable, src))
+	}
+
+	if src.isNetworkInterface() && !sameSubnet {
+		hops = append(hops, c.networkACLHop(reachabilityStepSourceReturnACL, src.networkACL, dst, false, reachabilityEphemeralPortFrom, reachabilityEphemeralPortTo))
+	}
+
+	return reachabilityResult{
+		Hops: hops,
+		Reachable: !slices.ContainsFunc(hops, func(v reachabilityHop) bool {
+			return !v.Allowed
+		}),
+	}
+}
+
+// securityGroupHop evaluates the egress or ingress rules of the security groups of the specified network interface.
+// Security groups only contain allow rules, so the traffic is allowed if any rule matches.
+func (c *reachabilityCheck) securityGroupHop(step string, e, peer reachabilityEndpoint, egress bool) reachabilityHop {
+	for _, rule := range e.securityGroupRules {
+		if aws.ToBool(rule.IsEgress) != egress {
+			continue
+		}
+
+		if !c.matchesProtocolAndPort(aws.ToString(rule.IpProtocol), aws.ToInt32(rule.FromPort), aws.ToInt32(rule.ToPort)) {
+			continue
+		}
+
+		if v, ok := c.securityGroupRulePeer(rule, peer); ok {
+			return reachabilityHop{
+				Allowed:     true,
+				ComponentID: aws.ToString(rule.GroupId),
+				Explanation: fmt.Sprintf("%s %d allowed %s %s", c.protocol, c.port, directionPreposition(egress), v),
+				Rule:        aws.ToString(rule.SecurityGroupRuleId),
+				Step:        step,
+			}
+		}
+	}
+
+	return reachabilityHop{
+		ComponentID: strings.Join(e.securityGroupIDs, ","),
+		Explanation: fmt.Sprintf("no security group rule allows %s %d %s %s", c.protocol, c.port, directionPreposition(egress), peer.prefix),
+		Step:        step,
+	}
+}
+
+// securityGroupRulePeer returns a description of the rule's peer if it includes the specified endpoint.
+func (c *reachabilityCheck) securityGroupRulePeer(rule awstypes.SecurityGroupRule, peer reachabilityEndpoint) (string, bool) {
+	switch {
+	case rule.CidrIpv4 != nil:
+		if v, err := netip.ParsePrefix(aws.ToString(rule.CidrIpv4)); err == nil && prefixContains(v, peer.prefix) {
+			return v.String(), true
+		}
+	case rule.PrefixListId != nil:
+		if id := aws.ToString(rule.PrefixListId); c.prefixListContains(id, peer.prefix) {
+			return id, true
+		}
+	case rule.ReferencedGroupInfo != nil:
+		if id := aws.ToString(rule.ReferencedGroupInfo.GroupId); slices.Contains(peer.securityGroupIDs, id) {
+			return id, true
+		}
+	}
+
+	return "", false
+}
+
+// networkACLHop evaluates the egress or ingress entries of a network ACL in rule number order for a range of ports.
+// An allow entry must include the whole of the peer's CIDR block and the port range whereas a deny entry need only overlap them.
+func (c *reachabilityCheck) networkACLHop(step string, nacl *awstypes.NetworkAcl, peer reachabilityEndpoint, egress bool, fromPort, toPort int32) reachabilityHop {
+	if nacl == nil {
+		return reachabilityHop{
+			Explanation: "no network ACL is associated with the subnet",
+			Step:        step,
+		}
+	}
+
+	naclID := aws.ToString(nacl.NetworkAclId)
+	ports := portRangeString(fromPort, toPort)
+	entries := slices.SortedFunc(slices.Values(nacl.Entries), func(a, b awstypes.NetworkAclEntry) int {
+		return cmp.Compare(aws.ToInt32(a.RuleNumber), aws.ToInt32(b.RuleNumber))
+	})
+
+	for _, entry := range entries {
+		if aws.ToBool(entry.Egress) != egress || entry.CidrBlock == nil {
+			continue
+		}
+
+		protocol := protocolForValue(aws.ToString(entry.Protocol))
+		if protocol != "-1" && protocol != c.protocol {
+			continue
+		}
+
+		// Entries for all protocols apply to all ports.
+		from, to := int32(0), int32(65535)
+		if v := entry.PortRange; v != nil && protocol != "-1" {
+			from, to = aws.ToInt32(v.From), aws.ToInt32(v.To)
+		}
+		if to < fromPort || toPort < from {
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(aws.ToString(entry.CidrBlock))
+		if err != nil || !prefix.Overlaps(peer.prefix) {
+			continue
+		}
+
+		hop := reachabilityHop{
+			ComponentID: naclID,
+			Rule:        strconv.Itoa(int(aws.ToInt32(entry.RuleNumber))),
+			Step:        step,
+		}
+
+		if entry.RuleAction == awstypes.RuleActionAllow {
+			// Only part of the peer's CIDR block or the port range is allowed.
+			if !prefixContains(prefix, peer.prefix) || from > fromPort || to < toPort {
+				continue
+			}
+
+			hop.Allowed = true
+			hop.Explanation = fmt.Sprintf("%s %s allowed %s %s", c.protocol, ports, directionPreposition(egress), prefix)
+		} else {
+			hop.Explanation = fmt.Sprintf("%s %s denied %s %s", c.protocol, ports, directionPreposition(egress), prefix)
+		}
+
+		return hop
+	}
+
+	return reachabilityHop{
+		ComponentID: naclID,
+		Explanation: fmt.Sprintf("no network ACL entry allows %s %s %s %s", c.protocol, ports, directionPreposition(egress), peer.prefix),
+		Step:        step,
+	}
+}
+
+// routeHop finds the most specific route to the peer in a route table.
+// Traffic to a network interface in the same VPC must use the local route and traffic to a network interface in another VPC
+// must use a VPC peering connection or transit gateway. Any active route can be used for traffic to a CIDR block.
+func (c *reachabilityCheck) routeHop(step string, routeTable *awstypes.RouteTable, peer reachabilityEndpoint) reachabilityHop {
+	if routeTable == nil {
+		return reachabilityHop{
+			Explanation: "no route table is associated with the subnet",
+			Step:        step,
+		}
+	}
+
+	routeTableID := aws.ToString(routeTable.RouteTableId)
+	var route *awstypes.Route
+	var destination netip.Prefix
+
+	for _, v := range routeTable.Routes {
+		var prefixes []netip.Prefix
+		switch {
+		case v.DestinationCidrBlock != nil:
+			if prefix, err := netip.ParsePrefix(aws.ToString(v.DestinationCidrBlock)); err == nil {
+				prefixes = append(prefixes, prefix)
+			}
+		case v.DestinationPrefixListId != nil:
+			prefixes = c.prefixLists[aws.ToString(v.DestinationPrefixListId)]
+		}
+
+		for _, prefix := range prefixes {
+			if prefixContains(prefix, peer.prefix) && (route == nil || prefix.Bits() > destination.Bits()) {
+				route, destination = &v, prefix
+			}
+		}
+	}
+
+	if route == nil {
+		return reachabilityHop{
+			ComponentID: routeTableID,
+			Explanation: fmt.Sprintf("no route to %s", peer.prefix),
+			Step:        step,
+		}
+	}
+
+	hop := reachabilityHop{
+		ComponentID: routeTableID,
+		Rule:        destination.String(),
+		Step:        step,
+	}
+	target := routeTarget(route)
+
+	switch {
+	case route.State == awstypes.RouteStateBlackhole:
+		hop.Explanation = fmt.Sprintf("route to %s via %s is a blackhole", destination, target)
+	case peer.isNetworkInterface() && peer.vpcID == aws.ToString(routeTable.VpcId) && target != gatewayIDLocal:
+		hop.Explanation = fmt.Sprintf("route to %s via %s does not use the local route", destination, target)
+	case peer.isNetworkInterface() && peer.vpcID != aws.ToString(routeTable.VpcId) && route.VpcPeeringConnectionId == nil && route.TransitGatewayId == nil:
+		hop.Explanation = fmt.Sprintf("route to %s via %s does not use a VPC peering connection or transit gateway", destination, target)
+	default:
+		hop.Allowed = true
+		hop.Explanation = fmt.Sprintf("route to %s via %s", destination, target)
+	}
+
+	return hop
+}
+
+// matchesProtocolAndPort returns whether a rule's protocol and port range include the checked protocol and port.
+func (c *reachabilityCheck) matchesProtocolAndPort(protocol string, from, to int32) bool {
+	switch protocolForValue(protocol) {
+	case "-1":
+		return true
+	case c.protocol:
+		// Security group rules for all ports of a protocol have no port range.
+		return (from == -1 && to == -1) || (from <= c.port && c.port <= to)
+	default:
+		return false
+	}
+}
+
+func (c *reachabilityCheck) prefixListContains(id string, prefix netip.Prefix) bool {
+	return slices.ContainsFunc(c.prefixLists[id], func(v netip.Prefix) bool {
+		return prefixContains(v, prefix)
+	})
+}
+
+// prefixContains returns whether the outer CIDR block includes every address in the inner CIDR block.
+func prefixContains(outer, inner netip.Prefix) bool {
+	return outer.Bits() <= inner.Bits() && outer.Contains(inner.Addr())
+}
+
+func portRangeString(from, to int32) string {
+	if from == to {
+		return strconv.Itoa(int(from))
+	}
+	return fmt.Sprintf("%d-%d", from, to)
+}
+
+func directionPreposition(egress bool) string {
+	if egress {
+		return "to"
+	}
+	return "from"
+}
+
+func routeTarget(route *awstypes.Route) string {
+	for _, v := range []*string{
+		route.GatewayId,
+		route.NatGatewayId,
+		route.TransitGatewayId,
+		route.VpcPeeringConnectionId,
+		route.NetworkInterfaceId,
+		route.InstanceId,
+		route.LocalGatewayId,
+		route.CarrierGatewayId,
+		route.EgressOnlyInternetGatewayId,
+		route.CoreNetworkArn,
+	} {
+		if v != nil {
+			return aws.ToString(v)
+		}
+	}
+
+	return "<unknown>"
+}
@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_vpc_reachability_check", name="Reachability Check")
+func newReachabilityCheckDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &reachabilityCheckDataSource{}, nil
+}
+
+type reachabilityCheckDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *reachabilityCheckDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"destination_network_interface_id": schema.StringAttribute{
+				Required: true,
+			},
+			"hops": framework.DataSourceComputedListOfObjectAttribute[reachabilityHopModel](ctx),
+			names.AttrPort: schema.Int32Attribute{
+				Required: true,
+				Validators: []validator.Int32{
+					int32validator.Between(0, 65535),
+				},
+			},
+			names.AttrProtocol: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("tcp", "udp"),
+				},
+			},
+			"reachable": schema.BoolAttribute{
+				Computed: true,
+			},
+			"source_cidr_block": schema.StringAttribute{
+				CustomType: fwtypes.CIDRBlockType,
+				Optional:   true,
+				Validators: []validator.String{
+					fwvalidators.IPv4CIDRNetworkAddress(),
+				},
+			},
+			"source_network_interface_id": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (d *reachabilityCheckDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("source_cidr_block"),
+			path.MatchRoot("source_network_interface_id"),
+		),
+	}
+}
+
+func (d *reachabilityCheckDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data reachabilityCheckDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().EC2Client(ctx)
+
+	destination, err := findReachabilityEndpointByNetworkInterfaceID(ctx, conn, data.DestinationNetworkInterfaceID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError("reading VPC Reachability Check destination", err.Error())
+
+		return
+	}
+
+	var source reachabilityEndpoint
+	if data.SourceNetworkInterfaceID.IsNull() {
+		prefix, err := netip.ParsePrefix(data.SourceCIDRBlock.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError("reading VPC Reachability Check source", err.Error())
+
+			return
+		}
+
+		source = reachabilityEndpoint{
+			prefix: prefix.Masked(),
+		}
+	} else {
+		source, err = findReachabilityEndpointByNetworkInterfaceID(ctx, conn, data.SourceNetworkInterfaceID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError("reading VPC Reachability Check source", err.Error())
+
+			return
+		}
+	}
+
+	prefixLists, err := findReachabilityPrefixLists(ctx, conn, source, destination)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading VPC Reachability Check prefix lists", err.Error())
+
+		return
+	}
+
+	check := reachabilityCheck{
+		destination: destination,
+		port:        data.Port.ValueInt32(),
+		prefixLists: prefixLists,
+		protocol:    data.Protocol.ValueString(),
+		source:      source,
+	}
+	result := check.evaluate()
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, result, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// findReachabilityEndpointByNetworkInterfaceID returns the reachability check endpoint for the specified network interface,
+// with the rules of its security groups and its subnet's network ACL and route table.
+func findReachabilityEndpointByNetworkInterfaceID(ctx context.Context, conn *ec2.Client, id string) (reachabilityEndpoint, error) {
+	var endpoint reachabilityEndpoint
+
+	eni, err := findNetworkInterfaceByID(ctx, conn, id)
+
+	if err != nil {
+		return endpoint, fmt.Errorf("reading EC2 Network Interface (%s): %w", id, err)
+	}
+
+	addr, err := netip.ParseAddr(aws.ToString(eni.PrivateIpAddress))
+
+	if err != nil {
+		return endpoint, fmt.Errorf("EC2 Network Interface (%s) has no private IPv4 address: %w", id, err)
+	}
+
+	endpoint.networkInterfaceID = id
+	endpoint.prefix = netip.PrefixFrom(addr, addr.BitLen())
+	endpoint.subnetID = aws.ToString(eni.SubnetId)
+	endpoint.vpcID = aws.ToString(eni.VpcId)
+
+	for _, v := range eni.Groups {
+		securityGroupID := aws.ToString(v.GroupId)
+		rules, err := findSecurityGroupRulesBySecurityGroupID(ctx, conn, securityGroupID)
+
+		if err != nil {
+			return endpoint, fmt.Errorf("reading VPC Security Group (%s) Rules: %w", securityGroupID, err)
+		}
+
+		endpoint.securityGroupIDs = append(endpoint.securityGroupIDs, securityGroupID)
+		endpoint.securityGroupRules = append(endpoint.securityGroupRules, rules...)
+	}
+
+	endpoint.networkACL, err = findNetworkACLBySubnetID(ctx, conn, endpoint.subnetID)
+
+	if err != nil {
+		return endpoint, fmt.Errorf("reading EC2 Network ACL for Subnet (%s): %w", endpoint.subnetID, err)
+	}
+
+	endpoint.routeTable, err = findRouteTableBySubnetID(ctx, conn, endpoint.subnetID, endpoint.vpcID)
+
+	if err != nil {
+		return endpoint, fmt.Errorf("reading EC2 Route Table for Subnet (%s): %w", endpoint.subnetID, err)
+	}
+
+	return endpoint, nil
+}
+
+// findReachabilityPrefixLists returns the IPv4 entries of the prefix lists referenced by the endpoints' security group rules and routes.
+func findReachabilityPrefixLists(ctx context.Context, conn *ec2.Client, endpoints ...reachabilityEndpoint) (map[string][]netip.Prefix, error) {
+	var ids []string
+	for _, endpoint := range endpoints {
+		for _, v := range endpoint.securityGroupRules {
+			if v.PrefixListId != nil {
+				ids = append(ids, aws.ToString(v.PrefixListId))
+			}
+		}
+		if endpoint.routeTable != nil {
+			for _, v := range endpoint.routeTable.Routes {
+				if v.DestinationPrefixListId != nil {
+					ids = append(ids, aws.ToString(v.DestinationPrefixListId))
+				}
+			}
+		}
+	}
+
+	slices.Sort(ids)
+	prefixLists := make(map[string][]netip.Prefix)
+	for _, id := range slices.Compact(ids) {
+		entries, err := findManagedPrefixListEntriesByID(ctx, conn, id)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading EC2 Managed Prefix List (%s) Entries: %w", id, err)
+		}
+
+		var prefixes []netip.Prefix
+		for _, v := range entries {
+			if prefix, err := netip.ParsePrefix(aws.ToString(v.Cidr)); err == nil && prefix.Addr().Is4() {
+				prefixes = append(prefixes, prefix)
+			}
+		}
+		prefixLists[id] = prefixes
+	}
+
+	return prefixLists, nil
+}
+
+type reachabilityCheckDataSourceModel struct {
+	DestinationNetworkInterfaceID types.String                                          `tfsdk:"destination_network_interface_id"`
+	Hops                          fwtypes.ListNestedObjectValueOf[reachabilityHopModel] `tfsdk:"hops"`
+	Port                          types.Int32                                           `tfsdk:"port"`
+	Protocol                      types.String                                          `tfsdk:"protocol"`
+	Reachable                     types.Bool                                            `tfsdk:"reachable"`
+	SourceCIDRBlock               fwtypes.CIDRBlock                                     `tfsdk:"source_cidr_block"`
+	SourceNetworkInterfaceID      types.String                                          `tfsdk:"source_network_interface_id"`
+}
+
+type reachabilityHopModel struct {
+	Allowed     types.Bool   `tfsdk:"allowed"`
+	ComponentID types.String `tfsdk:"component_id"`
+	Explanation types.String `tfsdk:"explanation"`
+	Rule        types.String `tfsdk:"rule"`
+	Step        types.String `tfsdk:"step"`
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCReachabilityCheckDataSource_networkInterface(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_vpc_reachability_check.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCReachabilityCheckDataSourceConfig_networkInterface(rName, 443),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "reachable", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "hops.#", "7"),
+					resource.TestCheckResourceAttr(dataSourceName, "hops.0.step", "source-security-group-egress"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "hops.4.component_id", "aws_security_group.destination", names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "hops.4.rule", "aws_vpc_security_group_ingress_rule.test", names.AttrID),
+				),
+			},
+			{
+				Config: testAccVPCReachabilityCheckDataSourceConfig_networkInterface(rName, 22),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "reachable", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "hops.#", "7"),
+					resource.TestCheckResourceAttr(dataSourceName, "hops.4.allowed", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "hops.4.step", "destination-security-group-ingress"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCReachabilityCheckDataSource_cidrBlock(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_vpc_reachability_check.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCReachabilityCheckDataSourceConfig_cidrBlock(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "reachable", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "hops.#", "4"),
+					resource.TestCheckResourceAttr(dataSourceName, "hops.0.step", "destination-network-acl-ingress"),
+					resource.TestCheckResourceAttr(dataSourceName, "hops.3.step", "destination-return-route"),
+					resource.TestCheckResourceAttr(dataSourceName, "hops.3.allowed", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCReachabilityCheckDataSourceConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_security_group" "source" {
+  name   = "%[1]s-source"
+  vpc_id = aws_vpc.test.id
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "destination" {
+  name   = "%[1]s-destination"
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.destination.id
+
+  referenced_security_group_id = aws_security_group.source.id
+  from_port                    = 443
+  ip_protocol                  = "tcp"
+  to_port                      = 443
+}
+
+resource "aws_network_interface" "source" {
+  subnet_id       = aws_subnet.test[0].id
+  security_groups = [aws_security_group.source.id]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface" "destination" {
+  subnet_id       = aws_subnet.test[1].id
+  security_groups = [aws_security_group.destination.id]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccVPCReachabilityCheckDataSourceConfig_networkInterface(rName string, port int) string {
+	return acctest.ConfigCompose(testAccVPCReachabilityCheckDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_vpc_reachability_check" "test" {
+  source_network_interface_id      = aws_network_interface.source.id
+  destination_network_interface_id = aws_network_interface.destination.id
+  protocol                         = "tcp"
+  port                             = %[1]d
+
+  depends_on = [aws_vpc_security_group_ingress_rule.test]
+}
+`, port))
+}
+
+func testAccVPCReachabilityCheckDataSourceConfig_cidrBlock(rName string) string {
+	return acctest.ConfigCompose(testAccVPCReachabilityCheckDataSourceConfig_base(rName), `
+data "aws_vpc_reachability_check" "test" {
+  source_cidr_block                = "192.0.2.0/24"
+  destination_network_interface_id = aws_network_interface.destination.id
+  protocol                         = "tcp"
+  port                             = 443
+
+  depends_on = [aws_vpc_security_group_ingress_rule.test]
+}
+`)
+}
@@ -0,0 +1,319 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReachabilityCheck(t *testing.T) {
+	t.Parallel()
+
+	allowAllNACL := func(id string) *awstypes.NetworkAcl {
+		return &awstypes.NetworkAcl{
+			NetworkAclId: aws.String(id),
+			Entries: []awstypes.NetworkAclEntry{
+				{RuleNumber: aws.Int32(100), Egress: aws.Bool(false), Protocol: aws.String("-1"), CidrBlock: aws.String("0.0.0.0/0"), RuleAction: awstypes.RuleActionAllow},
+				{RuleNumber: aws.Int32(100), Egress: aws.Bool(true), Protocol: aws.String("-1"), CidrBlock: aws.String("0.0.0.0/0"), RuleAction: awstypes.RuleActionAllow},
+				{RuleNumber: aws.Int32(32767), Egress: aws.Bool(false), Protocol: aws.String("-1"), CidrBlock: aws.String("0.0.0.0/0"), RuleAction: awstypes.RuleActionDeny},
+				{RuleNumber: aws.Int32(32767), Egress: aws.Bool(true), Protocol: aws.String("-1"), CidrBlock: aws.String("0.0.0.0/0"), RuleAction: awstypes.RuleActionDeny},
+			},
+		}
+	}
+	routeTable := func(vpcID string, routes ...awstypes.Route) *awstypes.RouteTable {
+		return &awstypes.RouteTable{
+			RouteTableId: aws.String("rtb-" + vpcID),
+			VpcId:        aws.String(vpcID),
+			Routes: append([]awstypes.Route{
+				{DestinationCidrBlock: aws.String("10.0.0.0/16"), GatewayId: aws.String("local"), State: awstypes.RouteStateActive},
+			}, routes...),
+		}
+	}
+	egressAll := awstypes.SecurityGroupRule{
+		SecurityGroupRuleId: aws.String("sgr-egress"),
+		GroupId:             aws.String("sg-source"),
+		IsEgress:            aws.Bool(true),
+		IpProtocol:          aws.String("-1"),
+		FromPort:            aws.Int32(-1),
+		ToPort:              aws.Int32(-1),
+		CidrIpv4:            aws.String("0.0.0.0/0"),
+	}
+	source := reachabilityEndpoint{
+		networkACL:         allowAllNACL("acl-source"),
+		networkInterfaceID: "eni-source",
+		prefix:             netip.MustParsePrefix("10.0.1.10/32"),
+		routeTable:         routeTable("vpc-1"),
+		securityGroupIDs:   []string{"sg-source"},
+		securityGroupRules: []awstypes.SecurityGroupRule{egressAll},
+		subnetID:           "subnet-source",
+		vpcID:              "vpc-1",
+	}
+	destination := func(rules ...awstypes.SecurityGroupRule) reachabilityEndpoint {
+		return reachabilityEndpoint{
+			networkACL:         allowAllNACL("acl-destination"),
+			networkInterfaceID: "eni-destination",
+			prefix:             netip.MustParsePrefix("10.0.2.20/32"),
+			routeTable:         routeTable("vpc-1", awstypes.Route{DestinationCidrBlock: aws.String("0.0.0.0/0"), GatewayId: aws.String("igw-1"), State: awstypes.RouteStateActive}),
+			securityGroupIDs:   []string{"sg-destination"},
+			securityGroupRules: rules,
+			subnetID:           "subnet-destination",
+			vpcID:              "vpc-1",
+		}
+	}
+	ingressFromSource := awstypes.SecurityGroupRule{
+		SecurityGroupRuleId: aws.String("sgr-ingress"),
+		GroupId:             aws.String("sg-destination"),
+		IsEgress:            aws.Bool(false),
+		IpProtocol:          aws.String("tcp"),
+		FromPort:            aws.Int32(443),
+		ToPort:              aws.Int32(443),
+		ReferencedGroupInfo: &awstypes.ReferencedSecurityGroup{GroupId: aws.String("sg-source")},
+	}
+	ingressFromPrefixList := awstypes.SecurityGroupRule{
+		SecurityGroupRuleId: aws.String("sgr-prefix-list"),
+		GroupId:             aws.String("sg-destination"),
+		IsEgress:            aws.Bool(false),
+		IpProtocol:          aws.String("6"),
+		FromPort:            aws.Int32(1024),
+		ToPort:              aws.Int32(65535),
+		PrefixListId:        aws.String("pl-12345678"),
+	}
+	prefixLists := map[string][]netip.Prefix{
+		"pl-12345678": {netip.MustParsePrefix("192.0.2.0/24")},
+	}
+
+	testCases := []struct {
+		name              string
+		check             reachabilityCheck
+		expectedHops      []reachabilityHop
+		expectedReachable bool
+	}{
+		{
+			name: "network interfaces in same VPC",
+			check: reachabilityCheck{
+				destination: destination(ingressFromSource),
+				port:        443,
+				protocol:    "tcp",
+				source:      source,
+			},
+			expectedHops: []reachabilityHop{
+				{Allowed: true, ComponentID: "sg-source", Explanation: "tcp 443 allowed to 0.0.0.0/0", Rule: "sgr-egress", Step: reachabilityStepSourceSecurityGroup},
+				{Allowed: true, ComponentID: "acl-source", Explanation: "tcp 443 allowed to 0.0.0.0/0", Rule: "100", Step: reachabilityStepSourceNetworkACL},
+				{Allowed: true, ComponentID: "rtb-vpc-1", Explanation: "route to 10.0.0.0/16 via local", Rule: "10.0.0.0/16", Step: reachabilityStepSourceRoute},
+				{Allowed: true, ComponentID: "acl-destination", Explanation: "tcp 443 allowed from 0.0.0.0/0", Rule: "100", Step: reachabilityStepDestinationNetworkACL},
+				{Allowed: true, ComponentID: "sg-destination", Explanation: "tcp 443 allowed from sg-source", Rule: "sgr-ingress", Step: reachabilityStepDestinationSecurityGroup},
+				{Allowed: true, ComponentID: "acl-destination", Explanation: "tcp 1024-65535 allowed to 0.0.0.0/0", Rule: "100", Step: reachabilityStepDestinationReturnACL},
+				{Allowed: true, ComponentID: "acl-source", Explanation: "tcp 1024-65535 allowed from 0.0.0.0/0", Rule: "100", Step: reachabilityStepSourceReturnACL},
+			},
+			expectedReachable: true,
+		},
+		{
+			name: "port not allowed by destination security group",
+			check: reachabilityCheck{
+				destination: destination(ingressFromSource),
+				port:        22,
+				protocol:    "tcp",
+				source:      source,
+			},
+			expectedHops: []reachabilityHop{
+				{Allowed: true, ComponentID: "sg-source", Explanation: "tcp 22 allowed to 0.0.0.0/0", Rule: "sgr-egress", Step: reachabilityStepSourceSecurityGroup},
+				{Allowed: true, ComponentID: "acl-source", Explanation: "tcp 22 allowed to 0.0.0.0/0", Rule: "100", Step: reachabilityStepSourceNetworkACL},
+				{Allowed: true, ComponentID: "rtb-vpc-1", Explanation: "route to 10.0.0.0/16 via local", Rule: "10.0.0.0/16", Step: reachabilityStepSourceRoute},
+				{Allowed: true, ComponentID: "acl-destination", Explanation: "tcp 22 allowed from 0.0.0.0/0", Rule: "100", Step: reachabilityStepDestinationNetworkACL},
+				{ComponentID: "sg-destination", Explanation: "no security group rule allows tcp 22 from 10.0.1.10/32", Step: reachabilityStepDestinationSecurityGroup},
+				{Allowed: true, ComponentID: "acl-destination", Explanation: "tcp 1024-65535 allowed to 0.0.0.0/0", Rule: "100", Step: reachabilityStepDestinationReturnACL},
+				{Allowed: true, ComponentID: "acl-source", Explanation: "tcp 1024-65535 allowed from 0.0.0.0/0", Rule: "100", Step: reachabilityStepSourceReturnACL},
+			},
+		},
+		{
+			name: "network interfaces in same subnet",
+			check: reachabilityCheck{
+				destination: func() reachabilityEndpoint {
+					v := destination(ingressFromSource)
+					v.networkACL = nil
+					v.subnetID = source.subnetID
+					return v
+				}(),
+				port:     443,
+				protocol: "tcp",
+				source:   source,
+			},
+			expectedHops: []reachabilityHop{
+				{Allowed: true, ComponentID: "sg-source", Explanation: "tcp 443 allowed to 0.0.0.0/0", Rule: "sgr-egress", Step: reachabilityStepSourceSecurityGroup},
+				{Allowed: true, ComponentID: "sg-destination", Explanation: "tcp 443 allowed from sg-source", Rule: "sgr-ingress", Step: reachabilityStepDestinationSecurityGroup},
+			},
+			expectedReachable: true,
+		},
+		{
+			name: "CIDR block source via prefix list",
+			check: reachabilityCheck{
+				destination: destination(ingressFromPrefixList),
+				port:        8080,
+				prefixLists: prefixLists,
+				protocol:    "tcp",
+				source: reachabilityEndpoint{
+					prefix: netip.MustParsePrefix("192.0.2.128/25"),
+				},
+			},
+			expectedHops: []reachabilityHop{
+				{Allowed: true, ComponentID: "acl-destination", Explanation: "tcp 8080 allowed from 0.0.0.0/0", Rule: "100", Step: reachabilityStepDestinationNetworkACL},
+				{Allowed: true, ComponentID: "sg-destination", Explanation: "tcp 8080 allowed from pl-12345678", Rule: "sgr-prefix-list", Step: reachabilityStepDestinationSecurityGroup},
+				{Allowed: true, ComponentID: "acl-destination", Explanation: "tcp 1024-65535 allowed to 0.0.0.0/0", Rule: "100", Step: reachabilityStepDestinationReturnACL},
+				{Allowed: true, ComponentID: "rtb-vpc-1", Explanation: "route to 0.0.0.0/0 via igw-1", Rule: "0.0.0.0/0", Step: reachabilityStepDestinationReturnRoute},
+			},
+			expectedReachable: true,
+		},
+		{
+			name: "CIDR block source wider than prefix list",
+			check: reachabilityCheck{
+				destination: destination(ingressFromPrefixList),
+				port:        8080,
+				prefixLists: prefixLists,
+				protocol:    "tcp",
+				source: reachabilityEndpoint{
+					prefix: netip.MustParsePrefix("192.0.0.0/16"),
+				},
+			},
+			expectedHops: []reachabilityHop{
+				{Allowed: true, ComponentID: "acl-destination", Explanation: "tcp 8080 allowed from 0.0.0.0/0", Rule: "100", Step: reachabilityStepDestinationNetworkACL},
+				{ComponentID: "sg-destination", Explanation: "no security group rule allows tcp 8080 from 192.0.0.0/16", Step: reachabilityStepDestinationSecurityGroup},
+				{Allowed: true, ComponentID: "acl-destination", Explanation: "tcp 1024-65535 allowed to 0.0.0.0/0", Rule: "100", Step: reachabilityStepDestinationReturnACL},
+				{Allowed: true, ComponentID: "rtb-vpc-1", Explanation: "route to 0.0.0.0/0 via igw-1", Rule: "0.0.0.0/0", Step: reachabilityStepDestinationReturnRoute},
+			},
+		},
+		{
+			name: "network ACL deny before allow",
+			check: reachabilityCheck{
+				destination: func() reachabilityEndpoint {
+					v := destination(ingressFromPrefixList)
+					v.networkACL.Entries = append(v.networkACL.Entries, awstypes.NetworkAclEntry{
+						RuleNumber: aws.Int32(90), Egress: aws.Bool(false), Protocol: aws.String("6"), PortRange: &awstypes.PortRange{From: aws.Int32(8000), To: aws.Int32(8999)}, CidrBlock: aws.String("192.0.2.192/26"), RuleAction: awstypes.RuleActionDeny,
+					})
+					return v
+				}(),
+				port:        8080,
+				prefixLists: prefixLists,
+				protocol:    "tcp",
+				source: reachabilityEndpoint{
+					prefix: netip.MustParsePrefix("192.0.2.128/25"),
+				},
+			},
+			expectedHops: []reachabilityHop{
+				{ComponentID: "acl-destination", Explanation: "tcp 8080 denied from 192.0.2.192/26", Rule: "90", Step: reachabilityStepDestinationNetworkACL},
+				{Allowed: true, ComponentID: "sg-destination", Explanation: "tcp 8080 allowed from pl-12345678", Rule: "sgr-prefix-list", Step: reachabilityStepDestinationSecurityGroup},
+				{Allowed: true, ComponentID: "acl-destination", Explanation: "tcp 1024-65535 allowed to 0.0.0.0/0", Rule: "100", Step: reachabilityStepDestinationReturnACL},
+				{Allowed: true, ComponentID: "rtb-vpc-1", Explanation: "route to 0.0.0.0/0 via igw-1", Rule: "0.0.0.0/0", Step: reachabilityStepDestinationReturnRoute},
+			},
+		},
+		{
+			name: "network interface in peer VPC without peering route",
+			check: reachabilityCheck{
+				destination: func() reachabilityEndpoint {
+					v := destination(ingressFromSource)
+					v.prefix = netip.MustParsePrefix("10.1.2.20/32")
+					v.vpcID = "vpc-2"
+					v.routeTable = &awstypes.RouteTable{
+						RouteTableId: aws.String("rtb-vpc-2"),
+						VpcId:        aws.String("vpc-2"),
+						Routes: []awstypes.Route{
+							{DestinationCidrBlock: aws.String("10.1.0.0/16"), GatewayId: aws.String("local"), State: awstypes.RouteStateActive},
+							{DestinationCidrBlock: aws.String("10.0.0.0/16"), VpcPeeringConnectionId: aws.String("pcx-1"), State: awstypes.RouteStateActive},
+						},
+					}
+					return v
+				}(),
+				port:     443,
+				protocol: "tcp",
+				source: func() reachabilityEndpoint {
+					v := source
+					v.routeTable = routeTable("vpc-1", awstypes.Route{DestinationCidrBlock: aws.String("0.0.0.0/0"), NatGatewayId: aws.String("nat-1"), State: awstypes.RouteStateActive})
+					return v
+				}(),
+			},
+			expectedHops: []reachabilityHop{
+				{Allowed: true, ComponentID: "sg-source", Explanation: "tcp 443 allowed to 0.0.0.0/0", Rule: "sgr-egress", Step: reachabilityStepSourceSecurityGroup},
+				{Allowed: true, ComponentID: "acl-source", Explanation: "tcp 443 allowed to 0.0.0.0/0", Rule: "100", Step: reachabilityStepSourceNetworkACL},
+				{ComponentID: "rtb-vpc-1", Explanation: "route to 0.0.0.0/0 via nat-1 does not use a VPC peering connection or transit gateway", Rule: "0.0.0.0/0", Step: reachabilityStepSourceRoute},
+				{Allowed: true, ComponentID: "acl-destination", Explanation: "tcp 443 allowed from 0.0.0.0/0", Rule: "100", Step: reachabilityStepDestinationNetworkACL},
+				{Allowed: true, ComponentID: "sg-destination", Explanation: "tcp 443 allowed from sg-source", Rule: "sgr-ingress", Step: reachabilityStepDestinationSecurityGroup},
+				{Allowed: true, ComponentID: "acl-destination", Explanation: "tcp 1024-65535 allowed to 0.0.0.0/0", Rule: "100", Step: reachabilityStepDestinationReturnACL},
+				{Allowed: true, ComponentID: "rtb-vpc-2", Explanation: "route to 10.0.0.0/16 via pcx-1", Rule: "10.0.0.0/16", Step: reachabilityStepDestinationReturnRoute},
+				{Allowed: true, ComponentID: "acl-source", Explanation: "tcp 1024-65535 allowed from 0.0.0.0/0", Rule: "100", Step: reachabilityStepSourceReturnACL},
+			},
+		},
+		{
+			name: "return traffic not allowed by destination network ACL",
+			check: reachabilityCheck{
+				destination: func() reachabilityEndpoint {
+					v := destination(ingressFromSource)
+					v.networkACL = &awstypes.NetworkAcl{
+						NetworkAclId: aws.String("acl-destination"),
+						Entries: []awstypes.NetworkAclEntry{
+							{RuleNumber: aws.Int32(100), Egress: aws.Bool(false), Protocol: aws.String("6"), PortRange: &awstypes.PortRange{From: aws.Int32(443), To: aws.Int32(443)}, CidrBlock: aws.String("10.0.0.0/16"), RuleAction: awstypes.RuleActionAllow},
+							{RuleNumber: aws.Int32(100), Egress: aws.Bool(true), Protocol: aws.String("6"), PortRange: &awstypes.PortRange{From: aws.Int32(32768), To: aws.Int32(65535)}, CidrBlock: aws.String("10.0.0.0/16"), RuleAction: awstypes.RuleActionAllow},
+						},
+					}
+					return v
+				}(),
+				port:     443,
+				protocol: "tcp",
+				source:   source,
+			},
+			expectedHops: []reachabilityHop{
+				{Allowed: true, ComponentID: "sg-source", Explanation: "tcp 443 allowed to 0.0.0.0/0", Rule: "sgr-egress", Step: reachabilityStepSourceSecurityGroup},
+				{Allowed: true, ComponentID: "acl-source", Explanation: "tcp 443 allowed to 0.0.0.0/0", Rule: "100", Step: reachabilityStepSourceNetworkACL},
+				{Allowed: true, ComponentID: "rtb-vpc-1", Explanation: "route to 10.0.0.0/16 via local", Rule: "10.0.0.0/16", Step: reachabilityStepSourceRoute},
+				{Allowed: true, ComponentID: "acl-destination", Explanation: "tcp 443 allowed from 10.0.0.0/16", Rule: "100", Step: reachabilityStepDestinationNetworkACL},
+				{Allowed: true, ComponentID: "sg-destination", Explanation: "tcp 443 allowed from sg-source", Rule: "sgr-ingress", Step: reachabilityStepDestinationSecurityGroup},
+				{ComponentID: "acl-destination", Explanation: "no network ACL entry allows tcp 1024-65535 to 10.0.1.10/32", Step: reachabilityStepDestinationReturnACL},
+				{Allowed: true, ComponentID: "acl-source", Explanation: "tcp 1024-65535 allowed from 0.0.0.0/0", Rule: "100", Step: reachabilityStepSourceReturnACL},
+			},
+		},
+		{
+			name: "return traffic denied by source network ACL",
+			check: reachabilityCheck{
+				destination: destination(ingressFromSource),
+				port:        443,
+				protocol:    "tcp",
+				source: func() reachabilityEndpoint {
+					v := source
+					v.networkACL = allowAllNACL("acl-source")
+					v.networkACL.Entries = append(v.networkACL.Entries, awstypes.NetworkAclEntry{
+						RuleNumber: aws.Int32(90), Egress: aws.Bool(false), Protocol: aws.String("6"), PortRange: &awstypes.PortRange{From: aws.Int32(49152), To: aws.Int32(65535)}, CidrBlock: aws.String("10.0.2.0/24"), RuleAction: awstypes.RuleActionDeny,
+					})
+					return v
+				}(),
+			},
+			expectedHops: []reachabilityHop{
+				{Allowed: true, ComponentID: "sg-source", Explanation: "tcp 443 allowed to 0.0.0.0/0", Rule: "sgr-egress", Step: reachabilityStepSourceSecurityGroup},
+				{Allowed: true, ComponentID: "acl-source", Explanation: "tcp 443 allowed to 0.0.0.0/0", Rule: "100", Step: reachabilityStepSourceNetworkACL},
+				{Allowed: true, ComponentID: "rtb-vpc-1", Explanation: "route to 10.0.0.0/16 via local", Rule: "10.0.0.0/16", Step: reachabilityStepSourceRoute},
+				{Allowed: true, ComponentID: "acl-destination", Explanation: "tcp 443 allowed from 0.0.0.0/0", Rule: "100", Step: reachabilityStepDestinationNetworkACL},
+				{Allowed: true, ComponentID: "sg-destination", Explanation: "tcp 443 allowed from sg-source", Rule: "sgr-ingress", Step: reachabilityStepDestinationSecurityGroup},
+				{Allowed: true, ComponentID: "acl-destination", Explanation: "tcp 1024-65535 allowed to 0.0.0.0/0", Rule: "100", Step: reachabilityStepDestinationReturnACL},
+				{ComponentID: "acl-source", Explanation: "tcp 1024-65535 denied from 10.0.2.0/24", Rule: "90", Step: reachabilityStepSourceReturnACL},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.check.evaluate()
+
+			if diff := cmp.Diff(testCase.expectedHops, got.Hops); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+			if got, want := got.Reachable, testCase.expectedReachable; got != want {
+				t.Errorf("Reachable = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	tflist "github.com/hashicorp/terraform-provider-aws/internal/list"
+)
+
+// @SDKListResource("aws_security_group", name="Security Group")
+func newSecurityGroupListResource(_ context.Context) (list.ListResourceWithConfigure, error) {
+	return &securityGroupListResource{}, nil
+}
+
+type securityGroupListResource struct {
+	tflist.ListResourceWithSDKv2Resource
+}
+
+func (l *securityGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	conn := l.Meta().EC2Client(ctx)
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		input := ec2.DescribeSecurityGroupsInput{}
+		output, err := findSecurityGroups(ctx, conn, &input)
+
+		if err != nil {
+			var result list.ListResult
+			result.Diagnostics.AddError("listing Security Groups", err.Error())
+			yield(result)
+			return
+		}
+
+		for _, v := range output {
+			d := l.ResourceData()
+			d.SetId(aws.ToString(v.GroupId))
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = aws.ToString(v.GroupName)
+			if !l.SetResult(ctx, request, d, &result) {
+				continue
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,535 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_vpc_security_group_rules_exclusive", name="Security Group Rules Exclusive")
+func newSecurityGroupRulesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &securityGroupRulesExclusiveResource{}, nil
+}
+
+type securityGroupRulesExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (r *securityGroupRulesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	ruleBlock := func() schema.SetNestedBlock {
+		return schema.SetNestedBlock{
+			CustomType: fwtypes.NewSetNestedObjectTypeOf[securityGroupExclusiveRuleModel](ctx),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"cidr_ipv4": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							fwvalidators.IPv4CIDRNetworkAddress(),
+							stringvalidator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("cidr_ipv6"),
+								path.MatchRelative().AtParent().AtName("prefix_list_id"),
+								path.MatchRelative().AtParent().AtName("referenced_security_group_id"),
+							),
+						},
+					},
+					"cidr_ipv6": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							fwvalidators.IPv6CIDRNetworkAddress(),
+						},
+					},
+					names.AttrDescription: schema.StringAttribute{
+						Optional: true,
+					},
+					"from_port": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.Between(-1, 65535),
+						},
+					},
+					"ip_protocol": schema.StringAttribute{
+						CustomType: ipProtocolType{},
+						Required:   true,
+					},
+					"prefix_list_id": schema.StringAttribute{
+						Optional: true,
+					},
+					"referenced_security_group_id": schema.StringAttribute{
+						Optional: true,
+					},
+					"to_port": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.Between(-1, 65535),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"egress":  ruleBlock(),
+			"ingress": ruleBlock(),
+		},
+	}
+}
+
+func (r *securityGroupRulesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	egress, diags := expandSecurityGroupExclusiveRules(ctx, data.Egress)
+	response.Diagnostics.Append(diags...)
+	ingress, diags := expandSecurityGroupExclusiveRules(ctx, data.Ingress)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	securityGroupID := data.SecurityGroupID.ValueString()
+	if err := r.syncRules(ctx, securityGroupID, egress, ingress); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating VPC Security Group (%s) Rules Exclusive", securityGroupID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	securityGroupID := data.SecurityGroupID.ValueString()
+	_, err := findSecurityGroupByID(ctx, conn, securityGroupID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Security Group (%s)", securityGroupID), err.Error())
+
+		return
+	}
+
+	rules, err := findSecurityGroupRulesBySecurityGroupID(ctx, conn, securityGroupID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Security Group (%s) Rules", securityGroupID), err.Error())
+
+		return
+	}
+
+	accountID := r.Meta().AccountID(ctx)
+	egress, diags := flattenSecurityGroupExclusiveRules(ctx, filterSecurityGroupRules(rules, true), data.Egress, accountID)
+	response.Diagnostics.Append(diags...)
+	ingress, diags := flattenSecurityGroupExclusiveRules(ctx, filterSecurityGroupRules(rules, false), data.Ingress, accountID)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Egress = egress
+	data.Ingress = ingress
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.Egress.Equal(old.Egress) || !new.Ingress.Equal(old.Ingress) {
+		egress, diags := expandSecurityGroupExclusiveRules(ctx, new.Egress)
+		response.Diagnostics.Append(diags...)
+		ingress, diags := expandSecurityGroupExclusiveRules(ctx, new.Ingress)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		securityGroupID := new.SecurityGroupID.ValueString()
+		if err := r.syncRules(ctx, securityGroupID, egress, ingress); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating VPC Security Group (%s) Rules Exclusive", securityGroupID), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("security_group_id"), request, response)
+}
+
+// syncRules converges the security group's rules on the wanted rules.
+// For each direction, missing rules are authorized in a single call before rules that are not wanted are revoked in a single call,
+// so that traffic allowed by both the old and new rules is not interrupted.
+// Rules whose description alone has changed are updated in place.
+func (r *securityGroupRulesExclusiveResource) syncRules(ctx context.Context, securityGroupID string, egress, ingress []awstypes.SecurityGroupRule) error {
+	conn := r.Meta().EC2Client(ctx)
+	accountID := r.Meta().AccountID(ctx)
+
+	have, err := findSecurityGroupRulesBySecurityGroupID(ctx, conn, securityGroupID)
+
+	if err != nil {
+		return fmt.Errorf("reading rules: %w", err)
+	}
+
+	add, update, remove := diffSecurityGroupExclusiveRules(filterSecurityGroupRules(have, true), egress, accountID)
+
+	if len(add) > 0 {
+		input := ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:       aws.String(securityGroupID),
+			IpPermissions: add,
+		}
+		_, err := conn.AuthorizeSecurityGroupEgress(ctx, &input)
+
+		if err != nil {
+			return fmt.Errorf("authorizing egress rules: %w", err)
+		}
+	}
+
+	if len(update) > 0 {
+		input := ec2.UpdateSecurityGroupRuleDescriptionsEgressInput{
+			GroupId:                       aws.String(securityGroupID),
+			SecurityGroupRuleDescriptions: update,
+		}
+		_, err := conn.UpdateSecurityGroupRuleDescriptionsEgress(ctx, &input)
+
+		if err != nil {
+			return fmt.Errorf("updating egress rule descriptions: %w", err)
+		}
+	}
+
+	if len(remove) > 0 {
+		input := ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: remove,
+		}
+		_, err := conn.RevokeSecurityGroupEgress(ctx, &input)
+
+		if err != nil {
+			return fmt.Errorf("revoking egress rules: %w", err)
+		}
+	}
+
+	add, update, remove = diffSecurityGroupExclusiveRules(filterSecurityGroupRules(have, false), ingress, accountID)
+
+	if len(add) > 0 {
+		input := ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:       aws.String(securityGroupID),
+			IpPermissions: add,
+		}
+		_, err := conn.AuthorizeSecurityGroupIngress(ctx, &input)
+
+		if err != nil {
+			return fmt.Errorf("authorizing ingress rules: %w", err)
+		}
+	}
+
+	if len(update) > 0 {
+		input := ec2.UpdateSecurityGroupRuleDescriptionsIngressInput{
+			GroupId:                       aws.String(securityGroupID),
+			SecurityGroupRuleDescriptions: update,
+		}
+		_, err := conn.UpdateSecurityGroupRuleDescriptionsIngress(ctx, &input)
+
+		if err != nil {
+			return fmt.Errorf("updating ingress rule descriptions: %w", err)
+		}
+	}
+
+	if len(remove) > 0 {
+		input := ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: remove,
+		}
+		_, err := conn.RevokeSecurityGroupIngress(ctx, &input)
+
+		if err != nil {
+			return fmt.Errorf("revoking ingress rules: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// diffSecurityGroupExclusiveRules compares a security group's current rules with the wanted rules by their normalized identity.
+// It returns the permissions to authorize, the descriptions of existing rules to update and the IDs of the rules to revoke.
+func diffSecurityGroupExclusiveRules(have, want []awstypes.SecurityGroupRule, accountID string) ([]awstypes.IpPermission, []awstypes.SecurityGroupRuleDescription, []string) {
+	relationship := framework.ExclusiveRelationship[awstypes.SecurityGroupRule]{
+		Equal: func(v1, v2 awstypes.SecurityGroupRule) bool {
+			return newSecurityGroupRuleIdentity(v1, accountID) == newSecurityGroupRuleIdentity(v2, accountID)
+		},
+	}
+	add, remove := relationship.Diff(have, want)
+
+	var update []awstypes.SecurityGroupRuleDescription
+	for _, w := range want {
+		identity := newSecurityGroupRuleIdentity(w, accountID)
+		i := slices.IndexFunc(have, func(h awstypes.SecurityGroupRule) bool {
+			return newSecurityGroupRuleIdentity(h, accountID) == identity
+		})
+		if i == -1 || aws.ToString(have[i].Description) == aws.ToString(w.Description) {
+			continue
+		}
+
+		update = append(update, awstypes.SecurityGroupRuleDescription{
+			Description:         aws.String(aws.ToString(w.Description)),
+			SecurityGroupRuleId: have[i].SecurityGroupRuleId,
+		})
+	}
+
+	return tfslices.ApplyToAll(add, expandIPPermissionFromSecurityGroupRule), update, tfslices.ApplyToAll(remove, func(v awstypes.SecurityGroupRule) string {
+		return aws.ToString(v.SecurityGroupRuleId)
+	})
+}
+
+// securityGroupRuleIdentity is the normalized identity of a security group rule:
+// its protocol, port range and peer.
+// A rule's description can be changed without replacing the rule and is not part of its identity.
+type securityGroupRuleIdentity struct {
+	cidrIPv4                  string
+	cidrIPv6                  string
+	fromPort                  int32
+	ipProtocol                string
+	prefixListID              string
+	referencedSecurityGroupID string // [UserID/]GroupID.
+	toPort                    int32
+}
+
+func newSecurityGroupRuleIdentity(apiObject awstypes.SecurityGroupRule, accountID string) securityGroupRuleIdentity {
+	identity := securityGroupRuleIdentity{
+		fromPort:     -1,
+		ipProtocol:   protocolForValue(aws.ToString(apiObject.IpProtocol)),
+		prefixListID: aws.ToString(apiObject.PrefixListId),
+		toPort:       -1,
+	}
+
+	// Rules for all protocols apply to all ports.
+	if identity.ipProtocol != "-1" {
+		if v := apiObject.FromPort; v != nil {
+			identity.fromPort = aws.ToInt32(v)
+		}
+		if v := apiObject.ToPort; v != nil {
+			identity.toPort = aws.ToInt32(v)
+		}
+	}
+
+	if v := apiObject.CidrIpv4; v != nil {
+		identity.cidrIPv4 = itypes.CanonicalCIDRBlock(aws.ToString(v))
+	}
+	if v := apiObject.CidrIpv6; v != nil {
+		identity.cidrIPv6 = itypes.CanonicalCIDRBlock(aws.ToString(v))
+	}
+
+	if v := apiObject.ReferencedGroupInfo; v != nil {
+		identity.referencedSecurityGroupID = aws.ToString(v.GroupId)
+		if userID := aws.ToString(v.UserId); userID != "" && userID != accountID {
+			identity.referencedSecurityGroupID = userID + "/" + identity.referencedSecurityGroupID
+		}
+	}
+
+	return identity
+}
+
+func expandIPPermissionFromSecurityGroupRule(apiObject awstypes.SecurityGroupRule) awstypes.IpPermission {
+	ipPermission := awstypes.IpPermission{
+		FromPort:   apiObject.FromPort,
+		IpProtocol: apiObject.IpProtocol,
+		ToPort:     apiObject.ToPort,
+	}
+
+	switch {
+	case apiObject.CidrIpv4 != nil:
+		ipPermission.IpRanges = []awstypes.IpRange{{
+			CidrIp:      apiObject.CidrIpv4,
+			Description: apiObject.Description,
+		}}
+	case apiObject.CidrIpv6 != nil:
+		ipPermission.Ipv6Ranges = []awstypes.Ipv6Range{{
+			CidrIpv6:    apiObject.CidrIpv6,
+			Description: apiObject.Description,
+		}}
+	case apiObject.PrefixListId != nil:
+		ipPermission.PrefixListIds = []awstypes.PrefixListId{{
+			Description:  apiObject.Description,
+			PrefixListId: apiObject.PrefixListId,
+		}}
+	case apiObject.ReferencedGroupInfo != nil:
+		ipPermission.UserIdGroupPairs = []awstypes.UserIdGroupPair{{
+			Description: apiObject.Description,
+			GroupId:     apiObject.ReferencedGroupInfo.GroupId,
+			UserId:      apiObject.ReferencedGroupInfo.UserId,
+		}}
+	}
+
+	return ipPermission
+}
+
+func filterSecurityGroupRules(apiObjects []awstypes.SecurityGroupRule, egress bool) []awstypes.SecurityGroupRule {
+	return tfslices.Filter(apiObjects, func(v awstypes.SecurityGroupRule) bool {
+		return aws.ToBool(v.IsEgress) == egress
+	})
+}
+
+func expandSecurityGroupExclusiveRules(ctx context.Context, tfSet fwtypes.SetNestedObjectValueOf[securityGroupExclusiveRuleModel]) ([]awstypes.SecurityGroupRule, diag.Diagnostics) {
+	data, diags := tfSet.ToSlice(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return tfslices.ApplyToAll(data, func(v *securityGroupExclusiveRuleModel) awstypes.SecurityGroupRule {
+		return v.expandSecurityGroupRule(ctx)
+	}), diags
+}
+
+// flattenSecurityGroupExclusiveRules returns the rules' set value.
+// Prior elements with the same identity and description as a rule are kept so that equivalent configured values, e.g. protocol numbers, don't show as differences.
+func flattenSecurityGroupExclusiveRules(ctx context.Context, apiObjects []awstypes.SecurityGroupRule, prior fwtypes.SetNestedObjectValueOf[securityGroupExclusiveRuleModel], accountID string) (fwtypes.SetNestedObjectValueOf[securityGroupExclusiveRuleModel], diag.Diagnostics) {
+	priorData, diags := prior.ToSlice(ctx)
+	if diags.HasError() {
+		return prior, diags
+	}
+
+	data := make([]*securityGroupExclusiveRuleModel, 0, len(apiObjects))
+	for _, apiObject := range apiObjects {
+		identity := newSecurityGroupRuleIdentity(apiObject, accountID)
+		if i := slices.IndexFunc(priorData, func(v *securityGroupExclusiveRuleModel) bool {
+			rule := v.expandSecurityGroupRule(ctx)
+			return newSecurityGroupRuleIdentity(rule, accountID) == identity && aws.ToString(rule.Description) == aws.ToString(apiObject.Description)
+		}); i != -1 {
+			data = append(data, priorData[i])
+			continue
+		}
+
+		data = append(data, flattenSecurityGroupExclusiveRule(ctx, apiObject, accountID))
+	}
+
+	return fwtypes.NewSetNestedObjectValueOfSlice(ctx, data)
+}
+
+func flattenSecurityGroupExclusiveRule(ctx context.Context, apiObject awstypes.SecurityGroupRule, accountID string) *securityGroupExclusiveRuleModel {
+	data := &securityGroupExclusiveRuleModel{
+		CIDRIPv4:                  fwflex.StringToFramework(ctx, apiObject.CidrIpv4),
+		CIDRIPv6:                  fwflex.StringToFramework(ctx, apiObject.CidrIpv6),
+		Description:               fwflex.StringToFramework(ctx, apiObject.Description),
+		FromPort:                  fwflex.Int32ToFrameworkInt64(ctx, apiObject.FromPort),
+		IPProtocol:                fwflex.StringToFrameworkValuable[ipProtocol](ctx, apiObject.IpProtocol),
+		PrefixListID:              fwflex.StringToFramework(ctx, apiObject.PrefixListId),
+		ReferencedSecurityGroupID: flattenReferencedSecurityGroup(ctx, apiObject.ReferencedGroupInfo, accountID),
+		ToPort:                    fwflex.Int32ToFrameworkInt64(ctx, apiObject.ToPort),
+	}
+
+	// Rules for all protocols have no port range.
+	if protocolForValue(aws.ToString(apiObject.IpProtocol)) == "-1" {
+		data.FromPort = types.Int64Null()
+		data.ToPort = types.Int64Null()
+	}
+
+	return data
+}
+
+type securityGroupRulesExclusiveResourceModel struct {
+	Egress          fwtypes.SetNestedObjectValueOf[securityGroupExclusiveRuleModel] `tfsdk:"egress"`
+	Ingress         fwtypes.SetNestedObjectValueOf[securityGroupExclusiveRuleModel] `tfsdk:"ingress"`
+	SecurityGroupID types.String                                                    `tfsdk:"security_group_id"`
+}
+
+type securityGroupExclusiveRuleModel struct {
+	CIDRIPv4                  types.String `tfsdk:"cidr_ipv4"`
+	CIDRIPv6                  types.String `tfsdk:"cidr_ipv6"`
+	Description               types.String `tfsdk:"description"`
+	FromPort                  types.Int64  `tfsdk:"from_port"`
+	IPProtocol                ipProtocol   `tfsdk:"ip_protocol"`
+	PrefixListID              types.String `tfsdk:"prefix_list_id"`
+	ReferencedSecurityGroupID types.String `tfsdk:"referenced_security_group_id"`
+	ToPort                    types.Int64  `tfsdk:"to_port"`
+}
+
+func (model *securityGroupExclusiveRuleModel) expandSecurityGroupRule(ctx context.Context) awstypes.SecurityGroupRule {
+	apiObject := awstypes.SecurityGroupRule{
+		CidrIpv4:     fwflex.StringFromFramework(ctx, model.CIDRIPv4),
+		CidrIpv6:     fwflex.StringFromFramework(ctx, model.CIDRIPv6),
+		Description:  fwflex.StringFromFramework(ctx, model.Description),
+		FromPort:     fwflex.Int32FromFrameworkInt64(ctx, model.FromPort),
+		IpProtocol:   fwflex.StringFromFramework(ctx, model.IPProtocol),
+		PrefixListId: fwflex.StringFromFramework(ctx, model.PrefixListID),
+		ToPort:       fwflex.Int32FromFrameworkInt64(ctx, model.ToPort),
+	}
+
+	if !model.ReferencedSecurityGroupID.IsNull() {
+		// [UserID/]GroupID.
+		if userID, groupID, ok := strings.Cut(model.ReferencedSecurityGroupID.ValueString(), "/"); ok {
+			apiObject.ReferencedGroupInfo = &awstypes.ReferencedSecurityGroup{
+				GroupId: aws.String(groupID),
+				UserId:  aws.String(userID),
+			}
+		} else {
+			apiObject.ReferencedGroupInfo = &awstypes.ReferencedSecurityGroup{
+				GroupId: fwflex.StringFromFramework(ctx, model.ReferencedSecurityGroupID),
+			}
+		}
+	}
+
+	return apiObject
+}
@@ -0,0 +1,424 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDiffSecurityGroupExclusiveRules(t *testing.T) {
+	t.Parallel()
+
+	const accountID = "123456789012"
+
+	testCases := map[string]struct {
+		have       []awstypes.SecurityGroupRule
+		want       []awstypes.SecurityGroupRule
+		wantAdd    int
+		wantUpdate []string
+		wantRemove []string
+	}{
+		"no rules": {},
+		"equivalent protocol": {
+			have: []awstypes.SecurityGroupRule{{
+				CidrIpv4:            aws.String("10.0.0.0/8"),
+				FromPort:            aws.Int32(443),
+				IpProtocol:          aws.String("tcp"),
+				SecurityGroupRuleId: aws.String("sgr-1"),
+				ToPort:              aws.Int32(443),
+			}},
+			want: []awstypes.SecurityGroupRule{{
+				CidrIpv4:   aws.String("10.0.0.0/8"),
+				FromPort:   aws.Int32(443),
+				IpProtocol: aws.String("6"),
+				ToPort:     aws.Int32(443),
+			}},
+		},
+		"all protocols without ports": {
+			have: []awstypes.SecurityGroupRule{{
+				CidrIpv4:            aws.String("0.0.0.0/0"),
+				FromPort:            aws.Int32(-1),
+				IpProtocol:          aws.String("-1"),
+				SecurityGroupRuleId: aws.String("sgr-1"),
+				ToPort:              aws.Int32(-1),
+			}},
+			want: []awstypes.SecurityGroupRule{{
+				CidrIpv4:   aws.String("0.0.0.0/0"),
+				IpProtocol: aws.String("all"),
+			}},
+		},
+		"equivalent IPv6 CIDR block": {
+			have: []awstypes.SecurityGroupRule{{
+				CidrIpv6:            aws.String("2001:db8::/32"),
+				FromPort:            aws.Int32(22),
+				IpProtocol:          aws.String("tcp"),
+				SecurityGroupRuleId: aws.String("sgr-1"),
+				ToPort:              aws.Int32(22),
+			}},
+			want: []awstypes.SecurityGroupRule{{
+				CidrIpv6:   aws.String("2001:0db8::/32"),
+				FromPort:   aws.Int32(22),
+				IpProtocol: aws.String("tcp"),
+				ToPort:     aws.Int32(22),
+			}},
+		},
+		"referenced security group in same account": {
+			have: []awstypes.SecurityGroupRule{{
+				FromPort:            aws.Int32(5432),
+				IpProtocol:          aws.String("tcp"),
+				ReferencedGroupInfo: &awstypes.ReferencedSecurityGroup{GroupId: aws.String("sg-1"), UserId: aws.String(accountID)},
+				SecurityGroupRuleId: aws.String("sgr-1"),
+				ToPort:              aws.Int32(5432),
+			}},
+			want: []awstypes.SecurityGroupRule{{
+				FromPort:            aws.Int32(5432),
+				IpProtocol:          aws.String("tcp"),
+				ReferencedGroupInfo: &awstypes.ReferencedSecurityGroup{GroupId: aws.String("sg-1")},
+				ToPort:              aws.Int32(5432),
+			}},
+		},
+		"referenced security group in other account": {
+			have: []awstypes.SecurityGroupRule{{
+				FromPort:            aws.Int32(5432),
+				IpProtocol:          aws.String("tcp"),
+				ReferencedGroupInfo: &awstypes.ReferencedSecurityGroup{GroupId: aws.String("sg-1"), UserId: aws.String("210987654321")},
+				SecurityGroupRuleId: aws.String("sgr-1"),
+				ToPort:              aws.Int32(5432),
+			}},
+			want: []awstypes.SecurityGroupRule{{
+				FromPort:            aws.Int32(5432),
+				IpProtocol:          aws.String("tcp"),
+				ReferencedGroupInfo: &awstypes.ReferencedSecurityGroup{GroupId: aws.String("sg-1")},
+				ToPort:              aws.Int32(5432),
+			}},
+			wantAdd:    1,
+			wantRemove: []string{"sgr-1"},
+		},
+		"description changed": {
+			have: []awstypes.SecurityGroupRule{{
+				CidrIpv4:            aws.String("10.0.0.0/8"),
+				Description:         aws.String("old"),
+				FromPort:            aws.Int32(443),
+				IpProtocol:          aws.String("tcp"),
+				SecurityGroupRuleId: aws.String("sgr-1"),
+				ToPort:              aws.Int32(443),
+			}},
+			want: []awstypes.SecurityGroupRule{{
+				CidrIpv4:    aws.String("10.0.0.0/8"),
+				Description: aws.String("new"),
+				FromPort:    aws.Int32(443),
+				IpProtocol:  aws.String("tcp"),
+				ToPort:      aws.Int32(443),
+			}},
+			wantUpdate: []string{"sgr-1"},
+		},
+		"description removed": {
+			have: []awstypes.SecurityGroupRule{{
+				CidrIpv4:            aws.String("10.0.0.0/8"),
+				Description:         aws.String("old"),
+				FromPort:            aws.Int32(443),
+				IpProtocol:          aws.String("tcp"),
+				SecurityGroupRuleId: aws.String("sgr-1"),
+				ToPort:              aws.Int32(443),
+			}},
+			want: []awstypes.SecurityGroupRule{{
+				CidrIpv4:   aws.String("10.0.0.0/8"),
+				FromPort:   aws.Int32(443),
+				IpProtocol: aws.String("tcp"),
+				ToPort:     aws.Int32(443),
+			}},
+			wantUpdate: []string{"sgr-1"},
+		},
+		"rules added and removed": {
+			have: []awstypes.SecurityGroupRule{
+				{
+					CidrIpv4:            aws.String("10.0.0.0/8"),
+					FromPort:            aws.Int32(443),
+					IpProtocol:          aws.String("tcp"),
+					SecurityGroupRuleId: aws.String("sgr-1"),
+					ToPort:              aws.Int32(443),
+				},
+				{
+					CidrIpv4:            aws.String("10.0.0.0/8"),
+					FromPort:            aws.Int32(22),
+					IpProtocol:          aws.String("tcp"),
+					SecurityGroupRuleId: aws.String("sgr-2"),
+					ToPort:              aws.Int32(22),
+				},
+				{
+					FromPort:            aws.Int32(53),
+					IpProtocol:          aws.String("udp"),
+					PrefixListId:        aws.String("pl-1"),
+					SecurityGroupRuleId: aws.String("sgr-3"),
+					ToPort:              aws.Int32(53),
+				},
+			},
+			want: []awstypes.SecurityGroupRule{
+				{
+					CidrIpv4:   aws.String("10.0.0.0/8"),
+					FromPort:   aws.Int32(443),
+					IpProtocol: aws.String("tcp"),
+					ToPort:     aws.Int32(443),
+				},
+				{
+					CidrIpv4:   aws.String("10.0.0.0/8"),
+					FromPort:   aws.Int32(80),
+					IpProtocol: aws.String("tcp"),
+					ToPort:     aws.Int32(80),
+				},
+				{
+					CidrIpv4:   aws.String("192.168.0.0/16"),
+					FromPort:   aws.Int32(-1),
+					IpProtocol: aws.String("icmp"),
+					ToPort:     aws.Int32(-1),
+				},
+			},
+			wantAdd:    2,
+			wantRemove: []string{"sgr-2", "sgr-3"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			add, update, remove := tfec2.DiffSecurityGroupExclusiveRules(testCase.have, testCase.want, accountID)
+
+			if got, want := len(add), testCase.wantAdd; got != want {
+				t.Errorf("len(add) = %d, want %d", got, want)
+			}
+			if got, want := tfslices.ApplyToAll(update, func(v awstypes.SecurityGroupRuleDescription) string {
+				return aws.ToString(v.SecurityGroupRuleId)
+			}), testCase.wantUpdate; !slices.Equal(got, want) {
+				t.Errorf("update = %v, want %v", got, want)
+			}
+			if got, want := remove, testCase.wantRemove; !slices.Equal(got, want) {
+				t.Errorf("remove = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestAccVPCSecurityGroupRulesExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SecurityGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules_exclusive.test"
+	securityGroupResourceName := "aws_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName, "https"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, securityGroupResourceName, &v),
+					testAccCheckSecurityGroupRulesExclusiveCount(ctx, &v, 1, 2),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", securityGroupResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "egress.*", map[string]string{
+						"cidr_ipv4":   "0.0.0.0/0",
+						"ip_protocol": "-1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ingress.*", map[string]string{
+						"cidr_ipv4":           "10.0.0.0/8",
+						names.AttrDescription: "https",
+						"from_port":           "443",
+						"ip_protocol":         "6",
+						"to_port":             "443",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "ingress.*.referenced_security_group_id", "aws_security_group.peer", names.AttrID),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "security_group_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "security_group_id",
+				// The API returns protocol names.
+				ImportStateVerifyIgnore: []string{"ingress"},
+			},
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName, "updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, securityGroupResourceName, &v),
+					testAccCheckSecurityGroupRulesExclusiveCount(ctx, &v, 1, 2),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ingress.*", map[string]string{
+						"cidr_ipv4":           "10.0.0.0/8",
+						names.AttrDescription: "updated",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupRulesExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SecurityGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules_exclusive.test"
+	securityGroupResourceName := "aws_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName, "https"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, securityGroupResourceName, &v),
+					testAccCheckSecurityGroupRulesExclusiveAuthorizeIngress(ctx, &v, "192.168.0.0/16", 22),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName, "https"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesExclusiveCount(ctx, &v, 1, 2),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupRulesExclusive_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SecurityGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules_exclusive.test"
+	securityGroupResourceName := "aws_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_empty(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, securityGroupResourceName, &v),
+					testAccCheckSecurityGroupRulesExclusiveCount(ctx, &v, 0, 0),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSecurityGroupRulesExclusiveCount(ctx context.Context, v *awstypes.SecurityGroup, wantEgress, wantIngress int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindSecurityGroupByID(ctx, conn, aws.ToString(v.GroupId))
+
+		if err != nil {
+			return err
+		}
+
+		var egress, ingress int
+		for _, v := range output.IpPermissionsEgress {
+			egress += len(v.IpRanges) + len(v.Ipv6Ranges) + len(v.PrefixListIds) + len(v.UserIdGroupPairs)
+		}
+		for _, v := range output.IpPermissions {
+			ingress += len(v.IpRanges) + len(v.Ipv6Ranges) + len(v.PrefixListIds) + len(v.UserIdGroupPairs)
+		}
+
+		if egress != wantEgress || ingress != wantIngress {
+			return fmt.Errorf("VPC Security Group (%s) has %d egress and %d ingress rules, want %d and %d", aws.ToString(v.GroupId), egress, ingress, wantEgress, wantIngress)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSecurityGroupRulesExclusiveAuthorizeIngress(ctx context.Context, v *awstypes.SecurityGroup, cidrBlock string, port int32) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		input := ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId: v.GroupId,
+			IpPermissions: []awstypes.IpPermission{{
+				FromPort:   aws.Int32(port),
+				IpProtocol: aws.String("tcp"),
+				IpRanges:   []awstypes.IpRange{{CidrIp: aws.String(cidrBlock)}},
+				ToPort:     aws.Int32(port),
+			}},
+		}
+		_, err := conn.AuthorizeSecurityGroupIngress(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_security_group" "peer" {
+  vpc_id = aws_vpc.test.id
+  name   = "%[1]s-peer"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_security_group_rules_exclusive" "test" {
+  security_group_id = aws_security_group.test.id
+
+  egress {
+    cidr_ipv4   = "0.0.0.0/0"
+    ip_protocol = "-1"
+  }
+
+  ingress {
+    cidr_ipv4   = "10.0.0.0/8"
+    description = %[2]q
+    from_port   = 443
+    ip_protocol = "6"
+    to_port     = 443
+  }
+
+  ingress {
+    referenced_security_group_id = aws_security_group.peer.id
+    from_port                    = 5432
+    ip_protocol                  = "tcp"
+    to_port                      = 5432
+  }
+}
+`, rName, description))
+}
+
+func testAccVPCSecurityGroupRulesExclusiveConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+resource "aws_vpc_security_group_rules_exclusive" "test" {
+  security_group_id = aws_security_group.test.id
+}
+`)
+}
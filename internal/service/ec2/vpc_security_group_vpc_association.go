@@ -208,7 +208,7 @@ func (r *resourceSecurityGroupVPCAssociation) ImportState(ctx context.Context, r
 }
 
 func waitSecurityGroupVPCAssociationCreated(ctx context.Context, conn *ec2.Client, groupId string, vpcId string, timeout time.Duration) (*awstypes.SecurityGroupVpcAssociation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.SecurityGroupVpcAssociationStateAssociating),
 		Target:                    enum.Slice(awstypes.SecurityGroupVpcAssociationStateAssociated),
 		Refresh:                   statusSecurityGroupVPCAssociation(ctx, conn, groupId, vpcId),
@@ -226,7 +226,7 @@ func waitSecurityGroupVPCAssociationCreated(ctx context.Context, conn *ec2.Clien
 }
 
 func waitSecurityGroupVPCAssociationDeleted(ctx context.Context, conn *ec2.Client, groupId string, vpcId string, timeout time.Duration) (*awstypes.SecurityGroupVpcAssociation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SecurityGroupVpcAssociationStateDisassociating),
 		Target:  []string{},
 		Refresh: statusSecurityGroupVPCAssociation(ctx, conn, groupId, vpcId),
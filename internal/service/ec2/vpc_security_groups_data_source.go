@@ -40,12 +40,15 @@ func dataSourceSecurityGroups() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"limit":        limitSchema(),
+			"sort_by":      sortBySchema(),
 			names.AttrTags: tftags.TagsSchemaComputed(),
 			"vpc_ids": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"where": whereSchema(),
 		},
 	}
 }
@@ -75,6 +78,12 @@ func dataSourceSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, m
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Security Groups: %s", err)
 	}
 
+	output, err = filterByExpressionsFromResourceData(d, output)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Security Groups: %s", err)
+	}
+
 	var arns, securityGroupIDs, vpcIDs []string
 
 	for _, v := range output {
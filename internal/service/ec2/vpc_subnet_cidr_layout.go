@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"cmp"
+	"fmt"
+	"net/netip"
+	"slices"
+)
+
+const (
+	ipv4SubnetCIDRPlanMinNetmaskLength  = 16
+	ipv4SubnetCIDRPlanMaxNetmaskLength  = 28
+	ipv6SubnetCIDRPlanMinNetmaskLength  = 44
+	ipv6SubnetCIDRPlanMaxNetmaskLength  = 64
+	ipv6SubnetCIDRPlanNetmaskLengthStep = 4
+)
+
+type subnetCIDRPlanRequest struct {
+	name          string
+	netmaskLength int
+}
+
+// planSubnetCIDRBlocks packs the requested subnets into the CIDR blocks.
+// The address family of each subnet follows from its netmask length, as the valid IPv4 and IPv6 subnet sizes don't overlap.
+// A subnet keeps its prior CIDR block if it has the same size, is still within one of the CIDR blocks and doesn't overlap another kept subnet.
+// The remaining subnets are placed largest first, then by name, at the lowest free address in the first CIDR block that has room,
+// so that adding, removing or resizing one subnet doesn't move any other.
+func planSubnetCIDRBlocks(cidrBlocks []netip.Prefix, subnets []subnetCIDRPlanRequest, prior map[string]netip.Prefix) (map[string]netip.Prefix, error) {
+	for i, subnet := range subnets {
+		if slices.ContainsFunc(subnets[:i], func(v subnetCIDRPlanRequest) bool { return v.name == subnet.name }) {
+			return nil, fmt.Errorf("duplicate subnet name %q", subnet.name)
+		}
+
+		if subnetCIDRPlanAddressBitLen(subnet.netmaskLength) == 0 {
+			return nil, fmt.Errorf("subnet %q netmask length (%d) must be between %d and %d for IPv4 or a multiple of %d between %d and %d for IPv6", subnet.name, subnet.netmaskLength,
+				ipv4SubnetCIDRPlanMinNetmaskLength, ipv4SubnetCIDRPlanMaxNetmaskLength, ipv6SubnetCIDRPlanNetmaskLengthStep, ipv6SubnetCIDRPlanMinNetmaskLength, ipv6SubnetCIDRPlanMaxNetmaskLength)
+		}
+	}
+
+	assignments := make(map[string]netip.Prefix, len(subnets))
+	var used []netip.Prefix
+
+	// Names are unique, so the order in which prior assignments are kept doesn't depend on configuration order.
+	keep := slices.Clone(subnets)
+	slices.SortFunc(keep, func(a, b subnetCIDRPlanRequest) int {
+		return cmp.Compare(a.name, b.name)
+	})
+
+	var pending []subnetCIDRPlanRequest
+	for _, subnet := range keep {
+		prefix, ok := prior[subnet.name]
+
+		if ok && prefix.Bits() == subnet.netmaskLength && prefix.Addr().BitLen() == subnetCIDRPlanAddressBitLen(subnet.netmaskLength) &&
+			slices.ContainsFunc(cidrBlocks, func(v netip.Prefix) bool { return prefixContains(v, prefix) }) &&
+			!slices.ContainsFunc(used, prefix.Overlaps) {
+			assignments[subnet.name] = prefix
+			used = append(used, prefix)
+
+			continue
+		}
+
+		pending = append(pending, subnet)
+	}
+
+	slices.SortStableFunc(pending, func(a, b subnetCIDRPlanRequest) int {
+		return cmp.Compare(a.netmaskLength, b.netmaskLength)
+	})
+
+	for _, subnet := range pending {
+		prefix, ok := nextFreeSubnetCIDRBlock(cidrBlocks, used, subnet.netmaskLength)
+
+		if !ok {
+			return nil, fmt.Errorf("no free /%d CIDR block for subnet %q in %v", subnet.netmaskLength, subnet.name, cidrBlocks)
+		}
+
+		assignments[subnet.name] = prefix
+		used = append(used, prefix)
+	}
+
+	return assignments, nil
+}
+
+// nextFreeSubnetCIDRBlock returns the lowest aligned CIDR block of the specified length in the first CIDR block that has room for it.
+func nextFreeSubnetCIDRBlock(cidrBlocks, used []netip.Prefix, netmaskLength int) (netip.Prefix, bool) {
+	bitLen := subnetCIDRPlanAddressBitLen(netmaskLength)
+
+	for _, cidrBlock := range cidrBlocks {
+		if cidrBlock.Addr().BitLen() != bitLen || cidrBlock.Bits() > netmaskLength {
+			continue
+		}
+
+		candidate := netip.PrefixFrom(cidrBlock.Addr(), netmaskLength)
+		for {
+			i := slices.IndexFunc(used, candidate.Overlaps)
+
+			if i == -1 {
+				return candidate, true
+			}
+
+			// Skip past whichever of the two overlapping CIDR blocks is larger. Its end is aligned to the candidate's size.
+			end := prefixLastAddr(candidate)
+			if v := used[i]; v.Bits() < candidate.Bits() {
+				end = prefixLastAddr(v)
+			}
+
+			next := end.Next()
+			if !next.IsValid() || !cidrBlock.Contains(next) {
+				break
+			}
+
+			candidate = netip.PrefixFrom(next, netmaskLength)
+		}
+	}
+
+	return netip.Prefix{}, false
+}
+
+// subnetCIDRPlanAddressBitLen returns the address length of subnets with the specified netmask length, or 0 if it isn't a valid subnet size.
+func subnetCIDRPlanAddressBitLen(netmaskLength int) int {
+	switch {
+	case netmaskLength >= ipv4SubnetCIDRPlanMinNetmaskLength && netmaskLength <= ipv4SubnetCIDRPlanMaxNetmaskLength:
+		return 32
+	case netmaskLength >= ipv6SubnetCIDRPlanMinNetmaskLength && netmaskLength <= ipv6SubnetCIDRPlanMaxNetmaskLength && netmaskLength%ipv6SubnetCIDRPlanNetmaskLengthStep == 0:
+		return 128
+	default:
+		return 0
+	}
+}
+
+// prefixLastAddr returns the last address in a CIDR block.
+func prefixLastAddr(prefix netip.Prefix) netip.Addr {
+	b := prefix.Masked().Addr().AsSlice()
+
+	for i := prefix.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 0x80 >> (i % 8)
+	}
+
+	addr, _ := netip.AddrFromSlice(b)
+
+	return addr
+}
@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPlanSubnetCIDRBlocks(t *testing.T) {
+	t.Parallel()
+
+	prefixes := func(m map[string]string) map[string]netip.Prefix {
+		if m == nil {
+			return nil
+		}
+
+		output := make(map[string]netip.Prefix, len(m))
+		for k, v := range m {
+			output[k] = netip.MustParsePrefix(v)
+		}
+
+		return output
+	}
+
+	testCases := map[string]struct {
+		cidrBlocks    []string
+		subnets       []subnetCIDRPlanRequest
+		prior         map[string]string
+		expected      map[string]string
+		expectedError bool
+	}{
+		"empty": {
+			cidrBlocks: []string{"10.0.0.0/16"},
+			expected:   map[string]string{},
+		},
+		"packed largest first": {
+			cidrBlocks: []string{"10.0.0.0/16"},
+			subnets: []subnetCIDRPlanRequest{
+				{name: "private-a", netmaskLength: 24},
+				{name: "public-a", netmaskLength: 26},
+				{name: "database-a", netmaskLength: 24},
+				{name: "large", netmaskLength: 20},
+			},
+			expected: map[string]string{
+				"large":      "10.0.0.0/20",
+				"database-a": "10.0.16.0/24",
+				"private-a":  "10.0.17.0/24",
+				"public-a":   "10.0.18.0/26",
+			},
+		},
+		"configuration order": {
+			cidrBlocks: []string{"10.0.0.0/16"},
+			subnets: []subnetCIDRPlanRequest{
+				{name: "public-a", netmaskLength: 26},
+				{name: "private-a", netmaskLength: 24},
+				{name: "database-a", netmaskLength: 24},
+				{name: "large", netmaskLength: 20},
+			},
+			expected: map[string]string{
+				"large":      "10.0.0.0/20",
+				"database-a": "10.0.16.0/24",
+				"private-a":  "10.0.17.0/24",
+				"public-a":   "10.0.18.0/26",
+			},
+		},
+		"prior kept": {
+			cidrBlocks: []string{"10.0.0.0/16"},
+			subnets: []subnetCIDRPlanRequest{
+				{name: "a", netmaskLength: 24},
+				{name: "b", netmaskLength: 24},
+				{name: "c", netmaskLength: 20},
+			},
+			prior: map[string]string{
+				"a": "10.0.0.0/24",
+				"b": "10.0.1.0/24",
+			},
+			expected: map[string]string{
+				"a": "10.0.0.0/24",
+				"b": "10.0.1.0/24",
+				"c": "10.0.16.0/20",
+			},
+		},
+		"resized": {
+			cidrBlocks: []string{"10.0.0.0/16"},
+			subnets: []subnetCIDRPlanRequest{
+				{name: "a", netmaskLength: 24},
+				{name: "b", netmaskLength: 23},
+				{name: "c", netmaskLength: 24},
+			},
+			prior: map[string]string{
+				"a": "10.0.0.0/24",
+				"b": "10.0.1.0/24",
+				"c": "10.0.2.0/24",
+			},
+			expected: map[string]string{
+				"a": "10.0.0.0/24",
+				"b": "10.0.4.0/23",
+				"c": "10.0.2.0/24",
+			},
+		},
+		"removed gap reused": {
+			cidrBlocks: []string{"10.0.0.0/16"},
+			subnets: []subnetCIDRPlanRequest{
+				{name: "a", netmaskLength: 24},
+				{name: "c", netmaskLength: 24},
+				{name: "d", netmaskLength: 25},
+			},
+			prior: map[string]string{
+				"a": "10.0.0.0/24",
+				"b": "10.0.1.0/24",
+				"c": "10.0.2.0/24",
+			},
+			expected: map[string]string{
+				"a": "10.0.0.0/24",
+				"c": "10.0.2.0/24",
+				"d": "10.0.1.0/25",
+			},
+		},
+		"prior outside CIDR blocks": {
+			cidrBlocks: []string{"10.1.0.0/16"},
+			subnets: []subnetCIDRPlanRequest{
+				{name: "a", netmaskLength: 24},
+			},
+			prior: map[string]string{
+				"a": "10.0.0.0/24",
+			},
+			expected: map[string]string{
+				"a": "10.1.0.0/24",
+			},
+		},
+		"secondary CIDR block": {
+			cidrBlocks: []string{"10.0.0.0/23", "10.1.0.0/16"},
+			subnets: []subnetCIDRPlanRequest{
+				{name: "a", netmaskLength: 24},
+				{name: "b", netmaskLength: 24},
+				{name: "c", netmaskLength: 24},
+				{name: "d", netmaskLength: 22},
+			},
+			expected: map[string]string{
+				"d": "10.1.0.0/22",
+				"a": "10.0.0.0/24",
+				"b": "10.0.1.0/24",
+				"c": "10.1.4.0/24",
+			},
+		},
+		"dual stack": {
+			cidrBlocks: []string{"10.0.0.0/16", "2001:db8:1234:1a00::/56"},
+			subnets: []subnetCIDRPlanRequest{
+				{name: "a", netmaskLength: 24},
+				{name: "a-ipv6", netmaskLength: 64},
+				{name: "b-ipv6", netmaskLength: 60},
+			},
+			expected: map[string]string{
+				"a":      "10.0.0.0/24",
+				"a-ipv6": "2001:db8:1234:1a10::/64",
+				"b-ipv6": "2001:db8:1234:1a00::/60",
+			},
+		},
+		"full": {
+			cidrBlocks: []string{"10.0.0.0/24"},
+			subnets: []subnetCIDRPlanRequest{
+				{name: "a", netmaskLength: 25},
+				{name: "b", netmaskLength: 25},
+				{name: "c", netmaskLength: 28},
+			},
+			expectedError: true,
+		},
+		"too large": {
+			cidrBlocks: []string{"10.0.0.0/24"},
+			subnets: []subnetCIDRPlanRequest{
+				{name: "a", netmaskLength: 20},
+			},
+			expectedError: true,
+		},
+		"no IPv6 CIDR block": {
+			cidrBlocks: []string{"10.0.0.0/16"},
+			subnets: []subnetCIDRPlanRequest{
+				{name: "a", netmaskLength: 64},
+			},
+			expectedError: true,
+		},
+		"invalid netmask length": {
+			cidrBlocks: []string{"10.0.0.0/16"},
+			subnets: []subnetCIDRPlanRequest{
+				{name: "a", netmaskLength: 30},
+			},
+			expectedError: true,
+		},
+		"invalid IPv6 netmask length": {
+			cidrBlocks: []string{"2001:db8:1234:1a00::/56"},
+			subnets: []subnetCIDRPlanRequest{
+				{name: "a", netmaskLength: 62},
+			},
+			expectedError: true,
+		},
+		"duplicate name": {
+			cidrBlocks: []string{"10.0.0.0/16"},
+			subnets: []subnetCIDRPlanRequest{
+				{name: "a", netmaskLength: 24},
+				{name: "a", netmaskLength: 25},
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var cidrBlocks []netip.Prefix
+			for _, v := range testCase.cidrBlocks {
+				cidrBlocks = append(cidrBlocks, netip.MustParsePrefix(v))
+			}
+
+			got, err := planSubnetCIDRBlocks(cidrBlocks, testCase.subnets, prefixes(testCase.prior))
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("planSubnetCIDRBlocks() err %t, want %t (%v)", got, want, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, prefixes(testCase.expected), cmp.Comparer(func(x, y netip.Prefix) bool { return x == y })); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,456 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_vpc_subnet_cidr_plan", name="Subnet CIDR Plan")
+func newSubnetCIDRPlanResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &subnetCIDRPlanResource{}, nil
+}
+
+type subnetCIDRPlanResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *subnetCIDRPlanResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cidr_blocks": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"ipam_pool_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"subnet": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[subnetCIDRPlanSubnetModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrAvailabilityZone: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrCIDRBlock: schema.StringAttribute{
+							Computed: true,
+						},
+						"ipam_pool_allocation_id": schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"netmask_length": schema.Int32Attribute{
+							Required: true,
+							Validators: []validator.Int32{
+								int32validator.Between(ipv4SubnetCIDRPlanMinNetmaskLength, ipv6SubnetCIDRPlanMaxNetmaskLength),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *subnetCIDRPlanResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data subnetCIDRPlanResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	subnets, diags := data.layout(ctx, nil)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(sdkid.UniqueId())
+
+	if err := r.allocateCIDRs(ctx, data.IPAMPoolID.ValueString(), subnets); err != nil {
+		response.Diagnostics.AddError("creating VPC Subnet CIDR Plan", err.Error())
+	}
+
+	// Save any allocations already made so that they are released when the tainted resource is replaced.
+	data.Subnets = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, subnets)
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *subnetCIDRPlanResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data subnetCIDRPlanResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.IPAMPoolID.IsNull() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	subnets, diags := data.Subnets.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	poolID := data.IPAMPoolID.ValueString()
+	for _, subnet := range subnets {
+		if subnet.IPAMPoolAllocationID.IsNull() {
+			continue
+		}
+
+		allocationID := subnet.IPAMPoolAllocationID.ValueString()
+		_, err := findIPAMPoolAllocationByTwoPartKey(ctx, conn, allocationID, poolID)
+
+		// A missing allocation is made again on the next apply.
+		if tfresource.NotFound(err) {
+			subnet.IPAMPoolAllocationID = types.StringNull()
+
+			continue
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading IPAM Pool (%s) Allocation (%s)", poolID, allocationID), err.Error())
+
+			return
+		}
+	}
+
+	data.Subnets = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, subnets)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *subnetCIDRPlanResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new subnetCIDRPlanResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	prior, diags := old.Subnets.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	subnets, diags := new.layout(ctx, prior)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !old.IPAMPoolID.IsNull() {
+		// Release the allocations of subnets that were removed or moved before allocating their new CIDR blocks.
+		kept := make(map[string]bool)
+		for _, subnet := range subnets {
+			if !subnet.IPAMPoolAllocationID.IsUnknown() {
+				kept[subnet.IPAMPoolAllocationID.ValueString()] = true
+			}
+		}
+
+		for _, subnet := range prior {
+			if allocationID := subnet.IPAMPoolAllocationID.ValueString(); allocationID != "" && !kept[allocationID] {
+				if err := r.releaseCIDR(ctx, old.IPAMPoolID.ValueString(), subnet); err != nil {
+					response.Diagnostics.AddError(fmt.Sprintf("updating VPC Subnet CIDR Plan (%s)", new.ID.ValueString()), err.Error())
+
+					return
+				}
+			}
+		}
+	}
+
+	if err := r.allocateCIDRs(ctx, new.IPAMPoolID.ValueString(), subnets); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating VPC Subnet CIDR Plan (%s)", new.ID.ValueString()), err.Error())
+	}
+
+	new.Subnets = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, subnets)
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *subnetCIDRPlanResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data subnetCIDRPlanResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.IPAMPoolID.IsNull() {
+		return
+	}
+
+	subnets, diags := data.Subnets.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for _, subnet := range subnets {
+		if subnet.IPAMPoolAllocationID.IsNull() {
+			continue
+		}
+
+		if err := r.releaseCIDR(ctx, data.IPAMPoolID.ValueString(), subnet); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("deleting VPC Subnet CIDR Plan (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+}
+
+// ModifyPlan computes each subnet's CIDR block at plan time so that resources referencing the layout can be fully planned.
+func (r *subnetCIDRPlanResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan subnetCIDRPlanResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.isKnown(ctx) {
+		return
+	}
+
+	var prior []*subnetCIDRPlanSubnetModel
+	if !request.State.Raw.IsNull() {
+		var state subnetCIDRPlanResourceModel
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		var diags diag.Diagnostics
+		prior, diags = state.Subnets.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Allocations from a different IPAM pool are never kept.
+		if !state.IPAMPoolID.Equal(plan.IPAMPoolID) {
+			for _, subnet := range prior {
+				subnet.IPAMPoolAllocationID = types.StringNull()
+			}
+		}
+	}
+
+	subnets, diags := plan.layout(ctx, prior)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Subnets = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, subnets)
+
+	response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+}
+
+// allocateCIDRs reserves the CIDR block of each subnet that doesn't yet have an allocation in the IPAM pool.
+// A subnet whose allocation fails, and those after it, are left without an allocation.
+func (r *subnetCIDRPlanResource) allocateCIDRs(ctx context.Context, poolID string, subnets []*subnetCIDRPlanSubnetModel) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	var err error
+	for _, subnet := range subnets {
+		if !subnet.IPAMPoolAllocationID.IsUnknown() {
+			continue
+		}
+
+		if poolID == "" || err != nil {
+			subnet.IPAMPoolAllocationID = types.StringNull()
+
+			continue
+		}
+
+		input := ec2.AllocateIpamPoolCidrInput{
+			Cidr:        subnet.CIDRBlock.ValueStringPointer(),
+			ClientToken: aws.String(sdkid.UniqueId()),
+			Description: subnet.Name.ValueStringPointer(),
+			IpamPoolId:  aws.String(poolID),
+		}
+		var output *ec2.AllocateIpamPoolCidrOutput
+		output, err = conn.AllocateIpamPoolCidr(ctx, &input)
+
+		if err != nil {
+			err = fmt.Errorf("allocating IPAM Pool (%s) CIDR (%s) for subnet %q: %w", poolID, subnet.CIDRBlock.ValueString(), subnet.Name.ValueString(), err)
+			subnet.IPAMPoolAllocationID = types.StringNull()
+
+			continue
+		}
+
+		subnet.IPAMPoolAllocationID = types.StringPointerValue(output.IpamPoolAllocation.IpamPoolAllocationId)
+	}
+
+	return err
+}
+
+func (r *subnetCIDRPlanResource) releaseCIDR(ctx context.Context, poolID string, subnet *subnetCIDRPlanSubnetModel) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	allocationID := subnet.IPAMPoolAllocationID.ValueString()
+	input := ec2.ReleaseIpamPoolAllocationInput{
+		Cidr:                 subnet.CIDRBlock.ValueStringPointer(),
+		IpamPoolAllocationId: aws.String(allocationID),
+		IpamPoolId:           aws.String(poolID),
+	}
+	_, err := conn.ReleaseIpamPoolAllocation(ctx, &input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidIPAMPoolIdNotFound) || tfawserr.ErrMessageContains(err, errCodeInvalidParameterCombination, "No allocation found") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("releasing IPAM Pool (%s) Allocation (%s): %w", poolID, allocationID, err)
+	}
+
+	return nil
+}
+
+type subnetCIDRPlanResourceModel struct {
+	CIDRBlocks fwtypes.ListOfString                                       `tfsdk:"cidr_blocks"`
+	ID         types.String                                               `tfsdk:"id"`
+	IPAMPoolID types.String                                               `tfsdk:"ipam_pool_id"`
+	Subnets    fwtypes.ListNestedObjectValueOf[subnetCIDRPlanSubnetModel] `tfsdk:"subnet"`
+}
+
+type subnetCIDRPlanSubnetModel struct {
+	AvailabilityZone     types.String `tfsdk:"availability_zone"`
+	CIDRBlock            types.String `tfsdk:"cidr_block"`
+	IPAMPoolAllocationID types.String `tfsdk:"ipam_pool_allocation_id"`
+	Name                 types.String `tfsdk:"name"`
+	NetmaskLength        types.Int32  `tfsdk:"netmask_length"`
+}
+
+// isKnown returns whether all the values needed to lay out the subnets are known.
+func (data *subnetCIDRPlanResourceModel) isKnown(ctx context.Context) bool {
+	if data.CIDRBlocks.IsUnknown() || data.Subnets.IsUnknown() {
+		return false
+	}
+
+	for _, v := range data.CIDRBlocks.Elements() {
+		if v.IsUnknown() {
+			return false
+		}
+	}
+
+	subnets, diags := data.Subnets.ToSlice(ctx)
+	if diags.HasError() {
+		return false
+	}
+
+	for _, subnet := range subnets {
+		if subnet.Name.IsUnknown() || subnet.NetmaskLength.IsUnknown() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// layout returns the configured subnets with their CIDR blocks assigned, keeping the prior assignments where possible.
+// A subnet keeps its prior IPAM pool allocation if its CIDR block is unchanged, otherwise the allocation is unknown until apply.
+func (data *subnetCIDRPlanResourceModel) layout(ctx context.Context, prior []*subnetCIDRPlanSubnetModel) ([]*subnetCIDRPlanSubnetModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	subnets, d := data.Subnets.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var cidrBlocks []netip.Prefix
+	for _, v := range data.CIDRBlocks.Elements() {
+		s := v.(types.String).ValueString()
+		prefix, err := netip.ParsePrefix(s)
+
+		if err != nil || prefix != prefix.Masked() {
+			diags.AddError("planning VPC Subnet CIDR Plan", fmt.Sprintf("%q is not a valid CIDR block network address", s))
+
+			return nil, diags
+		}
+
+		cidrBlocks = append(cidrBlocks, prefix)
+	}
+
+	priorSubnets := make(map[string]*subnetCIDRPlanSubnetModel)
+	priorCIDRBlocks := make(map[string]netip.Prefix)
+	for _, subnet := range prior {
+		if prefix, err := netip.ParsePrefix(subnet.CIDRBlock.ValueString()); err == nil {
+			priorSubnets[subnet.Name.ValueString()] = subnet
+			priorCIDRBlocks[subnet.Name.ValueString()] = prefix
+		}
+	}
+
+	requests := make([]subnetCIDRPlanRequest, 0, len(subnets))
+	for _, subnet := range subnets {
+		requests = append(requests, subnetCIDRPlanRequest{
+			name:          subnet.Name.ValueString(),
+			netmaskLength: int(subnet.NetmaskLength.ValueInt32()),
+		})
+	}
+
+	assignments, err := planSubnetCIDRBlocks(cidrBlocks, requests, priorCIDRBlocks)
+
+	if err != nil {
+		diags.AddError("planning VPC Subnet CIDR Plan", err.Error())
+
+		return nil, diags
+	}
+
+	for _, subnet := range subnets {
+		name := subnet.Name.ValueString()
+		cidrBlock := assignments[name].String()
+
+		subnet.CIDRBlock = types.StringValue(cidrBlock)
+		subnet.IPAMPoolAllocationID = types.StringUnknown()
+		if data.IPAMPoolID.IsNull() {
+			subnet.IPAMPoolAllocationID = types.StringNull()
+		} else if v, ok := priorSubnets[name]; ok && v.CIDRBlock.ValueString() == cidrBlock && !v.IPAMPoolAllocationID.IsNull() {
+			subnet.IPAMPoolAllocationID = v.IPAMPoolAllocationID
+		}
+	}
+
+	return subnets, diags
+}
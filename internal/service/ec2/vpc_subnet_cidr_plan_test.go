@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCSubnetCIDRPlan_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_subnet_cidr_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSubnetCIDRPlanConfig_basic(24),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("subnet").AtSliceIndex(0).AtMapKey(names.AttrCIDRBlock), knownvalue.StringExact("10.0.16.0/24")),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("subnet").AtSliceIndex(1).AtMapKey(names.AttrCIDRBlock), knownvalue.StringExact("10.0.17.0/24")),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("subnet").AtSliceIndex(2).AtMapKey(names.AttrCIDRBlock), knownvalue.StringExact("10.0.0.0/20")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "subnet.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.0.availability_zone", "us-west-2a"), //lintignore:AWSAT003
+					resource.TestCheckResourceAttr(resourceName, "subnet.0.ipam_pool_allocation_id", ""),
+				),
+			},
+			{
+				Config: testAccVPCSubnetCIDRPlanConfig_basic(23),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("subnet").AtSliceIndex(0).AtMapKey(names.AttrCIDRBlock), knownvalue.StringExact("10.0.16.0/24")),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("subnet").AtSliceIndex(1).AtMapKey(names.AttrCIDRBlock), knownvalue.StringExact("10.0.18.0/23")),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("subnet").AtSliceIndex(2).AtMapKey(names.AttrCIDRBlock), knownvalue.StringExact("10.0.0.0/20")),
+					},
+				},
+			},
+			{
+				Config: testAccVPCSubnetCIDRPlanConfig_basic(23),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccVPCSubnetCIDRPlan_ipamPool(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_subnet_cidr_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubnetCIDRPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSubnetCIDRPlanConfig_ipamPool(26),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSubnetCIDRPlanAllocationsExist(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "ipam_pool_id", "aws_vpc_ipam_pool.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "subnet.0.cidr_block", "172.2.0.0/26"),
+					resource.TestMatchResourceAttr(resourceName, "subnet.0.ipam_pool_allocation_id", regexache.MustCompile(`^ipam-pool-alloc-[0-9a-f]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "subnet.1.cidr_block", "172.2.0.64/26"),
+					resource.TestMatchResourceAttr(resourceName, "subnet.1.ipam_pool_allocation_id", regexache.MustCompile(`^ipam-pool-alloc-[0-9a-f]+$`)),
+				),
+			},
+			{
+				Config: testAccVPCSubnetCIDRPlanConfig_ipamPool(25),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSubnetCIDRPlanAllocationsExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subnet.0.cidr_block", "172.2.0.0/26"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.1.cidr_block", "172.2.0.128/25"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSubnetCIDRPlanAllocationsExist(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["subnet.#"])
+		if err != nil {
+			return err
+		}
+
+		for i := range count {
+			_, err := tfec2.FindIPAMPoolAllocationByTwoPartKey(ctx, conn, rs.Primary.Attributes[fmt.Sprintf("subnet.%d.ipam_pool_allocation_id", i)], rs.Primary.Attributes["ipam_pool_id"])
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSubnetCIDRPlanDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_vpc_subnet_cidr_plan" {
+				continue
+			}
+
+			count, err := strconv.Atoi(rs.Primary.Attributes["subnet.#"])
+			if err != nil {
+				return err
+			}
+
+			for i := range count {
+				allocationID := rs.Primary.Attributes[fmt.Sprintf("subnet.%d.ipam_pool_allocation_id", i)]
+				_, err := tfec2.FindIPAMPoolAllocationByTwoPartKey(ctx, conn, allocationID, rs.Primary.Attributes["ipam_pool_id"])
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("VPC Subnet CIDR Plan %s IPAM Pool Allocation %s still exists", rs.Primary.ID, allocationID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCSubnetCIDRPlanConfig_basic(netmaskLength int) string {
+	//lintignore:AWSAT003
+	return fmt.Sprintf(`
+resource "aws_vpc_subnet_cidr_plan" "test" {
+  cidr_blocks = ["10.0.0.0/16"]
+
+  subnet {
+    name              = "a"
+    availability_zone = "us-west-2a"
+    netmask_length    = 24
+  }
+
+  subnet {
+    name              = "b"
+    availability_zone = "us-west-2b"
+    netmask_length    = %[1]d
+  }
+
+  subnet {
+    name           = "c"
+    netmask_length = 20
+  }
+}
+`, netmaskLength)
+}
+
+func testAccVPCSubnetCIDRPlanConfig_ipamPool(netmaskLength int) string {
+	return acctest.ConfigCompose(testAccIPAMPoolCIDRAllocationConfig_base, fmt.Sprintf(`
+resource "aws_vpc_subnet_cidr_plan" "test" {
+  cidr_blocks  = [aws_vpc_ipam_pool_cidr.test.cidr]
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+
+  subnet {
+    name           = "a"
+    netmask_length = 26
+  }
+
+  subnet {
+    name           = "b"
+    netmask_length = %[1]d
+  }
+}
+`, netmaskLength))
+}
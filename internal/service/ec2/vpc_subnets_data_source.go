@@ -33,7 +33,10 @@ func dataSourceSubnets() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"limit":        limitSchema(),
+			"sort_by":      sortBySchema(),
 			names.AttrTags: tftags.TagsSchemaComputed(),
+			"where":        whereSchema(),
 		},
 	}
 }
@@ -65,6 +68,12 @@ func dataSourceSubnetsRead(ctx context.Context, d *schema.ResourceData, meta any
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Subnets: %s", err)
 	}
 
+	output, err = filterByExpressionsFromResourceData(d, output)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Subnets: %s", err)
+	}
+
 	var subnetIDs []string
 
 	for _, v := range output {
@@ -89,6 +89,31 @@ func TestAccVPCsDataSource_empty(t *testing.T) {
 	})
 }
 
+func TestAccVPCsDataSource_expressions(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCVPCsDataSourceConfig_expressions(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_vpcs.where", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_vpcs.where", "ids.0", "aws_vpc.test.1", names.AttrID),
+					resource.TestCheckResourceAttr("data.aws_vpcs.sort_by", "ids.#", "2"),
+					resource.TestCheckResourceAttrPair("data.aws_vpcs.sort_by", "ids.0", "aws_vpc.test.0", names.AttrID),
+					resource.TestCheckResourceAttrPair("data.aws_vpcs.sort_by", "ids.1", "aws_vpc.test.1", names.AttrID),
+					resource.TestCheckResourceAttr("data.aws_vpcs.limit", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_vpcs.limit", "ids.0", "aws_vpc.test.0", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
 func testAccVPCVPCsDataSourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -151,3 +176,49 @@ data "aws_vpcs" "test" {
 }
 `, rName)
 }
+
+func testAccVPCVPCsDataSourceConfig_expressions(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  count = 2
+
+  cidr_block = "10.0.${count.index}.0/24"
+
+  tags = {
+    Name = %[1]q
+    Tier = count.index == 0 ? "public" : "private"
+  }
+}
+
+data "aws_vpcs" "where" {
+  tags = {
+    Name = %[1]q
+  }
+
+  where = "Tags[?Key == 'Tier'] | [0].Value == 'private'"
+
+  depends_on = [aws_vpc.test]
+}
+
+data "aws_vpcs" "sort_by" {
+  tags = {
+    Name = %[1]q
+  }
+
+  sort_by = "CidrBlock"
+
+  depends_on = [aws_vpc.test]
+}
+
+data "aws_vpcs" "limit" {
+  tags = {
+    Name = %[1]q
+  }
+
+  sort_by = "CidrBlock"
+  limit   = 1
+
+  depends_on = [aws_vpc.test]
+}
+`, rName)
+}
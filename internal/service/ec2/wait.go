@@ -15,7 +15,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -66,7 +65,7 @@ const (
 )
 
 func waitAvailabilityZoneGroupNotOptedIn(ctx context.Context, conn *ec2.Client, name string) (*awstypes.AvailabilityZone, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.AvailabilityZoneOptInStatusOptedIn),
 		Target:  enum.Slice(awstypes.AvailabilityZoneOptInStatusNotOptedIn),
 		Refresh: statusAvailabilityZoneGroupOptInStatus(ctx, conn, name),
@@ -83,7 +82,7 @@ func waitAvailabilityZoneGroupNotOptedIn(ctx context.Context, conn *ec2.Client,
 }
 
 func waitAvailabilityZoneGroupOptedIn(ctx context.Context, conn *ec2.Client, name string) (*awstypes.AvailabilityZone, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.AvailabilityZoneOptInStatusNotOptedIn),
 		Target:  enum.Slice(awstypes.AvailabilityZoneOptInStatusOptedIn),
 		Refresh: statusAvailabilityZoneGroupOptInStatus(ctx, conn, name),
@@ -100,7 +99,7 @@ func waitAvailabilityZoneGroupOptedIn(ctx context.Context, conn *ec2.Client, nam
 }
 
 func waitCapacityReservationActive(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.CapacityReservation, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.CapacityReservationStatePending),
 		Target:  enum.Slice(awstypes.CapacityReservationStateActive),
 		Refresh: statusCapacityReservation(ctx, conn, id),
@@ -117,7 +116,7 @@ func waitCapacityReservationActive(ctx context.Context, conn *ec2.Client, id str
 }
 
 func waitCapacityReservationDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.CapacityReservation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.CapacityReservationStateActive),
 		Target:  []string{},
 		Refresh: statusCapacityReservation(ctx, conn, id),
@@ -134,7 +133,7 @@ func waitCapacityReservationDeleted(ctx context.Context, conn *ec2.Client, id st
 }
 
 func waitCapacityBlockReservationActive(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.CapacityReservation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.CapacityReservationStatePaymentPending),
 		Target:     enum.Slice(awstypes.CapacityReservationStateActive, awstypes.CapacityReservationStateScheduled),
 		Refresh:    statusCapacityReservation(ctx, conn, id),
@@ -153,7 +152,7 @@ func waitCapacityBlockReservationActive(ctx context.Context, conn *ec2.Client, i
 }
 
 func waitCarrierGatewayCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.CarrierGateway, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.CarrierGatewayStatePending),
 		Target:  enum.Slice(awstypes.CarrierGatewayStateAvailable),
 		Refresh: statusCarrierGateway(ctx, conn, id),
@@ -170,7 +169,7 @@ func waitCarrierGatewayCreated(ctx context.Context, conn *ec2.Client, id string,
 }
 
 func waitCarrierGatewayDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.CarrierGateway, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.CarrierGatewayStateDeleting),
 		Target:  []string{},
 		Refresh: statusCarrierGateway(ctx, conn, id),
@@ -187,7 +186,7 @@ func waitCarrierGatewayDeleted(ctx context.Context, conn *ec2.Client, id string,
 }
 
 func waitClientVPNAuthorizationRuleCreated(ctx context.Context, conn *ec2.Client, endpointID, targetNetworkCIDR, accessGroupID string, timeout time.Duration) (*awstypes.AuthorizationRule, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ClientVpnAuthorizationRuleStatusCodeAuthorizing),
 		Target:  enum.Slice(awstypes.ClientVpnAuthorizationRuleStatusCodeActive),
 		Refresh: statusClientVPNAuthorizationRule(ctx, conn, endpointID, targetNetworkCIDR, accessGroupID),
@@ -206,7 +205,7 @@ func waitClientVPNAuthorizationRuleCreated(ctx context.Context, conn *ec2.Client
 }
 
 func waitClientVPNAuthorizationRuleDeleted(ctx context.Context, conn *ec2.Client, endpointID, targetNetworkCIDR, accessGroupID string, timeout time.Duration) (*awstypes.AuthorizationRule, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ClientVpnAuthorizationRuleStatusCodeRevoking),
 		Target:  []string{},
 		Refresh: statusClientVPNAuthorizationRule(ctx, conn, endpointID, targetNetworkCIDR, accessGroupID),
@@ -225,7 +224,7 @@ func waitClientVPNAuthorizationRuleDeleted(ctx context.Context, conn *ec2.Client
 }
 
 func waitClientVPNEndpointClientConnectResponseOptionsUpdated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.ClientConnectResponseOptions, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ClientVpnEndpointAttributeStatusCodeApplying),
 		Target:  enum.Slice(awstypes.ClientVpnEndpointAttributeStatusCodeApplied),
 		Refresh: statusClientVPNEndpointClientConnectResponseOptions(ctx, conn, id),
@@ -244,7 +243,7 @@ func waitClientVPNEndpointClientConnectResponseOptionsUpdated(ctx context.Contex
 }
 
 func waitClientVPNEndpointDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.ClientVpnEndpoint, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ClientVpnEndpointStatusCodeDeleting),
 		Target:  []string{},
 		Refresh: statusClientVPNEndpoint(ctx, conn, id),
@@ -263,7 +262,7 @@ func waitClientVPNEndpointDeleted(ctx context.Context, conn *ec2.Client, id stri
 }
 
 func waitClientVPNNetworkAssociationCreated(ctx context.Context, conn *ec2.Client, associationID, endpointID string, timeout time.Duration) (*awstypes.TargetNetwork, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:      enum.Slice(awstypes.AssociationStatusCodeAssociating),
 		Target:       enum.Slice(awstypes.AssociationStatusCodeAssociated),
 		Refresh:      statusClientVPNNetworkAssociation(ctx, conn, associationID, endpointID),
@@ -284,7 +283,7 @@ func waitClientVPNNetworkAssociationCreated(ctx context.Context, conn *ec2.Clien
 }
 
 func waitClientVPNNetworkAssociationDeleted(ctx context.Context, conn *ec2.Client, associationID, endpointID string, timeout time.Duration) (*awstypes.TargetNetwork, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:      enum.Slice(awstypes.AssociationStatusCodeDisassociating),
 		Target:       []string{},
 		Refresh:      statusClientVPNNetworkAssociation(ctx, conn, associationID, endpointID),
@@ -305,7 +304,7 @@ func waitClientVPNNetworkAssociationDeleted(ctx context.Context, conn *ec2.Clien
 }
 
 func waitClientVPNRouteCreated(ctx context.Context, conn *ec2.Client, endpointID, targetSubnetID, destinationCIDR string, timeout time.Duration) (*awstypes.ClientVpnRoute, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ClientVpnRouteStatusCodeCreating),
 		Target:  enum.Slice(awstypes.ClientVpnRouteStatusCodeActive),
 		Refresh: statusClientVPNRoute(ctx, conn, endpointID, targetSubnetID, destinationCIDR),
@@ -324,7 +323,7 @@ func waitClientVPNRouteCreated(ctx context.Context, conn *ec2.Client, endpointID
 }
 
 func waitClientVPNRouteDeleted(ctx context.Context, conn *ec2.Client, endpointID, targetSubnetID, destinationCIDR string, timeout time.Duration) (*awstypes.ClientVpnRoute, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ClientVpnRouteStatusCodeActive, awstypes.ClientVpnRouteStatusCodeDeleting),
 		Target:  []string{},
 		Refresh: statusClientVPNRoute(ctx, conn, endpointID, targetSubnetID, destinationCIDR),
@@ -346,7 +345,7 @@ func waitCustomerGatewayCreated(ctx context.Context, conn *ec2.Client, id string
 	const (
 		timeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(customerGatewayStatePending),
 		Target:     enum.Slice(customerGatewayStateAvailable),
 		Refresh:    statusCustomerGateway(ctx, conn, id),
@@ -368,7 +367,7 @@ func waitCustomerGatewayDeleted(ctx context.Context, conn *ec2.Client, id string
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(customerGatewayStateAvailable, customerGatewayStateDeleting),
 		Target:  []string{},
 		Refresh: statusCustomerGateway(ctx, conn, id),
@@ -385,7 +384,7 @@ func waitCustomerGatewayDeleted(ctx context.Context, conn *ec2.Client, id string
 }
 
 func waitEBSSnapshotImportComplete(ctx context.Context, conn *ec2.Client, importTaskID string, timeout time.Duration) (*awstypes.SnapshotTaskDetail, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			ebsSnapshotImportStateActive,
 			ebsSnapshotImportStateUpdating,
@@ -411,7 +410,7 @@ func waitEBSSnapshotImportComplete(ctx context.Context, conn *ec2.Client, import
 }
 
 func waitEBSSnapshotTierArchive(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.SnapshotTierStatus, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(targetStorageTierStandard),
 		Target:  enum.Slice(awstypes.TargetStorageTierArchive),
 		Refresh: statusSnapshotStorageTier(ctx, conn, id),
@@ -431,7 +430,7 @@ func waitEBSSnapshotTierArchive(ctx context.Context, conn *ec2.Client, id string
 }
 
 func waitEIPDomainNameAttributeDeleted(ctx context.Context, conn *ec2.Client, allocationID string, timeout time.Duration) (*awstypes.AddressAttribute, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{ptrUpdateStatusPending},
 		Target:  []string{},
 		Timeout: timeout,
@@ -452,7 +451,7 @@ func waitEIPDomainNameAttributeDeleted(ctx context.Context, conn *ec2.Client, al
 }
 
 func waitEIPDomainNameAttributeUpdated(ctx context.Context, conn *ec2.Client, allocationID string, timeout time.Duration) (*awstypes.AddressAttribute, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{ptrUpdateStatusPending},
 		Target:  []string{""},
 		Timeout: timeout,
@@ -473,7 +472,7 @@ func waitEIPDomainNameAttributeUpdated(ctx context.Context, conn *ec2.Client, al
 }
 
 func waitFastSnapshotRestoreCreated(ctx context.Context, conn *ec2.Client, availabilityZone, snapshotID string, timeout time.Duration) (*awstypes.DescribeFastSnapshotRestoreSuccessItem, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.FastSnapshotRestoreStateCodeEnabling, awstypes.FastSnapshotRestoreStateCodeOptimizing),
 		Target:  enum.Slice(awstypes.FastSnapshotRestoreStateCodeEnabled),
 		Refresh: statusFastSnapshotRestore(ctx, conn, availabilityZone, snapshotID),
@@ -490,7 +489,7 @@ func waitFastSnapshotRestoreCreated(ctx context.Context, conn *ec2.Client, avail
 }
 
 func waitFastSnapshotRestoreDeleted(ctx context.Context, conn *ec2.Client, availabilityZone, snapshotID string, timeout time.Duration) (*awstypes.DescribeFastSnapshotRestoreSuccessItem, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.FastSnapshotRestoreStateCodeDisabling, awstypes.FastSnapshotRestoreStateCodeOptimizing, awstypes.FastSnapshotRestoreStateCodeEnabled),
 		Target:  []string{},
 		Refresh: statusFastSnapshotRestore(ctx, conn, availabilityZone, snapshotID),
@@ -507,7 +506,7 @@ func waitFastSnapshotRestoreDeleted(ctx context.Context, conn *ec2.Client, avail
 }
 
 func waitFleet(ctx context.Context, conn *ec2.Client, id string, pending, target []string, timeout, delay time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    pending,
 		Target:     target,
 		Refresh:    statusFleet(ctx, conn, id),
@@ -522,7 +521,7 @@ func waitFleet(ctx context.Context, conn *ec2.Client, id string, pending, target
 }
 
 func waitHostCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Host, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.AllocationStatePending),
 		Target:  enum.Slice(awstypes.AllocationStateAvailable),
 		Timeout: timeout,
@@ -539,7 +538,7 @@ func waitHostCreated(ctx context.Context, conn *ec2.Client, id string, timeout t
 }
 
 func waitHostDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Host, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.AllocationStateAvailable),
 		Target:  []string{},
 		Timeout: timeout,
@@ -556,7 +555,7 @@ func waitHostDeleted(ctx context.Context, conn *ec2.Client, id string, timeout t
 }
 
 func waitHostUpdated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Host, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.AllocationStatePending),
 		Target:  enum.Slice(awstypes.AllocationStateAvailable),
 		Timeout: timeout,
@@ -573,7 +572,7 @@ func waitHostUpdated(ctx context.Context, conn *ec2.Client, id string, timeout t
 }
 
 func waitImageAvailable(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Image, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.ImageStatePending),
 		Target:     enum.Slice(awstypes.ImageStateAvailable),
 		Refresh:    statusImage(ctx, conn, id),
@@ -596,7 +595,7 @@ func waitImageAvailable(ctx context.Context, conn *ec2.Client, id string, timeou
 }
 
 func waitImageBlockPublicAccessState(ctx context.Context, conn *ec2.Client, target string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:     []string{target},
 		Refresh:    statusImageBlockPublicAccess(ctx, conn),
 		Timeout:    timeout,
@@ -610,7 +609,7 @@ func waitImageBlockPublicAccessState(ctx context.Context, conn *ec2.Client, targ
 }
 
 func waitImageDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Image, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.ImageStateAvailable, awstypes.ImageStateFailed, awstypes.ImageStatePending),
 		Target:     []string{},
 		Refresh:    statusImage(ctx, conn, id),
@@ -633,7 +632,7 @@ func waitImageDeleted(ctx context.Context, conn *ec2.Client, id string, timeout
 }
 
 func waitInstanceCapacityReservationSpecificationUpdated(ctx context.Context, conn *ec2.Client, instanceID string, expectedValue *awstypes.CapacityReservationSpecification) (*awstypes.Instance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:     enum.Slice(strconv.FormatBool(true)),
 		Refresh:    statusInstanceCapacityReservationSpecificationEquals(ctx, conn, instanceID, expectedValue),
 		Timeout:    ec2PropagationTimeout,
@@ -651,7 +650,7 @@ func waitInstanceCapacityReservationSpecificationUpdated(ctx context.Context, co
 }
 
 func waitInstanceConnectEndpointCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Ec2InstanceConnectEndpoint, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.Ec2InstanceConnectEndpointStateCreateInProgress),
 		Target:  enum.Slice(awstypes.Ec2InstanceConnectEndpointStateCreateComplete),
 		Refresh: statusInstanceConnectEndpoint(ctx, conn, id),
@@ -670,7 +669,7 @@ func waitInstanceConnectEndpointCreated(ctx context.Context, conn *ec2.Client, i
 }
 
 func waitInstanceConnectEndpointDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Ec2InstanceConnectEndpoint, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.Ec2InstanceConnectEndpointStateDeleteInProgress),
 		Target:  []string{},
 		Refresh: statusInstanceConnectEndpoint(ctx, conn, id),
@@ -689,7 +688,7 @@ func waitInstanceConnectEndpointDeleted(ctx context.Context, conn *ec2.Client, i
 }
 
 func waitInstanceIAMInstanceProfileUpdated(ctx context.Context, conn *ec2.Client, instanceID string, expectedValue string) (*awstypes.Instance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:     enum.Slice(expectedValue),
 		Refresh:    statusInstanceIAMInstanceProfile(ctx, conn, instanceID),
 		Timeout:    ec2PropagationTimeout,
@@ -707,7 +706,7 @@ func waitInstanceIAMInstanceProfileUpdated(ctx context.Context, conn *ec2.Client
 }
 
 func waitInstanceMaintenanceOptionsAutoRecoveryUpdated(ctx context.Context, conn *ec2.Client, id, expectedValue string, timeout time.Duration) (*awstypes.InstanceMaintenanceOptions, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:     enum.Slice(expectedValue),
 		Refresh:    statusInstanceMaintenanceOptionsAutoRecovery(ctx, conn, id),
 		Timeout:    timeout,
@@ -725,7 +724,7 @@ func waitInstanceMaintenanceOptionsAutoRecoveryUpdated(ctx context.Context, conn
 }
 
 func waitInstanceMetadataOptionsApplied(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.InstanceMetadataOptionsResponse, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.InstanceMetadataOptionsStatePending),
 		Target:     enum.Slice(awstypes.InstanceMetadataOptionsStateApplied),
 		Refresh:    statusInstanceMetadataOptions(ctx, conn, id),
@@ -744,7 +743,7 @@ func waitInstanceMetadataOptionsApplied(ctx context.Context, conn *ec2.Client, i
 }
 
 func waitInstanceRootBlockDeviceDeleteOnTerminationUpdated(ctx context.Context, conn *ec2.Client, id string, expectedValue bool, timeout time.Duration) (*awstypes.EbsInstanceBlockDevice, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:     []string{strconv.FormatBool(expectedValue)},
 		Refresh:    statusInstanceRootBlockDeviceDeleteOnTermination(ctx, conn, id),
 		Timeout:    timeout,
@@ -762,7 +761,7 @@ func waitInstanceRootBlockDeviceDeleteOnTerminationUpdated(ctx context.Context,
 }
 
 func waitInternetGatewayAttached(ctx context.Context, conn *ec2.Client, internetGatewayID, vpcID string, timeout time.Duration) (*awstypes.InternetGatewayAttachment, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:        enum.Slice(awstypes.AttachmentStatusAttaching),
 		Target:         enum.Slice(internetGatewayAttachmentStateAvailable),
 		Timeout:        timeout,
@@ -780,7 +779,7 @@ func waitInternetGatewayAttached(ctx context.Context, conn *ec2.Client, internet
 }
 
 func waitInternetGatewayDetached(ctx context.Context, conn *ec2.Client, internetGatewayID, vpcID string, timeout time.Duration) (*awstypes.InternetGatewayAttachment, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(internetGatewayAttachmentStateAvailable, awstypes.AttachmentStatusDetaching),
 		Target:  []string{},
 		Timeout: timeout,
@@ -797,7 +796,7 @@ func waitInternetGatewayDetached(ctx context.Context, conn *ec2.Client, internet
 }
 
 func waitIPAMCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Ipam, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.IpamStateCreateInProgress),
 		Target:  enum.Slice(awstypes.IpamStateCreateComplete),
 		Refresh: statusIPAM(ctx, conn, id),
@@ -815,7 +814,7 @@ func waitIPAMCreated(ctx context.Context, conn *ec2.Client, id string, timeout t
 }
 
 func waitIPAMDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Ipam, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.IpamStateCreateComplete, awstypes.IpamStateModifyComplete, awstypes.IpamStateDeleteInProgress),
 		Target:  []string{},
 		Refresh: statusIPAM(ctx, conn, id),
@@ -833,7 +832,7 @@ func waitIPAMDeleted(ctx context.Context, conn *ec2.Client, id string, timeout t
 }
 
 func waitIPAMPoolCIDRCreated(ctx context.Context, conn *ec2.Client, poolCIDRID, poolID, cidrBlock string, timeout time.Duration) (*awstypes.IpamPoolCidr, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:        enum.Slice(awstypes.IpamPoolCidrStatePendingProvision),
 		Target:         enum.Slice(awstypes.IpamPoolCidrStateProvisioned),
 		Refresh:        statusIPAMPoolCIDR(ctx, conn, cidrBlock, poolID, poolCIDRID),
@@ -856,7 +855,7 @@ func waitIPAMPoolCIDRCreated(ctx context.Context, conn *ec2.Client, poolCIDRID,
 }
 
 func waitIPAMPoolCIDRDeleted(ctx context.Context, conn *ec2.Client, poolCIDRID, poolID, cidrBlock string, timeout time.Duration) (*awstypes.IpamPoolCidr, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.IpamPoolCidrStatePendingDeprovision, awstypes.IpamPoolCidrStateProvisioned),
 		Target:  []string{},
 		Refresh: statusIPAMPoolCIDR(ctx, conn, cidrBlock, poolID, poolCIDRID),
@@ -878,7 +877,7 @@ func waitIPAMPoolCIDRDeleted(ctx context.Context, conn *ec2.Client, poolCIDRID,
 }
 
 func waitIPAMPoolCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.IpamPool, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.IpamPoolStateCreateInProgress),
 		Target:  enum.Slice(awstypes.IpamPoolStateCreateComplete),
 		Refresh: statusIPAMPool(ctx, conn, id),
@@ -900,7 +899,7 @@ func waitIPAMPoolCreated(ctx context.Context, conn *ec2.Client, id string, timeo
 }
 
 func waitIPAMPoolDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.IpamPool, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.IpamPoolStateDeleteInProgress),
 		Target:  []string{},
 		Refresh: statusIPAMPool(ctx, conn, id),
@@ -922,7 +921,7 @@ func waitIPAMPoolDeleted(ctx context.Context, conn *ec2.Client, id string, timeo
 }
 
 func waitIPAMPoolUpdated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.IpamPool, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.IpamPoolStateModifyInProgress),
 		Target:  enum.Slice(awstypes.IpamPoolStateModifyComplete),
 		Refresh: statusIPAMPool(ctx, conn, id),
@@ -944,7 +943,7 @@ func waitIPAMPoolUpdated(ctx context.Context, conn *ec2.Client, id string, timeo
 }
 
 func waitIPAMResourceDiscoveryAssociationCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.IpamResourceDiscoveryAssociation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.IpamResourceDiscoveryAssociationStateAssociateInProgress),
 		Target:  enum.Slice(awstypes.IpamResourceDiscoveryAssociationStateAssociateComplete),
 		Refresh: statusIPAMResourceDiscoveryAssociation(ctx, conn, id),
@@ -962,7 +961,7 @@ func waitIPAMResourceDiscoveryAssociationCreated(ctx context.Context, conn *ec2.
 }
 
 func waitIPAMResourceDiscoveryAssociationDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.IpamResourceDiscoveryAssociation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.IpamResourceDiscoveryAssociationStateAssociateComplete, awstypes.IpamResourceDiscoveryAssociationStateDisassociateInProgress),
 		Target:  []string{},
 		Refresh: statusIPAMResourceDiscoveryAssociation(ctx, conn, id),
@@ -980,7 +979,7 @@ func waitIPAMResourceDiscoveryAssociationDeleted(ctx context.Context, conn *ec2.
 }
 
 func waitIPAMResourceDiscoveryCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.IpamResourceDiscovery, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.IpamResourceDiscoveryStateCreateInProgress),
 		Target:  enum.Slice(awstypes.IpamResourceDiscoveryStateCreateComplete),
 		Refresh: statusIPAMResourceDiscovery(ctx, conn, id),
@@ -998,7 +997,7 @@ func waitIPAMResourceDiscoveryCreated(ctx context.Context, conn *ec2.Client, id
 }
 
 func waitIPAMResourceDiscoveryDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.IpamResourceDiscovery, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.IpamResourceDiscoveryStateCreateComplete, awstypes.IpamResourceDiscoveryStateModifyComplete, awstypes.IpamResourceDiscoveryStateDeleteInProgress),
 		Target:  []string{},
 		Refresh: statusIPAMResourceDiscovery(ctx, conn, id),
@@ -1016,7 +1015,7 @@ func waitIPAMResourceDiscoveryDeleted(ctx context.Context, conn *ec2.Client, id
 }
 
 func waitIPAMResourceDiscoveryUpdated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.IpamResourceDiscovery, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.IpamResourceDiscoveryStateModifyInProgress),
 		Target:  enum.Slice(awstypes.IpamResourceDiscoveryStateModifyComplete),
 		Refresh: statusIPAMResourceDiscovery(ctx, conn, id),
@@ -1034,7 +1033,7 @@ func waitIPAMResourceDiscoveryUpdated(ctx context.Context, conn *ec2.Client, id
 }
 
 func waitIPAMScopeCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.IpamScope, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.IpamScopeStateCreateInProgress),
 		Target:  enum.Slice(awstypes.IpamScopeStateCreateComplete),
 		Refresh: statusIPAMScope(ctx, conn, id),
@@ -1052,7 +1051,7 @@ func waitIPAMScopeCreated(ctx context.Context, conn *ec2.Client, id string, time
 }
 
 func waitIPAMScopeDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.IpamScope, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.IpamScopeStateCreateComplete, awstypes.IpamScopeStateModifyComplete, awstypes.IpamScopeStateDeleteInProgress),
 		Target:  []string{},
 		Refresh: statusIPAMScope(ctx, conn, id),
@@ -1070,7 +1069,7 @@ func waitIPAMScopeDeleted(ctx context.Context, conn *ec2.Client, id string, time
 }
 
 func waitIPAMScopeUpdated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.IpamScope, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.IpamScopeStateModifyInProgress),
 		Target:  enum.Slice(awstypes.IpamScopeStateModifyComplete),
 		Refresh: statusIPAMScope(ctx, conn, id),
@@ -1088,7 +1087,7 @@ func waitIPAMScopeUpdated(ctx context.Context, conn *ec2.Client, id string, time
 }
 
 func waitIPAMUpdated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Ipam, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.IpamStateModifyInProgress),
 		Target:  enum.Slice(awstypes.IpamStateModifyComplete),
 		Refresh: statusIPAM(ctx, conn, id),
@@ -1109,7 +1108,7 @@ func waitLocalGatewayRouteDeleted(ctx context.Context, conn *ec2.Client, localGa
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.LocalGatewayRouteStateDeleting),
 		Target:  []string{},
 		Refresh: statusLocalGatewayRoute(ctx, conn, localGatewayRouteTableID, destinationCIDRBlock),
@@ -1129,7 +1128,7 @@ func waitLocalGatewayRouteTableVPCAssociationAssociated(ctx context.Context, con
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.RouteTableAssociationStateCodeAssociating),
 		Target:  enum.Slice(awstypes.RouteTableAssociationStateCodeAssociated),
 		Refresh: statusLocalGatewayRouteTableVPCAssociation(ctx, conn, id),
@@ -1149,7 +1148,7 @@ func waitLocalGatewayRouteTableVPCAssociationDisassociated(ctx context.Context,
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.RouteTableAssociationStateCodeDisassociating),
 		Target:  []string{},
 		Refresh: statusLocalGatewayRouteTableVPCAssociation(ctx, conn, id),
@@ -1166,7 +1165,7 @@ func waitLocalGatewayRouteTableVPCAssociationDisassociated(ctx context.Context,
 }
 
 func waitManagedPrefixListCreated(ctx context.Context, conn *ec2.Client, id string) (*awstypes.ManagedPrefixList, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.PrefixListStateCreateInProgress),
 		Target:  enum.Slice(awstypes.PrefixListStateCreateComplete),
 		Timeout: managedPrefixListTimeout,
@@ -1187,7 +1186,7 @@ func waitManagedPrefixListCreated(ctx context.Context, conn *ec2.Client, id stri
 }
 
 func waitManagedPrefixListDeleted(ctx context.Context, conn *ec2.Client, id string) (*awstypes.ManagedPrefixList, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.PrefixListStateDeleteInProgress),
 		Target:  []string{},
 		Timeout: managedPrefixListTimeout,
@@ -1208,7 +1207,7 @@ func waitManagedPrefixListDeleted(ctx context.Context, conn *ec2.Client, id stri
 }
 
 func waitManagedPrefixListModified(ctx context.Context, conn *ec2.Client, id string) (*awstypes.ManagedPrefixList, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.PrefixListStateModifyInProgress),
 		Target:  enum.Slice(awstypes.PrefixListStateModifyComplete),
 		Timeout: managedPrefixListTimeout,
@@ -1229,7 +1228,7 @@ func waitManagedPrefixListModified(ctx context.Context, conn *ec2.Client, id str
 }
 
 func waitNATGatewayAddressAssigned(ctx context.Context, conn *ec2.Client, natGatewayID, privateIP string, timeout time.Duration) (*awstypes.NatGatewayAddress, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.NatGatewayAddressStatusAssigning),
 		Target:  enum.Slice(awstypes.NatGatewayAddressStatusSucceeded),
 		Refresh: statusNATGatewayAddressByNATGatewayIDAndPrivateIP(ctx, conn, natGatewayID, privateIP),
@@ -1250,7 +1249,7 @@ func waitNATGatewayAddressAssigned(ctx context.Context, conn *ec2.Client, natGat
 }
 
 func waitNATGatewayAddressAssociated(ctx context.Context, conn *ec2.Client, natGatewayID, allocationID string, timeout time.Duration) (*awstypes.NatGatewayAddress, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.NatGatewayAddressStatusAssociating),
 		Target:  enum.Slice(awstypes.NatGatewayAddressStatusSucceeded),
 		Refresh: statusNATGatewayAddressByNATGatewayIDAndAllocationID(ctx, conn, natGatewayID, allocationID),
@@ -1271,7 +1270,7 @@ func waitNATGatewayAddressAssociated(ctx context.Context, conn *ec2.Client, natG
 }
 
 func waitNATGatewayAddressDisassociated(ctx context.Context, conn *ec2.Client, natGatewayID, allocationID string, timeout time.Duration) (*awstypes.NatGatewayAddress, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.NatGatewayAddressStatusSucceeded, awstypes.NatGatewayAddressStatusDisassociating),
 		Target:  []string{},
 		Refresh: statusNATGatewayAddressByNATGatewayIDAndAllocationID(ctx, conn, natGatewayID, allocationID),
@@ -1292,7 +1291,7 @@ func waitNATGatewayAddressDisassociated(ctx context.Context, conn *ec2.Client, n
 }
 
 func waitNATGatewayAddressUnassigned(ctx context.Context, conn *ec2.Client, natGatewayID, privateIP string, timeout time.Duration) (*awstypes.NatGatewayAddress, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.NatGatewayAddressStatusSucceeded, awstypes.NatGatewayAddressStatusUnassigning),
 		Target:  []string{},
 		Refresh: statusNATGatewayAddressByNATGatewayIDAndPrivateIP(ctx, conn, natGatewayID, privateIP),
@@ -1313,7 +1312,7 @@ func waitNATGatewayAddressUnassigned(ctx context.Context, conn *ec2.Client, natG
 }
 
 func waitNATGatewayCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.NatGateway, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.NatGatewayStatePending),
 		Target:  enum.Slice(awstypes.NatGatewayStateAvailable),
 		Refresh: statusNATGatewayState(ctx, conn, id),
@@ -1334,7 +1333,7 @@ func waitNATGatewayCreated(ctx context.Context, conn *ec2.Client, id string, tim
 }
 
 func waitNATGatewayDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.NatGateway, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.NatGatewayStateDeleting),
 		Target:     []string{},
 		Refresh:    statusNATGatewayState(ctx, conn, id),
@@ -1357,7 +1356,7 @@ func waitNATGatewayDeleted(ctx context.Context, conn *ec2.Client, id string, tim
 }
 
 func waitNetworkInsightsAnalysisCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.NetworkInsightsAnalysis, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.AnalysisStatusRunning),
 		Target:     enum.Slice(awstypes.AnalysisStatusSucceeded),
 		Timeout:    timeout,
@@ -1380,7 +1379,7 @@ func waitNetworkInsightsAnalysisCreated(ctx context.Context, conn *ec2.Client, i
 func waitNetworkInterfaceAvailableAfterUse(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.NetworkInterface, error) {
 	// Hyperplane attached ENI.
 	// Wait for it to be moved into a removable state.
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.NetworkInterfaceStatusInUse),
 		Target:     enum.Slice(awstypes.NetworkInterfaceStatusAvailable),
 		Timeout:    timeout,
@@ -1402,7 +1401,7 @@ func waitNetworkInterfaceAvailableAfterUse(ctx context.Context, conn *ec2.Client
 }
 
 func waitNetworkInterfaceCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.NetworkInterface, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{networkInterfaceStatusPending},
 		Target:  enum.Slice(awstypes.NetworkInterfaceStatusAvailable),
 		Timeout: timeout,
@@ -1419,7 +1418,7 @@ func waitNetworkInterfaceCreated(ctx context.Context, conn *ec2.Client, id strin
 }
 
 func waitNetworkInterfaceAttached(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.NetworkInterfaceAttachment, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.AttachmentStatusAttaching),
 		Target:  enum.Slice(awstypes.AttachmentStatusAttached),
 		Timeout: timeout,
@@ -1436,7 +1435,7 @@ func waitNetworkInterfaceAttached(ctx context.Context, conn *ec2.Client, id stri
 }
 
 func waitNetworkInterfaceDetached(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.NetworkInterfaceAttachment, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.AttachmentStatusAttached, awstypes.AttachmentStatusDetaching),
 		Target:  enum.Slice(awstypes.AttachmentStatusDetached),
 		Timeout: timeout,
@@ -1453,7 +1452,7 @@ func waitNetworkInterfaceDetached(ctx context.Context, conn *ec2.Client, id stri
 }
 
 func waitNetworkInterfacePermissionCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.NetworkInterfacePermission, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		// For some reason, the API returns all caps statuses (e.g. "PENDING" instead of "pending")
 		Pending:                   []string{"PENDING"},
 		Target:                    []string{"GRANTED"},
@@ -1474,7 +1473,7 @@ func waitNetworkInterfacePermissionCreated(ctx context.Context, conn *ec2.Client
 }
 
 func waitNetworkInterfacePermissionDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.NetworkInterfacePermission, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{"PENDING", "GRANTED", "REVOKING"},
 		Target:  []string{},
 		Refresh: statusNetworkInterfacePermission(ctx, conn, id),
@@ -1493,7 +1492,7 @@ func waitNetworkInterfacePermissionDeleted(ctx context.Context, conn *ec2.Client
 }
 
 func waitPlacementGroupCreated(ctx context.Context, conn *ec2.Client, name string) (*awstypes.PlacementGroup, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.PlacementGroupStatePending),
 		Target:  enum.Slice(awstypes.PlacementGroupStateAvailable),
 		Timeout: placementGroupCreatedTimeout,
@@ -1510,7 +1509,7 @@ func waitPlacementGroupCreated(ctx context.Context, conn *ec2.Client, name strin
 }
 
 func waitPlacementGroupDeleted(ctx context.Context, conn *ec2.Client, name string) (*awstypes.PlacementGroup, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.PlacementGroupStateDeleting),
 		Target:  []string{},
 		Timeout: placementGroupDeletedTimeout,
@@ -1527,7 +1526,7 @@ func waitPlacementGroupDeleted(ctx context.Context, conn *ec2.Client, name strin
 }
 
 func waitRouteDeleted(ctx context.Context, conn *ec2.Client, routeFinder routeFinder, routeTableID, destination string, timeout time.Duration) (*awstypes.Route, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{routeStatusReady},
 		Target:                    []string{},
 		Refresh:                   statusRoute(ctx, conn, routeFinder, routeTableID, destination),
@@ -1545,7 +1544,7 @@ func waitRouteDeleted(ctx context.Context, conn *ec2.Client, routeFinder routeFi
 }
 
 func waitRouteReady(ctx context.Context, conn *ec2.Client, routeFinder routeFinder, routeTableID, destination string, timeout time.Duration) (*awstypes.Route, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{},
 		Target:                    []string{routeStatusReady},
 		Refresh:                   statusRoute(ctx, conn, routeFinder, routeTableID, destination),
@@ -1564,7 +1563,7 @@ func waitRouteReady(ctx context.Context, conn *ec2.Client, routeFinder routeFind
 }
 
 func waitRouteTableAssociationCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.RouteTableAssociationState, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:        enum.Slice(awstypes.RouteTableAssociationStateCodeAssociating),
 		Target:         enum.Slice(awstypes.RouteTableAssociationStateCodeAssociated),
 		Refresh:        statusRouteTableAssociation(ctx, conn, id),
@@ -1586,7 +1585,7 @@ func waitRouteTableAssociationCreated(ctx context.Context, conn *ec2.Client, id
 }
 
 func waitRouteTableAssociationDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.RouteTableAssociationState, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.RouteTableAssociationStateCodeDisassociating, awstypes.RouteTableAssociationStateCodeAssociated),
 		Target:  []string{},
 		Refresh: statusRouteTableAssociation(ctx, conn, id),
@@ -1607,7 +1606,7 @@ func waitRouteTableAssociationDeleted(ctx context.Context, conn *ec2.Client, id
 }
 
 func waitRouteTableAssociationUpdated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.RouteTableAssociationState, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.RouteTableAssociationStateCodeAssociating),
 		Target:  enum.Slice(awstypes.RouteTableAssociationStateCodeAssociated),
 		Refresh: statusRouteTableAssociation(ctx, conn, id),
@@ -1628,7 +1627,7 @@ func waitRouteTableAssociationUpdated(ctx context.Context, conn *ec2.Client, id
 }
 
 func waitRouteTableDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.RouteTable, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{routeTableStatusReady},
 		Target:                    []string{},
 		Refresh:                   statusRouteTable(ctx, conn, id),
@@ -1646,7 +1645,7 @@ func waitRouteTableDeleted(ctx context.Context, conn *ec2.Client, id string, tim
 }
 
 func waitRouteTableReady(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.RouteTable, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{},
 		Target:                    []string{routeTableStatusReady},
 		Refresh:                   statusRouteTable(ctx, conn, id),
@@ -1665,7 +1664,7 @@ func waitRouteTableReady(ctx context.Context, conn *ec2.Client, id string, timeo
 }
 
 func waitSecurityGroupCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.SecurityGroup, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{},
 		Target:                    []string{securityGroupStatusCreated},
 		Refresh:                   statusSecurityGroup(ctx, conn, id),
@@ -1684,7 +1683,7 @@ func waitSecurityGroupCreated(ctx context.Context, conn *ec2.Client, id string,
 }
 
 func waitSpotFleetRequestCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.SpotFleetRequestConfig, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.BatchStateSubmitted),
 		Target:     enum.Slice(awstypes.BatchStateActive),
 		Refresh:    statusSpotFleetRequest(ctx, conn, id),
@@ -1703,7 +1702,7 @@ func waitSpotFleetRequestCreated(ctx context.Context, conn *ec2.Client, id strin
 }
 
 func waitSpotFleetRequestFulfilled(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.SpotFleetRequestConfig, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.ActivityStatusPendingFulfillment),
 		Target:     enum.Slice(awstypes.ActivityStatusFulfilled),
 		Refresh:    statusSpotFleetActivityStatus(ctx, conn, id),
@@ -1741,7 +1740,7 @@ func waitSpotFleetRequestFulfilled(ctx context.Context, conn *ec2.Client, id str
 }
 
 func waitSpotFleetRequestUpdated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.SpotFleetRequestConfig, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.BatchStateModifying),
 		Target:     enum.Slice(awstypes.BatchStateActive),
 		Refresh:    statusSpotFleetRequest(ctx, conn, id),
@@ -1760,7 +1759,7 @@ func waitSpotFleetRequestUpdated(ctx context.Context, conn *ec2.Client, id strin
 }
 
 func waitSpotInstanceRequestFulfilled(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.SpotInstanceRequest, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{spotInstanceRequestStatusCodePendingEvaluation, spotInstanceRequestStatusCodePendingFulfillment},
 		Target:     []string{spotInstanceRequestStatusCodeFulfilled},
 		Refresh:    statusSpotInstanceRequest(ctx, conn, id),
@@ -1786,7 +1785,7 @@ func waitSpotInstanceRequestFulfilled(ctx context.Context, conn *ec2.Client, id
 }
 
 func waitSubnetAssignIPv6AddressOnCreationUpdated(ctx context.Context, conn *ec2.Client, subnetID string, expectedValue bool) (*awstypes.Subnet, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:     enum.Slice(strconv.FormatBool(expectedValue)),
 		Refresh:    statusSubnetAssignIPv6AddressOnCreation(ctx, conn, subnetID),
 		Timeout:    ec2PropagationTimeout,
@@ -1804,7 +1803,7 @@ func waitSubnetAssignIPv6AddressOnCreationUpdated(ctx context.Context, conn *ec2
 }
 
 func waitSubnetAvailable(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Subnet, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SubnetStatePending),
 		Target:  enum.Slice(awstypes.SubnetStateAvailable),
 		Refresh: statusSubnetState(ctx, conn, id),
@@ -1821,7 +1820,7 @@ func waitSubnetAvailable(ctx context.Context, conn *ec2.Client, id string, timeo
 }
 
 func waitSubnetEnableDNS64Updated(ctx context.Context, conn *ec2.Client, subnetID string, expectedValue bool) (*awstypes.Subnet, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:     enum.Slice(strconv.FormatBool(expectedValue)),
 		Refresh:    statusSubnetEnableDNS64(ctx, conn, subnetID),
 		Timeout:    ec2PropagationTimeout,
@@ -1839,7 +1838,7 @@ func waitSubnetEnableDNS64Updated(ctx context.Context, conn *ec2.Client, subnetI
 }
 
 func waitSubnetEnableLniAtDeviceIndexUpdated(ctx context.Context, conn *ec2.Client, subnetID string, expectedValue int32) (*awstypes.Subnet, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:     enum.Slice(strconv.FormatInt(int64(expectedValue), 10)),
 		Refresh:    statusSubnetEnableLniAtDeviceIndex(ctx, conn, subnetID),
 		Timeout:    ec2PropagationTimeout,
@@ -1857,7 +1856,7 @@ func waitSubnetEnableLniAtDeviceIndexUpdated(ctx context.Context, conn *ec2.Clie
 }
 
 func waitSubnetEnableResourceNameDNSAAAARecordOnLaunchUpdated(ctx context.Context, conn *ec2.Client, subnetID string, expectedValue bool) (*awstypes.Subnet, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:     enum.Slice(strconv.FormatBool(expectedValue)),
 		Refresh:    statusSubnetEnableResourceNameDNSAAAARecordOnLaunch(ctx, conn, subnetID),
 		Timeout:    ec2PropagationTimeout,
@@ -1875,7 +1874,7 @@ func waitSubnetEnableResourceNameDNSAAAARecordOnLaunchUpdated(ctx context.Contex
 }
 
 func waitSubnetEnableResourceNameDNSARecordOnLaunchUpdated(ctx context.Context, conn *ec2.Client, subnetID string, expectedValue bool) (*awstypes.Subnet, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:     enum.Slice(strconv.FormatBool(expectedValue)),
 		Refresh:    statusSubnetEnableResourceNameDNSARecordOnLaunch(ctx, conn, subnetID),
 		Timeout:    ec2PropagationTimeout,
@@ -1893,7 +1892,7 @@ func waitSubnetEnableResourceNameDNSARecordOnLaunchUpdated(ctx context.Context,
 }
 
 func waitSubnetIPv6CIDRBlockAssociationCreated(ctx context.Context, conn *ec2.Client, id string) (*awstypes.SubnetCidrBlockState, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SubnetCidrBlockStateCodeAssociating, awstypes.SubnetCidrBlockStateCodeDisassociated, awstypes.SubnetCidrBlockStateCodeFailing),
 		Target:  enum.Slice(awstypes.SubnetCidrBlockStateCodeAssociated),
 		Refresh: statusSubnetIPv6CIDRBlockAssociationState(ctx, conn, id),
@@ -1914,7 +1913,7 @@ func waitSubnetIPv6CIDRBlockAssociationCreated(ctx context.Context, conn *ec2.Cl
 }
 
 func waitSubnetIPv6CIDRBlockAssociationDeleted(ctx context.Context, conn *ec2.Client, id string) (*awstypes.SubnetCidrBlockState, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SubnetCidrBlockStateCodeAssociated, awstypes.SubnetCidrBlockStateCodeDisassociating, awstypes.SubnetCidrBlockStateCodeFailing),
 		Target:  []string{},
 		Refresh: statusSubnetIPv6CIDRBlockAssociationState(ctx, conn, id),
@@ -1935,7 +1934,7 @@ func waitSubnetIPv6CIDRBlockAssociationDeleted(ctx context.Context, conn *ec2.Cl
 }
 
 func waitSubnetMapCustomerOwnedIPOnLaunchUpdated(ctx context.Context, conn *ec2.Client, subnetID string, expectedValue bool) (*awstypes.Subnet, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:     enum.Slice(strconv.FormatBool(expectedValue)),
 		Refresh:    statusSubnetMapCustomerOwnedIPOnLaunch(ctx, conn, subnetID),
 		Timeout:    ec2PropagationTimeout,
@@ -1953,7 +1952,7 @@ func waitSubnetMapCustomerOwnedIPOnLaunchUpdated(ctx context.Context, conn *ec2.
 }
 
 func waitSubnetMapPublicIPOnLaunchUpdated(ctx context.Context, conn *ec2.Client, subnetID string, expectedValue bool) (*awstypes.Subnet, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:     enum.Slice(strconv.FormatBool(expectedValue)),
 		Refresh:    statusSubnetMapPublicIPOnLaunch(ctx, conn, subnetID),
 		Timeout:    ec2PropagationTimeout,
@@ -1971,7 +1970,7 @@ func waitSubnetMapPublicIPOnLaunchUpdated(ctx context.Context, conn *ec2.Client,
 }
 
 func waitSubnetPrivateDNSHostnameTypeOnLaunchUpdated(ctx context.Context, conn *ec2.Client, subnetID string, expectedValue string) (*awstypes.Subnet, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:     enum.Slice(expectedValue),
 		Refresh:    statusSubnetPrivateDNSHostnameTypeOnLaunch(ctx, conn, subnetID),
 		Timeout:    ec2PropagationTimeout,
@@ -1989,7 +1988,7 @@ func waitSubnetPrivateDNSHostnameTypeOnLaunchUpdated(ctx context.Context, conn *
 }
 
 func waitTransitGatewayConnectCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.TransitGatewayConnect, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayAttachmentStatePending),
 		Target:  enum.Slice(awstypes.TransitGatewayAttachmentStateAvailable),
 		Refresh: statusTransitGatewayConnect(ctx, conn, id),
@@ -2006,7 +2005,7 @@ func waitTransitGatewayConnectCreated(ctx context.Context, conn *ec2.Client, id
 }
 
 func waitTransitGatewayConnectDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.TransitGatewayConnect, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:        enum.Slice(awstypes.TransitGatewayAttachmentStateAvailable, awstypes.TransitGatewayAttachmentStateDeleting),
 		Target:         []string{},
 		Refresh:        statusTransitGatewayConnect(ctx, conn, id),
@@ -2024,7 +2023,7 @@ func waitTransitGatewayConnectDeleted(ctx context.Context, conn *ec2.Client, id
 }
 
 func waitTransitGatewayConnectPeerCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.TransitGatewayConnectPeer, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayConnectPeerStatePending),
 		Target:  enum.Slice(awstypes.TransitGatewayConnectPeerStateAvailable),
 		Refresh: statusTransitGatewayConnectPeer(ctx, conn, id),
@@ -2041,7 +2040,7 @@ func waitTransitGatewayConnectPeerCreated(ctx context.Context, conn *ec2.Client,
 }
 
 func waitTransitGatewayConnectPeerDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.TransitGatewayConnectPeer, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayConnectPeerStateAvailable, awstypes.TransitGatewayConnectPeerStateDeleting),
 		Target:  []string{},
 		Refresh: statusTransitGatewayConnectPeer(ctx, conn, id),
@@ -2058,7 +2057,7 @@ func waitTransitGatewayConnectPeerDeleted(ctx context.Context, conn *ec2.Client,
 }
 
 func waitTransitGatewayCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.TransitGateway, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayStatePending),
 		Target:  enum.Slice(awstypes.TransitGatewayStateAvailable),
 		Refresh: statusTransitGateway(ctx, conn, id),
@@ -2075,7 +2074,7 @@ func waitTransitGatewayCreated(ctx context.Context, conn *ec2.Client, id string,
 }
 
 func waitTransitGatewayDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.TransitGateway, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:        enum.Slice(awstypes.TransitGatewayStateAvailable, awstypes.TransitGatewayStateDeleting),
 		Target:         []string{},
 		Refresh:        statusTransitGateway(ctx, conn, id),
@@ -2093,7 +2092,7 @@ func waitTransitGatewayDeleted(ctx context.Context, conn *ec2.Client, id string,
 }
 
 func waitTransitGatewayMulticastDomainCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.TransitGatewayMulticastDomain, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayMulticastDomainStatePending),
 		Target:  enum.Slice(awstypes.TransitGatewayMulticastDomainStateAvailable),
 		Refresh: statusTransitGatewayMulticastDomain(ctx, conn, id),
@@ -2110,7 +2109,7 @@ func waitTransitGatewayMulticastDomainCreated(ctx context.Context, conn *ec2.Cli
 }
 
 func waitTransitGatewayMulticastDomainDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.TransitGatewayMulticastDomain, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayMulticastDomainStateAvailable, awstypes.TransitGatewayMulticastDomainStateDeleting),
 		Target:  []string{},
 		Refresh: statusTransitGatewayMulticastDomain(ctx, conn, id),
@@ -2127,7 +2126,7 @@ func waitTransitGatewayMulticastDomainDeleted(ctx context.Context, conn *ec2.Cli
 }
 
 func waitTransitGatewayMulticastDomainAssociationCreated(ctx context.Context, conn *ec2.Client, multicastDomainID, attachmentID, subnetID string, timeout time.Duration) (*awstypes.TransitGatewayMulticastDomainAssociation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.AssociationStatusCodeAssociating),
 		Target:  enum.Slice(awstypes.AssociationStatusCodeAssociated),
 		Refresh: statusTransitGatewayMulticastDomainAssociation(ctx, conn, multicastDomainID, attachmentID, subnetID),
@@ -2144,7 +2143,7 @@ func waitTransitGatewayMulticastDomainAssociationCreated(ctx context.Context, co
 }
 
 func waitTransitGatewayMulticastDomainAssociationDeleted(ctx context.Context, conn *ec2.Client, multicastDomainID, attachmentID, subnetID string, timeout time.Duration) (*awstypes.TransitGatewayMulticastDomainAssociation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.AssociationStatusCodeAssociated, awstypes.AssociationStatusCodeDisassociating),
 		Target:  []string{},
 		Refresh: statusTransitGatewayMulticastDomainAssociation(ctx, conn, multicastDomainID, attachmentID, subnetID),
@@ -2161,7 +2160,7 @@ func waitTransitGatewayMulticastDomainAssociationDeleted(ctx context.Context, co
 }
 
 func waitTransitGatewayPeeringAttachmentAccepted(ctx context.Context, conn *ec2.Client, id string) (*awstypes.TransitGatewayPeeringAttachment, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayAttachmentStatePending, awstypes.TransitGatewayAttachmentStatePendingAcceptance),
 		Target:  enum.Slice(awstypes.TransitGatewayAttachmentStateAvailable),
 		Timeout: transitGatewayPeeringAttachmentUpdatedTimeout,
@@ -2182,7 +2181,7 @@ func waitTransitGatewayPeeringAttachmentAccepted(ctx context.Context, conn *ec2.
 }
 
 func waitTransitGatewayPeeringAttachmentCreated(ctx context.Context, conn *ec2.Client, id string) (*awstypes.TransitGatewayPeeringAttachment, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayAttachmentStateFailing, awstypes.TransitGatewayAttachmentStateInitiatingRequest, awstypes.TransitGatewayAttachmentStatePending),
 		Target:  enum.Slice(awstypes.TransitGatewayAttachmentStateAvailable, awstypes.TransitGatewayAttachmentStatePendingAcceptance),
 		Timeout: transitGatewayPeeringAttachmentCreatedTimeout,
@@ -2203,7 +2202,7 @@ func waitTransitGatewayPeeringAttachmentCreated(ctx context.Context, conn *ec2.C
 }
 
 func waitTransitGatewayPeeringAttachmentDeleted(ctx context.Context, conn *ec2.Client, id string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.TransitGatewayAttachmentStateAvailable,
 			awstypes.TransitGatewayAttachmentStateDeleting,
@@ -2227,7 +2226,7 @@ func waitTransitGatewayPeeringAttachmentDeleted(ctx context.Context, conn *ec2.C
 }
 
 func waitTransitGatewayPrefixListReferenceStateCreated(ctx context.Context, conn *ec2.Client, transitGatewayRouteTableID string, prefixListID string) (*awstypes.TransitGatewayPrefixListReference, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayPrefixListReferenceStatePending),
 		Target:  enum.Slice(awstypes.TransitGatewayPrefixListReferenceStateAvailable),
 		Timeout: transitGatewayPrefixListReferenceTimeout,
@@ -2244,7 +2243,7 @@ func waitTransitGatewayPrefixListReferenceStateCreated(ctx context.Context, conn
 }
 
 func waitTransitGatewayPrefixListReferenceStateDeleted(ctx context.Context, conn *ec2.Client, transitGatewayRouteTableID string, prefixListID string) (*awstypes.TransitGatewayPrefixListReference, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayPrefixListReferenceStateDeleting),
 		Target:  []string{},
 		Timeout: transitGatewayPrefixListReferenceTimeout,
@@ -2261,7 +2260,7 @@ func waitTransitGatewayPrefixListReferenceStateDeleted(ctx context.Context, conn
 }
 
 func waitTransitGatewayPrefixListReferenceStateUpdated(ctx context.Context, conn *ec2.Client, transitGatewayRouteTableID string, prefixListID string) (*awstypes.TransitGatewayPrefixListReference, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayPrefixListReferenceStateModifying),
 		Target:  enum.Slice(awstypes.TransitGatewayPrefixListReferenceStateAvailable),
 		Timeout: transitGatewayPrefixListReferenceTimeout,
@@ -2278,7 +2277,7 @@ func waitTransitGatewayPrefixListReferenceStateUpdated(ctx context.Context, conn
 }
 
 func waitTransitGatewayRouteCreated(ctx context.Context, conn *ec2.Client, transitGatewayRouteTableID, destination string) (*awstypes.TransitGatewayRoute, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayRouteStatePending),
 		Target:  enum.Slice(awstypes.TransitGatewayRouteStateActive, awstypes.TransitGatewayRouteStateBlackhole),
 		Timeout: transitGatewayRouteCreatedTimeout,
@@ -2295,7 +2294,7 @@ func waitTransitGatewayRouteCreated(ctx context.Context, conn *ec2.Client, trans
 }
 
 func waitTransitGatewayRouteDeleted(ctx context.Context, conn *ec2.Client, transitGatewayRouteTableID, destination string) (*awstypes.TransitGatewayRoute, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayRouteStateActive, awstypes.TransitGatewayRouteStateBlackhole, awstypes.TransitGatewayRouteStateDeleting),
 		Target:  []string{},
 		Timeout: transitGatewayRouteDeletedTimeout,
@@ -2312,7 +2311,7 @@ func waitTransitGatewayRouteDeleted(ctx context.Context, conn *ec2.Client, trans
 }
 
 func waitTransitGatewayPolicyTableCreated(ctx context.Context, conn *ec2.Client, id string) (*awstypes.TransitGatewayPolicyTable, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayPolicyTableStatePending),
 		Target:  enum.Slice(awstypes.TransitGatewayPolicyTableStateAvailable),
 		Timeout: transitGatewayPolicyTableCreatedTimeout,
@@ -2329,7 +2328,7 @@ func waitTransitGatewayPolicyTableCreated(ctx context.Context, conn *ec2.Client,
 }
 
 func waitTransitGatewayRouteTableCreated(ctx context.Context, conn *ec2.Client, id string) (*awstypes.TransitGatewayRouteTable, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayRouteTableStatePending),
 		Target:  enum.Slice(awstypes.TransitGatewayRouteTableStateAvailable),
 		Timeout: transitGatewayRouteTableCreatedTimeout,
@@ -2346,7 +2345,7 @@ func waitTransitGatewayRouteTableCreated(ctx context.Context, conn *ec2.Client,
 }
 
 func waitTransitGatewayPolicyTableDeleted(ctx context.Context, conn *ec2.Client, id string) (*awstypes.TransitGatewayPolicyTable, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayPolicyTableStateAvailable, awstypes.TransitGatewayPolicyTableStateDeleting),
 		Target:  []string{},
 		Timeout: transitGatewayPolicyTableDeletedTimeout,
@@ -2363,7 +2362,7 @@ func waitTransitGatewayPolicyTableDeleted(ctx context.Context, conn *ec2.Client,
 }
 
 func waitTransitGatewayRouteTableDeleted(ctx context.Context, conn *ec2.Client, id string) (*awstypes.TransitGatewayRouteTable, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayRouteTableStateAvailable, awstypes.TransitGatewayRouteTableStateDeleting),
 		Target:  []string{},
 		Timeout: transitGatewayRouteTableDeletedTimeout,
@@ -2380,7 +2379,7 @@ func waitTransitGatewayRouteTableDeleted(ctx context.Context, conn *ec2.Client,
 }
 
 func waitTransitGatewayPolicyTableAssociationCreated(ctx context.Context, conn *ec2.Client, transitGatewayPolicyTableID, transitGatewayAttachmentID string) (*awstypes.TransitGatewayPolicyTableAssociation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayAssociationStateAssociating),
 		Target:  enum.Slice(awstypes.TransitGatewayAssociationStateAssociated),
 		Timeout: transitGatewayPolicyTableAssociationCreatedTimeout,
@@ -2397,7 +2396,7 @@ func waitTransitGatewayPolicyTableAssociationCreated(ctx context.Context, conn *
 }
 
 func waitTransitGatewayPolicyTableAssociationDeleted(ctx context.Context, conn *ec2.Client, transitGatewayPolicyTableID, transitGatewayAttachmentID string) (*awstypes.TransitGatewayPolicyTableAssociation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:        enum.Slice(awstypes.TransitGatewayAssociationStateAssociated, awstypes.TransitGatewayAssociationStateDisassociating),
 		Target:         []string{},
 		Timeout:        transitGatewayPolicyTableAssociationDeletedTimeout,
@@ -2415,7 +2414,7 @@ func waitTransitGatewayPolicyTableAssociationDeleted(ctx context.Context, conn *
 }
 
 func waitTransitGatewayRouteTableAssociationCreated(ctx context.Context, conn *ec2.Client, transitGatewayRouteTableID, transitGatewayAttachmentID string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayAssociationStateAssociating),
 		Target:  enum.Slice(awstypes.TransitGatewayAssociationStateAssociated),
 		Timeout: transitGatewayRouteTableAssociationCreatedTimeout,
@@ -2428,7 +2427,7 @@ func waitTransitGatewayRouteTableAssociationCreated(ctx context.Context, conn *e
 }
 
 func waitTransitGatewayRouteTableAssociationDeleted(ctx context.Context, conn *ec2.Client, transitGatewayRouteTableID, transitGatewayAttachmentID string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:        enum.Slice(awstypes.TransitGatewayAssociationStateAssociated, awstypes.TransitGatewayAssociationStateDisassociating),
 		Target:         []string{},
 		Timeout:        transitGatewayRouteTableAssociationDeletedTimeout,
@@ -2442,7 +2441,7 @@ func waitTransitGatewayRouteTableAssociationDeleted(ctx context.Context, conn *e
 }
 
 func waitTransitGatewayRouteTablePropagationCreated(ctx context.Context, conn *ec2.Client, transitGatewayRouteTableID string, transitGatewayAttachmentID string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayPropagationStateEnabling),
 		Target:  enum.Slice(awstypes.TransitGatewayPropagationStateEnabled),
 		Timeout: transitGatewayRouteTablePropagationCreatedTimeout,
@@ -2455,7 +2454,7 @@ func waitTransitGatewayRouteTablePropagationCreated(ctx context.Context, conn *e
 }
 
 func waitTransitGatewayRouteTablePropagationDeleted(ctx context.Context, conn *ec2.Client, transitGatewayRouteTableID string, transitGatewayAttachmentID string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayPropagationStateDisabling),
 		Target:  []string{},
 		Timeout: transitGatewayRouteTablePropagationDeletedTimeout,
@@ -2472,7 +2471,7 @@ func waitTransitGatewayRouteTablePropagationDeleted(ctx context.Context, conn *e
 }
 
 func waitTransitGatewayUpdated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.TransitGateway, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayStateModifying),
 		Target:  enum.Slice(awstypes.TransitGatewayStateAvailable),
 		Refresh: statusTransitGateway(ctx, conn, id),
@@ -2489,7 +2488,7 @@ func waitTransitGatewayUpdated(ctx context.Context, conn *ec2.Client, id string,
 }
 
 func waitTransitGatewayVPCAttachmentAccepted(ctx context.Context, conn *ec2.Client, id string) (*awstypes.TransitGatewayVpcAttachment, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayAttachmentStatePending, awstypes.TransitGatewayAttachmentStatePendingAcceptance),
 		Target:  enum.Slice(awstypes.TransitGatewayAttachmentStateAvailable),
 		Timeout: transitGatewayVPCAttachmentUpdatedTimeout,
@@ -2506,7 +2505,7 @@ func waitTransitGatewayVPCAttachmentAccepted(ctx context.Context, conn *ec2.Clie
 }
 
 func waitTransitGatewayVPCAttachmentCreated(ctx context.Context, conn *ec2.Client, id string) (*awstypes.TransitGatewayVpcAttachment, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayAttachmentStateFailing, awstypes.TransitGatewayAttachmentStatePending),
 		Target:  enum.Slice(awstypes.TransitGatewayAttachmentStateAvailable, awstypes.TransitGatewayAttachmentStatePendingAcceptance),
 		Timeout: transitGatewayVPCAttachmentCreatedTimeout,
@@ -2523,7 +2522,7 @@ func waitTransitGatewayVPCAttachmentCreated(ctx context.Context, conn *ec2.Clien
 }
 
 func waitTransitGatewayVPCAttachmentDeleted(ctx context.Context, conn *ec2.Client, id string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.TransitGatewayAttachmentStateAvailable,
 			awstypes.TransitGatewayAttachmentStateDeleting,
@@ -2541,7 +2540,7 @@ func waitTransitGatewayVPCAttachmentDeleted(ctx context.Context, conn *ec2.Clien
 }
 
 func waitTransitGatewayVPCAttachmentUpdated(ctx context.Context, conn *ec2.Client, id string) (*awstypes.TransitGatewayVpcAttachment, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayAttachmentStateModifying),
 		Target:  enum.Slice(awstypes.TransitGatewayAttachmentStateAvailable),
 		Timeout: transitGatewayVPCAttachmentUpdatedTimeout,
@@ -2558,7 +2557,7 @@ func waitTransitGatewayVPCAttachmentUpdated(ctx context.Context, conn *ec2.Clien
 }
 
 func waitVerifiedAccessEndpointCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.VerifiedAccessEndpoint, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.VerifiedAccessEndpointStatusCodePending),
 		Target:                    enum.Slice(awstypes.VerifiedAccessEndpointStatusCodeActive),
 		Refresh:                   statusVerifiedAccessEndpoint(ctx, conn, id),
@@ -2578,7 +2577,7 @@ func waitVerifiedAccessEndpointCreated(ctx context.Context, conn *ec2.Client, id
 }
 
 func waitVerifiedAccessEndpointUpdated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.VerifiedAccessEndpoint, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.VerifiedAccessEndpointStatusCodeUpdating),
 		Target:  enum.Slice(awstypes.VerifiedAccessEndpointStatusCodeActive),
 		Refresh: statusVerifiedAccessEndpoint(ctx, conn, id),
@@ -2597,7 +2596,7 @@ func waitVerifiedAccessEndpointUpdated(ctx context.Context, conn *ec2.Client, id
 }
 
 func waitVerifiedAccessEndpointDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.VerifiedAccessEndpoint, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.VerifiedAccessEndpointStatusCodeDeleting, awstypes.VerifiedAccessEndpointStatusCodeActive, awstypes.VerifiedAccessEndpointStatusCodeDeleted),
 		Target:  []string{},
 		Refresh: statusVerifiedAccessEndpoint(ctx, conn, id),
@@ -2616,7 +2615,7 @@ func waitVerifiedAccessEndpointDeleted(ctx context.Context, conn *ec2.Client, id
 }
 
 func waitVolumeAttachmentCreated(ctx context.Context, conn *ec2.Client, volumeID, instanceID, deviceName string, timeout time.Duration) (*awstypes.VolumeAttachment, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.VolumeAttachmentStateAttaching),
 		Target:     enum.Slice(awstypes.VolumeAttachmentStateAttached),
 		Refresh:    statusVolumeAttachment(ctx, conn, volumeID, instanceID, deviceName),
@@ -2635,7 +2634,7 @@ func waitVolumeAttachmentCreated(ctx context.Context, conn *ec2.Client, volumeID
 }
 
 func waitVolumeCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Volume, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.VolumeStateCreating),
 		Target:     enum.Slice(awstypes.VolumeStateAvailable),
 		Refresh:    statusVolume(ctx, conn, id),
@@ -2654,7 +2653,7 @@ func waitVolumeCreated(ctx context.Context, conn *ec2.Client, id string, timeout
 }
 
 func waitVolumeDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Volume, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.VolumeStateDeleting),
 		Target:     []string{},
 		Refresh:    statusVolume(ctx, conn, id),
@@ -2673,7 +2672,7 @@ func waitVolumeDeleted(ctx context.Context, conn *ec2.Client, id string, timeout
 }
 
 func waitVolumeModificationComplete(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.VolumeModification, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.VolumeModificationStateModifying),
 		// The volume is useable once the state is "optimizing", but will not be at full performance.
 		// Optimization can take hours. e.g. a full 1 TiB drive takes approximately 6 hours to optimize,
@@ -2697,7 +2696,7 @@ func waitVolumeModificationComplete(ctx context.Context, conn *ec2.Client, id st
 }
 
 func waitVolumeUpdated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Volume, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.VolumeStateCreating, awstypes.VolumeState(awstypes.VolumeModificationStateModifying)),
 		Target:     enum.Slice(awstypes.VolumeStateAvailable, awstypes.VolumeStateInUse),
 		Refresh:    statusVolume(ctx, conn, id),
@@ -2716,7 +2715,7 @@ func waitVolumeUpdated(ctx context.Context, conn *ec2.Client, id string, timeout
 }
 
 func waitVPCAttributeUpdated(ctx context.Context, conn *ec2.Client, vpcID string, attribute awstypes.VpcAttributeName, expectedValue bool) (*awstypes.Vpc, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:     []string{strconv.FormatBool(expectedValue)},
 		Refresh:    statusVPCAttributeValue(ctx, conn, vpcID, attribute),
 		Timeout:    ec2PropagationTimeout,
@@ -2734,7 +2733,7 @@ func waitVPCAttributeUpdated(ctx context.Context, conn *ec2.Client, vpcID string
 }
 
 func waitVPCCIDRBlockAssociationCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.VpcCidrBlockState, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.VpcCidrBlockStateCodeAssociating, awstypes.VpcCidrBlockStateCodeDisassociated, awstypes.VpcCidrBlockStateCodeFailing),
 		Target:     enum.Slice(awstypes.VpcCidrBlockStateCodeAssociated),
 		Refresh:    statusVPCCIDRBlockAssociationState(ctx, conn, id),
@@ -2757,7 +2756,7 @@ func waitVPCCIDRBlockAssociationCreated(ctx context.Context, conn *ec2.Client, i
 }
 
 func waitVPCCIDRBlockAssociationDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.VpcCidrBlockState, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.VpcCidrBlockStateCodeAssociated, awstypes.VpcCidrBlockStateCodeDisassociating, awstypes.VpcCidrBlockStateCodeFailing),
 		Target:     []string{},
 		Refresh:    statusVPCCIDRBlockAssociationState(ctx, conn, id),
@@ -2780,7 +2779,7 @@ func waitVPCCIDRBlockAssociationDeleted(ctx context.Context, conn *ec2.Client, i
 }
 
 func waitVPCCreated(ctx context.Context, conn *ec2.Client, id string) (*awstypes.Vpc, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.VpcStatePending),
 		Target:  enum.Slice(awstypes.VpcStateAvailable),
 		Refresh: statusVPC(ctx, conn, id),
@@ -2797,7 +2796,7 @@ func waitVPCCreated(ctx context.Context, conn *ec2.Client, id string) (*awstypes
 }
 
 func waitVPCEndpointAccepted(ctx context.Context, conn *ec2.Client, vpcEndpointID string, timeout time.Duration) (*awstypes.VpcEndpoint, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(vpcEndpointStatePendingAcceptance),
 		Target:     enum.Slice(vpcEndpointStateAvailable),
 		Timeout:    timeout,
@@ -2820,7 +2819,7 @@ func waitVPCEndpointAccepted(ctx context.Context, conn *ec2.Client, vpcEndpointI
 }
 
 func waitVPCEndpointAvailable(ctx context.Context, conn *ec2.Client, vpcEndpointID string, timeout time.Duration) (*awstypes.VpcEndpoint, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(vpcEndpointStatePending),
 		Target:     enum.Slice(vpcEndpointStateAvailable, vpcEndpointStatePendingAcceptance),
 		Timeout:    timeout,
@@ -2843,7 +2842,7 @@ func waitVPCEndpointAvailable(ctx context.Context, conn *ec2.Client, vpcEndpoint
 }
 
 func waitVPCEndpointConnectionAccepted(ctx context.Context, conn *ec2.Client, serviceID, vpcEndpointID string, timeout time.Duration) (*awstypes.VpcEndpointConnection, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{vpcEndpointStatePendingAcceptance, vpcEndpointStatePending},
 		Target:     []string{vpcEndpointStateAvailable},
 		Refresh:    statusVPCEndpointConnectionVPCEndpoint(ctx, conn, serviceID, vpcEndpointID),
@@ -2862,7 +2861,7 @@ func waitVPCEndpointConnectionAccepted(ctx context.Context, conn *ec2.Client, se
 }
 
 func waitVPCEndpointDeleted(ctx context.Context, conn *ec2.Client, vpcEndpointID string, timeout time.Duration) (*awstypes.VpcEndpoint, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(vpcEndpointStateDeleting, vpcEndpointStateDeleted),
 		Target:     []string{},
 		Refresh:    statusVPCEndpoint(ctx, conn, vpcEndpointID),
@@ -2881,7 +2880,7 @@ func waitVPCEndpointDeleted(ctx context.Context, conn *ec2.Client, vpcEndpointID
 }
 
 func waitVPCEndpointRouteTableAssociationDeleted(ctx context.Context, conn *ec2.Client, vpcEndpointID, routeTableID string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(vpcEndpointRouteTableAssociationStatusReady),
 		Target:                    []string{},
 		Refresh:                   statusVPCEndpointRouteTableAssociation(ctx, conn, vpcEndpointID, routeTableID),
@@ -2895,7 +2894,7 @@ func waitVPCEndpointRouteTableAssociationDeleted(ctx context.Context, conn *ec2.
 }
 
 func waitVPCEndpointRouteTableAssociationReady(ctx context.Context, conn *ec2.Client, vpcEndpointID, routeTableID string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{},
 		Target:                    enum.Slice(vpcEndpointRouteTableAssociationStatusReady),
 		Refresh:                   statusVPCEndpointRouteTableAssociation(ctx, conn, vpcEndpointID, routeTableID),
@@ -2909,7 +2908,7 @@ func waitVPCEndpointRouteTableAssociationReady(ctx context.Context, conn *ec2.Cl
 }
 
 func waitVPCEndpointServiceAvailable(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.ServiceConfiguration, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.ServiceStatePending),
 		Target:     enum.Slice(awstypes.ServiceStateAvailable),
 		Refresh:    statusVPCEndpointServiceAvailable(ctx, conn, id),
@@ -2928,7 +2927,7 @@ func waitVPCEndpointServiceAvailable(ctx context.Context, conn *ec2.Client, id s
 }
 
 func waitVPCEndpointServiceDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.ServiceConfiguration, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.ServiceStateAvailable, awstypes.ServiceStateDeleting),
 		Target:     []string{},
 		Timeout:    timeout,
@@ -2947,7 +2946,7 @@ func waitVPCEndpointServiceDeleted(ctx context.Context, conn *ec2.Client, id str
 }
 
 func waitVPCEndpointServicePrivateDNSNameVerified(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.PrivateDnsNameConfiguration, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.DnsNameStatePendingVerification),
 		Target:                    enum.Slice(awstypes.DnsNameStateVerified),
 		Refresh:                   statusVPCEndpointServicePrivateDNSNameConfiguration(ctx, conn, id),
@@ -2964,7 +2963,7 @@ func waitVPCEndpointServicePrivateDNSNameVerified(ctx context.Context, conn *ec2
 }
 
 func waitVPCIPv6CIDRBlockAssociationCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.VpcCidrBlockState, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.VpcCidrBlockStateCodeAssociating, awstypes.VpcCidrBlockStateCodeDisassociated, awstypes.VpcCidrBlockStateCodeFailing),
 		Target:     enum.Slice(awstypes.VpcCidrBlockStateCodeAssociated),
 		Refresh:    statusVPCIPv6CIDRBlockAssociation(ctx, conn, id),
@@ -2987,7 +2986,7 @@ func waitVPCIPv6CIDRBlockAssociationCreated(ctx context.Context, conn *ec2.Clien
 }
 
 func waitVPCIPv6CIDRBlockAssociationDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.VpcCidrBlockStateCodeAssociated, awstypes.VpcCidrBlockStateCodeDisassociating, awstypes.VpcCidrBlockStateCodeFailing),
 		Target:     []string{},
 		Refresh:    statusVPCIPv6CIDRBlockAssociation(ctx, conn, id),
@@ -3002,7 +3001,7 @@ func waitVPCIPv6CIDRBlockAssociationDeleted(ctx context.Context, conn *ec2.Clien
 }
 
 func waitVPCPeeringConnectionActive(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.VpcPeeringConnection, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.VpcPeeringConnectionStateReasonCodeInitiatingRequest, awstypes.VpcPeeringConnectionStateReasonCodeProvisioning),
 		Target:                    enum.Slice(awstypes.VpcPeeringConnectionStateReasonCodeActive, awstypes.VpcPeeringConnectionStateReasonCodePendingAcceptance),
 		Refresh:                   statusVPCPeeringConnectionActive(ctx, conn, id),
@@ -3022,7 +3021,7 @@ func waitVPCPeeringConnectionActive(ctx context.Context, conn *ec2.Client, id st
 }
 
 func waitVPCPeeringConnectionDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.VpcPeeringConnection, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.VpcPeeringConnectionStateReasonCodeActive,
 			awstypes.VpcPeeringConnectionStateReasonCodeDeleting,
@@ -3048,7 +3047,7 @@ func waitVPNConnectionCreated(ctx context.Context, conn *ec2.Client, id string)
 	const (
 		timeout = 40 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.VpnStatePending),
 		Target:     enum.Slice(awstypes.VpnStateAvailable),
 		Refresh:    statusVPNConnection(ctx, conn, id),
@@ -3070,7 +3069,7 @@ func waitVPNConnectionDeleted(ctx context.Context, conn *ec2.Client, id string)
 	const (
 		timeout = 30 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.VpnStateDeleting),
 		Target:     []string{},
 		Refresh:    statusVPNConnection(ctx, conn, id),
@@ -3092,7 +3091,7 @@ func waitVPNConnectionRouteCreated(ctx context.Context, conn *ec2.Client, vpnCon
 	const (
 		timeout = 15 * time.Second
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.VpnStatePending),
 		Target:  enum.Slice(awstypes.VpnStateAvailable),
 		Refresh: statusVPNConnectionRoute(ctx, conn, vpnConnectionID, cidrBlock),
@@ -3112,7 +3111,7 @@ func waitVPNConnectionRouteDeleted(ctx context.Context, conn *ec2.Client, vpnCon
 	const (
 		timeout = 15 * time.Second
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.VpnStatePending, awstypes.VpnStateAvailable, awstypes.VpnStateDeleting),
 		Target:  []string{},
 		Refresh: statusVPNConnectionRoute(ctx, conn, vpnConnectionID, cidrBlock),
@@ -3132,7 +3131,7 @@ func waitVPNConnectionUpdated(ctx context.Context, conn *ec2.Client, id string)
 	const (
 		timeout = 30 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(vpnStateModifying),
 		Target:     enum.Slice(awstypes.VpnStateAvailable),
 		Refresh:    statusVPNConnection(ctx, conn, id),
@@ -3154,7 +3153,7 @@ func waitVPNGatewayCreated(ctx context.Context, conn *ec2.Client, id string) (*a
 	const (
 		timeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.VpnStatePending),
 		Target:     enum.Slice(awstypes.VpnStateAvailable),
 		Refresh:    statusVPNGateway(ctx, conn, id),
@@ -3176,7 +3175,7 @@ func waitVPNGatewayDeleted(ctx context.Context, conn *ec2.Client, id string) (*a
 	const (
 		timeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.VpnStateDeleting),
 		Target:     []string{},
 		Refresh:    statusVPNGateway(ctx, conn, id),
@@ -3198,7 +3197,7 @@ func waitVPNGatewayVPCAttachmentAttached(ctx context.Context, conn *ec2.Client,
 	const (
 		timeout = 15 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.AttachmentStatusAttaching),
 		Target:  enum.Slice(awstypes.AttachmentStatusAttached),
 		Refresh: statusVPNGatewayVPCAttachment(ctx, conn, vpnGatewayID, vpcID),
@@ -3218,7 +3217,7 @@ func waitVPNGatewayVPCAttachmentDetached(ctx context.Context, conn *ec2.Client,
 	const (
 		timeout = 30 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.AttachmentStatusAttached, awstypes.AttachmentStatusDetaching),
 		Target:  []string{},
 		Refresh: statusVPNGatewayVPCAttachment(ctx, conn, vpnGatewayID, vpcID),
@@ -3235,7 +3234,7 @@ func waitVPNGatewayVPCAttachmentDetached(ctx context.Context, conn *ec2.Client,
 }
 
 func waitVPCBlockPublicAccessOptionsUpdated(ctx context.Context, conn *ec2.Client, timeout time.Duration) (*awstypes.VpcBlockPublicAccessOptions, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.VpcBlockPublicAccessStateUpdateInProgress),
 		Target:                    enum.Slice(awstypes.VpcBlockPublicAccessStateUpdateComplete),
 		Refresh:                   statusVPCBlockPublicAccessOptions(ctx, conn),
@@ -3255,7 +3254,7 @@ func waitVPCBlockPublicAccessOptionsUpdated(ctx context.Context, conn *ec2.Clien
 }
 
 func waitVPCBlockPublicAccessExclusionCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.VpcBlockPublicAccessExclusion, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.VpcBlockPublicAccessExclusionStateCreateInProgress),
 		Target:                    enum.Slice(awstypes.VpcBlockPublicAccessExclusionStateCreateComplete),
 		Refresh:                   statusVPCBlockPublicAccessExclusion(ctx, conn, id),
@@ -3275,7 +3274,7 @@ func waitVPCBlockPublicAccessExclusionCreated(ctx context.Context, conn *ec2.Cli
 }
 
 func waitVPCBlockPublicAccessExclusionUpdated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.VpcBlockPublicAccessExclusion, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.VpcBlockPublicAccessExclusionStateUpdateInProgress),
 		Target:                    enum.Slice(awstypes.VpcBlockPublicAccessExclusionStateUpdateComplete),
 		Refresh:                   statusVPCBlockPublicAccessExclusion(ctx, conn, id),
@@ -3295,7 +3294,7 @@ func waitVPCBlockPublicAccessExclusionUpdated(ctx context.Context, conn *ec2.Cli
 }
 
 func waitVPCBlockPublicAccessExclusionDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.VpcBlockPublicAccessExclusion, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.VpcBlockPublicAccessExclusionStateDeleteInProgress,
 			// There might API inconsistencies where even after invoking delete, the Describe might come back with a CreateComplete or UpdateComplete status (the status before delete was invoked).
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameAuthorizationToken = "Ephemeral Resource Authorization Token"
+)
+
+// @EphemeralResource(aws_ecr_authorization_token, name="Authorization Token")
+func newEphemeralAuthorizationToken(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralAuthorizationToken{}, nil
+}
+
+type ephemeralAuthorizationToken struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralAuthorizationToken) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authorization_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expires_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrPassword: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"proxy_endpoint": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrUserName: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralAuthorizationToken) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epAuthorizationTokenData
+	conn := e.Meta().ECRClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.GetAuthorizationToken(ctx, &ecr.GetAuthorizationTokenInput{})
+	if err == nil && len(output.AuthorizationData) == 0 {
+		err = errors.New("empty result")
+	}
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ECR, create.ErrActionOpening, ERNameAuthorizationToken, "", err),
+			err.Error(),
+		)
+		return
+	}
+
+	authorizationData := output.AuthorizationData[0]
+	response.Diagnostics.Append(fwflex.Flatten(ctx, authorizationData, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	userName, password, err := decodeAuthorizationToken(aws.ToString(authorizationData.AuthorizationToken))
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ECR, create.ErrActionOpening, ERNameAuthorizationToken, "", err),
+			err.Error(),
+		)
+		return
+	}
+	data.UserName = types.StringValue(userName)
+	data.Password = types.StringValue(password)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+// decodeAuthorizationToken returns the user name and password encoded in an ECR authorization token.
+func decodeAuthorizationToken(token string) (string, string, error) {
+	b, err := itypes.Base64Decode(token)
+	if err != nil {
+		return "", "", err
+	}
+
+	userName, password, ok := strings.Cut(string(b), ":")
+	if !ok {
+		return "", "", errors.New("unknown ECR authorization token format")
+	}
+
+	return userName, password, nil
+}
+
+type epAuthorizationTokenData struct {
+	AuthorizationToken types.String      `tfsdk:"authorization_token"`
+	ExpiresAt          timetypes.RFC3339 `tfsdk:"expires_at"`
+	Password           types.String      `tfsdk:"password"`
+	ProxyEndpoint      types.String      `tfsdk:"proxy_endpoint"`
+	UserName           types.String      `tfsdk:"user_name"`
+}
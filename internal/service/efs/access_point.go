@@ -316,7 +316,7 @@ func waitAccessPointCreated(ctx context.Context, conn *efs.Client, id string) (*
 	const (
 		timeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.LifeCycleStateCreating),
 		Target:  enum.Slice(awstypes.LifeCycleStateAvailable),
 		Refresh: statusAccessPointLifeCycleState(ctx, conn, id),
@@ -337,7 +337,7 @@ func waitAccessPointDeleted(ctx context.Context, conn *efs.Client, id string) (*
 		accessPointCreatedTimeout = 10 * time.Minute
 		accessPointDeletedTimeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.LifeCycleStateAvailable, awstypes.LifeCycleStateDeleting),
 		Target:  []string{},
 		Refresh: statusAccessPointLifeCycleState(ctx, conn, id),
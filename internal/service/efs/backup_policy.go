@@ -202,7 +202,7 @@ func waitBackupPolicyEnabled(ctx context.Context, conn *efs.Client, id string) (
 	const (
 		backupPoltimeoutcyEnabledTimeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.StatusEnabling),
 		Target:  enum.Slice(awstypes.StatusEnabled),
 		Refresh: statusBackupPolicy(ctx, conn, id),
@@ -222,7 +222,7 @@ func waitBackupPolicyDisabled(ctx context.Context, conn *efs.Client, id string)
 	const (
 		timeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.StatusDisabling),
 		Target:  enum.Slice(awstypes.StatusDisabled),
 		Refresh: statusBackupPolicy(ctx, conn, id),
@@ -477,7 +477,7 @@ func waitFileSystemAvailable(ctx context.Context, conn *efs.Client, fileSystemID
 	const (
 		timeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.LifeCycleStateCreating, awstypes.LifeCycleStateUpdating),
 		Target:     enum.Slice(awstypes.LifeCycleStateAvailable),
 		Refresh:    statusFileSystemLifeCycleState(ctx, conn, fileSystemID),
@@ -499,7 +499,7 @@ func waitFileSystemDeleted(ctx context.Context, conn *efs.Client, fileSystemID s
 	const (
 		timeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.LifeCycleStateAvailable, awstypes.LifeCycleStateDeleting),
 		Target:     []string{},
 		Refresh:    statusFileSystemLifeCycleState(ctx, conn, fileSystemID),
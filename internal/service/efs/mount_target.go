@@ -328,7 +328,7 @@ func statusMountTargetLifeCycleState(ctx context.Context, conn *efs.Client, id s
 }
 
 func waitMountTargetCreated(ctx context.Context, conn *efs.Client, id string, timeout time.Duration) (*awstypes.MountTargetDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.LifeCycleStateCreating),
 		Target:     enum.Slice(awstypes.LifeCycleStateAvailable),
 		Refresh:    statusMountTargetLifeCycleState(ctx, conn, id),
@@ -347,7 +347,7 @@ func waitMountTargetCreated(ctx context.Context, conn *efs.Client, id string, ti
 }
 
 func waitMountTargetDeleted(ctx context.Context, conn *efs.Client, id string, timeout time.Duration) (*awstypes.MountTargetDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.LifeCycleStateAvailable, awstypes.LifeCycleStateDeleting, awstypes.LifeCycleStateDeleted),
 		Target:     []string{},
 		Refresh:    statusMountTargetLifeCycleState(ctx, conn, id),
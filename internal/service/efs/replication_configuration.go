@@ -290,7 +290,7 @@ func statusReplicationConfiguration(ctx context.Context, conn *efs.Client, id st
 }
 
 func waitReplicationConfigurationCreated(ctx context.Context, conn *efs.Client, id string, timeout time.Duration, optFns ...func(*efs.Options)) (*awstypes.ReplicationConfigurationDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ReplicationStatusEnabling),
 		Target:  enum.Slice(awstypes.ReplicationStatusEnabled),
 		Refresh: statusReplicationConfiguration(ctx, conn, id, optFns...),
@@ -307,7 +307,7 @@ func waitReplicationConfigurationCreated(ctx context.Context, conn *efs.Client,
 }
 
 func waitReplicationConfigurationDeleted(ctx context.Context, conn *efs.Client, id string, timeout time.Duration, optFns ...func(*efs.Options)) (*awstypes.ReplicationConfigurationDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.ReplicationStatusDeleting),
 		Target:                    []string{},
 		Refresh:                   statusReplicationConfiguration(ctx, conn, id, optFns...),
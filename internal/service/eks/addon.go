@@ -515,7 +515,7 @@ func statusAddonUpdate(ctx context.Context, conn *eks.Client, clusterName, addon
 }
 
 func waitAddonCreated(ctx context.Context, conn *eks.Client, clusterName, addonName string, timeout time.Duration) (*types.Addon, error) {
-	stateConf := retry.StateChangeConf{
+	stateConf := tfresource.StateChangeConf{
 		Pending: enum.Slice(types.AddonStatusCreating, types.AddonStatusDegraded),
 		Target:  enum.Slice(types.AddonStatusActive),
 		Refresh: statusAddon(ctx, conn, clusterName, addonName),
@@ -536,7 +536,7 @@ func waitAddonCreated(ctx context.Context, conn *eks.Client, clusterName, addonN
 }
 
 func waitAddonDeleted(ctx context.Context, conn *eks.Client, clusterName, addonName string, timeout time.Duration) (*types.Addon, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.AddonStatusActive, types.AddonStatusDeleting),
 		Target:  []string{},
 		Refresh: statusAddon(ctx, conn, clusterName, addonName),
@@ -557,7 +557,7 @@ func waitAddonDeleted(ctx context.Context, conn *eks.Client, clusterName, addonN
 }
 
 func waitAddonUpdateSuccessful(ctx context.Context, conn *eks.Client, clusterName, addonName, id string, timeout time.Duration) (*types.Update, error) {
-	stateConf := retry.StateChangeConf{
+	stateConf := tfresource.StateChangeConf{
 		Pending: enum.Slice(types.UpdateStatusInProgress),
 		Target:  enum.Slice(types.UpdateStatusSuccessful),
 		Refresh: statusAddonUpdate(ctx, conn, clusterName, addonName, id),
@@ -1036,7 +1036,7 @@ func statusClusterUpdate(ctx context.Context, conn *eks.Client, name, id string)
 }
 
 func waitClusterCreated(ctx context.Context, conn *eks.Client, name string, timeout time.Duration) (*types.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ClusterStatusPending, types.ClusterStatusCreating),
 		Target:  enum.Slice(types.ClusterStatusActive),
 		Refresh: statusCluster(ctx, conn, name),
@@ -1053,7 +1053,7 @@ func waitClusterCreated(ctx context.Context, conn *eks.Client, name string, time
 }
 
 func waitClusterDeleted(ctx context.Context, conn *eks.Client, name string, timeout time.Duration) (*types.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.ClusterStatusActive, types.ClusterStatusDeleting),
 		Target:     []string{},
 		Refresh:    statusCluster(ctx, conn, name),
@@ -1074,7 +1074,7 @@ func waitClusterDeleted(ctx context.Context, conn *eks.Client, name string, time
 }
 
 func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.Client, name, id string, timeout time.Duration) (*types.Update, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.UpdateStatusInProgress),
 		Target:  enum.Slice(types.UpdateStatusSuccessful),
 		Refresh: statusClusterUpdate(ctx, conn, name, id),
@@ -268,7 +268,7 @@ func statusFargateProfile(ctx context.Context, conn *eks.Client, clusterName, fa
 }
 
 func waitFargateProfileCreated(ctx context.Context, conn *eks.Client, clusterName, fargateProfileName string, timeout time.Duration) (*types.FargateProfile, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.FargateProfileStatusCreating),
 		Target:  enum.Slice(types.FargateProfileStatusActive),
 		Refresh: statusFargateProfile(ctx, conn, clusterName, fargateProfileName),
@@ -285,7 +285,7 @@ func waitFargateProfileCreated(ctx context.Context, conn *eks.Client, clusterNam
 }
 
 func waitFargateProfileDeleted(ctx context.Context, conn *eks.Client, clusterName, fargateProfileName string, timeout time.Duration) (*types.FargateProfile, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.FargateProfileStatusActive, types.FargateProfileStatusDeleting),
 		Target:  []string{},
 		Refresh: statusFargateProfile(ctx, conn, clusterName, fargateProfileName),
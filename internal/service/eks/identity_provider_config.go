@@ -280,7 +280,7 @@ func statusOIDCIdentityProviderConfig(ctx context.Context, conn *eks.Client, clu
 }
 
 func waitOIDCIdentityProviderConfigCreated(ctx context.Context, conn *eks.Client, clusterName, configName string, timeout time.Duration) (*types.OidcIdentityProviderConfig, error) {
-	stateConf := retry.StateChangeConf{
+	stateConf := tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ConfigStatusCreating),
 		Target:  enum.Slice(types.ConfigStatusActive),
 		Refresh: statusOIDCIdentityProviderConfig(ctx, conn, clusterName, configName),
@@ -297,7 +297,7 @@ func waitOIDCIdentityProviderConfigCreated(ctx context.Context, conn *eks.Client
 }
 
 func waitOIDCIdentityProviderConfigDeleted(ctx context.Context, conn *eks.Client, clusterName, configName string, timeout time.Duration) (*types.OidcIdentityProviderConfig, error) {
-	stateConf := retry.StateChangeConf{
+	stateConf := tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ConfigStatusActive, types.ConfigStatusDeleting),
 		Target:  []string{},
 		Refresh: statusOIDCIdentityProviderConfig(ctx, conn, clusterName, configName),
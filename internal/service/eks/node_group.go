@@ -695,7 +695,7 @@ func statusNodegroupUpdate(ctx context.Context, conn *eks.Client, clusterName, n
 }
 
 func waitNodegroupCreated(ctx context.Context, conn *eks.Client, clusterName, nodeGroupName string, timeout time.Duration) (*types.Nodegroup, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.NodegroupStatusCreating),
 		Target:  enum.Slice(types.NodegroupStatusActive),
 		Refresh: statusNodegroup(ctx, conn, clusterName, nodeGroupName),
@@ -716,7 +716,7 @@ func waitNodegroupCreated(ctx context.Context, conn *eks.Client, clusterName, no
 }
 
 func waitNodegroupDeleted(ctx context.Context, conn *eks.Client, clusterName, nodeGroupName string, timeout time.Duration) (*types.Nodegroup, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.NodegroupStatusActive, types.NodegroupStatusDeleting),
 		Target:  []string{},
 		Refresh: statusNodegroup(ctx, conn, clusterName, nodeGroupName),
@@ -737,7 +737,7 @@ func waitNodegroupDeleted(ctx context.Context, conn *eks.Client, clusterName, no
 }
 
 func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.Client, clusterName, nodeGroupName, id string, timeout time.Duration) (*types.Update, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.UpdateStatusInProgress),
 		Target:  enum.Slice(types.UpdateStatusSuccessful),
 		Refresh: statusNodegroupUpdate(ctx, conn, clusterName, nodeGroupName, id),
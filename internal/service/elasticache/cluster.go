@@ -879,7 +879,7 @@ const (
 )
 
 func waitCacheClusterAvailable(ctx context.Context, conn *elasticache.Client, cacheClusterID string, timeout time.Duration) (*awstypes.CacheCluster, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			cacheClusterStatusCreating,
 			cacheClusterStatusModifying,
@@ -903,7 +903,7 @@ func waitCacheClusterAvailable(ctx context.Context, conn *elasticache.Client, ca
 }
 
 func waitCacheClusterDeleted(ctx context.Context, conn *elasticache.Client, cacheClusterID string, timeout time.Duration) (*awstypes.CacheCluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			cacheClusterStatusCreating,
 			cacheClusterStatusAvailable,
@@ -79,7 +79,7 @@ func resourceReplicationGroup() *schema.Resource {
 				Optional:      true,
 				Sensitive:     true,
 				ValidateFunc:  validReplicationGroupAuthToken,
-				ConflictsWith: []string{"user_group_ids"},
+				ConflictsWith: []string{"auth_token_wo", "user_group_ids"},
 			},
 			"auth_token_update_strategy": {
 				Type:             schema.TypeString,
@@ -87,6 +87,19 @@ func resourceReplicationGroup() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[awstypes.AuthTokenUpdateStrategyType](),
 				Default:          awstypes.AuthTokenUpdateStrategyTypeRotate,
 			},
+			"auth_token_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				ValidateFunc:  validReplicationGroupAuthToken,
+				ConflictsWith: []string{"auth_token", "user_group_ids"},
+				RequiredWith:  []string{"auth_token_wo_version"},
+			},
+			"auth_token_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"auth_token_wo"},
+			},
 			names.AttrAutoMinorVersionUpgrade: {
 				Type:         nullable.TypeNullableBool,
 				Optional:     true,
@@ -367,7 +380,7 @@ func resourceReplicationGroup() *schema.Resource {
 				Type:          schema.TypeSet,
 				Optional:      true,
 				Elem:          &schema.Schema{Type: schema.TypeString},
-				ConflictsWith: []string{"auth_token"},
+				ConflictsWith: []string{"auth_token", "auth_token_wo"},
 			},
 		},
 
@@ -441,8 +454,14 @@ func resourceReplicationGroupCreate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	if v, ok := d.GetOk("auth_token"); ok {
-		input.AuthToken = aws.String(v.(string))
+	authToken, di := flex.GetStringValueOrWriteOnlyStringValue(d, "auth_token", "auth_token_wo")
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if authToken != "" {
+		input.AuthToken = aws.String(authToken)
 	}
 
 	if v, ok := d.GetOk(names.AttrAutoMinorVersionUpgrade); ok {
@@ -986,10 +1005,16 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			})
 		}
 
-		if d.HasChanges("auth_token", "auth_token_update_strategy") {
+		if d.HasChanges("auth_token", "auth_token_update_strategy", "auth_token_wo_version") {
+			authToken, di := flex.GetStringValueOrWriteOnlyStringValue(d, "auth_token", "auth_token_wo")
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
 			authInput := elasticache.ModifyReplicationGroupInput{
 				ApplyImmediately:        aws.Bool(true),
-				AuthToken:               aws.String(d.Get("auth_token").(string)),
+				AuthToken:               aws.String(authToken),
 				AuthTokenUpdateStrategy: awstypes.AuthTokenUpdateStrategyType(d.Get("auth_token_update_strategy").(string)),
 				ReplicationGroupId:      aws.String(d.Id()),
 			}
@@ -1325,7 +1350,7 @@ const (
 )
 
 func waitReplicationGroupAvailable(ctx context.Context, conn *elasticache.Client, replicationGroupID string, timeout time.Duration, delay time.Duration) (*awstypes.ReplicationGroup, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			replicationGroupStatusCreating,
 			replicationGroupStatusModifying,
@@ -1348,7 +1373,7 @@ func waitReplicationGroupAvailable(ctx context.Context, conn *elasticache.Client
 }
 
 func waitReplicationGroupDeleted(ctx context.Context, conn *elasticache.Client, replicationGroupID string, timeout time.Duration) (*awstypes.ReplicationGroup, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			replicationGroupStatusCreating,
 			replicationGroupStatusAvailable,
@@ -1419,7 +1444,7 @@ func statusReplicationGroupMemberClusters(ctx context.Context, conn *elasticache
 }
 
 func waitReplicationGroupMemberClustersAvailable(ctx context.Context, conn *elasticache.Client, replicationGroupID string, timeout time.Duration) ([]*awstypes.CacheCluster, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			cacheClusterStatusCreating,
 			cacheClusterStatusDeleting,
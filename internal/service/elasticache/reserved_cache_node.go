@@ -269,7 +269,7 @@ func findReservedCacheNodeByID(ctx context.Context, conn *elasticache.Client, id
 }
 
 func waitReservedCacheNodeCreated(ctx context.Context, conn *elasticache.Client, id string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			reservedCacheNodeStatePaymentPending,
 		},
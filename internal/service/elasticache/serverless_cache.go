@@ -496,7 +496,7 @@ const (
 )
 
 func waitServerlessCacheAvailable(ctx context.Context, conn *elasticache.Client, cacheClusterID string, timeout time.Duration) (*awstypes.ServerlessCache, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			serverlessCacheStatusCreating,
 			serverlessCacheStatusDeleting,
@@ -519,7 +519,7 @@ func waitServerlessCacheAvailable(ctx context.Context, conn *elasticache.Client,
 }
 
 func waitServerlessCacheDeleted(ctx context.Context, conn *elasticache.Client, cacheClusterID string, timeout time.Duration) (*awstypes.ServerlessCache, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			serverlessCacheStatusCreating,
 			serverlessCacheStatusDeleting,
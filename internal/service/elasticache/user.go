@@ -360,7 +360,7 @@ const (
 )
 
 func waitUserCreated(ctx context.Context, conn *elasticache.Client, id string, timeout time.Duration) (*awstypes.User, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{userStatusCreating},
 		Target:  []string{userStatusActive},
 		Refresh: statusUser(ctx, conn, id),
@@ -377,7 +377,7 @@ func waitUserCreated(ctx context.Context, conn *elasticache.Client, id string, t
 }
 
 func waitUserUpdated(ctx context.Context, conn *elasticache.Client, id string, timeout time.Duration) (*awstypes.User, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{userStatusModifying},
 		Target:  []string{userStatusActive},
 		Refresh: statusUser(ctx, conn, id),
@@ -394,7 +394,7 @@ func waitUserUpdated(ctx context.Context, conn *elasticache.Client, id string, t
 }
 
 func waitUserDeleted(ctx context.Context, conn *elasticache.Client, id string, timeout time.Duration) (*awstypes.User, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{userStatusDeleting},
 		Target:  []string{},
 		Refresh: statusUser(ctx, conn, id),
@@ -276,7 +276,7 @@ const (
 )
 
 func waitUserGroupCreated(ctx context.Context, conn *elasticache.Client, id string, timeout time.Duration) (*awstypes.UserGroup, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{userGroupStatusCreating, userGroupStatusModifying},
 		Target:     []string{userGroupStatusActive},
 		Refresh:    statusUserGroup(ctx, conn, id),
@@ -295,7 +295,7 @@ func waitUserGroupCreated(ctx context.Context, conn *elasticache.Client, id stri
 }
 
 func waitUserGroupUpdated(ctx context.Context, conn *elasticache.Client, id string, timeout time.Duration) (*awstypes.UserGroup, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{userGroupStatusModifying},
 		Target:     []string{userGroupStatusActive},
 		Refresh:    statusUserGroup(ctx, conn, id),
@@ -314,7 +314,7 @@ func waitUserGroupUpdated(ctx context.Context, conn *elasticache.Client, id stri
 }
 
 func waitUserGroupDeleted(ctx context.Context, conn *elasticache.Client, id string, timeout time.Duration) (*awstypes.UserGroup, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{userGroupStatusDeleting},
 		Target:     []string{},
 		Refresh:    statusUserGroup(ctx, conn, id),
@@ -715,7 +715,7 @@ func statusEnvironment(ctx context.Context, conn *elasticbeanstalk.Client, id st
 }
 
 func waitEnvironmentReady(ctx context.Context, conn *elasticbeanstalk.Client, id string, pollInterval, timeout time.Duration) (*awstypes.EnvironmentDescription, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:      enum.Slice(awstypes.EnvironmentStatusLaunching, awstypes.EnvironmentStatusUpdating),
 		Target:       enum.Slice(awstypes.EnvironmentStatusReady),
 		Refresh:      statusEnvironment(ctx, conn, id),
@@ -735,7 +735,7 @@ func waitEnvironmentReady(ctx context.Context, conn *elasticbeanstalk.Client, id
 }
 
 func waitEnvironmentDeleted(ctx context.Context, conn *elasticbeanstalk.Client, id string, pollInterval, timeout time.Duration) (*awstypes.EnvironmentDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:      enum.Slice(awstypes.EnvironmentStatusTerminating),
 		Target:       []string{},
 		Refresh:      statusEnvironment(ctx, conn, id),
@@ -310,7 +310,7 @@ func statusTrustStore(ctx context.Context, conn *elasticloadbalancingv2.Client,
 }
 
 func waitTrustStoreActive(ctx context.Context, conn *elasticloadbalancingv2.Client, arn string, timeout time.Duration) (*awstypes.TrustStore, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.TrustStoreStatusCreating),
 		Target:     enum.Slice(awstypes.TrustStoreStatusActive),
 		Refresh:    statusTrustStore(ctx, conn, arn),
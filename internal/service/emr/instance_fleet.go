@@ -407,7 +407,7 @@ func statusInstanceFleet(ctx context.Context, conn *emr.Client, clusterID, fleet
 }
 
 func waitInstanceFleetRunning(ctx context.Context, conn *emr.Client, clusterID, fleetID string, timeout time.Duration) (*awstypes.InstanceFleet, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.InstanceFleetStateProvisioning, awstypes.InstanceFleetStateBootstrapping, awstypes.InstanceFleetStateResizing),
 		Target:     enum.Slice(awstypes.InstanceFleetStateRunning),
 		Refresh:    statusInstanceFleet(ctx, conn, clusterID, fleetID),
@@ -264,7 +264,7 @@ func statusVirtualCluster(ctx context.Context, conn *emrcontainers.Client, id st
 }
 
 func waitVirtualClusterDeleted(ctx context.Context, conn *emrcontainers.Client, id string, timeout time.Duration) (*awstypes.VirtualCluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.VirtualClusterStateTerminating),
 		Target:  []string{},
 		Refresh: statusVirtualCluster(ctx, conn, id),
@@ -489,7 +489,7 @@ func waitApplicationCreated(ctx context.Context, conn *emrserverless.Client, id
 		minTimeout = 10 * time.Second
 		delay      = 30 * time.Second
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.ApplicationStateCreating),
 		Target:     enum.Slice(types.ApplicationStateCreated),
 		Refresh:    statusApplication(ctx, conn, id),
@@ -517,7 +517,7 @@ func waitApplicationTerminated(ctx context.Context, conn *emrserverless.Client,
 		minTimeout = 10 * time.Second
 		delay      = 30 * time.Second
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Values[types.ApplicationState](),
 		Target:     []string{},
 		Refresh:    statusApplication(ctx, conn, id),
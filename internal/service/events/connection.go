@@ -457,7 +457,7 @@ func waitConnectionCreated(ctx context.Context, conn *eventbridge.Client, name s
 	const (
 		timeout = 2 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ConnectionStateCreating, types.ConnectionStateAuthorizing),
 		Target:  enum.Slice(types.ConnectionStateAuthorized, types.ConnectionStateDeauthorized),
 		Refresh: statusConnectionState(ctx, conn, name),
@@ -479,7 +479,7 @@ func waitConnectionUpdated(ctx context.Context, conn *eventbridge.Client, name s
 	const (
 		timeout = 2 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ConnectionStateUpdating, types.ConnectionStateAuthorizing, types.ConnectionStateDeauthorizing),
 		Target:  enum.Slice(types.ConnectionStateAuthorized, types.ConnectionStateDeauthorized),
 		Refresh: statusConnectionState(ctx, conn, name),
@@ -501,7 +501,7 @@ func waitConnectionDeleted(ctx context.Context, conn *eventbridge.Client, name s
 	const (
 		timeout = 2 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ConnectionStateDeleting),
 		Target:  []string{},
 		Refresh: statusConnectionState(ctx, conn, name),
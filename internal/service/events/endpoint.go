@@ -344,7 +344,7 @@ func statusEndpointState(ctx context.Context, conn *eventbridge.Client, name str
 }
 
 func waitEndpointCreated(ctx context.Context, conn *eventbridge.Client, name string, timeout time.Duration) (*eventbridge.DescribeEndpointOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.EndpointStateCreating),
 		Target:  enum.Slice(types.EndpointStateActive),
 		Refresh: statusEndpointState(ctx, conn, name),
@@ -363,7 +363,7 @@ func waitEndpointCreated(ctx context.Context, conn *eventbridge.Client, name str
 }
 
 func waitEndpointUpdated(ctx context.Context, conn *eventbridge.Client, name string, timeout time.Duration) (*eventbridge.DescribeEndpointOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.EndpointStateUpdating),
 		Target:  enum.Slice(types.EndpointStateActive),
 		Refresh: statusEndpointState(ctx, conn, name),
@@ -382,7 +382,7 @@ func waitEndpointUpdated(ctx context.Context, conn *eventbridge.Client, name str
 }
 
 func waitEndpointDeleted(ctx context.Context, conn *eventbridge.Client, name string, timeout time.Duration) (*eventbridge.DescribeEndpointOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.EndpointStateDeleting),
 		Target:  []string{},
 		Refresh: statusEndpointState(ctx, conn, name),
@@ -11,13 +11,12 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/evidently"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/evidently/types"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitFeatureCreated(ctx context.Context, conn *evidently.Client, id string, timeout time.Duration) (*awstypes.Feature, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{},
 		Target:  enum.Slice(awstypes.FeatureStatusAvailable),
 		Refresh: statusFeature(ctx, conn, id),
@@ -34,7 +33,7 @@ func waitFeatureCreated(ctx context.Context, conn *evidently.Client, id string,
 }
 
 func waitFeatureUpdated(ctx context.Context, conn *evidently.Client, id string, timeout time.Duration) (*awstypes.Feature, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.FeatureStatusUpdating),
 		Target:  enum.Slice(awstypes.FeatureStatusAvailable),
 		Refresh: statusFeature(ctx, conn, id),
@@ -51,7 +50,7 @@ func waitFeatureUpdated(ctx context.Context, conn *evidently.Client, id string,
 }
 
 func waitFeatureDeleted(ctx context.Context, conn *evidently.Client, id string, timeout time.Duration) (*awstypes.Feature, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.FeatureStatusAvailable),
 		Target:  []string{},
 		Refresh: statusFeature(ctx, conn, id),
@@ -68,7 +67,7 @@ func waitFeatureDeleted(ctx context.Context, conn *evidently.Client, id string,
 }
 
 func waitLaunchCreated(ctx context.Context, conn *evidently.Client, id string, timeout time.Duration) (*awstypes.Launch, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{},
 		Target:  enum.Slice(awstypes.LaunchStatusCreated),
 		Refresh: statusLaunch(ctx, conn, id),
@@ -89,7 +88,7 @@ func waitLaunchCreated(ctx context.Context, conn *evidently.Client, id string, t
 }
 
 func waitLaunchUpdated(ctx context.Context, conn *evidently.Client, id string, timeout time.Duration) (*awstypes.Launch, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.LaunchStatusUpdating),
 		Target:  enum.Slice(awstypes.LaunchStatusCreated, awstypes.LaunchStatusRunning),
 		Refresh: statusLaunch(ctx, conn, id),
@@ -110,7 +109,7 @@ func waitLaunchUpdated(ctx context.Context, conn *evidently.Client, id string, t
 }
 
 func waitLaunchDeleted(ctx context.Context, conn *evidently.Client, id string, timeout time.Duration) (*awstypes.Launch, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.LaunchStatusCreated, awstypes.LaunchStatusCompleted, awstypes.LaunchStatusRunning, awstypes.LaunchStatusCancelled, awstypes.LaunchStatusUpdating),
 		Target:  []string{},
 		Refresh: statusLaunch(ctx, conn, id),
@@ -131,7 +130,7 @@ func waitLaunchDeleted(ctx context.Context, conn *evidently.Client, id string, t
 }
 
 func waitProjectCreated(ctx context.Context, conn *evidently.Client, nameOrARN string, timeout time.Duration) (*awstypes.Project, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{},
 		Target:  enum.Slice(awstypes.ProjectStatusAvailable),
 		Refresh: statusProject(ctx, conn, nameOrARN),
@@ -148,7 +147,7 @@ func waitProjectCreated(ctx context.Context, conn *evidently.Client, nameOrARN s
 }
 
 func waitProjectUpdated(ctx context.Context, conn *evidently.Client, nameOrARN string, timeout time.Duration) (*awstypes.Project, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ProjectStatusUpdating),
 		Target:  enum.Slice(awstypes.ProjectStatusAvailable),
 		Refresh: statusProject(ctx, conn, nameOrARN),
@@ -165,7 +164,7 @@ func waitProjectUpdated(ctx context.Context, conn *evidently.Client, nameOrARN s
 }
 
 func waitProjectDeleted(ctx context.Context, conn *evidently.Client, nameOrARN string, timeout time.Duration) (*awstypes.Project, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ProjectStatusAvailable),
 		Target:  []string{},
 		Refresh: statusProject(ctx, conn, nameOrARN),
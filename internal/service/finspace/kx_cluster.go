@@ -710,7 +710,7 @@ func resourceKxClusterDelete(ctx context.Context, d *schema.ResourceData, meta a
 }
 
 func waitKxClusterCreated(ctx context.Context, conn *finspace.Client, id string, timeout time.Duration) (*finspace.GetKxClusterOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(types.KxClusterStatusPending, types.KxClusterStatusCreating),
 		Target:                    enum.Slice(types.KxClusterStatusRunning),
 		Refresh:                   statusKxCluster(ctx, conn, id),
@@ -728,7 +728,7 @@ func waitKxClusterCreated(ctx context.Context, conn *finspace.Client, id string,
 }
 
 func waitKxClusterUpdated(ctx context.Context, conn *finspace.Client, id string, timeout time.Duration) (*finspace.GetKxClusterOutput, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(types.KxClusterStatusPending, types.KxClusterStatusUpdating),
 		Target:                    enum.Slice(types.KxClusterStatusRunning),
 		Refresh:                   statusKxCluster(ctx, conn, id),
@@ -746,7 +746,7 @@ func waitKxClusterUpdated(ctx context.Context, conn *finspace.Client, id string,
 }
 
 func waitKxClusterDeleted(ctx context.Context, conn *finspace.Client, id string, timeout time.Duration) (*finspace.GetKxClusterOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.KxClusterStatusDeleting),
 		Target:  enum.Slice(types.KxClusterStatusDeleted),
 		Refresh: statusKxCluster(ctx, conn, id),
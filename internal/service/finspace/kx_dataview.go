@@ -344,7 +344,7 @@ func FindKxDataviewById(ctx context.Context, conn *finspace.Client, id string) (
 }
 
 func waitKxDataviewCreated(ctx context.Context, conn *finspace.Client, id string, timeout time.Duration) (*finspace.GetKxDataviewOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(types.KxDataviewStatusCreating),
 		Target:                    enum.Slice(types.KxDataviewStatusActive),
 		Refresh:                   statusKxDataview(ctx, conn, id),
@@ -361,7 +361,7 @@ func waitKxDataviewCreated(ctx context.Context, conn *finspace.Client, id string
 }
 
 func waitKxDataviewUpdated(ctx context.Context, conn *finspace.Client, id string, timeout time.Duration) (*finspace.GetKxDataviewOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(types.KxDataviewStatusUpdating),
 		Target:                    enum.Slice(types.KxDataviewStatusActive),
 		Refresh:                   statusKxDataview(ctx, conn, id),
@@ -378,7 +378,7 @@ func waitKxDataviewUpdated(ctx context.Context, conn *finspace.Client, id string
 }
 
 func waitKxDataviewDeleted(ctx context.Context, conn *finspace.Client, id string, timeout time.Duration) (*finspace.GetKxDataviewOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.KxDataviewStatusDeleting),
 		Target:  []string{},
 		Refresh: statusKxDataview(ctx, conn, id),
@@ -403,7 +403,7 @@ func updateKxEnvironmentNetwork(ctx context.Context, d *schema.ResourceData, cli
 }
 
 func waitKxEnvironmentCreated(ctx context.Context, conn *finspace.Client, id string, timeout time.Duration) (*finspace.GetKxEnvironmentOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(types.EnvironmentStatusCreateRequested, types.EnvironmentStatusCreating),
 		Target:                    enum.Slice(types.EnvironmentStatusCreated),
 		Refresh:                   statusKxEnvironment(ctx, conn, id),
@@ -421,7 +421,7 @@ func waitKxEnvironmentCreated(ctx context.Context, conn *finspace.Client, id str
 }
 
 func waitTransitGatewayConfigurationUpdated(ctx context.Context, conn *finspace.Client, id string, timeout time.Duration) (*finspace.GetKxEnvironmentOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.TgwStatusUpdateRequested, types.TgwStatusUpdating),
 		Target:  enum.Slice(types.TgwStatusSuccessfullyUpdated),
 		Refresh: statusTransitGatewayConfiguration(ctx, conn, id),
@@ -437,7 +437,7 @@ func waitTransitGatewayConfigurationUpdated(ctx context.Context, conn *finspace.
 }
 
 func waitCustomDNSConfigurationUpdated(ctx context.Context, conn *finspace.Client, id string, timeout time.Duration) (*finspace.GetKxEnvironmentOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.DnsStatusUpdateRequested, types.DnsStatusUpdating),
 		Target:  enum.Slice(types.DnsStatusSuccessfullyUpdated),
 		Refresh: statusCustomDNSConfiguration(ctx, conn, id),
@@ -453,7 +453,7 @@ func waitCustomDNSConfigurationUpdated(ctx context.Context, conn *finspace.Clien
 }
 
 func waitKxEnvironmentDeleted(ctx context.Context, conn *finspace.Client, id string, timeout time.Duration) (*finspace.GetKxEnvironmentOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.EnvironmentStatusDeleteRequested, types.EnvironmentStatusDeleting),
 		Target:  []string{},
 		Refresh: statusKxEnvironment(ctx, conn, id),
@@ -241,7 +241,7 @@ func FindKxScalingGroupById(ctx context.Context, conn *finspace.Client, id strin
 }
 
 func waitKxScalingGroupCreated(ctx context.Context, conn *finspace.Client, id string, timeout time.Duration) (*finspace.GetKxScalingGroupOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(types.KxScalingGroupStatusCreating),
 		Target:                    enum.Slice(types.KxScalingGroupStatusActive),
 		Refresh:                   statusKxScalingGroup(ctx, conn, id),
@@ -259,7 +259,7 @@ func waitKxScalingGroupCreated(ctx context.Context, conn *finspace.Client, id st
 }
 
 func waitKxScalingGroupDeleted(ctx context.Context, conn *finspace.Client, id string, timeout time.Duration) (*finspace.GetKxScalingGroupOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.KxScalingGroupStatusDeleting),
 		Target:  enum.Slice(types.KxScalingGroupStatusDeleted),
 		Refresh: statusKxScalingGroup(ctx, conn, id),
@@ -327,7 +327,7 @@ func resourceKxVolumeDelete(ctx context.Context, d *schema.ResourceData, meta an
 }
 
 func waitKxVolumeCreated(ctx context.Context, conn *finspace.Client, id string, timeout time.Duration) (*finspace.GetKxVolumeOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(types.KxVolumeStatusCreating),
 		Target:                    enum.Slice(types.KxVolumeStatusActive),
 		Refresh:                   statusKxVolume(ctx, conn, id),
@@ -345,7 +345,7 @@ func waitKxVolumeCreated(ctx context.Context, conn *finspace.Client, id string,
 }
 
 func waitKxVolumeUpdated(ctx context.Context, conn *finspace.Client, id string, timeout time.Duration) (*finspace.GetKxVolumeOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(types.KxVolumeStatusCreating, types.KxVolumeStatusUpdating),
 		Target:                    enum.Slice(types.KxVolumeStatusActive),
 		Refresh:                   statusKxVolume(ctx, conn, id),
@@ -363,7 +363,7 @@ func waitKxVolumeUpdated(ctx context.Context, conn *finspace.Client, id string,
 }
 
 func waitKxVolumeDeleted(ctx context.Context, conn *finspace.Client, id string, timeout time.Duration) (*finspace.GetKxVolumeOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.KxVolumeStatusDeleting),
 		Target:  enum.Slice(types.KxVolumeStatusDeleted),
 		Refresh: statusKxVolume(ctx, conn, id),
@@ -204,7 +204,7 @@ func statusAdminAccount(ctx context.Context, conn *fms.Client) retry.StateRefres
 }
 
 func waitAdminAccountCreated(ctx context.Context, conn *fms.Client, accountID string, timeout time.Duration) (*fms.GetAdminAccountOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.AccountRoleStatusDeleted, // Recreating association can return this status.
 			awstypes.AccountRoleStatusCreating,
@@ -225,7 +225,7 @@ func waitAdminAccountCreated(ctx context.Context, conn *fms.Client, accountID st
 }
 
 func waitAdminAccountDeleted(ctx context.Context, conn *fms.Client, timeout time.Duration) (*fms.GetAdminAccountOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.AccountRoleStatusDeleting,
 			awstypes.AccountRoleStatusPendingDeletion,
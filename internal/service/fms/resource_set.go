@@ -287,7 +287,7 @@ func (r *resourceResourceSet) ImportState(ctx context.Context, req resource.Impo
 }
 
 func waitResourceSetCreated(ctx context.Context, conn *fms.Client, id string, timeout time.Duration) (*fms.GetResourceSetOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{},
 		Target:                    enum.Slice(awstypes.ResourceSetStatusActive),
 		Refresh:                   statusResourceSet(ctx, conn, id),
@@ -305,7 +305,7 @@ func waitResourceSetCreated(ctx context.Context, conn *fms.Client, id string, ti
 }
 
 func waitResourceSetUpdated(ctx context.Context, conn *fms.Client, id string, timeout time.Duration) (*fms.GetResourceSetOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.ResourceSetStatusOutOfAdminScope),
 		Target:                    enum.Slice(awstypes.ResourceSetStatusActive),
 		Refresh:                   statusResourceSet(ctx, conn, id),
@@ -323,7 +323,7 @@ func waitResourceSetUpdated(ctx context.Context, conn *fms.Client, id string, ti
 }
 
 func waitResourceSetDeleted(ctx context.Context, conn *fms.Client, id string, timeout time.Duration) (*fms.GetResourceSetOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResourceSetStatusOutOfAdminScope),
 		Target:  []string{},
 		Refresh: statusResourceSet(ctx, conn, id),
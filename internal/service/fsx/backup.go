@@ -246,7 +246,7 @@ func waitBackupAvailable(ctx context.Context, conn *fsx.Client, id string) (*aws
 	const (
 		timeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.BackupLifecycleCreating, awstypes.BackupLifecyclePending, awstypes.BackupLifecycleTransferring),
 		Target:  enum.Slice(awstypes.BackupLifecycleAvailable),
 		Refresh: statusBackup(ctx, conn, id),
@@ -266,7 +266,7 @@ func waitBackupDeleted(ctx context.Context, conn *fsx.Client, id string) (*awsty
 	const (
 		timeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.FileSystemLifecycleDeleting),
 		Target:  []string{},
 		Refresh: statusBackup(ctx, conn, id),
@@ -241,7 +241,7 @@ func statusSnapshot(ctx context.Context, conn *fsx.Client, id string) retry.Stat
 }
 
 func waitSnapshotCreated(ctx context.Context, conn *fsx.Client, id string, timeout time.Duration) (*awstypes.Snapshot, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SnapshotLifecycleCreating, awstypes.SnapshotLifecyclePending),
 		Target:  enum.Slice(awstypes.SnapshotLifecycleAvailable),
 		Refresh: statusSnapshot(ctx, conn, id),
@@ -263,7 +263,7 @@ func waitSnapshotCreated(ctx context.Context, conn *fsx.Client, id string, timeo
 }
 
 func waitSnapshotUpdated(ctx context.Context, conn *fsx.Client, id string, timeout time.Duration) (*awstypes.Snapshot, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SnapshotLifecyclePending),
 		Target:  enum.Slice(awstypes.SnapshotLifecycleAvailable),
 		Refresh: statusSnapshot(ctx, conn, id),
@@ -285,7 +285,7 @@ func waitSnapshotUpdated(ctx context.Context, conn *fsx.Client, id string, timeo
 }
 
 func waitSnapshotDeleted(ctx context.Context, conn *fsx.Client, id string, timeout time.Duration) (*awstypes.Snapshot, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SnapshotLifecyclePending, awstypes.SnapshotLifecycleDeleting),
 		Target:  []string{},
 		Refresh: statusSnapshot(ctx, conn, id),
@@ -248,7 +248,7 @@ func waitBuildReady(ctx context.Context, conn *gamelift.Client, id string) (*aws
 	const (
 		timeout = 1 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.BuildStatusInitialized),
 		Target:  enum.Slice(awstypes.BuildStatusReady),
 		Refresh: statusBuild(ctx, conn, id),
@@ -551,7 +551,7 @@ func statusFleet(ctx context.Context, conn *gamelift.Client, id string) retry.St
 }
 
 func waitFleetActive(ctx context.Context, conn *gamelift.Client, id string, startTime time.Time, timeout time.Duration) (*awstypes.FleetAttributes, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.FleetStatusActivating,
 			awstypes.FleetStatusBuilding,
@@ -578,7 +578,7 @@ func waitFleetActive(ctx context.Context, conn *gamelift.Client, id string, star
 }
 
 func waitFleetTerminated(ctx context.Context, conn *gamelift.Client, id string, startTime time.Time, timeout time.Duration) (*awstypes.FleetAttributes, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.FleetStatusActive,
 			awstypes.FleetStatusDeleting,
@@ -402,7 +402,7 @@ func statusGameServerGroup(ctx context.Context, conn *gamelift.Client, name stri
 }
 
 func waitGameServerGroupActive(ctx context.Context, conn *gamelift.Client, name string, timeout time.Duration) (*awstypes.GameServerGroup, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.GameServerGroupStatusNew,
 			awstypes.GameServerGroupStatusActivating,
@@ -424,7 +424,7 @@ func waitGameServerGroupActive(ctx context.Context, conn *gamelift.Client, name
 }
 
 func waitGameServerGroupTerminated(ctx context.Context, conn *gamelift.Client, name string, timeout time.Duration) (*awstypes.GameServerGroup, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.GameServerGroupStatusDeleteScheduled,
 			awstypes.GameServerGroupStatusDeleting,
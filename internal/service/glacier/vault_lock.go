@@ -214,7 +214,7 @@ func statusLockState(ctx context.Context, conn *glacier.Client, name string) ret
 }
 
 func waitVaultLockComplete(ctx context.Context, conn *glacier.Client, name string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{lockStateInProgress},
 		Target:  []string{lockStateLocked},
 		Refresh: statusLockState(ctx, conn, name),
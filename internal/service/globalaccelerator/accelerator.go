@@ -400,7 +400,7 @@ func statusAccelerator(ctx context.Context, conn *globalaccelerator.Client, arn
 }
 
 func waitAcceleratorDeployed(ctx context.Context, conn *globalaccelerator.Client, arn string, timeout time.Duration) (*awstypes.Accelerator, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.AcceleratorStatusInProgress),
 		Target:  enum.Slice(awstypes.AcceleratorStatusDeployed),
 		Refresh: statusAccelerator(ctx, conn, arn),
@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/glue"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -28,7 +27,7 @@ const (
 
 // waitMLTransformDeleted waits for an MLTransform to return Deleted
 func waitMLTransformDeleted(ctx context.Context, conn *glue.Client, transformId string) (*glue.GetMLTransformOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransformStatusTypeNotReady, awstypes.TransformStatusTypeReady, awstypes.TransformStatusTypeDeleting),
 		Target:  []string{},
 		Refresh: statusMLTransform(ctx, conn, transformId),
@@ -46,7 +45,7 @@ func waitMLTransformDeleted(ctx context.Context, conn *glue.Client, transformId
 
 // waitRegistryDeleted waits for a Registry to return Deleted
 func waitRegistryDeleted(ctx context.Context, conn *glue.Client, registryID string) (*glue.GetRegistryOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.RegistryStatusDeleting),
 		Target:  []string{},
 		Refresh: statusRegistry(ctx, conn, registryID),
@@ -64,7 +63,7 @@ func waitRegistryDeleted(ctx context.Context, conn *glue.Client, registryID stri
 
 // waitSchemaAvailable waits for a Schema to return Available
 func waitSchemaAvailable(ctx context.Context, conn *glue.Client, registryID string) (*glue.GetSchemaOutput, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SchemaStatusPending),
 		Target:  enum.Slice(awstypes.SchemaStatusAvailable),
 		Refresh: statusSchema(ctx, conn, registryID),
@@ -82,7 +81,7 @@ func waitSchemaAvailable(ctx context.Context, conn *glue.Client, registryID stri
 
 // waitSchemaDeleted waits for a Schema to return Deleted
 func waitSchemaDeleted(ctx context.Context, conn *glue.Client, registryID string) (*glue.GetSchemaOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SchemaStatusDeleting),
 		Target:  []string{},
 		Refresh: statusSchema(ctx, conn, registryID),
@@ -100,7 +99,7 @@ func waitSchemaDeleted(ctx context.Context, conn *glue.Client, registryID string
 
 // waitSchemaVersionAvailable waits for a Schema to return Available
 func waitSchemaVersionAvailable(ctx context.Context, conn *glue.Client, registryID string) (*glue.GetSchemaVersionOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SchemaVersionStatusPending),
 		Target:  enum.Slice(awstypes.SchemaVersionStatusAvailable),
 		Refresh: statusSchemaVersion(ctx, conn, registryID),
@@ -118,7 +117,7 @@ func waitSchemaVersionAvailable(ctx context.Context, conn *glue.Client, registry
 
 // waitTriggerCreated waits for a Trigger to return Created
 func waitTriggerCreated(ctx context.Context, conn *glue.Client, triggerName string, timeout time.Duration) (*glue.GetTriggerOutput, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.TriggerStateActivating,
 			awstypes.TriggerStateCreating,
@@ -143,7 +142,7 @@ func waitTriggerCreated(ctx context.Context, conn *glue.Client, triggerName stri
 
 // waitTriggerDeleted waits for a Trigger to return Deleted
 func waitTriggerDeleted(ctx context.Context, conn *glue.Client, triggerName string, timeout time.Duration) (*glue.GetTriggerOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.TriggerStateDeleting),
 		Target:  []string{},
 		Refresh: statusTrigger(ctx, conn, triggerName),
@@ -160,7 +159,7 @@ func waitTriggerDeleted(ctx context.Context, conn *glue.Client, triggerName stri
 }
 
 func waitDevEndpointCreated(ctx context.Context, conn *glue.Client, name string) (*awstypes.DevEndpoint, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{devEndpointStatusProvisioning},
 		Target:  []string{devEndpointStatusReady},
 		Refresh: statusDevEndpoint(ctx, conn, name),
@@ -181,7 +180,7 @@ func waitDevEndpointCreated(ctx context.Context, conn *glue.Client, name string)
 }
 
 func waitDevEndpointDeleted(ctx context.Context, conn *glue.Client, name string) (*awstypes.DevEndpoint, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{devEndpointStatusTerminating},
 		Target:  []string{},
 		Refresh: statusDevEndpoint(ctx, conn, name),
@@ -202,7 +201,7 @@ func waitDevEndpointDeleted(ctx context.Context, conn *glue.Client, name string)
 }
 
 func waitPartitionIndexCreated(ctx context.Context, conn *glue.Client, id string, timeout time.Duration) (*awstypes.PartitionIndexDescriptor, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.PartitionIndexStatusCreating),
 		Target:  enum.Slice(awstypes.PartitionIndexStatusActive),
 		Refresh: statusPartitionIndex(ctx, conn, id),
@@ -219,7 +218,7 @@ func waitPartitionIndexCreated(ctx context.Context, conn *glue.Client, id string
 }
 
 func waitPartitionIndexDeleted(ctx context.Context, conn *glue.Client, id string, timeout time.Duration) (*awstypes.PartitionIndexDescriptor, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.PartitionIndexStatusDeleting),
 		Target:  []string{},
 		Refresh: statusPartitionIndex(ctx, conn, id),
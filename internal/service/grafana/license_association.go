@@ -170,7 +170,7 @@ func findLicensedWorkspaceByID(ctx context.Context, conn *grafana.Client, id str
 }
 
 func waitLicenseAssociationCreated(ctx context.Context, conn *grafana.Client, id string, timeout time.Duration) (*awstypes.WorkspaceDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.WorkspaceStatusUpgrading),
 		Target:  enum.Slice(awstypes.WorkspaceStatusActive),
 		Refresh: statusWorkspace(ctx, conn, id),
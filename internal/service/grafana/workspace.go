@@ -497,7 +497,7 @@ func statusWorkspace(ctx context.Context, conn *grafana.Client, id string) retry
 }
 
 func waitWorkspaceCreated(ctx context.Context, conn *grafana.Client, id string, timeout time.Duration) (*awstypes.WorkspaceDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.WorkspaceStatusCreating),
 		Target:  enum.Slice(awstypes.WorkspaceStatusActive),
 		Refresh: statusWorkspace(ctx, conn, id),
@@ -514,7 +514,7 @@ func waitWorkspaceCreated(ctx context.Context, conn *grafana.Client, id string,
 }
 
 func waitWorkspaceUpdated(ctx context.Context, conn *grafana.Client, id string, timeout time.Duration) (*awstypes.WorkspaceDescription, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.WorkspaceStatusUpdating, awstypes.WorkspaceStatusVersionUpdating),
 		Target:  enum.Slice(awstypes.WorkspaceStatusActive),
 		Refresh: statusWorkspace(ctx, conn, id),
@@ -531,7 +531,7 @@ func waitWorkspaceUpdated(ctx context.Context, conn *grafana.Client, id string,
 }
 
 func waitWorkspaceDeleted(ctx context.Context, conn *grafana.Client, id string, timeout time.Duration) (*awstypes.WorkspaceDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.WorkspaceStatusDeleting),
 		Target:  []string{},
 		Refresh: statusWorkspace(ctx, conn, id),
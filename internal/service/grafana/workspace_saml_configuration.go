@@ -320,7 +320,7 @@ func statusWorkspaceSAMLConfiguration(ctx context.Context, conn *grafana.Client,
 }
 
 func waitWorkspaceSAMLConfigurationCreated(ctx context.Context, conn *grafana.Client, id string, timeout time.Duration) (*awstypes.SamlAuthentication, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{},
 		Target:  enum.Slice(awstypes.SamlConfigurationStatusConfigured),
 		Refresh: statusWorkspaceSAMLConfiguration(ctx, conn, id),
@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -18,18 +17,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
-	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
-	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @FrameworkResource("aws_iam_group_policies_exclusive", name="Group Policies Exclusive")
 func newResourceGroupPoliciesExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &resourceGroupPoliciesExclusive{}, nil
+	r := &resourceGroupPoliciesExclusive{}
+	r.SetExclusiveRelationship(names.IAM, ResNameGroupPoliciesExclusive, names.AttrGroupName, "policy_names", r.relationship)
+
+	return r, nil
 }
 
 const (
@@ -37,8 +36,7 @@ const (
 )
 
 type resourceGroupPoliciesExclusive struct {
-	framework.ResourceWithConfigure
-	framework.WithNoOpDelete
+	framework.ExclusiveRelationshipResource
 }
 
 func (r *resourceGroupPoliciesExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -61,130 +59,40 @@ func (r *resourceGroupPoliciesExclusive) Schema(ctx context.Context, req resourc
 	}
 }
 
-func (r *resourceGroupPoliciesExclusive) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan resourceGroupPoliciesExclusiveData
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	var policyNames []string
-	resp.Diagnostics.Append(plan.PolicyNames.ElementsAs(ctx, &policyNames, false)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	err := r.syncAttachments(ctx, plan.GroupName.ValueString(), policyNames)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.IAM, create.ErrActionCreating, ResNameGroupPoliciesExclusive, plan.GroupName.String(), err),
-			err.Error(),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
-}
-
-func (r *resourceGroupPoliciesExclusive) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	conn := r.Meta().IAMClient(ctx)
-
-	var state resourceGroupPoliciesExclusiveData
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	out, err := findGroupPoliciesByName(ctx, conn, state.GroupName.ValueString())
-	if tfresource.NotFound(err) {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.IAM, create.ErrActionReading, ResNameGroupPoliciesExclusive, state.GroupName.String(), err),
-			err.Error(),
-		)
-		return
-	}
-
-	state.PolicyNames = flex.FlattenFrameworkStringValueSetLegacy(ctx, out)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
-}
-
-func (r *resourceGroupPoliciesExclusive) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, state resourceGroupPoliciesExclusiveData
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	if !plan.PolicyNames.Equal(state.PolicyNames) {
-		var policyNames []string
-		resp.Diagnostics.Append(plan.PolicyNames.ElementsAs(ctx, &policyNames, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		err := r.syncAttachments(ctx, plan.GroupName.ValueString(), policyNames)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				create.ProblemStandardMessage(names.IAM, create.ErrActionUpdating, ResNameGroupPoliciesExclusive, plan.GroupName.String(), err),
-				err.Error(),
-			)
-			return
-		}
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
-}
-
-// syncAttachments handles keeping the configured inline policy attachments
-// in sync with the remote resource.
+// relationship returns the relationship between the group and its inline policies.
 //
 // Inline policies defined on this resource but not attached to the group will
 // be added. Policies attached to the group but not configured on this resource
 // will be removed.
-func (r *resourceGroupPoliciesExclusive) syncAttachments(ctx context.Context, groupName string, want []string) error {
-	conn := r.Meta().IAMClient(ctx)
-
-	have, err := findGroupPoliciesByName(ctx, conn, groupName)
-	if err != nil {
-		return err
-	}
+func (r *resourceGroupPoliciesExclusive) relationship(ctx context.Context, c *conns.AWSClient) framework.ExclusiveRelationship[string] {
+	conn := c.IAMClient(ctx)
 
-	create, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
+	return framework.ExclusiveRelationship[string]{
+		Find: func(ctx context.Context, groupName string) ([]string, error) {
+			return findGroupPoliciesByName(ctx, conn, groupName)
+		},
+		Add: func(ctx context.Context, groupName, name string) error {
+			in := &iam.PutGroupPolicyInput{
+				GroupName:  aws.String(groupName),
+				PolicyName: aws.String(name),
+			}
 
-	for _, name := range create {
-		in := &iam.PutGroupPolicyInput{
-			GroupName:  aws.String(groupName),
-			PolicyName: aws.String(name),
-		}
+			_, err := conn.PutGroupPolicy(ctx, in)
 
-		_, err := conn.PutGroupPolicy(ctx, in)
-		if err != nil {
 			return err
-		}
-	}
+		},
+		Remove: func(ctx context.Context, groupName, name string) error {
+			in := &iam.DeleteGroupPolicyInput{
+				GroupName:  aws.String(groupName),
+				PolicyName: aws.String(name),
+			}
 
-	for _, name := range remove {
-		in := &iam.DeleteGroupPolicyInput{
-			GroupName:  aws.String(groupName),
-			PolicyName: aws.String(name),
-		}
+			_, err := conn.DeleteGroupPolicy(ctx, in)
 
-		_, err := conn.DeleteGroupPolicy(ctx, in)
-		if err != nil {
 			return err
-		}
+		},
+		Equal: func(s1, s2 string) bool { return s1 == s2 },
 	}
-
-	return nil
-}
-
-func (r *resourceGroupPoliciesExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrGroupName), req, resp)
 }
 
 func findGroupPoliciesByName(ctx context.Context, conn *iam.Client, groupName string) ([]string, error) {
@@ -211,8 +119,3 @@ func findGroupPoliciesByName(ctx context.Context, conn *iam.Client, groupName st
 
 	return policyNames, nil
 }
-
-type resourceGroupPoliciesExclusiveData struct {
-	GroupName   types.String `tfsdk:"group_name"`
-	PolicyNames types.Set    `tfsdk:"policy_names"`
-}
@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -18,18 +17,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
-	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
-	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @FrameworkResource("aws_iam_group_policy_attachments_exclusive", name="Group Policy Attachments Exclusive")
 func newResourceGroupPolicyAttachmentsExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &resourceGroupPolicyAttachmentsExclusive{}, nil
+	r := &resourceGroupPolicyAttachmentsExclusive{}
+	r.SetExclusiveRelationship(names.IAM, ResNameGroupPolicyAttachmentsExclusive, names.AttrGroupName, "policy_arns", r.relationship)
+
+	return r, nil
 }
 
 const (
@@ -37,8 +36,7 @@ const (
 )
 
 type resourceGroupPolicyAttachmentsExclusive struct {
-	framework.ResourceWithConfigure
-	framework.WithNoOpDelete
+	framework.ExclusiveRelationshipResource
 }
 
 func (r *resourceGroupPolicyAttachmentsExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -61,120 +59,26 @@ func (r *resourceGroupPolicyAttachmentsExclusive) Schema(ctx context.Context, re
 	}
 }
 
-func (r *resourceGroupPolicyAttachmentsExclusive) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan resourceGroupPolicyAttachmentsExclusiveData
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	var policyARNs []string
-	resp.Diagnostics.Append(plan.PolicyARNs.ElementsAs(ctx, &policyARNs, false)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	err := r.syncAttachments(ctx, plan.GroupName.ValueString(), policyARNs)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.IAM, create.ErrActionCreating, ResNameGroupPolicyAttachmentsExclusive, plan.GroupName.String(), err),
-			err.Error(),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
-}
-
-func (r *resourceGroupPolicyAttachmentsExclusive) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	conn := r.Meta().IAMClient(ctx)
-
-	var state resourceGroupPolicyAttachmentsExclusiveData
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	out, err := findGroupPolicyAttachmentsByName(ctx, conn, state.GroupName.ValueString())
-	if tfresource.NotFound(err) {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.IAM, create.ErrActionReading, ResNameGroupPolicyAttachmentsExclusive, state.GroupName.String(), err),
-			err.Error(),
-		)
-		return
-	}
-
-	state.PolicyARNs = flex.FlattenFrameworkStringValueSetLegacy(ctx, out)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
-}
-
-func (r *resourceGroupPolicyAttachmentsExclusive) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, state resourceGroupPolicyAttachmentsExclusiveData
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	if !plan.PolicyARNs.Equal(state.PolicyARNs) {
-		var policyARNs []string
-		resp.Diagnostics.Append(plan.PolicyARNs.ElementsAs(ctx, &policyARNs, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		err := r.syncAttachments(ctx, plan.GroupName.ValueString(), policyARNs)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				create.ProblemStandardMessage(names.IAM, create.ErrActionUpdating, ResNameGroupPolicyAttachmentsExclusive, plan.GroupName.String(), err),
-				err.Error(),
-			)
-			return
-		}
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
-}
-
-// syncAttachments handles keeping the configured managed IAM policy
-// attachments in sync with the remote resource.
+// relationship returns the relationship between the group and its attached managed IAM policies.
 //
 // Managed IAM policies defined on this resource but not attached to
 // the group will be added. Policies attached to the group but not configured
 // on this resource will be removed.
-func (r *resourceGroupPolicyAttachmentsExclusive) syncAttachments(ctx context.Context, groupName string, want []string) error {
-	conn := r.Meta().IAMClient(ctx)
-
-	have, err := findGroupPolicyAttachmentsByName(ctx, conn, groupName)
-	if err != nil {
-		return err
-	}
-
-	create, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
-
-	for _, arn := range create {
-		err := attachPolicyToGroup(ctx, conn, groupName, arn)
-		if err != nil {
-			return err
-		}
-	}
+func (r *resourceGroupPolicyAttachmentsExclusive) relationship(ctx context.Context, c *conns.AWSClient) framework.ExclusiveRelationship[string] {
+	conn := c.IAMClient(ctx)
 
-	for _, arn := range remove {
-		err := detachPolicyFromGroup(ctx, conn, groupName, arn)
-		if err != nil {
-			return err
-		}
+	return framework.ExclusiveRelationship[string]{
+		Find: func(ctx context.Context, groupName string) ([]string, error) {
+			return findGroupPolicyAttachmentsByName(ctx, conn, groupName)
+		},
+		Add: func(ctx context.Context, groupName, arn string) error {
+			return attachPolicyToGroup(ctx, conn, groupName, arn)
+		},
+		Remove: func(ctx context.Context, groupName, arn string) error {
+			return detachPolicyFromGroup(ctx, conn, groupName, arn)
+		},
+		Equal: func(s1, s2 string) bool { return s1 == s2 },
 	}
-
-	return nil
-}
-
-func (r *resourceGroupPolicyAttachmentsExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrGroupName), req, resp)
 }
 
 func findGroupPolicyAttachmentsByName(ctx context.Context, conn *iam.Client, groupName string) ([]string, error) {
@@ -205,8 +109,3 @@ func findGroupPolicyAttachmentsByName(ctx context.Context, conn *iam.Client, gro
 
 	return policyARNs, nil
 }
-
-type resourceGroupPolicyAttachmentsExclusiveData struct {
-	GroupName  types.String `tfsdk:"group_name"`
-	PolicyARNs types.Set    `tfsdk:"policy_arns"`
-}
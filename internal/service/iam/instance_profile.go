@@ -367,7 +367,7 @@ func statusInstanceProfile(ctx context.Context, conn *iam.Client, name string) r
 }
 
 func waitInstanceProfileReady(ctx context.Context, conn *iam.Client, id string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{"", InstanceProfileInvalidARN},
 		Target:                    enum.Slice(InstanceProfileFound),
 		Refresh:                   statusInstanceProfile(ctx, conn, id),
@@ -35,6 +35,7 @@ const (
 )
 
 // @SDKResource("aws_iam_policy", name="Policy")
+// @ArnIdentity
 // @Tags(identifierAttribute="arn", resourceType="Policy")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.Policy")
 func resourcePolicy() *schema.Resource {
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -169,7 +170,7 @@ func dataSourcePolicyDocument() *schema.Resource {
 
 func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
-	mergedDoc := &IAMPolicyDoc{}
+	mergedDoc := &verify.IAMPolicyDoc{}
 
 	if v, ok := d.GetOk("source_policy_documents"); ok && len(v.([]any)) > 0 {
 		// generate sid map to assure there are no duplicates in source jsons
@@ -186,7 +187,7 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 				continue
 			}
 
-			sourceDoc := &IAMPolicyDoc{}
+			sourceDoc := &verify.IAMPolicyDoc{}
 			if err := json.Unmarshal([]byte(sourceJSON.(string)), sourceDoc); err != nil {
 				return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging source document %d: %s", sourceJSONIndex, err)
 			}
@@ -206,7 +207,7 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	// process the current document
-	doc := &IAMPolicyDoc{
+	doc := &verify.IAMPolicyDoc{
 		Version: d.Get(names.AttrVersion).(string),
 	}
 
@@ -216,12 +217,12 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 
 	if cfgStmts, hasCfgStmts := d.GetOk("statement"); hasCfgStmts {
 		var cfgStmtIntf = cfgStmts.([]any)
-		stmts := make([]*IAMPolicyStatement, len(cfgStmtIntf))
+		stmts := make([]*verify.IAMPolicyStatement, len(cfgStmtIntf))
 		sidMap := make(map[string]struct{})
 
 		for i, stmtI := range cfgStmtIntf {
 			cfgStmt := stmtI.(map[string]any)
-			stmt := &IAMPolicyStatement{
+			stmt := &verify.IAMPolicyStatement{
 				Effect: cfgStmt["effect"].(string),
 			}
 
@@ -300,7 +301,7 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 			if overrideJSON == nil {
 				continue
 			}
-			overrideDoc := &IAMPolicyDoc{}
+			overrideDoc := &verify.IAMPolicyDoc{}
 			if err := json.Unmarshal([]byte(overrideJSON.(string)), overrideDoc); err != nil {
 				return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging override document %d: %s", overrideJSONIndex, err)
 			}
@@ -353,12 +354,12 @@ func dataSourcePolicyDocumentReplaceVarsInList(in any, version string) (any, err
 	}
 }
 
-func dataSourcePolicyDocumentMakeConditions(in []any, version string) (IAMPolicyStatementConditionSet, error) {
-	out := make([]IAMPolicyStatementCondition, len(in))
+func dataSourcePolicyDocumentMakeConditions(in []any, version string) (verify.IAMPolicyStatementConditionSet, error) {
+	out := make([]verify.IAMPolicyStatementCondition, len(in))
 	for i, itemI := range in {
 		var err error
 		item := itemI.(map[string]any)
-		out[i] = IAMPolicyStatementCondition{
+		out[i] = verify.IAMPolicyStatementCondition{
 			Test:     item["test"].(string),
 			Variable: item["variable"].(string),
 		}
@@ -374,15 +375,15 @@ func dataSourcePolicyDocumentMakeConditions(in []any, version string) (IAMPolicy
 			out[i].Values = itemValues[0]
 		}
 	}
-	return IAMPolicyStatementConditionSet(out), nil
+	return verify.IAMPolicyStatementConditionSet(out), nil
 }
 
-func dataSourcePolicyDocumentMakePrincipals(in []any, version string) (IAMPolicyStatementPrincipalSet, error) {
-	out := make([]IAMPolicyStatementPrincipal, len(in))
+func dataSourcePolicyDocumentMakePrincipals(in []any, version string) (verify.IAMPolicyStatementPrincipalSet, error) {
+	out := make([]verify.IAMPolicyStatementPrincipal, len(in))
 	for i, itemI := range in {
 		var err error
 		item := itemI.(map[string]any)
-		out[i] = IAMPolicyStatementPrincipal{
+		out[i] = verify.IAMPolicyStatementPrincipal{
 			Type: item[names.AttrType].(string),
 		}
 		out[i].Identifiers, err = dataSourcePolicyDocumentReplaceVarsInList(
@@ -394,5 +395,5 @@ func dataSourcePolicyDocumentMakePrincipals(in []any, version string) (IAMPolicy
 			return nil, fmt.Errorf("reading identifiers: %w", err)
 		}
 	}
-	return IAMPolicyStatementPrincipalSet(out), nil
+	return verify.IAMPolicyStatementPrincipalSet(out), nil
 }
@@ -40,6 +40,7 @@ const (
 )
 
 // @SDKResource("aws_iam_role", name="Role")
+// @IdentityAttribute("name")
 // @Tags(identifierAttribute="name", resourceType="Role")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.Role")
 func resourceRole() *schema.Resource {
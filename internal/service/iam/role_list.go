@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	tflist "github.com/hashicorp/terraform-provider-aws/internal/list"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_iam_role", name="Role")
+func newRoleListResource(_ context.Context) (list.ListResourceWithConfigure, error) {
+	return &roleListResource{}, nil
+}
+
+type roleListResource struct {
+	tflist.ListResourceWithSDKv2Resource
+}
+
+func (l *roleListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	conn := l.Meta().IAMClient(ctx)
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		input := iam.ListRolesInput{}
+		err := listRolesPages(ctx, conn, &input, func(page *iam.ListRolesOutput, lastPage bool) bool {
+			for _, v := range page.Roles {
+				name := aws.ToString(v.RoleName)
+				d := l.ResourceData()
+				d.SetId(name)
+				d.Set(names.AttrName, name)
+
+				result := request.NewListResult(ctx)
+				result.DisplayName = name
+				if !l.SetResult(ctx, request, d, &result) {
+					continue
+				}
+
+				if !yield(result) {
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			var result list.ListResult
+			result.Diagnostics.AddError("listing IAM Roles", err.Error())
+			yield(result)
+		}
+	}
+}
@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -18,18 +17,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
-	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
-	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @FrameworkResource("aws_iam_role_policies_exclusive", name="Role Policies Exclusive")
 func newResourceRolePoliciesExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &resourceRolePoliciesExclusive{}, nil
+	r := &resourceRolePoliciesExclusive{}
+	r.SetExclusiveRelationship(names.IAM, ResNameRolePoliciesExclusive, "role_name", "policy_names", r.relationship)
+
+	return r, nil
 }
 
 const (
@@ -37,8 +36,7 @@ const (
 )
 
 type resourceRolePoliciesExclusive struct {
-	framework.ResourceWithConfigure
-	framework.WithNoOpDelete
+	framework.ExclusiveRelationshipResource
 }
 
 func (r *resourceRolePoliciesExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -61,130 +59,40 @@ func (r *resourceRolePoliciesExclusive) Schema(ctx context.Context, req resource
 	}
 }
 
-func (r *resourceRolePoliciesExclusive) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan resourceRolePoliciesExclusiveData
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	var policyNames []string
-	resp.Diagnostics.Append(plan.PolicyNames.ElementsAs(ctx, &policyNames, false)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	err := r.syncAttachments(ctx, plan.RoleName.ValueString(), policyNames)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.IAM, create.ErrActionCreating, ResNameRolePoliciesExclusive, plan.RoleName.String(), err),
-			err.Error(),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
-}
-
-func (r *resourceRolePoliciesExclusive) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	conn := r.Meta().IAMClient(ctx)
-
-	var state resourceRolePoliciesExclusiveData
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	out, err := findRolePoliciesByName(ctx, conn, state.RoleName.ValueString())
-	if tfresource.NotFound(err) {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.IAM, create.ErrActionReading, ResNameRolePoliciesExclusive, state.RoleName.String(), err),
-			err.Error(),
-		)
-		return
-	}
-
-	state.PolicyNames = flex.FlattenFrameworkStringValueSetLegacy(ctx, out)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
-}
-
-func (r *resourceRolePoliciesExclusive) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, state resourceRolePoliciesExclusiveData
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	if !plan.PolicyNames.Equal(state.PolicyNames) {
-		var policyNames []string
-		resp.Diagnostics.Append(plan.PolicyNames.ElementsAs(ctx, &policyNames, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		err := r.syncAttachments(ctx, plan.RoleName.ValueString(), policyNames)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				create.ProblemStandardMessage(names.IAM, create.ErrActionUpdating, ResNameRolePoliciesExclusive, plan.RoleName.String(), err),
-				err.Error(),
-			)
-			return
-		}
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
-}
-
-// syncAttachments handles keeping the configured inline policy attachments
-// in sync with the remote resource.
+// relationship returns the relationship between the role and its inline policies.
 //
 // Inline policies defined on this resource but not attached to the role will
 // be added. Policies attached to the role but not configured on this resource
 // will be removed.
-func (r *resourceRolePoliciesExclusive) syncAttachments(ctx context.Context, roleName string, want []string) error {
-	conn := r.Meta().IAMClient(ctx)
-
-	have, err := findRolePoliciesByName(ctx, conn, roleName)
-	if err != nil {
-		return err
-	}
+func (r *resourceRolePoliciesExclusive) relationship(ctx context.Context, c *conns.AWSClient) framework.ExclusiveRelationship[string] {
+	conn := c.IAMClient(ctx)
 
-	create, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
+	return framework.ExclusiveRelationship[string]{
+		Find: func(ctx context.Context, roleName string) ([]string, error) {
+			return findRolePoliciesByName(ctx, conn, roleName)
+		},
+		Add: func(ctx context.Context, roleName, name string) error {
+			in := &iam.PutRolePolicyInput{
+				RoleName:   aws.String(roleName),
+				PolicyName: aws.String(name),
+			}
 
-	for _, name := range create {
-		in := &iam.PutRolePolicyInput{
-			RoleName:   aws.String(roleName),
-			PolicyName: aws.String(name),
-		}
+			_, err := conn.PutRolePolicy(ctx, in)
 
-		_, err := conn.PutRolePolicy(ctx, in)
-		if err != nil {
 			return err
-		}
-	}
+		},
+		Remove: func(ctx context.Context, roleName, name string) error {
+			in := &iam.DeleteRolePolicyInput{
+				RoleName:   aws.String(roleName),
+				PolicyName: aws.String(name),
+			}
 
-	for _, name := range remove {
-		in := &iam.DeleteRolePolicyInput{
-			RoleName:   aws.String(roleName),
-			PolicyName: aws.String(name),
-		}
+			_, err := conn.DeleteRolePolicy(ctx, in)
 
-		_, err := conn.DeleteRolePolicy(ctx, in)
-		if err != nil {
 			return err
-		}
+		},
+		Equal: func(s1, s2 string) bool { return s1 == s2 },
 	}
-
-	return nil
-}
-
-func (r *resourceRolePoliciesExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("role_name"), req, resp)
 }
 
 func findRolePoliciesByName(ctx context.Context, conn *iam.Client, roleName string) ([]string, error) {
@@ -211,8 +119,3 @@ func findRolePoliciesByName(ctx context.Context, conn *iam.Client, roleName stri
 
 	return policyNames, nil
 }
-
-type resourceRolePoliciesExclusiveData struct {
-	RoleName    types.String `tfsdk:"role_name"`
-	PolicyNames types.Set    `tfsdk:"policy_names"`
-}
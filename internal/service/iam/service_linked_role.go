@@ -251,7 +251,7 @@ func deleteServiceLinkedRole(ctx context.Context, conn *iam.Client, roleName str
 }
 
 func waitServiceLinkedRoleDeleted(ctx context.Context, conn *iam.Client, id string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.DeletionTaskStatusTypeInProgress, awstypes.DeletionTaskStatusTypeNotStarted),
 		Target:  enum.Slice(awstypes.DeletionTaskStatusTypeSucceeded),
 		Refresh: statusServiceLinkedRoleDeletion(ctx, conn, id),
@@ -152,6 +152,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 	}
 }
 
+func (p *servicePackage) SDKListResources(ctx context.Context) []*types.ServicePackageSDKListResource {
+	return []*types.ServicePackageSDKListResource{
+		{
+			Factory:  newRoleListResource,
+			TypeName: "aws_iam_role",
+			Name:     "Role",
+		},
+	}
+}
+
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
 		{
@@ -215,6 +225,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 				ResourceType:        "Policy",
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				ARN: true,
+			},
 		},
 		{
 			Factory:  resourcePolicyAttachment,
@@ -229,6 +242,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrName,
 				ResourceType:        "Role",
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				IdentityAttribute: names.AttrName,
+			},
 		},
 		{
 			Factory:  resourceRolePolicy,
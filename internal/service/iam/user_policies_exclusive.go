@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -18,18 +17,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
-	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
-	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @FrameworkResource("aws_iam_user_policies_exclusive", name="User Policies Exclusive")
 func newResourceUserPoliciesExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &resourceUserPoliciesExclusive{}, nil
+	r := &resourceUserPoliciesExclusive{}
+	r.SetExclusiveRelationship(names.IAM, ResNameUserPoliciesExclusive, names.AttrUserName, "policy_names", r.relationship)
+
+	return r, nil
 }
 
 const (
@@ -37,8 +36,7 @@ const (
 )
 
 type resourceUserPoliciesExclusive struct {
-	framework.ResourceWithConfigure
-	framework.WithNoOpDelete
+	framework.ExclusiveRelationshipResource
 }
 
 func (r *resourceUserPoliciesExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -61,130 +59,40 @@ func (r *resourceUserPoliciesExclusive) Schema(ctx context.Context, req resource
 	}
 }
 
-func (r *resourceUserPoliciesExclusive) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan resourceUserPoliciesExclusiveData
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	var policyNames []string
-	resp.Diagnostics.Append(plan.PolicyNames.ElementsAs(ctx, &policyNames, false)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	err := r.syncAttachments(ctx, plan.UserName.ValueString(), policyNames)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.IAM, create.ErrActionCreating, ResNameUserPoliciesExclusive, plan.UserName.String(), err),
-			err.Error(),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
-}
-
-func (r *resourceUserPoliciesExclusive) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	conn := r.Meta().IAMClient(ctx)
-
-	var state resourceUserPoliciesExclusiveData
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	out, err := findUserPoliciesByName(ctx, conn, state.UserName.ValueString())
-	if tfresource.NotFound(err) {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.IAM, create.ErrActionReading, ResNameUserPoliciesExclusive, state.UserName.String(), err),
-			err.Error(),
-		)
-		return
-	}
-
-	state.PolicyNames = flex.FlattenFrameworkStringValueSetLegacy(ctx, out)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
-}
-
-func (r *resourceUserPoliciesExclusive) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, state resourceUserPoliciesExclusiveData
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	if !plan.PolicyNames.Equal(state.PolicyNames) {
-		var policyNames []string
-		resp.Diagnostics.Append(plan.PolicyNames.ElementsAs(ctx, &policyNames, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		err := r.syncAttachments(ctx, plan.UserName.ValueString(), policyNames)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				create.ProblemStandardMessage(names.IAM, create.ErrActionUpdating, ResNameUserPoliciesExclusive, plan.UserName.String(), err),
-				err.Error(),
-			)
-			return
-		}
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
-}
-
-// syncAttachments handles keeping the configured inline policy attachments
-// in sync with the remote resource.
+// relationship returns the relationship between the user and its inline policies.
 //
 // Inline policies defined on this resource but not attached to the user will
 // be added. Policies attached to the user but not configured on this resource
 // will be removed.
-func (r *resourceUserPoliciesExclusive) syncAttachments(ctx context.Context, userName string, want []string) error {
-	conn := r.Meta().IAMClient(ctx)
-
-	have, err := findUserPoliciesByName(ctx, conn, userName)
-	if err != nil {
-		return err
-	}
+func (r *resourceUserPoliciesExclusive) relationship(ctx context.Context, c *conns.AWSClient) framework.ExclusiveRelationship[string] {
+	conn := c.IAMClient(ctx)
 
-	create, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
+	return framework.ExclusiveRelationship[string]{
+		Find: func(ctx context.Context, userName string) ([]string, error) {
+			return findUserPoliciesByName(ctx, conn, userName)
+		},
+		Add: func(ctx context.Context, userName, name string) error {
+			in := &iam.PutUserPolicyInput{
+				UserName:   aws.String(userName),
+				PolicyName: aws.String(name),
+			}
 
-	for _, name := range create {
-		in := &iam.PutUserPolicyInput{
-			UserName:   aws.String(userName),
-			PolicyName: aws.String(name),
-		}
+			_, err := conn.PutUserPolicy(ctx, in)
 
-		_, err := conn.PutUserPolicy(ctx, in)
-		if err != nil {
 			return err
-		}
-	}
+		},
+		Remove: func(ctx context.Context, userName, name string) error {
+			in := &iam.DeleteUserPolicyInput{
+				UserName:   aws.String(userName),
+				PolicyName: aws.String(name),
+			}
 
-	for _, name := range remove {
-		in := &iam.DeleteUserPolicyInput{
-			UserName:   aws.String(userName),
-			PolicyName: aws.String(name),
-		}
+			_, err := conn.DeleteUserPolicy(ctx, in)
 
-		_, err := conn.DeleteUserPolicy(ctx, in)
-		if err != nil {
 			return err
-		}
+		},
+		Equal: func(s1, s2 string) bool { return s1 == s2 },
 	}
-
-	return nil
-}
-
-func (r *resourceUserPoliciesExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrUserName), req, resp)
 }
 
 func findUserPoliciesByName(ctx context.Context, conn *iam.Client, userName string) ([]string, error) {
@@ -211,8 +119,3 @@ func findUserPoliciesByName(ctx context.Context, conn *iam.Client, userName stri
 
 	return policyNames, nil
 }
-
-type resourceUserPoliciesExclusiveData struct {
-	UserName    types.String `tfsdk:"user_name"`
-	PolicyNames types.Set    `tfsdk:"policy_names"`
-}
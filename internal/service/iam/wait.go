@@ -34,7 +34,7 @@ func waitRoleARNIsNotUniqueID(ctx context.Context, conn *iam.Client, id string,
 		return role, nil
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{RoleStatusARNIsUniqueID, RoleStatusNotFound},
 		Target:                    []string{names.AttrARN},
 		Refresh:                   statusRoleCreate(ctx, conn, id),
@@ -472,7 +472,7 @@ func statusImage(ctx context.Context, conn *imagebuilder.Client, arn string) ret
 }
 
 func waitImageStatusAvailable(ctx context.Context, conn *imagebuilder.Client, arn string, timeout time.Duration) (*awstypes.Image, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.ImageStatusBuilding,
 			awstypes.ImageStatusCreating,
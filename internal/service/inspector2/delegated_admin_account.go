@@ -213,7 +213,7 @@ const (
 )
 
 func waitDelegatedAdminAccountEnabled(ctx context.Context, conn *inspector2.Client, accountID string, timeout time.Duration) (*awstypes.DelegatedAdminAccount, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(delegatedAdminStatusDisableInProgress, delegatedAdminStatusEnableInProgress, delegatedAdminStatusEnabling),
 		Target:  enum.Slice(delegatedAdminStatusEnabled),
 		Refresh: statusDelegatedAdminAccount(ctx, conn, accountID),
@@ -230,7 +230,7 @@ func waitDelegatedAdminAccountEnabled(ctx context.Context, conn *inspector2.Clie
 }
 
 func waitDelegatedAdminAccountDisabled(ctx context.Context, conn *inspector2.Client, accountID string, timeout time.Duration) (*awstypes.DelegatedAdminAccount, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(delegatedAdminStatusDisableInProgress, delegatedAdminStatusCreated, delegatedAdminStatusEnabled),
 		Target:  []string{},
 		Refresh: statusDelegatedAdminAccount(ctx, conn, accountID),
@@ -423,7 +423,7 @@ const (
 )
 
 func waitEnabled(ctx context.Context, conn *inspector2.Client, accountIDs []string, timeout time.Duration) (map[string]AccountResourceStatus, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{statusInProgress},
 		Target:  []string{statusComplete},
 		Refresh: statusEnablerAccountAndResourceTypes(ctx, conn, accountIDs),
@@ -441,7 +441,7 @@ func waitEnabled(ctx context.Context, conn *inspector2.Client, accountIDs []stri
 }
 
 func waitDisabled(ctx context.Context, conn *inspector2.Client, accountIDs []string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{statusInProgress},
 		Target:  []string{},
 		Refresh: statusEnablerAccount(ctx, conn, accountIDs),
@@ -192,7 +192,7 @@ func statusMemberAssociation(ctx context.Context, conn *inspector2.Client, id st
 }
 
 func waitMemberAssociationCreated(ctx context.Context, conn *inspector2.Client, id string, timeout time.Duration) (*awstypes.Member, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.RelationshipStatusCreated),
 		Target:  enum.Slice(awstypes.RelationshipStatusEnabled),
 		Refresh: statusMemberAssociation(ctx, conn, id),
@@ -209,7 +209,7 @@ func waitMemberAssociationCreated(ctx context.Context, conn *inspector2.Client,
 }
 
 func waitMemberAssociationDeleted(ctx context.Context, conn *inspector2.Client, id string, timeout time.Duration) (*awstypes.Member, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.RelationshipStatusCreated, awstypes.RelationshipStatusEnabled),
 		Target:  []string{},
 		Refresh: statusMemberAssociation(ctx, conn, id),
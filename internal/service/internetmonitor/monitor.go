@@ -387,7 +387,7 @@ func waitMonitor(ctx context.Context, conn *internetmonitor.Client, name string,
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.MonitorConfigStatePending),
 		Target:  enum.Slice(targetState),
 		Refresh: statusMonitor(ctx, conn, name),
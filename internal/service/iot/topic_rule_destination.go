@@ -292,7 +292,7 @@ func statusTopicRuleDestination(ctx context.Context, conn *iot.Client, arn strin
 }
 
 func waitTopicRuleDestinationCreated(ctx context.Context, conn *iot.Client, arn string, timeout time.Duration) (*awstypes.TopicRuleDestination, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(string(awstypes.TopicRuleDestinationStatusInProgress)),
 		Target:  enum.Slice(string(awstypes.TopicRuleDestinationStatusEnabled)),
 		Refresh: statusTopicRuleDestination(ctx, conn, arn),
@@ -311,7 +311,7 @@ func waitTopicRuleDestinationCreated(ctx context.Context, conn *iot.Client, arn
 }
 
 func waitTopicRuleDestinationDeleted(ctx context.Context, conn *iot.Client, arn string, timeout time.Duration) (*awstypes.TopicRuleDestination, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(string(awstypes.TopicRuleDestinationStatusDeleting)),
 		Target:  []string{},
 		Refresh: statusTopicRuleDestination(ctx, conn, arn),
@@ -330,7 +330,7 @@ func waitTopicRuleDestinationDeleted(ctx context.Context, conn *iot.Client, arn
 }
 
 func waitTopicRuleDestinationDisabled(ctx context.Context, conn *iot.Client, arn string, timeout time.Duration) (*awstypes.TopicRuleDestination, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(string(awstypes.TopicRuleDestinationStatusInProgress)),
 		Target:  enum.Slice(string(awstypes.TopicRuleDestinationStatusDisabled)),
 		Refresh: statusTopicRuleDestination(ctx, conn, arn),
@@ -349,7 +349,7 @@ func waitTopicRuleDestinationDisabled(ctx context.Context, conn *iot.Client, arn
 }
 
 func waitTopicRuleDestinationEnabled(ctx context.Context, conn *iot.Client, arn string, timeout time.Duration) (*awstypes.TopicRuleDestination, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(string(awstypes.TopicRuleDestinationStatusInProgress)),
 		Target:  enum.Slice(string(awstypes.TopicRuleDestinationStatusEnabled)),
 		Refresh: statusTopicRuleDestination(ctx, conn, arn),
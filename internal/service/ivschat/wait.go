@@ -9,12 +9,12 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/service/ivschat"
 	"github.com/aws/aws-sdk-go-v2/service/ivschat/types"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitLoggingConfigurationCreated(ctx context.Context, conn *ivschat.Client, id string, timeout time.Duration) (*ivschat.GetLoggingConfigurationOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(types.LoggingConfigurationStateCreating),
 		Target:                    enum.Slice(types.LoggingConfigurationStateActive),
 		Refresh:                   statusLoggingConfiguration(ctx, conn, id),
@@ -32,7 +32,7 @@ func waitLoggingConfigurationCreated(ctx context.Context, conn *ivschat.Client,
 }
 
 func waitLoggingConfigurationUpdated(ctx context.Context, conn *ivschat.Client, id string, timeout time.Duration) (*ivschat.GetLoggingConfigurationOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(types.LoggingConfigurationStateUpdating),
 		Target:                    enum.Slice(types.LoggingConfigurationStateActive),
 		Refresh:                   statusLoggingConfiguration(ctx, conn, id),
@@ -50,7 +50,7 @@ func waitLoggingConfigurationUpdated(ctx context.Context, conn *ivschat.Client,
 }
 
 func waitLoggingConfigurationDeleted(ctx context.Context, conn *ivschat.Client, id string, timeout time.Duration) (*ivschat.GetLoggingConfigurationOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.LoggingConfigurationStateDeleting, types.LoggingConfigurationStateActive),
 		Target:  []string{},
 		Refresh: statusLoggingConfiguration(ctx, conn, id),
@@ -66,7 +66,7 @@ func waitLoggingConfigurationDeleted(ctx context.Context, conn *ivschat.Client,
 }
 
 func waitRoomUpdated(ctx context.Context, conn *ivschat.Client, id string, timeout time.Duration, updateDetails *ivschat.UpdateRoomInput) (*ivschat.GetRoomOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{statusChangePending},
 		Target:                    []string{statusUpdated},
 		Refresh:                   statusRoom(ctx, conn, id, updateDetails),
@@ -84,7 +84,7 @@ func waitRoomUpdated(ctx context.Context, conn *ivschat.Client, id string, timeo
 }
 
 func waitRoomDeleted(ctx context.Context, conn *ivschat.Client, id string, timeout time.Duration) (*ivschat.GetRoomOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{statusNormal},
 		Target:  []string{},
 		Refresh: statusRoom(ctx, conn, id, nil),
@@ -100,7 +100,7 @@ func waitRoomDeleted(ctx context.Context, conn *ivschat.Client, id string, timeo
 }
 
 func waitRoomCreated(ctx context.Context, conn *ivschat.Client, id string, timeout time.Duration) (*ivschat.GetRoomOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{},
 		Target:                    []string{statusNormal},
 		Refresh:                   statusRoom(ctx, conn, id, nil),
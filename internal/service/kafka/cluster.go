@@ -1172,7 +1172,7 @@ func statusClusterOperationState(ctx context.Context, conn *kafka.Client, arn st
 }
 
 func waitClusterCreated(ctx context.Context, conn *kafka.Client, arn string, timeout time.Duration) (*types.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ClusterStateCreating),
 		Target:  enum.Slice(types.ClusterStateActive),
 		Refresh: statusClusterState(ctx, conn, arn),
@@ -1193,7 +1193,7 @@ func waitClusterCreated(ctx context.Context, conn *kafka.Client, arn string, tim
 }
 
 func waitClusterDeleted(ctx context.Context, conn *kafka.Client, arn string, timeout time.Duration) (*types.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ClusterStateDeleting),
 		Target:  []string{},
 		Refresh: statusClusterState(ctx, conn, arn),
@@ -1214,7 +1214,7 @@ func waitClusterDeleted(ctx context.Context, conn *kafka.Client, arn string, tim
 }
 
 func waitClusterOperationCompleted(ctx context.Context, conn *kafka.Client, arn string, timeout time.Duration) (*types.ClusterOperationInfo, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{clusterOperationStatePending, clusterOperationStateUpdateInProgress},
 		Target:  []string{clusterOperationStateUpdateComplete},
 		Refresh: statusClusterOperationState(ctx, conn, arn),
@@ -247,7 +247,7 @@ func waitConfigurationDeleted(ctx context.Context, conn *kafka.Client, arn strin
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ConfigurationStateDeleting),
 		Target:  []string{},
 		Refresh: statusConfigurationState(ctx, conn, arn),
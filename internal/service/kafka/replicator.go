@@ -396,7 +396,7 @@ func resourceReplicatorDelete(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func waitReplicatorCreated(ctx context.Context, conn *kafka.Client, arn string, timeout time.Duration) (*kafka.DescribeReplicatorOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ReplicatorStateCreating),
 		Target:  enum.Slice(types.ReplicatorStateRunning),
 		Refresh: statusReplicator(ctx, conn, arn),
@@ -416,7 +416,7 @@ func waitReplicatorCreated(ctx context.Context, conn *kafka.Client, arn string,
 }
 
 func waitReplicatorUpdated(ctx context.Context, conn *kafka.Client, arn string, timeout time.Duration) (*kafka.DescribeReplicatorOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ReplicatorStateUpdating),
 		Target:  enum.Slice(types.ReplicatorStateRunning),
 		Refresh: statusReplicator(ctx, conn, arn),
@@ -436,7 +436,7 @@ func waitReplicatorUpdated(ctx context.Context, conn *kafka.Client, arn string,
 }
 
 func waitReplicatorDeleted(ctx context.Context, conn *kafka.Client, arn string, timeout time.Duration) (*kafka.DescribeReplicatorOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ReplicatorStateRunning, types.ReplicatorStateDeleting),
 		Target:  []string{},
 		Refresh: statusReplicator(ctx, conn, arn),
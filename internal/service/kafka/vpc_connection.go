@@ -164,7 +164,7 @@ func resourceVPCConnectionDelete(ctx context.Context, d *schema.ResourceData, me
 }
 
 func waitVPCConnectionCreated(ctx context.Context, conn *kafka.Client, id string, timeout time.Duration) (*kafka.DescribeVpcConnectionOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(types.VpcConnectionStateCreating),
 		Target:                    enum.Slice(types.VpcConnectionStateAvailable),
 		Refresh:                   statusVPCConnection(ctx, conn, id),
@@ -182,7 +182,7 @@ func waitVPCConnectionCreated(ctx context.Context, conn *kafka.Client, id string
 }
 
 func waitVPCConnectionDeleted(ctx context.Context, conn *kafka.Client, arn string, timeout time.Duration) (*kafka.DescribeVpcConnectionOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.VpcConnectionStateAvailable, types.VpcConnectionStateInactive, types.VpcConnectionStateDeactivating, types.VpcConnectionStateDeleting),
 		Target:  []string{},
 		Refresh: statusVPCConnection(ctx, conn, arn),
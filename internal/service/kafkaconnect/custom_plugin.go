@@ -259,7 +259,7 @@ func statusCustomPlugin(ctx context.Context, conn *kafkaconnect.Client, arn stri
 }
 
 func waitCustomPluginCreated(ctx context.Context, conn *kafkaconnect.Client, arn string, timeout time.Duration) (*kafkaconnect.DescribeCustomPluginOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.CustomPluginStateCreating),
 		Target:  enum.Slice(awstypes.CustomPluginStateActive),
 		Refresh: statusCustomPlugin(ctx, conn, arn),
@@ -280,7 +280,7 @@ func waitCustomPluginCreated(ctx context.Context, conn *kafkaconnect.Client, arn
 }
 
 func waitCustomPluginDeleted(ctx context.Context, conn *kafkaconnect.Client, arn string, timeout time.Duration) (*kafkaconnect.DescribeCustomPluginOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.CustomPluginStateDeleting),
 		Target:  []string{},
 		Refresh: statusCustomPlugin(ctx, conn, arn),
@@ -211,7 +211,7 @@ func statusWorkerConfiguration(ctx context.Context, conn *kafkaconnect.Client, a
 }
 
 func waitWorkerConfigurationDeleted(ctx context.Context, conn *kafkaconnect.Client, arn string, timeout time.Duration) (*kafkaconnect.DescribeWorkerConfigurationOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.WorkerConfigurationStateDeleting),
 		Target:  []string{},
 		Refresh: statusWorkerConfiguration(ctx, conn, arn),
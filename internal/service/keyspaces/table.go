@@ -709,7 +709,7 @@ func statusTable(ctx context.Context, conn *keyspaces.Client, keyspaceName, tabl
 }
 
 func waitTableCreated(ctx context.Context, conn *keyspaces.Client, keyspaceName, tableName string, timeout time.Duration) (*keyspaces.GetTableOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.TableStatusCreating),
 		Target:  enum.Slice(types.TableStatusActive),
 		Refresh: statusTable(ctx, conn, keyspaceName, tableName),
@@ -726,7 +726,7 @@ func waitTableCreated(ctx context.Context, conn *keyspaces.Client, keyspaceName,
 }
 
 func waitTableDeleted(ctx context.Context, conn *keyspaces.Client, keyspaceName, tableName string, timeout time.Duration) (*keyspaces.GetTableOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.TableStatusActive, types.TableStatusDeleting),
 		Target:  []string{},
 		Refresh: statusTable(ctx, conn, keyspaceName, tableName),
@@ -743,7 +743,7 @@ func waitTableDeleted(ctx context.Context, conn *keyspaces.Client, keyspaceName,
 }
 
 func waitTableUpdated(ctx context.Context, conn *keyspaces.Client, keyspaceName, tableName string, timeout time.Duration) (*keyspaces.GetTableOutput, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.TableStatusUpdating),
 		Target:  enum.Slice(types.TableStatusActive),
 		Refresh: statusTable(ctx, conn, keyspaceName, tableName),
@@ -580,7 +580,7 @@ func streamStatus(ctx context.Context, conn *kinesis.Client, name string) retry.
 }
 
 func waitStreamCreated(ctx context.Context, conn *kinesis.Client, name string, timeout time.Duration) (*types.StreamDescriptionSummary, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.StreamStatusCreating),
 		Target:     enum.Slice(types.StreamStatusActive),
 		Refresh:    streamStatus(ctx, conn, name),
@@ -599,7 +599,7 @@ func waitStreamCreated(ctx context.Context, conn *kinesis.Client, name string, t
 }
 
 func waitStreamDeleted(ctx context.Context, conn *kinesis.Client, name string, timeout time.Duration) (*types.StreamDescriptionSummary, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.StreamStatusDeleting),
 		Target:     []string{},
 		Refresh:    streamStatus(ctx, conn, name),
@@ -618,7 +618,7 @@ func waitStreamDeleted(ctx context.Context, conn *kinesis.Client, name string, t
 }
 
 func waitStreamUpdated(ctx context.Context, conn *kinesis.Client, name string, timeout time.Duration) (*types.StreamDescriptionSummary, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.StreamStatusUpdating),
 		Target:     enum.Slice(types.StreamStatusActive),
 		Refresh:    streamStatus(ctx, conn, name),
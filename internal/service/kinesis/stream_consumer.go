@@ -176,7 +176,7 @@ func waitStreamConsumerCreated(ctx context.Context, conn *kinesis.Client, arn st
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ConsumerStatusCreating),
 		Target:  enum.Slice(types.ConsumerStatusActive),
 		Refresh: statusStreamConsumer(ctx, conn, arn),
@@ -196,7 +196,7 @@ func waitStreamConsumerDeleted(ctx context.Context, conn *kinesis.Client, arn st
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ConsumerStatusDeleting),
 		Target:  []string{},
 		Refresh: statusStreamConsumer(ctx, conn, arn),
@@ -1604,7 +1604,7 @@ func statusApplication(ctx context.Context, conn *kinesisanalyticsv2.Client, nam
 }
 
 func waitApplicationStarted(ctx context.Context, conn *kinesisanalyticsv2.Client, name string, timeout time.Duration) (*awstypes.ApplicationDetail, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ApplicationStatusStarting),
 		Target:  enum.Slice(awstypes.ApplicationStatusRunning),
 		Refresh: statusApplication(ctx, conn, name),
@@ -1621,7 +1621,7 @@ func waitApplicationStarted(ctx context.Context, conn *kinesisanalyticsv2.Client
 }
 
 func waitApplicationStopped(ctx context.Context, conn *kinesisanalyticsv2.Client, name string, timeout time.Duration) (*awstypes.ApplicationDetail, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ApplicationStatusForceStopping, awstypes.ApplicationStatusStopping),
 		Target:  enum.Slice(awstypes.ApplicationStatusReady),
 		Refresh: statusApplication(ctx, conn, name),
@@ -1638,7 +1638,7 @@ func waitApplicationStopped(ctx context.Context, conn *kinesisanalyticsv2.Client
 }
 
 func waitApplicationUpdated(ctx context.Context, conn *kinesisanalyticsv2.Client, name string, timeout time.Duration) (*awstypes.ApplicationDetail, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ApplicationStatusUpdating),
 		Target:  enum.Slice(awstypes.ApplicationStatusReady, awstypes.ApplicationStatusRunning),
 		Refresh: statusApplication(ctx, conn, name),
@@ -1655,7 +1655,7 @@ func waitApplicationUpdated(ctx context.Context, conn *kinesisanalyticsv2.Client
 }
 
 func waitApplicationDeleted(ctx context.Context, conn *kinesisanalyticsv2.Client, name string, timeout time.Duration) (*awstypes.ApplicationDetail, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ApplicationStatusDeleting),
 		Target:  []string{},
 		Refresh: statusApplication(ctx, conn, name),
@@ -1718,7 +1718,7 @@ func statusApplicationOperation(ctx context.Context, conn *kinesisanalyticsv2.Cl
 }
 
 func waitApplicationOperationSucceeded(ctx context.Context, conn *kinesisanalyticsv2.Client, applicationName, operationID string, timeout time.Duration) (*awstypes.ApplicationOperationInfoDetails, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.OperationStatusInProgress),
 		Target:  enum.Slice(awstypes.OperationStatusSuccessful),
 		Refresh: statusApplicationOperation(ctx, conn, applicationName, operationID),
@@ -248,7 +248,7 @@ func statusSnapshotDetails(ctx context.Context, conn *kinesisanalyticsv2.Client,
 }
 
 func waitSnapshotCreated(ctx context.Context, conn *kinesisanalyticsv2.Client, applicationName, snapshotName string, timeout time.Duration) (*awstypes.SnapshotDetails, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SnapshotStatusCreating),
 		Target:  enum.Slice(awstypes.SnapshotStatusReady),
 		Refresh: statusSnapshotDetails(ctx, conn, applicationName, snapshotName),
@@ -265,7 +265,7 @@ func waitSnapshotCreated(ctx context.Context, conn *kinesisanalyticsv2.Client, a
 }
 
 func waitSnapshotDeleted(ctx context.Context, conn *kinesisanalyticsv2.Client, applicationName, snapshotName string, timeout time.Duration) (*awstypes.SnapshotDetails, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SnapshotStatusDeleting),
 		Target:  []string{},
 		Refresh: statusSnapshotDetails(ctx, conn, applicationName, snapshotName),
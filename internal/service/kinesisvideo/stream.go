@@ -261,7 +261,7 @@ func statusStream(ctx context.Context, conn *kinesisvideo.Client, arn string) re
 }
 
 func waitStreamCreated(ctx context.Context, conn *kinesisvideo.Client, arn string, timeout time.Duration) (*awstypes.StreamInfo, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.StatusCreating),
 		Target:     enum.Slice(awstypes.StatusActive),
 		Refresh:    statusStream(ctx, conn, arn),
@@ -280,7 +280,7 @@ func waitStreamCreated(ctx context.Context, conn *kinesisvideo.Client, arn strin
 }
 
 func waitStreamUpdated(ctx context.Context, conn *kinesisvideo.Client, arn string, timeout time.Duration) (*awstypes.StreamInfo, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.StatusUpdating),
 		Target:     enum.Slice(awstypes.StatusActive),
 		Refresh:    statusStream(ctx, conn, arn),
@@ -299,7 +299,7 @@ func waitStreamUpdated(ctx context.Context, conn *kinesisvideo.Client, arn strin
 }
 
 func waitStreamDeleted(ctx context.Context, conn *kinesisvideo.Client, arn string, timeout time.Duration) (*awstypes.StreamInfo, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.StatusDeleting),
 		Target:     []string{},
 		Refresh:    statusStream(ctx, conn, arn),
@@ -924,7 +924,7 @@ func waitEventSourceMappingCreated(ctx context.Context, conn *lambda.Client, id
 	const (
 		timeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{eventSourceMappingStateCreating, eventSourceMappingStateDisabling, eventSourceMappingStateEnabling},
 		Target:  []string{eventSourceMappingStateDisabled, eventSourceMappingStateEnabled},
 		Refresh: statusEventSourceMapping(ctx, conn, id),
@@ -946,7 +946,7 @@ func waitEventSourceMappingUpdated(ctx context.Context, conn *lambda.Client, id
 	const (
 		timeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{eventSourceMappingStateDisabling, eventSourceMappingStateEnabling, eventSourceMappingStateUpdating},
 		Target:  []string{eventSourceMappingStateDisabled, eventSourceMappingStateEnabled},
 		Refresh: statusEventSourceMapping(ctx, conn, id),
@@ -968,7 +968,7 @@ func waitEventSourceMappingDeleted(ctx context.Context, conn *lambda.Client, id
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{eventSourceMappingStateDeleting},
 		Target:  []string{},
 		Refresh: statusEventSourceMapping(ctx, conn, id),
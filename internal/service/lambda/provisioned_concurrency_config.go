@@ -251,7 +251,7 @@ func statusProvisionedConcurrencyConfig(ctx context.Context, conn *lambda.Client
 }
 
 func waitProvisionedConcurrencyConfigReady(ctx context.Context, conn *lambda.Client, functionName, qualifier string, timeout time.Duration) (*lambda.GetProvisionedConcurrencyConfigOutput, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ProvisionedConcurrencyStatusEnumInProgress),
 		Target:  enum.Slice(awstypes.ProvisionedConcurrencyStatusEnumReady),
 		Refresh: statusProvisionedConcurrencyConfig(ctx, conn, functionName, qualifier),
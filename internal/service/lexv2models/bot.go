@@ -381,7 +381,7 @@ func (r *resourceBot) ImportState(ctx context.Context, req resource.ImportStateR
 }
 
 func waitBotCreated(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.BotStatusCreating),
 		Target:                    enum.Slice(awstypes.BotStatusAvailable),
 		Refresh:                   statusBot(ctx, conn, id),
@@ -399,7 +399,7 @@ func waitBotCreated(ctx context.Context, conn *lexmodelsv2.Client, id string, ti
 }
 
 func waitBotUpdated(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.BotStatusUpdating),
 		Target:                    enum.Slice(awstypes.BotStatusAvailable),
 		Refresh:                   statusBot(ctx, conn, id),
@@ -417,7 +417,7 @@ func waitBotUpdated(ctx context.Context, conn *lexmodelsv2.Client, id string, ti
 }
 
 func waitBotDeleted(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.BotStatusDeleting),
 		Target:  []string{},
 		Refresh: statusBot(ctx, conn, id),
@@ -243,7 +243,7 @@ func (r *resourceBotVersion) ImportState(ctx context.Context, req resource.Impor
 }
 
 func waitBotVersionCreated(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotVersionOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.BotStatusCreating, awstypes.BotStatusVersioning),
 		Target:                    enum.Slice(awstypes.BotStatusAvailable),
 		Refresh:                   statusBotVersion(ctx, conn, id),
@@ -261,7 +261,7 @@ func waitBotVersionCreated(ctx context.Context, conn *lexmodelsv2.Client, id str
 }
 
 func waitBotVersionDeleted(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotVersionOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.BotStatusDeleting),
 		Target:  []string{},
 		Refresh: statusBotVersion(ctx, conn, id),
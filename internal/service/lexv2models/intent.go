@@ -1149,7 +1149,7 @@ const (
 )
 
 func waitIntentNormal(ctx context.Context, conn *lexmodelsv2.Client, intentID, botID, botVersion, localeID string, timeout time.Duration) (*lexmodelsv2.DescribeIntentOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{},
 		Target:                    []string{statusNormal},
 		Refresh:                   statusIntent(ctx, conn, intentID, botID, botVersion, localeID),
@@ -1168,7 +1168,7 @@ func waitIntentNormal(ctx context.Context, conn *lexmodelsv2.Client, intentID, b
 }
 
 func waitIntentDeleted(ctx context.Context, conn *lexmodelsv2.Client, intentID, botID, botVersion, localeID string, timeout time.Duration) (*lexmodelsv2.DescribeIntentOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{statusNormal},
 		Target:     []string{},
 		Refresh:    statusIntent(ctx, conn, intentID, botID, botVersion, localeID),
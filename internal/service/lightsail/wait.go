@@ -13,7 +13,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -41,7 +40,7 @@ const (
 
 // waitOperation waits for an Operation to return Succeeded or Completed
 func waitOperation(ctx context.Context, conn *lightsail.Client, oid *string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.OperationStatusStarted),
 		Target:     enum.Slice(types.OperationStatusCompleted, types.OperationStatusSucceeded),
 		Refresh:    statusOperation(ctx, conn, oid),
@@ -61,7 +60,7 @@ func waitOperation(ctx context.Context, conn *lightsail.Client, oid *string) err
 
 // waitDatabaseModified waits for a Modified Database return available
 func waitDatabaseModified(ctx context.Context, conn *lightsail.Client, db *string) (*lightsail.GetRelationalDatabaseOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{DatabaseStateModifying},
 		Target:     []string{DatabaseStateAvailable},
 		Refresh:    statusDatabase(ctx, conn, db),
@@ -82,7 +81,7 @@ func waitDatabaseModified(ctx context.Context, conn *lightsail.Client, db *strin
 // waitDatabaseBackupRetentionModified waits for a Modified  BackupRetention on Database return available
 
 func waitDatabaseBackupRetentionModified(ctx context.Context, conn *lightsail.Client, db *string, target bool) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{strconv.FormatBool(!target)},
 		Target:     []string{strconv.FormatBool(target)},
 		Refresh:    statusDatabaseBackupRetention(ctx, conn, db),
@@ -101,7 +100,7 @@ func waitDatabaseBackupRetentionModified(ctx context.Context, conn *lightsail.Cl
 }
 
 func waitDatabasePubliclyAccessibleModified(ctx context.Context, conn *lightsail.Client, db *string, target bool) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{strconv.FormatBool(!target)},
 		Target:     []string{strconv.FormatBool(target)},
 		Refresh:    statusDatabasePubliclyAccessible(ctx, conn, db),
@@ -120,7 +119,7 @@ func waitDatabasePubliclyAccessibleModified(ctx context.Context, conn *lightsail
 }
 
 func waitContainerServiceCreated(ctx context.Context, conn *lightsail.Client, serviceName string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.ContainerServiceStatePending),
 		Target:     enum.Slice(types.ContainerServiceStateReady),
 		Refresh:    statusContainerService(ctx, conn, serviceName),
@@ -143,7 +142,7 @@ func waitContainerServiceCreated(ctx context.Context, conn *lightsail.Client, se
 }
 
 func waitContainerServiceDisabled(ctx context.Context, conn *lightsail.Client, serviceName string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.ContainerServiceStateUpdating),
 		Target:     enum.Slice(types.ContainerServiceStateDisabled),
 		Refresh:    statusContainerService(ctx, conn, serviceName),
@@ -166,7 +165,7 @@ func waitContainerServiceDisabled(ctx context.Context, conn *lightsail.Client, s
 }
 
 func waitContainerServiceUpdated(ctx context.Context, conn *lightsail.Client, serviceName string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.ContainerServiceStateUpdating),
 		Target:     enum.Slice(types.ContainerServiceStateReady, types.ContainerServiceStateRunning),
 		Refresh:    statusContainerService(ctx, conn, serviceName),
@@ -189,7 +188,7 @@ func waitContainerServiceUpdated(ctx context.Context, conn *lightsail.Client, se
 }
 
 func waitContainerServiceDeleted(ctx context.Context, conn *lightsail.Client, serviceName string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.ContainerServiceStateDeleting),
 		Target:     []string{},
 		Refresh:    statusContainerService(ctx, conn, serviceName),
@@ -212,7 +211,7 @@ func waitContainerServiceDeleted(ctx context.Context, conn *lightsail.Client, se
 }
 
 func waitContainerServiceDeploymentVersionActive(ctx context.Context, conn *lightsail.Client, serviceName string, version int, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.ContainerServiceDeploymentStateActivating),
 		Target:     enum.Slice(types.ContainerServiceDeploymentStateActive),
 		Refresh:    statusContainerServiceDeploymentVersion(ctx, conn, serviceName, version),
@@ -235,7 +234,7 @@ func waitContainerServiceDeploymentVersionActive(ctx context.Context, conn *ligh
 }
 
 func waitInstanceState(ctx context.Context, conn *lightsail.Client, id *string) (*lightsail.GetInstanceStateOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{"pending", "stopping"},
 		Target:     []string{"stopped", "running"},
 		Refresh:    statusInstance(ctx, conn, id),
@@ -30,6 +30,7 @@ import (
 )
 
 // @FrameworkResource("aws_cloudwatch_log_delivery_source", name="Delivery Source")
+// @IdentityAttribute("name")
 // @Tags(identifierAttribute="arn")
 // @Testing(tagsTest=false)
 func newDeliverySourceResource(context.Context) (resource.ResourceWithConfigure, error) {
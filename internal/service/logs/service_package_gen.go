@@ -56,6 +56,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				IdentityAttribute: names.AttrName,
+			},
 		},
 		{
 			Factory:  newIndexPolicyResource,
@@ -457,7 +457,7 @@ func statusApplicationVersion(ctx context.Context, conn *m2.Client, id string, v
 }
 
 func waitApplicationCreated(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) (*m2.GetApplicationOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ApplicationLifecycleCreating),
 		Target:  enum.Slice(awstypes.ApplicationLifecycleCreated, awstypes.ApplicationLifecycleAvailable),
 		Refresh: statusApplication(ctx, conn, id),
@@ -476,7 +476,7 @@ func waitApplicationCreated(ctx context.Context, conn *m2.Client, id string, tim
 }
 
 func waitApplicationUpdated(ctx context.Context, conn *m2.Client, id string, version int32, timeout time.Duration) (*m2.GetApplicationVersionOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ApplicationVersionLifecycleCreating),
 		Target:  enum.Slice(awstypes.ApplicationVersionLifecycleAvailable),
 		Refresh: statusApplicationVersion(ctx, conn, id, version),
@@ -495,7 +495,7 @@ func waitApplicationUpdated(ctx context.Context, conn *m2.Client, id string, ver
 }
 
 func waitApplicationDeleted(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) (*m2.GetApplicationOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ApplicationLifecycleDeleting, awstypes.ApplicationLifecycleDeletingFromEnvironment),
 		Target:  []string{},
 		Refresh: statusApplication(ctx, conn, id),
@@ -514,7 +514,7 @@ func waitApplicationDeleted(ctx context.Context, conn *m2.Client, id string, tim
 }
 
 func waitApplicationDeletedFromEnvironment(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) (*m2.GetApplicationOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ApplicationLifecycleDeletingFromEnvironment),
 		Target:  enum.Slice(awstypes.ApplicationLifecycleAvailable),
 		Refresh: statusApplication(ctx, conn, id),
@@ -533,7 +533,7 @@ func waitApplicationDeletedFromEnvironment(ctx context.Context, conn *m2.Client,
 }
 
 func waitApplicationStopped(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) (*m2.GetApplicationOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.ApplicationLifecycleStopping),
 		Target:                    enum.Slice(awstypes.ApplicationLifecycleStopped),
 		Refresh:                   statusApplication(ctx, conn, id),
@@ -553,7 +553,7 @@ func waitApplicationStopped(ctx context.Context, conn *m2.Client, id string, tim
 }
 
 func waitApplicationRunning(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) (*m2.GetApplicationOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.ApplicationLifecycleStarting),
 		Target:                    enum.Slice(awstypes.ApplicationLifecycleRunning),
 		Refresh:                   statusApplication(ctx, conn, id),
@@ -383,7 +383,7 @@ func statusDeployment(ctx context.Context, conn *m2.Client, applicationID, deplo
 }
 
 func waitDeploymentCreated(ctx context.Context, conn *m2.Client, applicationID, deploymentID string, timeout time.Duration) (*m2.GetDeploymentOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.DeploymentLifecycleDeploying),
 		Target:  enum.Slice(awstypes.DeploymentLifecycleSucceeded),
 		Refresh: statusDeployment(ctx, conn, applicationID, deploymentID),
@@ -402,7 +402,7 @@ func waitDeploymentCreated(ctx context.Context, conn *m2.Client, applicationID,
 }
 
 func waitDeploymentUpdated(ctx context.Context, conn *m2.Client, applicationID, deploymentID string, timeout time.Duration) (*m2.GetDeploymentOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.DeploymentLifecycleDeployUpdate),
 		Target:  enum.Slice(awstypes.DeploymentLifecycleSucceeded),
 		Refresh: statusDeployment(ctx, conn, applicationID, deploymentID),
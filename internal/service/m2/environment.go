@@ -493,7 +493,7 @@ func statusEnvironment(ctx context.Context, conn *m2.Client, id string) retry.St
 }
 
 func waitEnvironmentCreated(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) (*m2.GetEnvironmentOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.EnvironmentLifecycleCreating),
 		Target:  enum.Slice(awstypes.EnvironmentLifecycleAvailable),
 		Refresh: statusEnvironment(ctx, conn, id),
@@ -512,7 +512,7 @@ func waitEnvironmentCreated(ctx context.Context, conn *m2.Client, id string, tim
 }
 
 func waitEnvironmentUpdated(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) (*m2.GetEnvironmentOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.EnvironmentLifecycleUpdating),
 		Target:  enum.Slice(awstypes.EnvironmentLifecycleAvailable),
 		Refresh: statusEnvironment(ctx, conn, id),
@@ -531,7 +531,7 @@ func waitEnvironmentUpdated(ctx context.Context, conn *m2.Client, id string, tim
 }
 
 func waitEnvironmentDeleted(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) (*m2.GetEnvironmentOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.EnvironmentLifecycleAvailable, awstypes.EnvironmentLifecycleCreating, awstypes.EnvironmentLifecycleDeleting),
 		Target:     []string{},
 		Refresh:    statusEnvironment(ctx, conn, id),
@@ -357,7 +357,7 @@ func waitMemberInvited(ctx context.Context, conn *macie2.Client, adminAccountID
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.RelationshipStatusCreated, awstypes.RelationshipStatusEmailVerificationInProgress),
 		Target:  enum.Slice(awstypes.RelationshipStatusInvited, awstypes.RelationshipStatusEnabled, awstypes.RelationshipStatusPaused),
 		Refresh: statusMemberRelationship(ctx, conn, adminAccountID),
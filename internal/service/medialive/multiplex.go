@@ -271,7 +271,7 @@ func resourceMultiplexDelete(ctx context.Context, d *schema.ResourceData, meta a
 }
 
 func waitMultiplexCreated(ctx context.Context, conn *medialive.Client, id string, timeout time.Duration) (*medialive.DescribeMultiplexOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(types.MultiplexStateCreating),
 		Target:                    enum.Slice(types.MultiplexStateIdle),
 		Refresh:                   statusMultiplex(ctx, conn, id),
@@ -290,7 +290,7 @@ func waitMultiplexCreated(ctx context.Context, conn *medialive.Client, id string
 }
 
 func waitMultiplexUpdated(ctx context.Context, conn *medialive.Client, id string, timeout time.Duration) (*medialive.DescribeMultiplexOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{},
 		Target:                    enum.Slice(types.MultiplexStateIdle),
 		Refresh:                   statusMultiplex(ctx, conn, id),
@@ -309,7 +309,7 @@ func waitMultiplexUpdated(ctx context.Context, conn *medialive.Client, id string
 }
 
 func waitMultiplexDeleted(ctx context.Context, conn *medialive.Client, id string, timeout time.Duration) (*medialive.DescribeMultiplexOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.MultiplexStateDeleting),
 		Target:  enum.Slice(types.MultiplexStateDeleted),
 		Refresh: statusMultiplex(ctx, conn, id),
@@ -325,7 +325,7 @@ func waitMultiplexDeleted(ctx context.Context, conn *medialive.Client, id string
 }
 
 func waitMultiplexRunning(ctx context.Context, conn *medialive.Client, id string, timeout time.Duration) (*medialive.DescribeMultiplexOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.MultiplexStateStarting),
 		Target:  enum.Slice(types.MultiplexStateRunning),
 		Refresh: statusMultiplex(ctx, conn, id),
@@ -341,7 +341,7 @@ func waitMultiplexRunning(ctx context.Context, conn *medialive.Client, id string
 }
 
 func waitMultiplexStopped(ctx context.Context, conn *medialive.Client, id string, timeout time.Duration) (*medialive.DescribeMultiplexOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.MultiplexStateStopping),
 		Target:  enum.Slice(types.MultiplexStateIdle),
 		Refresh: statusMultiplex(ctx, conn, id),
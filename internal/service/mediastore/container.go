@@ -182,7 +182,7 @@ func findContainerByName(ctx context.Context, conn *mediastore.Client, id string
 }
 
 func waitContainerActive(ctx context.Context, conn *mediastore.Client, id string) (*awstypes.Container, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.ContainerStatusCreating),
 		Target:     enum.Slice(awstypes.ContainerStatusActive),
 		Refresh:    containerRefreshStatusFunc(ctx, conn, id),
@@ -200,7 +200,7 @@ func waitContainerActive(ctx context.Context, conn *mediastore.Client, id string
 }
 
 func waitContainerDeleted(ctx context.Context, conn *mediastore.Client, id string) (*awstypes.Container, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.ContainerStatusDeleting),
 		Target:     []string{},
 		Refresh:    containerRefreshStatusFunc(ctx, conn, id),
@@ -277,7 +277,7 @@ func waitACLActive(ctx context.Context, conn *memorydb.Client, name string) (*aw
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{aclStatusCreating, aclStatusModifying},
 		Target:  []string{aclStatusActive},
 		Refresh: statusACL(ctx, conn, name),
@@ -297,7 +297,7 @@ func waitACLDeleted(ctx context.Context, conn *memorydb.Client, name string) (*a
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:      []string{aclStatusDeleting},
 		Target:       []string{},
 		Refresh:      statusACL(ctx, conn, name),
@@ -704,7 +704,7 @@ func statusClusterSecurityGroups(ctx context.Context, conn *memorydb.Client, nam
 }
 
 func waitClusterAvailable(ctx context.Context, conn *memorydb.Client, name string, timeout time.Duration) (*awstypes.Cluster, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{clusterStatusCreating, clusterStatusUpdating, clusterStatusSnapshotting},
 		Target:  []string{clusterStatusAvailable},
 		Refresh: statusCluster(ctx, conn, name),
@@ -721,7 +721,7 @@ func waitClusterAvailable(ctx context.Context, conn *memorydb.Client, name strin
 }
 
 func waitClusterDeleted(ctx context.Context, conn *memorydb.Client, name string, timeout time.Duration) (*awstypes.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:      []string{clusterStatusDeleting},
 		Target:       []string{},
 		Refresh:      statusCluster(ctx, conn, name),
@@ -743,7 +743,7 @@ func waitClusterParameterGroupInSync(ctx context.Context, conn *memorydb.Client,
 	const (
 		timeout = 60 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{clusterParameterGroupStatusApplying},
 		Target:  []string{clusterParameterGroupStatusInSync},
 		Refresh: statusClusterParameterGroup(ctx, conn, name),
@@ -763,7 +763,7 @@ func waitClusterSecurityGroupsActive(ctx context.Context, conn *memorydb.Client,
 	const (
 		timeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{clusterSecurityGroupStatusModifying},
 		Target:  []string{clusterSecurityGroupStatusActive},
 		Refresh: statusClusterSecurityGroups(ctx, conn, name),
@@ -506,7 +506,7 @@ func statusMultiRegionCluster(ctx context.Context, conn *memorydb.Client, name s
 }
 
 func waitMultiRegionClusterAvailable(ctx context.Context, conn *memorydb.Client, name string, timeout time.Duration) (*awstypes.MultiRegionCluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Delay:                     20 * time.Second,
 		Pending:                   []string{clusterStatusCreating, clusterStatusUpdating, clusterStatusSnapshotting},
 		Target:                    []string{clusterStatusAvailable},
@@ -525,7 +525,7 @@ func waitMultiRegionClusterAvailable(ctx context.Context, conn *memorydb.Client,
 }
 
 func waitMultiRegionClusterDeleted(ctx context.Context, conn *memorydb.Client, name string, timeout time.Duration) (*awstypes.MultiRegionCluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Delay:                     20 * time.Second,
 		Pending:                   []string{clusterStatusDeleting},
 		Target:                    []string{},
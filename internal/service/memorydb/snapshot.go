@@ -300,7 +300,7 @@ func statusSnapshot(ctx context.Context, conn *memorydb.Client, name string) ret
 }
 
 func waitSnapshotAvailable(ctx context.Context, conn *memorydb.Client, name string, timeout time.Duration) (*awstypes.Snapshot, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{snapshotStatusCreating},
 		Target:  []string{snapshotStatusAvailable},
 		Refresh: statusSnapshot(ctx, conn, name),
@@ -317,7 +317,7 @@ func waitSnapshotAvailable(ctx context.Context, conn *memorydb.Client, name stri
 }
 
 func waitSnapshotDeleted(ctx context.Context, conn *memorydb.Client, name string, timeout time.Duration) (*awstypes.Snapshot, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{snapshotStatusDeleting},
 		Target:  []string{},
 		Refresh: statusSnapshot(ctx, conn, name),
@@ -271,7 +271,7 @@ func waitUserActive(ctx context.Context, conn *memorydb.Client, userName string)
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{userStatusModifying},
 		Target:  []string{userStatusActive},
 		Refresh: statusUser(ctx, conn, userName),
@@ -291,7 +291,7 @@ func waitUserDeleted(ctx context.Context, conn *memorydb.Client, userName string
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{userStatusDeleting},
 		Target:  []string{},
 		Refresh: statusUser(ctx, conn, userName),
@@ -733,7 +733,7 @@ func statusBrokerState(ctx context.Context, conn *mq.Client, id string) retry.St
 }
 
 func waitBrokerCreated(ctx context.Context, conn *mq.Client, id string, timeout time.Duration) (*mq.DescribeBrokerOutput, error) {
-	stateConf := retry.StateChangeConf{
+	stateConf := tfresource.StateChangeConf{
 		Pending: enum.Slice(types.BrokerStateCreationInProgress, types.BrokerStateRebootInProgress),
 		Target:  enum.Slice(types.BrokerStateRunning),
 		Timeout: timeout,
@@ -749,7 +749,7 @@ func waitBrokerCreated(ctx context.Context, conn *mq.Client, id string, timeout
 }
 
 func waitBrokerDeleted(ctx context.Context, conn *mq.Client, id string, timeout time.Duration) (*mq.DescribeBrokerOutput, error) {
-	stateConf := retry.StateChangeConf{
+	stateConf := tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			types.BrokerStateCreationFailed,
 			types.BrokerStateDeletionInProgress,
@@ -770,7 +770,7 @@ func waitBrokerDeleted(ctx context.Context, conn *mq.Client, id string, timeout
 }
 
 func waitBrokerRebooted(ctx context.Context, conn *mq.Client, id string, timeout time.Duration) (*mq.DescribeBrokerOutput, error) {
-	stateConf := retry.StateChangeConf{
+	stateConf := tfresource.StateChangeConf{
 		Pending: enum.Slice(types.BrokerStateRebootInProgress),
 		Target:  enum.Slice(types.BrokerStateRunning),
 		Timeout: timeout,
@@ -702,7 +702,7 @@ func statusEnvironment(ctx context.Context, conn *mwaa.Client, name string) retr
 }
 
 func waitEnvironmentCreated(ctx context.Context, conn *mwaa.Client, name string, timeout time.Duration) (*awstypes.Environment, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.EnvironmentStatusCreating),
 		Target:  enum.Slice(awstypes.EnvironmentStatusAvailable, awstypes.EnvironmentStatusPending),
 		Refresh: statusEnvironment(ctx, conn, name),
@@ -723,7 +723,7 @@ func waitEnvironmentCreated(ctx context.Context, conn *mwaa.Client, name string,
 }
 
 func waitEnvironmentUpdated(ctx context.Context, conn *mwaa.Client, name string, timeout time.Duration) (*awstypes.Environment, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.EnvironmentStatusUpdating, awstypes.EnvironmentStatusCreatingSnapshot),
 		Target:  enum.Slice(awstypes.EnvironmentStatusAvailable),
 		Refresh: statusEnvironment(ctx, conn, name),
@@ -744,7 +744,7 @@ func waitEnvironmentUpdated(ctx context.Context, conn *mwaa.Client, name string,
 }
 
 func waitEnvironmentDeleted(ctx context.Context, conn *mwaa.Client, name string, timeout time.Duration) (*awstypes.Environment, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.EnvironmentStatusDeleting),
 		Target:  []string{},
 		Refresh: statusEnvironment(ctx, conn, name),
@@ -305,7 +305,7 @@ func waitClusterEndpointAvailable(ctx context.Context, conn *neptune.Client, clu
 	const (
 		timeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{clusterEndpointStatusCreating, clusterEndpointStatusModifying},
 		Target:  []string{clusterEndpointStatusAvailable},
 		Refresh: statusClusterEndpoint(ctx, conn, clusterID, clusterEndpointID),
@@ -325,7 +325,7 @@ func waitClusterEndpointDeleted(ctx context.Context, conn *neptune.Client, clust
 	const (
 		timeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{clusterEndpointStatusDeleting},
 		Target:  []string{},
 		Refresh: statusClusterEndpoint(ctx, conn, clusterID, clusterEndpointID),
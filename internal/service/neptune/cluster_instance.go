@@ -474,7 +474,7 @@ func statusDBInstance(ctx context.Context, conn *neptune.Client, id string) retr
 }
 
 func waitDBInstanceAvailable(ctx context.Context, conn *neptune.Client, id string, timeout time.Duration) (*awstypes.DBInstance, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			dbInstanceStatusBackingUp,
 			dbInstanceStatusConfiguringEnhancedMonitoring,
@@ -507,7 +507,7 @@ func waitDBInstanceAvailable(ctx context.Context, conn *neptune.Client, id strin
 }
 
 func waitDBInstanceDeleted(ctx context.Context, conn *neptune.Client, id string, timeout time.Duration) (*awstypes.DBInstance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			dbInstanceStatusModifying,
 			dbInstanceStatusDeleting,
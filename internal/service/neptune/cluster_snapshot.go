@@ -255,7 +255,7 @@ func statusClusterSnapshot(ctx context.Context, conn *neptune.Client, id string)
 }
 
 func waitClusterSnapshotCreated(ctx context.Context, conn *neptune.Client, id string, timeout time.Duration) (*awstypes.DBClusterSnapshot, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{clusterSnapshotStatusCreating},
 		Target:     []string{clusterSnapshotStatusAvailable},
 		Refresh:    statusClusterSnapshot(ctx, conn, id),
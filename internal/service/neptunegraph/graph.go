@@ -392,7 +392,7 @@ func statusGraph(ctx context.Context, conn *neptunegraph.Client, id string) retr
 }
 
 func waitGraphCreated(ctx context.Context, conn *neptunegraph.Client, id string, timeout time.Duration) (*neptunegraph.GetGraphOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.GraphStatusCreating),
 		Target:                    enum.Slice(awstypes.GraphStatusAvailable),
 		Refresh:                   statusGraph(ctx, conn, id),
@@ -412,7 +412,7 @@ func waitGraphCreated(ctx context.Context, conn *neptunegraph.Client, id string,
 }
 
 func waitGraphUpdated(ctx context.Context, conn *neptunegraph.Client, id string, timeout time.Duration) (*neptunegraph.GetGraphOutput, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.GraphStatusUpdating),
 		Target:                    enum.Slice(awstypes.GraphStatusAvailable),
 		Refresh:                   statusGraph(ctx, conn, id),
@@ -435,7 +435,7 @@ func waitGraphDeleted(ctx context.Context, conn *neptunegraph.Client, id string,
 	const (
 		delay = 10 * time.Second
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.GraphStatusDeleting),
 		Target:  []string{},
 		Refresh: statusGraph(ctx, conn, id),
@@ -471,7 +471,7 @@ func statusFirewall(ctx context.Context, conn *networkfirewall.Client, arn strin
 }
 
 func waitFirewallCreated(ctx context.Context, conn *networkfirewall.Client, timeout time.Duration, arn string) (*networkfirewall.DescribeFirewallOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.FirewallStatusValueProvisioning),
 		Target:  enum.Slice(awstypes.FirewallStatusValueReady),
 		Refresh: statusFirewall(ctx, conn, arn),
@@ -488,7 +488,7 @@ func waitFirewallCreated(ctx context.Context, conn *networkfirewall.Client, time
 }
 
 func waitFirewallUpdated(ctx context.Context, conn *networkfirewall.Client, timeout time.Duration, arn string) (*networkfirewall.DescribeFirewallOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.FirewallStatusValueProvisioning),
 		Target:  enum.Slice(awstypes.FirewallStatusValueReady),
 		Refresh: statusFirewall(ctx, conn, arn),
@@ -509,7 +509,7 @@ func waitFirewallUpdated(ctx context.Context, conn *networkfirewall.Client, time
 }
 
 func waitFirewallDeleted(ctx context.Context, conn *networkfirewall.Client, timeout time.Duration, arn string) (*networkfirewall.DescribeFirewallOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.FirewallStatusValueDeleting),
 		Target:  []string{},
 		Refresh: statusFirewall(ctx, conn, arn),
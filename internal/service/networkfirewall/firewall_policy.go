@@ -395,7 +395,7 @@ func statusFirewallPolicy(ctx context.Context, conn *networkfirewall.Client, arn
 }
 
 func waitFirewallPolicyDeleted(ctx context.Context, conn *networkfirewall.Client, arn string, timeout time.Duration) (*networkfirewall.DescribeFirewallPolicyOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResourceStatusDeleting),
 		Target:  []string{},
 		Refresh: statusFirewallPolicy(ctx, conn, arn),
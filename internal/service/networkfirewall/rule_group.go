@@ -660,7 +660,7 @@ func statusRuleGroup(ctx context.Context, conn *networkfirewall.Client, arn stri
 }
 
 func waitRuleGroupDeleted(ctx context.Context, conn *networkfirewall.Client, arn string, timeout time.Duration) (*networkfirewall.DescribeRuleGroupOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResourceStatusDeleting),
 		Target:  []string{},
 		Refresh: statusRuleGroup(ctx, conn, arn),
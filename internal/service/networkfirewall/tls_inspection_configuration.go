@@ -533,7 +533,7 @@ func statusTLSInspectionConfigurationCertificates(ctx context.Context, conn *net
 }
 
 func waitTLSInspectionConfigurationCreated(ctx context.Context, conn *networkfirewall.Client, arn string, timeout time.Duration) (*networkfirewall.DescribeTLSInspectionConfigurationOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{resourceStatusPending},
 		Target:  enum.Slice(awstypes.ResourceStatusActive),
 		Refresh: statusTLSInspectionConfigurationCertificates(ctx, conn, arn),
@@ -550,7 +550,7 @@ func waitTLSInspectionConfigurationCreated(ctx context.Context, conn *networkfir
 }
 
 func waitTLSInspectionConfigurationUpdated(ctx context.Context, conn *networkfirewall.Client, arn string, timeout time.Duration) (*networkfirewall.DescribeTLSInspectionConfigurationOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{resourceStatusPending},
 		Target:  enum.Slice(awstypes.ResourceStatusActive),
 		Refresh: statusTLSInspectionConfigurationCertificates(ctx, conn, arn),
@@ -567,7 +567,7 @@ func waitTLSInspectionConfigurationUpdated(ctx context.Context, conn *networkfir
 }
 
 func waitTLSInspectionConfigurationDeleted(ctx context.Context, conn *networkfirewall.Client, arn string, timeout time.Duration) (*networkfirewall.DescribeTLSInspectionConfigurationOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResourceStatusActive, awstypes.ResourceStatusDeleting),
 		Target:  []string{},
 		Refresh: statusTLSInspectionConfiguration(ctx, conn, arn),
@@ -419,7 +419,7 @@ func statusConnectPeerState(ctx context.Context, conn *networkmanager.Client, id
 }
 
 func waitConnectPeerCreated(ctx context.Context, conn *networkmanager.Client, id string, timeout time.Duration) (*awstypes.ConnectPeer, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ConnectPeerStateCreating),
 		Target:  enum.Slice(awstypes.ConnectPeerStateAvailable),
 		Timeout: timeout,
@@ -438,7 +438,7 @@ func waitConnectPeerCreated(ctx context.Context, conn *networkmanager.Client, id
 }
 
 func waitConnectPeerDeleted(ctx context.Context, conn *networkmanager.Client, id string, timeout time.Duration) (*awstypes.ConnectPeer, error) {
-	stateconf := &retry.StateChangeConf{
+	stateconf := &tfresource.StateChangeConf{
 		Pending:        enum.Slice(awstypes.ConnectPeerStateDeleting),
 		Target:         []string{},
 		Timeout:        timeout,
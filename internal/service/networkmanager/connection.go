@@ -315,7 +315,7 @@ func statusConnectionState(ctx context.Context, conn *networkmanager.Client, glo
 }
 
 func waitConnectionCreated(ctx context.Context, conn *networkmanager.Client, globalNetworkID, connectionID string, timeout time.Duration) (*awstypes.Connection, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ConnectionStatePending),
 		Target:  enum.Slice(awstypes.ConnectionStateAvailable),
 		Timeout: timeout,
@@ -332,7 +332,7 @@ func waitConnectionCreated(ctx context.Context, conn *networkmanager.Client, glo
 }
 
 func waitConnectionDeleted(ctx context.Context, conn *networkmanager.Client, globalNetworkID, connectionID string, timeout time.Duration) (*awstypes.Connection, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ConnectionStateDeleting),
 		Target:  []string{},
 		Timeout: timeout,
@@ -349,7 +349,7 @@ func waitConnectionDeleted(ctx context.Context, conn *networkmanager.Client, glo
 }
 
 func waitConnectionUpdated(ctx context.Context, conn *networkmanager.Client, globalNetworkID, connectionID string, timeout time.Duration) (*awstypes.Connection, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ConnectionStateUpdating),
 		Target:  enum.Slice(awstypes.ConnectionStateAvailable),
 		Timeout: timeout,
@@ -409,7 +409,7 @@ func statusCoreNetworkState(ctx context.Context, conn *networkmanager.Client, id
 }
 
 func waitCoreNetworkCreated(ctx context.Context, conn *networkmanager.Client, id string, timeout time.Duration) (*awstypes.CoreNetwork, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.CoreNetworkStateCreating, coreNetworkStatePending),
 		Target:  enum.Slice(awstypes.CoreNetworkStateAvailable),
 		Timeout: timeout,
@@ -426,7 +426,7 @@ func waitCoreNetworkCreated(ctx context.Context, conn *networkmanager.Client, id
 }
 
 func waitCoreNetworkUpdated(ctx context.Context, conn *networkmanager.Client, id string, timeout time.Duration) (*awstypes.CoreNetwork, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.CoreNetworkStateUpdating),
 		Target:  enum.Slice(awstypes.CoreNetworkStateAvailable),
 		Timeout: timeout,
@@ -443,7 +443,7 @@ func waitCoreNetworkUpdated(ctx context.Context, conn *networkmanager.Client, id
 }
 
 func waitCoreNetworkDeleted(ctx context.Context, conn *networkmanager.Client, id string, timeout time.Duration) (*awstypes.CoreNetwork, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.CoreNetworkStateDeleting),
 		Target:     []string{},
 		Timeout:    timeout,
@@ -576,7 +576,7 @@ func statusCoreNetworkPolicyState(ctx context.Context, conn *networkmanager.Clie
 }
 
 func waitCoreNetworkPolicyCreated(ctx context.Context, conn *networkmanager.Client, coreNetworkId string, policyVersionId *int32, timeout time.Duration) (*awstypes.CoreNetworkPolicy, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ChangeSetStatePendingGeneration),
 		Target:  enum.Slice(awstypes.ChangeSetStateReadyToExecute),
 		Timeout: timeout,
@@ -410,7 +410,7 @@ func statusDeviceState(ctx context.Context, conn *networkmanager.Client, globalN
 }
 
 func waitDeviceCreated(ctx context.Context, conn *networkmanager.Client, globalNetworkID, deviceID string, timeout time.Duration) (*awstypes.Device, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.DeviceStatePending),
 		Target:  enum.Slice(awstypes.DeviceStateAvailable),
 		Timeout: timeout,
@@ -427,7 +427,7 @@ func waitDeviceCreated(ctx context.Context, conn *networkmanager.Client, globalN
 }
 
 func waitDeviceDeleted(ctx context.Context, conn *networkmanager.Client, globalNetworkID, deviceID string, timeout time.Duration) (*awstypes.Device, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.DeviceStateDeleting),
 		Target:  []string{},
 		Timeout: timeout,
@@ -444,7 +444,7 @@ func waitDeviceDeleted(ctx context.Context, conn *networkmanager.Client, globalN
 }
 
 func waitDeviceUpdated(ctx context.Context, conn *networkmanager.Client, globalNetworkID, deviceID string, timeout time.Duration) (*awstypes.Device, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.DeviceStateUpdating),
 		Target:  enum.Slice(awstypes.DeviceStateAvailable),
 		Timeout: timeout,
@@ -354,7 +354,7 @@ func statusGlobalNetworkState(ctx context.Context, conn *networkmanager.Client,
 }
 
 func waitGlobalNetworkCreated(ctx context.Context, conn *networkmanager.Client, id string, timeout time.Duration) (*awstypes.GlobalNetwork, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.GlobalNetworkStatePending),
 		Target:  enum.Slice(awstypes.GlobalNetworkStateAvailable),
 		Timeout: timeout,
@@ -371,7 +371,7 @@ func waitGlobalNetworkCreated(ctx context.Context, conn *networkmanager.Client,
 }
 
 func waitGlobalNetworkDeleted(ctx context.Context, conn *networkmanager.Client, id string, timeout time.Duration) (*awstypes.GlobalNetwork, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:        enum.Slice(awstypes.GlobalNetworkStateDeleting),
 		Target:         []string{},
 		Timeout:        timeout,
@@ -389,7 +389,7 @@ func waitGlobalNetworkDeleted(ctx context.Context, conn *networkmanager.Client,
 }
 
 func waitGlobalNetworkUpdated(ctx context.Context, conn *networkmanager.Client, id string, timeout time.Duration) (*awstypes.GlobalNetwork, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.GlobalNetworkStateUpdating),
 		Target:  enum.Slice(awstypes.GlobalNetworkStateAvailable),
 		Timeout: timeout,
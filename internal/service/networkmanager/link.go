@@ -341,7 +341,7 @@ func statusLinkState(ctx context.Context, conn *networkmanager.Client, globalNet
 }
 
 func waitLinkCreated(ctx context.Context, conn *networkmanager.Client, globalNetworkID, linkID string, timeout time.Duration) (*awstypes.Link, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.LinkStatePending),
 		Target:  enum.Slice(awstypes.LinkStateAvailable),
 		Timeout: timeout,
@@ -358,7 +358,7 @@ func waitLinkCreated(ctx context.Context, conn *networkmanager.Client, globalNet
 }
 
 func waitLinkDeleted(ctx context.Context, conn *networkmanager.Client, globalNetworkID, linkID string, timeout time.Duration) (*awstypes.Link, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.LinkStateDeleting),
 		Target:  []string{},
 		Timeout: timeout,
@@ -375,7 +375,7 @@ func waitLinkDeleted(ctx context.Context, conn *networkmanager.Client, globalNet
 }
 
 func waitLinkUpdated(ctx context.Context, conn *networkmanager.Client, globalNetworkID, linkID string, timeout time.Duration) (*awstypes.Link, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.LinkStateUpdating),
 		Target:  enum.Slice(awstypes.LinkStateAvailable),
 		Timeout: timeout,
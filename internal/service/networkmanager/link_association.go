@@ -242,7 +242,7 @@ func statusLinkAssociationState(ctx context.Context, conn *networkmanager.Client
 }
 
 func waitLinkAssociationCreated(ctx context.Context, conn *networkmanager.Client, globalNetworkID, linkID, deviceID string, timeout time.Duration) (*awstypes.LinkAssociation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.LinkAssociationStatePending),
 		Target:  enum.Slice(awstypes.LinkAssociationStateAvailable),
 		Timeout: timeout,
@@ -259,7 +259,7 @@ func waitLinkAssociationCreated(ctx context.Context, conn *networkmanager.Client
 }
 
 func waitLinkAssociationDeleted(ctx context.Context, conn *networkmanager.Client, globalNetworkID, linkID, deviceID string, timeout time.Duration) (*awstypes.LinkAssociation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.LinkAssociationStateDeleting),
 		Target:  []string{},
 		Timeout: timeout,
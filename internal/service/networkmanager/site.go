@@ -330,7 +330,7 @@ func statusSiteState(ctx context.Context, conn *networkmanager.Client, globalNet
 }
 
 func waitSiteCreated(ctx context.Context, conn *networkmanager.Client, globalNetworkID, siteID string, timeout time.Duration) (*awstypes.Site, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SiteStatePending),
 		Target:  enum.Slice(awstypes.SiteStateAvailable),
 		Timeout: timeout,
@@ -347,7 +347,7 @@ func waitSiteCreated(ctx context.Context, conn *networkmanager.Client, globalNet
 }
 
 func waitSiteDeleted(ctx context.Context, conn *networkmanager.Client, globalNetworkID, siteID string, timeout time.Duration) (*awstypes.Site, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SiteStateDeleting),
 		Target:  []string{},
 		Timeout: timeout,
@@ -364,7 +364,7 @@ func waitSiteDeleted(ctx context.Context, conn *networkmanager.Client, globalNet
 }
 
 func waitSiteUpdated(ctx context.Context, conn *networkmanager.Client, globalNetworkID, siteID string, timeout time.Duration) (*awstypes.Site, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SiteStateUpdating),
 		Target:  enum.Slice(awstypes.SiteStateAvailable),
 		Timeout: timeout,
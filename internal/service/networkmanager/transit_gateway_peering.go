@@ -219,7 +219,7 @@ func statusTransitGatewayPeeringState(ctx context.Context, conn *networkmanager.
 }
 
 func waitTransitGatewayPeeringCreated(ctx context.Context, conn *networkmanager.Client, id string, timeout time.Duration) (*awstypes.TransitGatewayPeering, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.PeeringStateCreating),
 		Target:  enum.Slice(awstypes.PeeringStateAvailable),
 		Timeout: timeout,
@@ -238,7 +238,7 @@ func waitTransitGatewayPeeringCreated(ctx context.Context, conn *networkmanager.
 }
 
 func waitTransitGatewayPeeringDeleted(ctx context.Context, conn *networkmanager.Client, id string, timeout time.Duration) (*awstypes.TransitGatewayPeering, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.PeeringStateDeleting),
 		Target:  []string{},
 		Timeout: timeout,
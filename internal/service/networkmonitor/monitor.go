@@ -276,7 +276,7 @@ func waitMonitorReady(ctx context.Context, conn *networkmonitor.Client, name str
 	const (
 		timeout = time.Minute * 10
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.MonitorStatePending),
 		Target:     enum.Slice(awstypes.MonitorStateActive, awstypes.MonitorStateInactive),
 		Refresh:    statusMonitor(ctx, conn, name),
@@ -297,7 +297,7 @@ func waitMonitorDeleted(ctx context.Context, conn *networkmonitor.Client, name s
 	const (
 		timeout = time.Minute * 10
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.MonitorStateDeleting, awstypes.MonitorStateActive, awstypes.MonitorStateInactive),
 		Target:     []string{},
 		Refresh:    statusMonitor(ctx, conn, name),
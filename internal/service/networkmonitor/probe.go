@@ -352,7 +352,7 @@ func waitProbeReady(ctx context.Context, conn *networkmonitor.Client, monitorNam
 	const (
 		timeout = time.Minute * 15
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.ProbeStatePending),
 		Target:     enum.Slice(awstypes.ProbeStateActive, awstypes.ProbeStateInactive),
 		Refresh:    statusProbe(ctx, conn, monitorName, probeID),
@@ -373,7 +373,7 @@ func waitProbeDeleted(ctx context.Context, conn *networkmonitor.Client, monitorN
 	const (
 		timeout = time.Minute * 15
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.ProbeStateActive, awstypes.ProbeStateInactive, awstypes.ProbeStateDeleting),
 		Target:     []string{},
 		Refresh:    statusProbe(ctx, conn, monitorName, probeID),
@@ -224,7 +224,7 @@ func statusInboundConnection(ctx context.Context, conn *opensearch.Client, id st
 }
 
 func waitInboundConnectionAccepted(ctx context.Context, conn *opensearch.Client, id string, timeout time.Duration) (*awstypes.InboundConnection, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.InboundConnectionStatusCodeProvisioning, awstypes.InboundConnectionStatusCodeApproved),
 		Target:  enum.Slice(awstypes.InboundConnectionStatusCodeActive),
 		Refresh: statusInboundConnection(ctx, conn, id),
@@ -243,7 +243,7 @@ func waitInboundConnectionAccepted(ctx context.Context, conn *opensearch.Client,
 }
 
 func waitInboundConnectionRejected(ctx context.Context, conn *opensearch.Client, id string, timeout time.Duration) (*awstypes.InboundConnection, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.InboundConnectionStatusCodeRejecting),
 		Target:  []string{},
 		Refresh: statusInboundConnection(ctx, conn, id),
@@ -262,7 +262,7 @@ func waitInboundConnectionRejected(ctx context.Context, conn *opensearch.Client,
 }
 
 func waitInboundConnectionDeleted(ctx context.Context, conn *opensearch.Client, id string, timeout time.Duration) (*awstypes.InboundConnection, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.InboundConnectionStatusCodeDeleting),
 		Target:  []string{},
 		Refresh: statusInboundConnection(ctx, conn, id),
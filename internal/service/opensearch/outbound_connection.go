@@ -302,7 +302,7 @@ func statusOutboundConnection(ctx context.Context, conn *opensearch.Client, id s
 }
 
 func waitOutboundConnectionCreated(ctx context.Context, conn *opensearch.Client, id string, timeout time.Duration) (*awstypes.OutboundConnection, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.OutboundConnectionStatusCodeValidating, awstypes.OutboundConnectionStatusCodeProvisioning),
 		Target: enum.Slice(
 			awstypes.OutboundConnectionStatusCodePendingAcceptance,
@@ -327,7 +327,7 @@ func waitOutboundConnectionCreated(ctx context.Context, conn *opensearch.Client,
 }
 
 func waitOutboundConnectionDeleted(ctx context.Context, conn *opensearch.Client, id string, timeout time.Duration) (*awstypes.OutboundConnection, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.OutboundConnectionStatusCodeActive,
 			awstypes.OutboundConnectionStatusCodePendingAcceptance,
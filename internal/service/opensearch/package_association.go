@@ -204,7 +204,7 @@ func statusPackageAssociation(ctx context.Context, conn *opensearch.Client, doma
 }
 
 func waitPackageAssociationCreated(ctx context.Context, conn *opensearch.Client, domainName, packageID string, timeout time.Duration) (*awstypes.DomainPackageDetails, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.DomainPackageStatusAssociating),
 		Target:  enum.Slice(awstypes.DomainPackageStatusActive),
 		Refresh: statusPackageAssociation(ctx, conn, domainName, packageID),
@@ -226,7 +226,7 @@ func waitPackageAssociationCreated(ctx context.Context, conn *opensearch.Client,
 }
 
 func waitPackageAssociationDeleted(ctx context.Context, conn *opensearch.Client, domainName, packageID string, timeout time.Duration) (*awstypes.DomainPackageDetails, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.DomainPackageStatusDissociating),
 		Target:  []string{},
 		Refresh: statusPackageAssociation(ctx, conn, domainName, packageID),
@@ -22,7 +22,7 @@ const (
 )
 
 func waitUpgradeSucceeded(ctx context.Context, conn *opensearch.Client, name string, timeout time.Duration) (*opensearch.GetUpgradeStatusOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.UpgradeStatusInProgress),
 		Target:     enum.Slice(awstypes.UpgradeStatusSucceeded),
 		Refresh:    statusUpgradeStatus(ctx, conn, name),
@@ -147,7 +147,7 @@ func waitForDomainDelete(ctx context.Context, conn *opensearch.Client, domainNam
 	// opensearch maintains information about the domain in multiple (at least 2) places that need
 	// to clear before it is really deleted - otherwise, requesting information about domain immediately
 	// after delete will return info about just deleted domain
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{configStatusUnknown, configStatusExists},
 		Target:                    []string{configStatusNotFound},
 		Refresh:                   domainConfigStatus(ctx, conn, domainName),
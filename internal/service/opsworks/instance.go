@@ -916,7 +916,7 @@ func stopInstance(ctx context.Context, d *schema.ResourceData, meta any, timeout
 }
 
 func waitInstanceDeleted(ctx context.Context, conn *opsworks.Client, instanceId string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{instanceStatusStopped, instanceStatusTerminating, instanceStatusTerminated},
 		Target:     []string{},
 		Refresh:    instanceStatus(ctx, conn, instanceId),
@@ -930,7 +930,7 @@ func waitInstanceDeleted(ctx context.Context, conn *opsworks.Client, instanceId
 }
 
 func waitInstanceStarted(ctx context.Context, conn *opsworks.Client, instanceId string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{instanceStatusRequested, instanceStatusPending, instanceStatusBooting, instanceStatusRunningSetup},
 		Target:     []string{instanceStatusOnline},
 		Refresh:    instanceStatus(ctx, conn, instanceId),
@@ -943,7 +943,7 @@ func waitInstanceStarted(ctx context.Context, conn *opsworks.Client, instanceId
 }
 
 func waitInstanceStopped(ctx context.Context, conn *opsworks.Client, instanceId string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{instanceStatusStopping, instanceStatusTerminating, instanceStatusShuttingDown, instanceStatusTerminated},
 		Target:     []string{instanceStatusStopped},
 		Refresh:    instanceStatus(ctx, conn, instanceId),
@@ -434,7 +434,7 @@ func statusCreateAccountState(ctx context.Context, conn *organizations.Client, i
 }
 
 func waitAccountCreated(ctx context.Context, conn *organizations.Client, id string, timeout time.Duration) (*awstypes.CreateAccountStatus, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:      enum.Slice(awstypes.CreateAccountStateInProgress),
 		Target:       enum.Slice(awstypes.CreateAccountStateSucceeded),
 		Refresh:      statusCreateAccountState(ctx, conn, id),
@@ -472,7 +472,7 @@ func statusAccountStatus(ctx context.Context, conn *organizations.Client, id str
 }
 
 func waitAccountDeleted(ctx context.Context, conn *organizations.Client, id string, timeout time.Duration) (*awstypes.Account, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:      enum.Slice(awstypes.AccountStatusPendingClosure, awstypes.AccountStatusActive),
 		Target:       []string{},
 		Refresh:      statusAccountStatus(ctx, conn, id),
@@ -413,7 +413,7 @@ func statusPipeline(ctx context.Context, conn *osis.Client, name string) retry.S
 }
 
 func waitPipelineCreated(ctx context.Context, conn *osis.Client, name string, timeout time.Duration) (*awstypes.Pipeline, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.PipelineStatusCreating, awstypes.PipelineStatusStarting),
 		Target:     enum.Slice(awstypes.PipelineStatusActive),
 		Refresh:    statusPipeline(ctx, conn, name),
@@ -436,7 +436,7 @@ func waitPipelineCreated(ctx context.Context, conn *osis.Client, name string, ti
 }
 
 func waitPipelineUpdated(ctx context.Context, conn *osis.Client, name string, timeout time.Duration) (*awstypes.Pipeline, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.PipelineStatusUpdating),
 		Target:     enum.Slice(awstypes.PipelineStatusActive),
 		Refresh:    statusPipeline(ctx, conn, name),
@@ -459,7 +459,7 @@ func waitPipelineUpdated(ctx context.Context, conn *osis.Client, name string, ti
 }
 
 func waitPipelineDeleted(ctx context.Context, conn *osis.Client, name string, timeout time.Duration) (*awstypes.Pipeline, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.PipelineStatusDeleting),
 		Target:     []string{},
 		Refresh:    statusPipeline(ctx, conn, name),
@@ -356,7 +356,7 @@ func statusApplication(ctx context.Context, conn *qbusiness.Client, id string) r
 }
 
 func waitApplicationActive(ctx context.Context, conn *qbusiness.Client, id string, timeout time.Duration) (*qbusiness.GetApplicationOutput, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.ApplicationStatusCreating, awstypes.ApplicationStatusUpdating),
 		Target:     enum.Slice(awstypes.ApplicationStatusActive),
 		Refresh:    statusApplication(ctx, conn, id),
@@ -375,7 +375,7 @@ func waitApplicationActive(ctx context.Context, conn *qbusiness.Client, id strin
 }
 
 func waitApplicationDeleted(ctx context.Context, conn *qbusiness.Client, id string, timeout time.Duration) (*qbusiness.GetApplicationOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.ApplicationStatusActive, awstypes.ApplicationStatusDeleting),
 		Target:     []string{},
 		Refresh:    statusApplication(ctx, conn, id),
@@ -273,7 +273,7 @@ const (
 )
 
 func waitAccountSubscriptionCreated(ctx context.Context, conn *quicksight.Client, id string, timeout time.Duration) (*awstypes.AccountInfo, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{accountSubscriptionStatusSignupAttemptInProgress},
 		Target:  []string{accountSubscriptionStatusCreated, accountSubscriptionStatusOK},
 		Refresh: statusAccountSubscription(ctx, conn, id),
@@ -290,7 +290,7 @@ func waitAccountSubscriptionCreated(ctx context.Context, conn *quicksight.Client
 }
 
 func waitAccountSubscriptionDeleted(ctx context.Context, conn *quicksight.Client, id string, timeout time.Duration) (*awstypes.AccountInfo, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{accountSubscriptionStatusCreated, accountSubscriptionStatusOK, accountSubscriptionStatusUnsuscribeInProgress},
 		Target:  []string{},
 		Refresh: statusAccountSubscription(ctx, conn, id),
@@ -484,7 +484,7 @@ func statusDashboard(ctx context.Context, conn *quicksight.Client, awsAccountID,
 }
 
 func waitDashboardCreated(ctx context.Context, conn *quicksight.Client, awsAccountID, dashboardID string, timeout time.Duration) (*awstypes.Dashboard, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResourceStatusCreationInProgress),
 		Target:  enum.Slice(awstypes.ResourceStatusCreationSuccessful),
 		Refresh: statusDashboard(ctx, conn, awsAccountID, dashboardID, dashboardLatestVersion),
@@ -505,7 +505,7 @@ func waitDashboardCreated(ctx context.Context, conn *quicksight.Client, awsAccou
 }
 
 func waitDashboardUpdated(ctx context.Context, conn *quicksight.Client, awsAccountID, dashboardID string, version int64, timeout time.Duration) (*awstypes.Dashboard, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResourceStatusUpdateInProgress, awstypes.ResourceStatusCreationInProgress),
 		Target:  enum.Slice(awstypes.ResourceStatusUpdateSuccessful, awstypes.ResourceStatusCreationSuccessful),
 		Refresh: statusDashboard(ctx, conn, awsAccountID, dashboardID, version),
@@ -426,7 +426,7 @@ func waitDataSourceCreated(ctx context.Context, conn *quicksight.Client, awsAcco
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResourceStatusCreationInProgress),
 		Target:  enum.Slice(awstypes.ResourceStatusCreationSuccessful),
 		Refresh: statusDataSource(ctx, conn, awsAccountID, dataSourceID),
@@ -450,7 +450,7 @@ func waitDataSourceUpdated(ctx context.Context, conn *quicksight.Client, awsAcco
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResourceStatusUpdateInProgress),
 		Target:  enum.Slice(awstypes.ResourceStatusUpdateSuccessful),
 		Refresh: statusDataSource(ctx, conn, awsAccountID, dataSourceID),
@@ -307,7 +307,7 @@ type resourceNamespaceData struct {
 }
 
 func waitNamespaceCreated(ctx context.Context, conn *quicksight.Client, awsAccountID, namespace string, timeout time.Duration) (*awstypes.NamespaceInfoV2, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.NamespaceStatusCreating),
 		Target:     enum.Slice(awstypes.NamespaceStatusCreated),
 		Refresh:    statusNamespace(ctx, conn, awsAccountID, namespace),
@@ -325,7 +325,7 @@ func waitNamespaceCreated(ctx context.Context, conn *quicksight.Client, awsAccou
 }
 
 func waitNamespaceDeleted(ctx context.Context, conn *quicksight.Client, awsAccountID, namespace string, timeout time.Duration) (*awstypes.NamespaceInfoV2, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.NamespaceStatusDeleting),
 		Target:     []string{},
 		Refresh:    statusNamespace(ctx, conn, awsAccountID, namespace),
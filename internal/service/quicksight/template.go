@@ -429,7 +429,7 @@ func statusTemplate(ctx context.Context, conn *quicksight.Client, awsAccountID,
 }
 
 func waitTemplateCreated(ctx context.Context, conn *quicksight.Client, awsAccountID, templateID string, timeout time.Duration) (*awstypes.Template, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResourceStatusCreationInProgress),
 		Target:  enum.Slice(awstypes.ResourceStatusCreationSuccessful),
 		Refresh: statusTemplate(ctx, conn, awsAccountID, templateID),
@@ -450,7 +450,7 @@ func waitTemplateCreated(ctx context.Context, conn *quicksight.Client, awsAccoun
 }
 
 func waitTemplateUpdated(ctx context.Context, conn *quicksight.Client, awsAccountID, templateID string, timeout time.Duration) (*awstypes.Template, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResourceStatusUpdateInProgress, awstypes.ResourceStatusCreationInProgress),
 		Target:  enum.Slice(awstypes.ResourceStatusUpdateSuccessful, awstypes.ResourceStatusCreationSuccessful),
 		Refresh: statusTemplate(ctx, conn, awsAccountID, templateID),
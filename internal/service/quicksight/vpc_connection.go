@@ -441,7 +441,7 @@ func retryVPCConnectionCreate(ctx context.Context, conn *quicksight.Client, in *
 }
 
 func waitVPCConnectionCreated(ctx context.Context, conn *quicksight.Client, awsAccountID, vpcConnectionID string, timeout time.Duration) (*awstypes.VPCConnection, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.VPCConnectionResourceStatusCreationInProgress),
 		Target:     enum.Slice(awstypes.VPCConnectionResourceStatusCreationSuccessful),
 		Refresh:    statusVPCConnection(ctx, conn, awsAccountID, vpcConnectionID),
@@ -459,7 +459,7 @@ func waitVPCConnectionCreated(ctx context.Context, conn *quicksight.Client, awsA
 }
 
 func waitVPCConnectionUpdated(ctx context.Context, conn *quicksight.Client, awsAccountID, vpcConnectionID string, timeout time.Duration) (*awstypes.VPCConnection, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.VPCConnectionResourceStatusUpdateInProgress),
 		Target:     enum.Slice(awstypes.VPCConnectionResourceStatusUpdateSuccessful),
 		Refresh:    statusVPCConnection(ctx, conn, awsAccountID, vpcConnectionID),
@@ -477,7 +477,7 @@ func waitVPCConnectionUpdated(ctx context.Context, conn *quicksight.Client, awsA
 }
 
 func waitVPCConnectionDeleted(ctx context.Context, conn *quicksight.Client, awsAccountID, vpcConnectionID string, timeout time.Duration) (*awstypes.VPCConnection, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.VPCConnectionResourceStatusDeletionInProgress),
 		Target:     []string{},
 		Refresh:    statusVPCConnection(ctx, conn, awsAccountID, vpcConnectionID),
@@ -216,7 +216,7 @@ func waitPrincipalAssociationCreated(ctx context.Context, conn *ram.Client, reso
 	const (
 		timeout = 3 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:        enum.Slice(awstypes.ResourceShareAssociationStatusAssociating),
 		Target:         enum.Slice(awstypes.ResourceShareAssociationStatusAssociated),
 		Refresh:        statusPrincipalAssociation(ctx, conn, resourceShareARN, principal),
@@ -239,7 +239,7 @@ func waitPrincipalAssociationDeleted(ctx context.Context, conn *ram.Client, reso
 	const (
 		timeout = 3 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResourceShareAssociationStatusAssociated, awstypes.ResourceShareAssociationStatusDisassociating),
 		Target:  []string{},
 		Refresh: statusPrincipalAssociation(ctx, conn, resourceShareARN, principal),
@@ -280,7 +280,7 @@ func statusResourceShareOwnerSelf(ctx context.Context, conn *ram.Client, arn str
 }
 
 func waitResourceShareOwnedBySelfActive(ctx context.Context, conn *ram.Client, arn string, timeout time.Duration) (*awstypes.ResourceShare, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResourceShareStatusPending),
 		Target:  enum.Slice(awstypes.ResourceShareStatusActive),
 		Refresh: statusResourceShareOwnerSelf(ctx, conn, arn),
@@ -299,7 +299,7 @@ func waitResourceShareOwnedBySelfActive(ctx context.Context, conn *ram.Client, a
 }
 
 func waitResourceShareOwnedBySelfDeleted(ctx context.Context, conn *ram.Client, arn string, timeout time.Duration) (*awstypes.ResourceShare, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResourceShareStatusDeleting),
 		Target:  []string{},
 		Refresh: statusResourceShareOwnerSelf(ctx, conn, arn),
@@ -377,7 +377,7 @@ func statusResourceShareInvitation(ctx context.Context, conn *ram.Client, arn st
 }
 
 func waitResourceShareInvitationAccepted(ctx context.Context, conn *ram.Client, arn string, timeout time.Duration) (*awstypes.ResourceShareInvitation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResourceShareInvitationStatusPending),
 		Target:  enum.Slice(awstypes.ResourceShareInvitationStatusAccepted),
 		Refresh: statusResourceShareInvitation(ctx, conn, arn),
@@ -394,7 +394,7 @@ func waitResourceShareInvitationAccepted(ctx context.Context, conn *ram.Client,
 }
 
 func waitResourceShareOwnedBySelfDisassociated(ctx context.Context, conn *ram.Client, arn string, timeout time.Duration) (*awstypes.ResourceShare, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResourceShareAssociationStatusAssociated),
 		Target:  []string{},
 		Refresh: statusResourceShareOwnerSelf(ctx, conn, arn),
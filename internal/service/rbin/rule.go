@@ -299,7 +299,7 @@ func resourceRuleDelete(ctx context.Context, d *schema.ResourceData, meta any) d
 }
 
 func waitRuleCreated(ctx context.Context, conn *rbin.Client, id string, timeout time.Duration) (*rbin.GetRuleOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(types.RuleStatusPending),
 		Target:                    enum.Slice(types.RuleStatusAvailable),
 		Refresh:                   statusRule(ctx, conn, id),
@@ -317,7 +317,7 @@ func waitRuleCreated(ctx context.Context, conn *rbin.Client, id string, timeout
 }
 
 func waitRuleUpdated(ctx context.Context, conn *rbin.Client, id string, timeout time.Duration) (*rbin.GetRuleOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(types.RuleStatusPending),
 		Target:                    enum.Slice(types.RuleStatusAvailable),
 		Refresh:                   statusRule(ctx, conn, id),
@@ -335,7 +335,7 @@ func waitRuleUpdated(ctx context.Context, conn *rbin.Client, id string, timeout
 }
 
 func waitRuleDeleted(ctx context.Context, conn *rbin.Client, id string, timeout time.Duration) (*rbin.GetRuleOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.RuleStatusPending, types.RuleStatusAvailable),
 		Target:  []string{},
 		Refresh: statusRule(ctx, conn, id),
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameAuthToken = "Ephemeral Resource Auth Token"
+)
+
+const (
+	// authTokenLifetime is the fixed lifetime of an RDS IAM authentication token.
+	authTokenLifetime = 15 * time.Minute
+	// authTokenSigningName is the SigV4 signing name for RDS IAM database authentication.
+	authTokenSigningName = "rds-db"
+	// emptyPayloadHash is the SHA-256 hash of an empty request payload.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// @EphemeralResource(aws_rds_auth_token, name="Auth Token")
+func newEphemeralAuthToken(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralAuthToken{}, nil
+}
+
+type ephemeralAuthToken struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralAuthToken) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"hostname": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrPort: schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrUserName: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralAuthToken) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epAuthTokenData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := net.JoinHostPort(data.Hostname.ValueString(), strconv.FormatInt(data.Port.ValueInt64(), 10))
+	now := time.Now().UTC()
+	token, err := buildAuthToken(ctx, endpoint, e.Meta().Region(ctx), data.UserName.ValueString(), e.Meta().AwsConfig(ctx).Credentials, now)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionOpening, ERNameAuthToken, endpoint, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Expiration = timetypes.NewRFC3339TimeValue(now.Add(authTokenLifetime))
+	data.Token = types.StringValue(token)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+// buildAuthToken returns an IAM authentication token for the specified database endpoint (host:port) and user.
+// The token is a SigV4 presigned `connect` request, without scheme, that is valid for 15 minutes.
+func buildAuthToken(ctx context.Context, endpoint, region, dbUser string, credentials aws.CredentialsProvider, signingTime time.Time) (string, error) {
+	if credentials == nil {
+		return "", errors.New("no AWS credentials configured")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+
+	values := req.URL.Query()
+	values.Set("Action", "connect")
+	values.Set("DBUser", dbUser)
+	values.Set("X-Amz-Expires", strconv.Itoa(int(authTokenLifetime.Seconds())))
+	req.URL.RawQuery = values.Encode()
+
+	creds, err := credentials.Retrieve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("retrieving AWS credentials: %w", err)
+	}
+
+	signedURI, _, err := v4.NewSigner().PresignHTTP(ctx, creds, req, emptyPayloadHash, authTokenSigningName, region, signingTime)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimPrefix(signedURI, "https://"), nil
+}
+
+type epAuthTokenData struct {
+	Expiration timetypes.RFC3339 `tfsdk:"expiration"`
+	Hostname   types.String      `tfsdk:"hostname"`
+	Port       types.Int64       `tfsdk:"port"`
+	Token      types.String      `tfsdk:"token"`
+	UserName   types.String      `tfsdk:"user_name"`
+}
@@ -179,7 +179,7 @@ func waitActivityStreamStarted(ctx context.Context, conn *rds.Client, arn string
 	const (
 		timeout = 30 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.ActivityStreamStatusStarting),
 		Target:     enum.Slice(types.ActivityStreamStatusStarted),
 		Refresh:    statusDBClusterActivityStream(ctx, conn, arn),
@@ -201,7 +201,7 @@ func waitActivityStreamStopped(ctx context.Context, conn *rds.Client, arn string
 	const (
 		timeout = 30 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(types.ActivityStreamStatusStopping),
 		Target:     []string{},
 		Refresh:    statusDBClusterActivityStream(ctx, conn, arn),
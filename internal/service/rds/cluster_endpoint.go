@@ -273,7 +273,7 @@ func statusClusterEndpoint(ctx context.Context, conn *rds.Client, id string) ret
 }
 
 func waitClusterEndpointCreated(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.DBClusterEndpoint, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{clusterEndpointStatusCreating},
 		Target:     []string{clusterEndpointStatusAvailable},
 		Refresh:    statusClusterEndpoint(ctx, conn, id),
@@ -292,7 +292,7 @@ func waitClusterEndpointCreated(ctx context.Context, conn *rds.Client, id string
 }
 
 func waitClusterEndpointDeleted(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.DBClusterEndpoint, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{clusterEndpointStatusAvailable, clusterEndpointStatusDeleting},
 		Target:     []string{},
 		Refresh:    statusClusterEndpoint(ctx, conn, id),
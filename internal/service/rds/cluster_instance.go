@@ -15,7 +15,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -577,7 +576,7 @@ func resourceClusterInstanceDelete(ctx context.Context, d *schema.ResourceData,
 }
 
 func waitDBClusterInstanceAvailable(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.DBInstance, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			instanceStatusBackingUp,
 			instanceStatusConfiguringEnhancedMonitoring,
@@ -609,7 +608,7 @@ func waitDBClusterInstanceAvailable(ctx context.Context, conn *rds.Client, id st
 }
 
 func waitDBClusterInstanceDeleted(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.DBInstance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			instanceStatusConfiguringLogExports,
 			instanceStatusDeletePreCheck,
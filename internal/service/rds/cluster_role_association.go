@@ -225,7 +225,7 @@ func statusDBClusterRole(ctx context.Context, conn *rds.Client, dbClusterID, rol
 }
 
 func waitDBClusterRoleAssociationCreated(ctx context.Context, conn *rds.Client, dbClusterID, roleARN string, timeout time.Duration) (*types.DBClusterRole, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{clusterRoleStatusPending},
 		Target:     []string{clusterRoleStatusActive},
 		Refresh:    statusDBClusterRole(ctx, conn, dbClusterID, roleARN),
@@ -244,7 +244,7 @@ func waitDBClusterRoleAssociationCreated(ctx context.Context, conn *rds.Client,
 }
 
 func waitDBClusterRoleAssociationDeleted(ctx context.Context, conn *rds.Client, dbClusterID, roleARN string, timeout time.Duration) (*types.DBClusterRole, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{clusterRoleStatusActive, clusterRoleStatusPending},
 		Target:     []string{},
 		Refresh:    statusDBClusterRole(ctx, conn, dbClusterID, roleARN),
@@ -448,7 +448,7 @@ func statusDBEngineVersion(ctx context.Context, conn *rds.Client, engine, engine
 }
 
 func waitCustomDBEngineVersionCreated(ctx context.Context, conn *rds.Client, engine, engineVersion string, timeout time.Duration) (*types.DBEngineVersion, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{statusCreating},
 		Target:                    []string{statusAvailable, statusPendingValidation},
 		Refresh:                   statusDBEngineVersion(ctx, conn, engine, engineVersion),
@@ -467,7 +467,7 @@ func waitCustomDBEngineVersionCreated(ctx context.Context, conn *rds.Client, eng
 }
 
 func waitCustomDBEngineVersionUpdated(ctx context.Context, conn *rds.Client, engine, engineVersion string, timeout time.Duration) (*types.DBEngineVersion, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{statusAvailable},
 		Target:  []string{statusAvailable, statusPendingValidation},
 		Refresh: statusDBEngineVersion(ctx, conn, engine, engineVersion),
@@ -484,7 +484,7 @@ func waitCustomDBEngineVersionUpdated(ctx context.Context, conn *rds.Client, eng
 }
 
 func waitCustomDBEngineVersionDeleted(ctx context.Context, conn *rds.Client, engine, engineVersion string, timeout time.Duration) (*types.DBEngineVersion, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{statusDeleting},
 		Target:  []string{},
 		Refresh: statusDBEngineVersion(ctx, conn, engine, engineVersion),
@@ -340,7 +340,7 @@ func statusEventSubscription(ctx context.Context, conn *rds.Client, id string) r
 }
 
 func waitEventSubscriptionCreated(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.EventSubscription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{eventSubscriptionStatusCreating},
 		Target:     []string{eventSubscriptionStatusActive},
 		Refresh:    statusEventSubscription(ctx, conn, id),
@@ -359,7 +359,7 @@ func waitEventSubscriptionCreated(ctx context.Context, conn *rds.Client, id stri
 }
 
 func waitEventSubscriptionDeleted(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.EventSubscription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{eventSubscriptionStatusDeleting},
 		Target:     []string{},
 		Refresh:    statusEventSubscription(ctx, conn, id),
@@ -378,7 +378,7 @@ func waitEventSubscriptionDeleted(ctx context.Context, conn *rds.Client, id stri
 }
 
 func waitEventSubscriptionUpdated(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.EventSubscription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{eventSubscriptionStatusModifying},
 		Target:                    []string{eventSubscriptionStatusActive},
 		Refresh:                   statusEventSubscription(ctx, conn, id),
@@ -303,7 +303,7 @@ func statusExportTask(ctx context.Context, conn *rds.Client, id string) retry.St
 }
 
 func waitExportTaskCreated(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*awstypes.ExportTask, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{StatusStarting, StatusInProgress},
 		Target:     []string{StatusComplete, StatusFailed},
 		Refresh:    statusExportTask(ctx, conn, id),
@@ -321,7 +321,7 @@ func waitExportTaskCreated(ctx context.Context, conn *rds.Client, id string, tim
 }
 
 func waitExportTaskDeleted(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*awstypes.ExportTask, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{StatusStarting, StatusInProgress, StatusCanceling},
 		Target:  []string{},
 		Refresh: statusExportTask(ctx, conn, id),
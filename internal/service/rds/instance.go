@@ -2920,7 +2920,7 @@ func waitDBInstanceAvailable(ctx context.Context, conn *rds.Client, id string, t
 		fn(&options)
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			instanceStatusBackingUp,
 			instanceStatusConfiguringEnhancedMonitoring,
@@ -2954,7 +2954,7 @@ func waitDBInstanceAvailable(ctx context.Context, conn *rds.Client, id string, t
 }
 
 func waitDBInstanceStopped(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.DBInstance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			instanceStatusBackingUp,
 			instanceStatusConfiguringEnhancedMonitoring,
@@ -2999,7 +2999,7 @@ func waitDBInstanceDeleted(ctx context.Context, conn *rds.Client, id string, tim
 		fn(&options)
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			instanceStatusAvailable,
 			instanceStatusBackingUp,
@@ -3114,7 +3114,7 @@ func waitBlueGreenDeploymentAvailable(ctx context.Context, conn *rds.Client, id
 		fn(&options)
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{"PROVISIONING"},
 		Target:  []string{"AVAILABLE"},
 		Refresh: statusBlueGreenDeployment(ctx, conn, id),
@@ -3140,7 +3140,7 @@ func waitBlueGreenDeploymentSwitchoverCompleted(ctx context.Context, conn *rds.C
 		fn(&options)
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{"AVAILABLE", "SWITCHOVER_IN_PROGRESS"},
 		Target:  []string{"SWITCHOVER_COMPLETED"},
 		Refresh: statusBlueGreenDeployment(ctx, conn, id),
@@ -3170,7 +3170,7 @@ func waitBlueGreenDeploymentDeleted(ctx context.Context, conn *rds.Client, id st
 		fn(&options)
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{"PROVISIONING", "AVAILABLE", "SWITCHOVER_IN_PROGRESS", "SWITCHOVER_COMPLETED", "INVALID_CONFIGURATION", "SWITCHOVER_FAILED", "DELETING"},
 		Target:  []string{},
 		Refresh: statusBlueGreenDeployment(ctx, conn, id),
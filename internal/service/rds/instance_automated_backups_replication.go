@@ -268,7 +268,7 @@ func statusDBInstanceAutomatedBackup(ctx context.Context, conn *rds.Client, arn
 }
 
 func waitDBInstanceAutomatedBackupCreated(ctx context.Context, conn *rds.Client, arn string, timeout time.Duration) (*types.DBInstanceAutomatedBackup, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{instanceAutomatedBackupStatusPending},
 		Target:  []string{instanceAutomatedBackupStatusReplicating},
 		Refresh: statusDBInstanceAutomatedBackup(ctx, conn, arn),
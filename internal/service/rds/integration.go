@@ -310,7 +310,7 @@ func statusIntegration(ctx context.Context, conn *rds.Client, arn string) retry.
 }
 
 func waitIntegrationCreated(ctx context.Context, conn *rds.Client, arn string, timeout time.Duration) (*awstypes.Integration, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{integrationStatusCreating, integrationStatusModifying},
 		Target:  []string{integrationStatusActive},
 		Refresh: statusIntegration(ctx, conn, arn),
@@ -329,7 +329,7 @@ func waitIntegrationCreated(ctx context.Context, conn *rds.Client, arn string, t
 }
 
 func waitIntegrationDeleted(ctx context.Context, conn *rds.Client, arn string, timeout time.Duration) (*awstypes.Integration, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{integrationStatusDeleting, integrationStatusActive},
 		Target:  []string{},
 		Refresh: statusIntegration(ctx, conn, arn),
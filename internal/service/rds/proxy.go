@@ -357,7 +357,7 @@ func statusDBProxy(ctx context.Context, conn *rds.Client, name string) retry.Sta
 }
 
 func waitDBProxyCreated(ctx context.Context, conn *rds.Client, name string, timeout time.Duration) (*types.DBProxy, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.DBProxyStatusCreating),
 		Target:  enum.Slice(types.DBProxyStatusAvailable),
 		Refresh: statusDBProxy(ctx, conn, name),
@@ -374,7 +374,7 @@ func waitDBProxyCreated(ctx context.Context, conn *rds.Client, name string, time
 }
 
 func waitDBProxyDeleted(ctx context.Context, conn *rds.Client, name string, timeout time.Duration) (*types.DBProxy, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.DBProxyStatusDeleting),
 		Target:  []string{},
 		Refresh: statusDBProxy(ctx, conn, name),
@@ -391,7 +391,7 @@ func waitDBProxyDeleted(ctx context.Context, conn *rds.Client, name string, time
 }
 
 func waitDBProxyUpdated(ctx context.Context, conn *rds.Client, name string, timeout time.Duration) (*types.DBProxy, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.DBProxyStatusModifying),
 		Target:  enum.Slice(types.DBProxyStatusAvailable),
 		Refresh: statusDBProxy(ctx, conn, name),
@@ -321,7 +321,7 @@ func statusDBProxyEndpoint(ctx context.Context, conn *rds.Client, dbProxyName, d
 }
 
 func waitDBProxyEndpointAvailable(ctx context.Context, conn *rds.Client, dbProxyName, dbProxyEndpointName string, timeout time.Duration) (*types.DBProxyEndpoint, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.DBProxyEndpointStatusCreating, types.DBProxyEndpointStatusModifying),
 		Target:  enum.Slice(types.DBProxyEndpointStatusAvailable),
 		Refresh: statusDBProxyEndpoint(ctx, conn, dbProxyName, dbProxyEndpointName),
@@ -338,7 +338,7 @@ func waitDBProxyEndpointAvailable(ctx context.Context, conn *rds.Client, dbProxy
 }
 
 func waitDBProxyEndpointDeleted(ctx context.Context, conn *rds.Client, dbProxyName, dbProxyEndpointName string, timeout time.Duration) (*types.DBProxyEndpoint, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.DBProxyEndpointStatusDeleting),
 		Target:  []string{},
 		Refresh: statusDBProxyEndpoint(ctx, conn, dbProxyName, dbProxyEndpointName),
@@ -278,7 +278,7 @@ func statusReservedInstance(ctx context.Context, conn *rds.Client, id string) re
 }
 
 func waitReservedInstanceCreated(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.ReservedDBInstance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:        []string{reservedInstanceStatePaymentPending},
 		Target:         []string{reservedInstanceStateActive},
 		Refresh:        statusReservedInstance(ctx, conn, id),
@@ -369,7 +369,7 @@ const (
 )
 
 func waitShardGroupCreated(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*awstypes.DBShardGroup, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{shardGroupStatusCreating},
 		Target:  []string{shardGroupStatusAvailable},
 		Refresh: statusShardGroup(ctx, conn, id),
@@ -389,7 +389,7 @@ func waitShardGroupUpdated(ctx context.Context, conn *rds.Client, id string, tim
 	const (
 		delay = 1 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{shardGroupStatusModifying},
 		Target:  []string{shardGroupStatusAvailable},
 		Refresh: statusShardGroup(ctx, conn, id),
@@ -410,7 +410,7 @@ func waitShardGroupDeleted(ctx context.Context, conn *rds.Client, id string, tim
 	const (
 		delay = 1 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{shardGroupStatusDeleting},
 		Target:  []string{},
 		Refresh: statusShardGroup(ctx, conn, id),
@@ -205,7 +205,7 @@ func waitSnapshotScheduleAssociationCreated(ctx context.Context, conn *redshift.
 	const (
 		timeout = 75 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.ScheduleStateModifying),
 		Target:     enum.Slice(awstypes.ScheduleStateActive),
 		Refresh:    statusSnapshotScheduleAssociation(ctx, conn, clusterIdentifier, scheduleIdentifier),
@@ -227,7 +227,7 @@ func waitSnapshotScheduleAssociationDeleted(ctx context.Context, conn *redshift.
 	const (
 		timeout = 75 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.ScheduleStateModifying, awstypes.ScheduleStateActive),
 		Target:     []string{},
 		Refresh:    statusSnapshotScheduleAssociation(ctx, conn, clusterIdentifier, scheduleIdentifier),
@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/redshift"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/redshift/types"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -23,7 +22,7 @@ const (
 )
 
 func waitClusterCreated(ctx context.Context, conn *redshift.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{clusterAvailabilityStatusModifying, clusterAvailabilityStatusUnavailable},
 		Target:     []string{clusterAvailabilityStatusAvailable},
 		Refresh:    statusClusterAvailability(ctx, conn, id),
@@ -43,7 +42,7 @@ func waitClusterCreated(ctx context.Context, conn *redshift.Client, id string, t
 }
 
 func waitClusterDeleted(ctx context.Context, conn *redshift.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{clusterAvailabilityStatusMaintenance, clusterAvailabilityStatusModifying},
 		Target:  []string{},
 		Refresh: statusClusterAvailability(ctx, conn, id),
@@ -62,7 +61,7 @@ func waitClusterDeleted(ctx context.Context, conn *redshift.Client, id string, t
 }
 
 func waitClusterUpdated(ctx context.Context, conn *redshift.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{clusterAvailabilityStatusMaintenance, clusterAvailabilityStatusModifying, clusterAvailabilityStatusUnavailable},
 		Target:  []string{clusterAvailabilityStatusAvailable},
 		Refresh: statusClusterAvailability(ctx, conn, id),
@@ -81,7 +80,7 @@ func waitClusterUpdated(ctx context.Context, conn *redshift.Client, id string, t
 }
 
 func waitClusterRelocationStatusResolved(ctx context.Context, conn *redshift.Client, id string) (*awstypes.Cluster, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: clusterAvailabilityZoneRelocationStatus_PendingValues(),
 		Target:  clusterAvailabilityZoneRelocationStatus_TerminalValues(),
 		Refresh: statusClusterAvailabilityZoneRelocation(ctx, conn, id),
@@ -98,7 +97,7 @@ func waitClusterRelocationStatusResolved(ctx context.Context, conn *redshift.Cli
 }
 
 func waitClusterRebooted(ctx context.Context, conn *redshift.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{clusterStatusRebooting, clusterStatusModifying},
 		Target:     []string{clusterStatusAvailable},
 		Refresh:    statusCluster(ctx, conn, id),
@@ -118,7 +117,7 @@ func waitClusterRebooted(ctx context.Context, conn *redshift.Client, id string,
 }
 
 func waitClusterAquaApplied(ctx context.Context, conn *redshift.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.AquaStatusApplying),
 		Target:     enum.Slice(awstypes.AquaStatusDisabled, awstypes.AquaStatusEnabled),
 		Refresh:    statusClusterAqua(ctx, conn, id),
@@ -138,7 +137,7 @@ func waitClusterAquaApplied(ctx context.Context, conn *redshift.Client, id strin
 }
 
 func waitEndpointAccessActive(ctx context.Context, conn *redshift.Client, id string) (*awstypes.EndpointAccess, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{endpointAccessStatusCreating, endpointAccessStatusModifying},
 		Target:     []string{endpointAccessStatusActive},
 		Refresh:    statusEndpointAccess(ctx, conn, id),
@@ -159,7 +158,7 @@ func waitEndpointAccessActive(ctx context.Context, conn *redshift.Client, id str
 }
 
 func waitEndpointAccessDeleted(ctx context.Context, conn *redshift.Client, id string) (*awstypes.EndpointAccess, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{endpointAccessStatusDeleting},
 		Target:     []string{},
 		Refresh:    statusEndpointAccess(ctx, conn, id),
@@ -180,7 +179,7 @@ func waitEndpointAccessDeleted(ctx context.Context, conn *redshift.Client, id st
 }
 
 func waitClusterSnapshotCreated(ctx context.Context, conn *redshift.Client, id string) (*awstypes.Snapshot, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{clusterSnapshotStatusCreating},
 		Target:     []string{clusterSnapshotStatusAvailable},
 		Refresh:    statusClusterSnapshot(ctx, conn, id),
@@ -201,7 +200,7 @@ func waitClusterSnapshotCreated(ctx context.Context, conn *redshift.Client, id s
 }
 
 func waitClusterSnapshotDeleted(ctx context.Context, conn *redshift.Client, id string) (*awstypes.Snapshot, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{clusterSnapshotStatusAvailable},
 		Target:     []string{},
 		Refresh:    statusClusterSnapshot(ctx, conn, id),
@@ -234,7 +234,7 @@ func statusStatement(ctx context.Context, conn *redshiftdata.Client, id string)
 }
 
 func waitStatementFinished(ctx context.Context, conn *redshiftdata.Client, id string, timeout time.Duration) (*redshiftdata.DescribeStatementOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			types.StatusStringPicked,
 			types.StatusStringStarted,
@@ -288,7 +288,7 @@ func statusEndpointAccess(ctx context.Context, conn *redshiftserverless.Client,
 }
 
 func waitEndpointAccessActive(ctx context.Context, conn *redshiftserverless.Client, name string) (*awstypes.EndpointAccess, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			"CREATING",
 			"MODIFYING",
@@ -310,7 +310,7 @@ func waitEndpointAccessActive(ctx context.Context, conn *redshiftserverless.Clie
 }
 
 func waitEndpointAccessDeleted(ctx context.Context, conn *redshiftserverless.Client, name string) (*awstypes.EndpointAccess, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{
 			"DELETING",
 		},
@@ -374,7 +374,7 @@ func statusNamespace(ctx context.Context, conn *redshiftserverless.Client, name
 }
 
 func waitNamespaceDeleted(ctx context.Context, conn *redshiftserverless.Client, name string) (*awstypes.Namespace, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.NamespaceStatusDeleting),
 		Target:  []string{},
 		Refresh: statusNamespace(ctx, conn, name),
@@ -391,7 +391,7 @@ func waitNamespaceDeleted(ctx context.Context, conn *redshiftserverless.Client,
 }
 
 func waitNamespaceUpdated(ctx context.Context, conn *redshiftserverless.Client, name string) (*awstypes.Namespace, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.NamespaceStatusModifying),
 		Target:  enum.Slice(awstypes.NamespaceStatusAvailable),
 		Refresh: statusNamespace(ctx, conn, name),
@@ -229,7 +229,7 @@ func statusSnapshot(ctx context.Context, conn *redshiftserverless.Client, name s
 }
 
 func waitSnapshotAvailable(ctx context.Context, conn *redshiftserverless.Client, name string) (*awstypes.Snapshot, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SnapshotStatusCreating),
 		Target:  enum.Slice(awstypes.SnapshotStatusAvailable),
 		Refresh: statusSnapshot(ctx, conn, name),
@@ -246,7 +246,7 @@ func waitSnapshotAvailable(ctx context.Context, conn *redshiftserverless.Client,
 }
 
 func waitSnapshotDeleted(ctx context.Context, conn *redshiftserverless.Client, name string) (*awstypes.Snapshot, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SnapshotStatusAvailable),
 		Target:  []string{},
 		Refresh: statusSnapshot(ctx, conn, name),
@@ -546,7 +546,7 @@ func statusWorkgroup(ctx context.Context, conn *redshiftserverless.Client, name
 }
 
 func waitWorkgroupAvailable(ctx context.Context, conn *redshiftserverless.Client, name string, wait time.Duration) (*awstypes.Workgroup, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.WorkgroupStatusCreating, awstypes.WorkgroupStatusModifying),
 		Target:  enum.Slice(awstypes.WorkgroupStatusAvailable),
 		Refresh: statusWorkgroup(ctx, conn, name),
@@ -563,7 +563,7 @@ func waitWorkgroupAvailable(ctx context.Context, conn *redshiftserverless.Client
 }
 
 func waitWorkgroupDeleted(ctx context.Context, conn *redshiftserverless.Client, name string, wait time.Duration) (*awstypes.Workgroup, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.WorkgroupStatusAvailable, awstypes.WorkgroupStatusModifying, awstypes.WorkgroupStatusDeleting),
 		Target:  []string{},
 		Refresh: statusWorkgroup(ctx, conn, name),
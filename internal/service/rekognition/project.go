@@ -250,7 +250,7 @@ func (r *resourceProject) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func waitProjectCreated(ctx context.Context, conn *rekognition.Client, name string, feature awstypes.CustomizationFeature, timeout time.Duration) (*awstypes.ProjectDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.ProjectStatusCreating),
 		Target:                    enum.Slice(awstypes.ProjectStatusCreated),
 		Refresh:                   statusProject(ctx, conn, name, feature),
@@ -268,7 +268,7 @@ func waitProjectCreated(ctx context.Context, conn *rekognition.Client, name stri
 }
 
 func waitProjectDeleted(ctx context.Context, conn *rekognition.Client, name string, feature awstypes.CustomizationFeature, timeout time.Duration) (*awstypes.ProjectDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.ProjectStatusDeleting),
 		Target:                    []string{},
 		Refresh:                   statusProject(ctx, conn, name, feature),
@@ -716,7 +716,7 @@ func (r *resourceStreamProcessor) ImportState(ctx context.Context, req resource.
 }
 
 func waitStreamProcessorCreated(ctx context.Context, conn *rekognition.Client, name string, timeout time.Duration) (*rekognition.DescribeStreamProcessorOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{},
 		Target:                    enum.Slice(awstypes.StreamProcessorStatusStopped),
 		Refresh:                   statusStreamProcessor(ctx, conn, name),
@@ -734,7 +734,7 @@ func waitStreamProcessorCreated(ctx context.Context, conn *rekognition.Client, n
 }
 
 func waitStreamProcessorUpdated(ctx context.Context, conn *rekognition.Client, name string, timeout time.Duration) (*rekognition.DescribeStreamProcessorOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.StreamProcessorStatusUpdating),
 		Target:                    enum.Slice(awstypes.StreamProcessorStatusStopped),
 		Refresh:                   statusStreamProcessor(ctx, conn, name),
@@ -752,7 +752,7 @@ func waitStreamProcessorUpdated(ctx context.Context, conn *rekognition.Client, n
 }
 
 func waitStreamProcessorDeleted(ctx context.Context, conn *rekognition.Client, name string, timeout time.Duration) (*rekognition.DescribeStreamProcessorOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.StreamProcessorStatusStopped,
 			awstypes.StreamProcessorStatusStarting,
@@ -432,7 +432,7 @@ const (
 )
 
 func waitResiliencyPolicyCreated(ctx context.Context, conn *resiliencehub.Client, arn string, timeout time.Duration) (*awstypes.ResiliencyPolicy, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{},
 		Target:                    []string{statusCompleted},
 		Refresh:                   statusResiliencyPolicy(ctx, conn, arn),
@@ -450,7 +450,7 @@ func waitResiliencyPolicyCreated(ctx context.Context, conn *resiliencehub.Client
 }
 
 func waitResiliencyPolicyUpdated(ctx context.Context, conn *resiliencehub.Client, arn string, timeout time.Duration) (*awstypes.ResiliencyPolicy, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{},
 		Target:                    []string{statusCompleted},
 		Refresh:                   statusResiliencyPolicy(ctx, conn, arn),
@@ -468,7 +468,7 @@ func waitResiliencyPolicyUpdated(ctx context.Context, conn *resiliencehub.Client
 }
 
 func waitResiliencyPolicyDeleted(ctx context.Context, conn *resiliencehub.Client, arn string, timeout time.Duration) (*awstypes.ResiliencyPolicy, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{},
 		Target:  []string{},
 		Refresh: statusResiliencyPolicy(ctx, conn, arn),
@@ -360,7 +360,7 @@ func statusGroupConfiguration(ctx context.Context, conn *resourcegroups.Client,
 }
 
 func waitGroupConfigurationUpdated(ctx context.Context, conn *resourcegroups.Client, groupName string, timeout time.Duration) (*types.GroupConfiguration, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.GroupConfigurationStatusUpdating),
 		Target:  enum.Slice(types.GroupConfigurationStatusUpdateComplete),
 		Refresh: statusGroupConfiguration(ctx, conn, groupName),
@@ -218,7 +218,7 @@ func statusResource(ctx context.Context, conn *resourcegroups.Client, groupARN,
 }
 
 func waitResourceCreated(ctx context.Context, conn *resourcegroups.Client, groupARN, resourceARN string, timeout time.Duration) (*types.ListGroupResourcesItem, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ResourceStatusValuePending),
 		Target:  []string{""},
 		Refresh: statusResource(ctx, conn, groupARN, resourceARN),
@@ -235,7 +235,7 @@ func waitResourceCreated(ctx context.Context, conn *resourcegroups.Client, group
 }
 
 func waitResourceDeleted(ctx context.Context, conn *resourcegroups.Client, groupARN, resourceARN string, timeout time.Duration) (*types.ListGroupResourcesItem, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ResourceStatusValuePending),
 		Target:  []string{},
 		Refresh: statusResource(ctx, conn, groupARN, resourceARN),
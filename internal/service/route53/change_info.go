@@ -64,7 +64,7 @@ func waitChangeInsync(ctx context.Context, conn *route53.Client, id string, time
 		minTimeout   = 5 * time.Second
 		pollInterval = 15 * time.Second
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:      enum.Slice(awstypes.ChangeStatusPending),
 		Target:       enum.Slice(awstypes.ChangeStatusInsync),
 		Refresh:      statusChange(ctx, conn, id),
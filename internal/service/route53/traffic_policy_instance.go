@@ -234,7 +234,7 @@ const (
 )
 
 func waitTrafficPolicyInstanceStateCreated(ctx context.Context, conn *route53.Client, id string) (*awstypes.TrafficPolicyInstance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{trafficPolicyInstanceStateCreating},
 		Target:  []string{trafficPolicyInstanceStateApplied},
 		Refresh: statusTrafficPolicyInstanceState(ctx, conn, id),
@@ -255,7 +255,7 @@ func waitTrafficPolicyInstanceStateCreated(ctx context.Context, conn *route53.Cl
 }
 
 func waitTrafficPolicyInstanceStateUpdated(ctx context.Context, conn *route53.Client, id string) (*awstypes.TrafficPolicyInstance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{trafficPolicyInstanceStateUpdating},
 		Target:  []string{trafficPolicyInstanceStateApplied},
 		Refresh: statusTrafficPolicyInstanceState(ctx, conn, id),
@@ -276,7 +276,7 @@ func waitTrafficPolicyInstanceStateUpdated(ctx context.Context, conn *route53.Cl
 }
 
 func waitTrafficPolicyInstanceStateDeleted(ctx context.Context, conn *route53.Client, id string) (*awstypes.TrafficPolicyInstance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{trafficPolicyInstanceStateDeleting},
 		Target:  []string{},
 		Refresh: statusTrafficPolicyInstanceState(ctx, conn, id),
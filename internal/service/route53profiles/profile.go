@@ -220,7 +220,7 @@ func (r *resourceProfile) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func waitProfileCreated(ctx context.Context, conn *route53profiles.Client, id string, timeout time.Duration) (*awstypes.Profile, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.ProfileStatusCreating),
 		Target:                    enum.Slice(awstypes.ProfileStatusComplete),
 		Refresh:                   statusProfile(ctx, conn, id),
@@ -238,7 +238,7 @@ func waitProfileCreated(ctx context.Context, conn *route53profiles.Client, id st
 }
 
 func waitProfileDeleted(ctx context.Context, conn *route53profiles.Client, id string, timeout time.Duration) (*awstypes.Profile, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ProfileStatusDeleting),
 		Target:  []string{},
 		Refresh: statusProfile(ctx, conn, id),
@@ -9,8 +9,8 @@ import (
 
 	r53rcc "github.com/aws/aws-sdk-go-v2/service/route53recoverycontrolconfig"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/route53recoverycontrolconfig/types"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -19,7 +19,7 @@ const (
 )
 
 func waitClusterCreated(ctx context.Context, conn *r53rcc.Client, clusterArn string) (*awstypes.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.StatusPending),
 		Target:     enum.Slice(awstypes.StatusDeployed),
 		Refresh:    statusCluster(ctx, conn, clusterArn),
@@ -37,7 +37,7 @@ func waitClusterCreated(ctx context.Context, conn *r53rcc.Client, clusterArn str
 }
 
 func waitClusterDeleted(ctx context.Context, conn *r53rcc.Client, clusterArn string) (*awstypes.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:        enum.Slice(awstypes.StatusPendingDeletion),
 		Target:         []string{},
 		Refresh:        statusCluster(ctx, conn, clusterArn),
@@ -56,7 +56,7 @@ func waitClusterDeleted(ctx context.Context, conn *r53rcc.Client, clusterArn str
 }
 
 func waitRoutingControlCreated(ctx context.Context, conn *r53rcc.Client, routingControlArn string) (*awstypes.RoutingControl, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.StatusPending),
 		Target:     enum.Slice(awstypes.StatusDeployed),
 		Refresh:    statusRoutingControl(ctx, conn, routingControlArn),
@@ -74,7 +74,7 @@ func waitRoutingControlCreated(ctx context.Context, conn *r53rcc.Client, routing
 }
 
 func waitRoutingControlDeleted(ctx context.Context, conn *r53rcc.Client, routingControlArn string) (*awstypes.RoutingControl, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:        enum.Slice(awstypes.StatusPendingDeletion),
 		Target:         []string{},
 		Refresh:        statusRoutingControl(ctx, conn, routingControlArn),
@@ -93,7 +93,7 @@ func waitRoutingControlDeleted(ctx context.Context, conn *r53rcc.Client, routing
 }
 
 func waitControlPanelCreated(ctx context.Context, conn *r53rcc.Client, controlPanelArn string) (*awstypes.ControlPanel, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.StatusPending),
 		Target:     enum.Slice(awstypes.StatusDeployed),
 		Refresh:    statusControlPanel(ctx, conn, controlPanelArn),
@@ -111,7 +111,7 @@ func waitControlPanelCreated(ctx context.Context, conn *r53rcc.Client, controlPa
 }
 
 func waitControlPanelDeleted(ctx context.Context, conn *r53rcc.Client, controlPanelArn string) (*awstypes.ControlPanel, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:        enum.Slice(awstypes.StatusPendingDeletion),
 		Target:         []string{},
 		Refresh:        statusControlPanel(ctx, conn, controlPanelArn),
@@ -130,7 +130,7 @@ func waitControlPanelDeleted(ctx context.Context, conn *r53rcc.Client, controlPa
 }
 
 func waitSafetyRuleCreated(ctx context.Context, conn *r53rcc.Client, safetyRuleArn string) (*r53rcc.DescribeSafetyRuleOutput, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.StatusPending),
 		Target:     enum.Slice(awstypes.StatusDeployed),
 		Refresh:    statusSafetyRule(ctx, conn, safetyRuleArn),
@@ -148,7 +148,7 @@ func waitSafetyRuleCreated(ctx context.Context, conn *r53rcc.Client, safetyRuleA
 }
 
 func waitSafetyRuleDeleted(ctx context.Context, conn *r53rcc.Client, safetyRuleArn string) (*r53rcc.DescribeSafetyRuleOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:        enum.Slice(awstypes.StatusPendingDeletion),
 		Target:         []string{},
 		Refresh:        statusSafetyRule(ctx, conn, safetyRuleArn),
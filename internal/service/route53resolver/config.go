@@ -188,7 +188,7 @@ func waitAutodefinedReverseUpdated(ctx context.Context, conn *route53resolver.Cl
 }
 
 func waitAutodefinedReverseEnabled(ctx context.Context, conn *route53resolver.Client, id string) (*awstypes.ResolverConfig, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResolverAutodefinedReverseStatusEnabling),
 		Target:  enum.Slice(awstypes.ResolverAutodefinedReverseStatusEnabled),
 		Refresh: statusAutodefinedReverse(ctx, conn, id),
@@ -205,7 +205,7 @@ func waitAutodefinedReverseEnabled(ctx context.Context, conn *route53resolver.Cl
 }
 
 func waitAutodefinedReverseDisabled(ctx context.Context, conn *route53resolver.Client, id string) (*awstypes.ResolverConfig, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResolverAutodefinedReverseStatusDisabling),
 		Target:  enum.Slice(awstypes.ResolverAutodefinedReverseStatusDisabled),
 		Refresh: statusAutodefinedReverse(ctx, conn, id),
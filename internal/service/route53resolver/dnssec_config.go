@@ -197,7 +197,7 @@ const (
 )
 
 func waitDNSSECConfigCreated(ctx context.Context, conn *route53resolver.Client, id string) (*awstypes.ResolverDnssecConfig, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResolverDNSSECValidationStatusEnabling),
 		Target:  enum.Slice(awstypes.ResolverDNSSECValidationStatusEnabled),
 		Refresh: statusDNSSECConfig(ctx, conn, id),
@@ -214,7 +214,7 @@ func waitDNSSECConfigCreated(ctx context.Context, conn *route53resolver.Client,
 }
 
 func waitDNSSECConfigDeleted(ctx context.Context, conn *route53resolver.Client, id string) (*awstypes.ResolverDnssecConfig, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResolverDNSSECValidationStatusDisabling),
 		Target:  []string{},
 		Refresh: statusDNSSECConfig(ctx, conn, id),
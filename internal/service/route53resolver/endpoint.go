@@ -371,7 +371,7 @@ func statusEndpoint(ctx context.Context, conn *route53resolver.Client, id string
 }
 
 func waitEndpointCreated(ctx context.Context, conn *route53resolver.Client, id string, timeout time.Duration) (*awstypes.ResolverEndpoint, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.ResolverEndpointStatusCreating),
 		Target:     enum.Slice(awstypes.ResolverEndpointStatusOperational),
 		Refresh:    statusEndpoint(ctx, conn, id),
@@ -392,7 +392,7 @@ func waitEndpointCreated(ctx context.Context, conn *route53resolver.Client, id s
 }
 
 func waitEndpointUpdated(ctx context.Context, conn *route53resolver.Client, id string, timeout time.Duration) (*awstypes.ResolverEndpoint, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.ResolverEndpointStatusUpdating),
 		Target:     enum.Slice(awstypes.ResolverEndpointStatusOperational),
 		Refresh:    statusEndpoint(ctx, conn, id),
@@ -413,7 +413,7 @@ func waitEndpointUpdated(ctx context.Context, conn *route53resolver.Client, id s
 }
 
 func waitEndpointDeleted(ctx context.Context, conn *route53resolver.Client, id string, timeout time.Duration) (*awstypes.ResolverEndpoint, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.ResolverEndpointStatusDeleting),
 		Target:     []string{},
 		Refresh:    statusEndpoint(ctx, conn, id),
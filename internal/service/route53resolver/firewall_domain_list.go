@@ -251,7 +251,7 @@ const (
 )
 
 func waitFirewallDomainListUpdated(ctx context.Context, conn *route53resolver.Client, id string) (*awstypes.FirewallDomainList, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.FirewallDomainListStatusUpdating, awstypes.FirewallDomainListStatusImporting),
 		Target:  enum.Slice(awstypes.FirewallDomainListStatusComplete, awstypes.FirewallDomainListStatusCompleteImportFailed),
 		Refresh: statusFirewallDomainList(ctx, conn, id),
@@ -272,7 +272,7 @@ func waitFirewallDomainListUpdated(ctx context.Context, conn *route53resolver.Cl
 }
 
 func waitFirewallDomainListDeleted(ctx context.Context, conn *route53resolver.Client, id string) (*awstypes.FirewallDomainList, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.FirewallDomainListStatusDeleting),
 		Target:  []string{},
 		Refresh: statusFirewallDomainList(ctx, conn, id),
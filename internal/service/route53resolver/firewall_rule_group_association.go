@@ -234,7 +234,7 @@ const (
 )
 
 func waitFirewallRuleGroupAssociationCreated(ctx context.Context, conn *route53resolver.Client, id string) (*awstypes.FirewallRuleGroupAssociation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.FirewallRuleGroupAssociationStatusUpdating),
 		Target:  enum.Slice(awstypes.FirewallRuleGroupAssociationStatusComplete),
 		Refresh: statusFirewallRuleGroupAssociation(ctx, conn, id),
@@ -253,7 +253,7 @@ func waitFirewallRuleGroupAssociationCreated(ctx context.Context, conn *route53r
 }
 
 func waitFirewallRuleGroupAssociationUpdated(ctx context.Context, conn *route53resolver.Client, id string) (*awstypes.FirewallRuleGroupAssociation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.FirewallRuleGroupAssociationStatusUpdating),
 		Target:  enum.Slice(awstypes.FirewallRuleGroupAssociationStatusComplete),
 		Refresh: statusFirewallRuleGroupAssociation(ctx, conn, id),
@@ -272,7 +272,7 @@ func waitFirewallRuleGroupAssociationUpdated(ctx context.Context, conn *route53r
 }
 
 func waitFirewallRuleGroupAssociationDeleted(ctx context.Context, conn *route53resolver.Client, id string) (*awstypes.FirewallRuleGroupAssociation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.FirewallRuleGroupAssociationStatusDeleting),
 		Target:  []string{},
 		Refresh: statusFirewallRuleGroupAssociation(ctx, conn, id),
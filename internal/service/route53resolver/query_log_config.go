@@ -197,7 +197,7 @@ const (
 )
 
 func waitQueryLogConfigCreated(ctx context.Context, conn *route53resolver.Client, id string) (*awstypes.ResolverQueryLogConfig, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResolverQueryLogConfigStatusCreating),
 		Target:  enum.Slice(awstypes.ResolverQueryLogConfigStatusCreated),
 		Refresh: statusQueryLogConfig(ctx, conn, id),
@@ -214,7 +214,7 @@ func waitQueryLogConfigCreated(ctx context.Context, conn *route53resolver.Client
 }
 
 func waitQueryLogConfigDeleted(ctx context.Context, conn *route53resolver.Client, id string) (*awstypes.ResolverQueryLogConfig, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResolverQueryLogConfigStatusDeleting),
 		Target:  []string{},
 		Refresh: statusQueryLogConfig(ctx, conn, id),
@@ -167,7 +167,7 @@ const (
 )
 
 func waitQueryLogConfigAssociationCreated(ctx context.Context, conn *route53resolver.Client, id string) (*awstypes.ResolverQueryLogConfigAssociation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResolverQueryLogConfigAssociationStatusCreating),
 		Target:  enum.Slice(awstypes.ResolverQueryLogConfigAssociationStatusActive),
 		Refresh: statusQueryLogConfigAssociation(ctx, conn, id),
@@ -188,7 +188,7 @@ func waitQueryLogConfigAssociationCreated(ctx context.Context, conn *route53reso
 }
 
 func waitQueryLogConfigAssociationDeleted(ctx context.Context, conn *route53resolver.Client, id string) (*awstypes.ResolverQueryLogConfigAssociation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResolverQueryLogConfigAssociationStatusDeleting),
 		Target:  []string{},
 		Refresh: statusQueryLogConfigAssociation(ctx, conn, id),
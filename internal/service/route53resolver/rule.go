@@ -305,7 +305,7 @@ func statusRule(ctx context.Context, conn *route53resolver.Client, id string) re
 }
 
 func waitRuleCreated(ctx context.Context, conn *route53resolver.Client, id string, timeout time.Duration) (*awstypes.ResolverRule, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:  enum.Slice(awstypes.ResolverRuleStatusComplete),
 		Refresh: statusRule(ctx, conn, id),
 		Timeout: timeout,
@@ -325,7 +325,7 @@ func waitRuleCreated(ctx context.Context, conn *route53resolver.Client, id strin
 }
 
 func waitRuleUpdated(ctx context.Context, conn *route53resolver.Client, id string, timeout time.Duration) (*awstypes.ResolverRule, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResolverRuleStatusUpdating),
 		Target:  enum.Slice(awstypes.ResolverRuleStatusComplete),
 		Refresh: statusRule(ctx, conn, id),
@@ -346,7 +346,7 @@ func waitRuleUpdated(ctx context.Context, conn *route53resolver.Client, id strin
 }
 
 func waitRuleDeleted(ctx context.Context, conn *route53resolver.Client, id string, timeout time.Duration) (*awstypes.ResolverRule, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResolverRuleStatusDeleting),
 		Target:  []string{},
 		Refresh: statusRule(ctx, conn, id),
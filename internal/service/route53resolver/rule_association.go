@@ -181,7 +181,7 @@ func statusRuleAssociation(ctx context.Context, conn *route53resolver.Client, id
 }
 
 func waitRuleAssociationCreated(ctx context.Context, conn *route53resolver.Client, id string, timeout time.Duration) (*awstypes.ResolverRuleAssociation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.ResolverRuleAssociationStatusCreating),
 		Target:     enum.Slice(awstypes.ResolverRuleAssociationStatusComplete),
 		Refresh:    statusRuleAssociation(ctx, conn, id),
@@ -202,7 +202,7 @@ func waitRuleAssociationCreated(ctx context.Context, conn *route53resolver.Clien
 }
 
 func waitRuleAssociationDeleted(ctx context.Context, conn *route53resolver.Client, id string, timeout time.Duration) (*awstypes.ResolverRuleAssociation, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.ResolverRuleAssociationStatusDeleting),
 		Target:     []string{},
 		Refresh:    statusRuleAssociation(ctx, conn, id),
@@ -49,6 +49,7 @@ const (
 )
 
 // @SDKResource("aws_s3_bucket", name="Bucket")
+// @IdentityAttribute("bucket")
 // @Tags(identifierAttribute="bucket", resourceType="Bucket")
 // @Testing(importIgnore="force_destroy")
 func resourceBucket() *schema.Resource {
@@ -1652,6 +1653,23 @@ func findBucketRegion(ctx context.Context, awsClient *conns.AWSClient, bucket st
 	return region, nil
 }
 
+func findBuckets(ctx context.Context, conn *s3.Client, input *s3.ListBucketsInput) ([]types.Bucket, error) {
+	var output []types.Bucket
+
+	pages := s3.NewListBucketsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Buckets...)
+	}
+
+	return output, nil
+}
+
 func retryWhenNoSuchBucketError[T any](ctx context.Context, timeout time.Duration, f func() (T, error)) (T, error) {
 	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (any, error) {
 		return f()
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	tflist "github.com/hashicorp/terraform-provider-aws/internal/list"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_s3_bucket", name="Bucket")
+func newBucketListResource(_ context.Context) (list.ListResourceWithConfigure, error) {
+	return &bucketListResource{}, nil
+}
+
+type bucketListResource struct {
+	tflist.ListResourceWithSDKv2Resource
+}
+
+func (l *bucketListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	c := l.Meta()
+	conn := c.S3Client(ctx)
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		// Only list buckets in the provider's configured Region.
+		input := s3.ListBucketsInput{
+			BucketRegion: aws.String(c.Region(ctx)),
+		}
+		output, err := findBuckets(ctx, conn, &input)
+
+		if err != nil {
+			var result list.ListResult
+			result.Diagnostics.AddError("listing S3 Buckets", err.Error())
+			yield(result)
+			return
+		}
+
+		for _, v := range output {
+			bucket := aws.ToString(v.Name)
+			d := l.ResourceData()
+			d.SetId(bucket)
+			d.Set(names.AttrBucket, bucket)
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = bucket
+			if !l.SetResult(ctx, request, d, &result) {
+				continue
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
@@ -87,6 +87,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 	}
 }
 
+func (p *servicePackage) SDKListResources(ctx context.Context) []*types.ServicePackageSDKListResource {
+	return []*types.ServicePackageSDKListResource{
+		{
+			Factory:  newBucketListResource,
+			TypeName: "aws_s3_bucket",
+			Name:     "Bucket",
+		},
+	}
+}
+
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
 		{
@@ -97,6 +107,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrBucket,
 				ResourceType:        "Bucket",
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				IdentityAttribute: names.AttrBucket,
+			},
 		},
 		{
 			Factory:  resourceBucketAccelerateConfiguration,
@@ -346,7 +346,7 @@ func waitMultiRegionAccessPointRequestSucceeded(ctx context.Context, conn *s3con
 		asyncOperationRequestStatusFailed    = "FAILED"
 		asyncOperationRequestStatusSucceeded = "SUCCEEDED"
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Target:     []string{asyncOperationRequestStatusSucceeded},
 		Timeout:    timeout,
 		Refresh:    statusMultiRegionAccessPointRequest(ctx, conn, accountID, requestTokenARN),
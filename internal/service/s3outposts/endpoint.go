@@ -271,7 +271,7 @@ func waitEndpointStatusCreated(ctx context.Context, conn *s3outposts.Client, arn
 	const (
 		timeout = 20 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.EndpointStatusPending),
 		Target:  enum.Slice(awstypes.EndpointStatusAvailable),
 		Refresh: statusEndpoint(ctx, conn, arn),
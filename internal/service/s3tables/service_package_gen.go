@@ -34,6 +34,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceTableBucket,
 			TypeName: "aws_s3tables_table_bucket",
 			Name:     "Table Bucket",
+			Identity: &types.ServicePackageResourceIdentity{
+				ARN: true,
+			},
 		},
 		{
 			Factory:  newResourceTableBucketPolicy,
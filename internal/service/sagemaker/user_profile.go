@@ -1127,7 +1127,7 @@ func waitUserProfileInService(ctx context.Context, conn *sagemaker.Client, domai
 	const (
 		timeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.UserProfileStatusPending, awstypes.UserProfileStatusUpdating),
 		Target:  enum.Slice(awstypes.UserProfileStatusInService),
 		Refresh: statusUserProfile(ctx, conn, domainID, userProfileName),
@@ -1149,7 +1149,7 @@ func waitUserProfileDeleted(ctx context.Context, conn *sagemaker.Client, domainI
 	const (
 		timeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.UserProfileStatusDeleting),
 		Target:  []string{},
 		Refresh: statusUserProfile(ctx, conn, domainID, userProfileName),
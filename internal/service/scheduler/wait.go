@@ -8,11 +8,11 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitScheduleGroupActive(ctx context.Context, conn *scheduler.Client, name string, timeout time.Duration) (*scheduler.GetScheduleGroupOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{},
 		Target:                    []string{scheduleGroupStatusActive},
 		Refresh:                   statusScheduleGroup(ctx, conn, name),
@@ -30,7 +30,7 @@ func waitScheduleGroupActive(ctx context.Context, conn *scheduler.Client, name s
 }
 
 func waitScheduleGroupDeleted(ctx context.Context, conn *scheduler.Client, name string, timeout time.Duration) (*scheduler.GetScheduleGroupOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{scheduleGroupStatusDeleting, scheduleGroupStatusActive},
 		Target:  []string{},
 		Refresh: statusScheduleGroup(ctx, conn, name),
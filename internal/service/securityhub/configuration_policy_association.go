@@ -182,7 +182,7 @@ func statusConfigurationPolicyAssociation(ctx context.Context, conn *securityhub
 }
 
 func waitConfigurationPolicyAssociationSucceeded(ctx context.Context, conn *securityhub.Client, id string, timeout time.Duration) (*securityhub.GetConfigurationPolicyAssociationOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ConfigurationPolicyAssociationStatusPending),
 		Target:  enum.Slice(types.ConfigurationPolicyAssociationStatusSuccess),
 		Refresh: statusConfigurationPolicyAssociation(ctx, conn, id),
@@ -190,7 +190,7 @@ func waitAdminAccountCreated(ctx context.Context, conn *securityhub.Client, admi
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{},
 		Target:  enum.Slice(types.AdminStatusEnabled),
 		Refresh: statusAdminAccount(ctx, conn, adminAccountID),
@@ -207,7 +207,7 @@ func waitAdminAccountCreated(ctx context.Context, conn *securityhub.Client, admi
 }
 
 func waitAdminAccountDeleted(ctx context.Context, conn *securityhub.Client, adminAccountID string) (*types.AdminAccount, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.AdminStatusDisableInProgress),
 		Target:  []string{},
 		Refresh: statusAdminAccount(ctx, conn, adminAccountID),
@@ -221,7 +221,7 @@ func statusOrganizationConfiguration(ctx context.Context, conn *securityhub.Clie
 }
 
 func waitOrganizationConfigurationEnabled(ctx context.Context, conn *securityhub.Client, timeout time.Duration) (*securityhub.DescribeOrganizationConfigurationOutput, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(types.OrganizationConfigurationStatusPending),
 		Target:                    enum.Slice(types.OrganizationConfigurationStatusEnabled),
 		Refresh:                   statusOrganizationConfiguration(ctx, conn),
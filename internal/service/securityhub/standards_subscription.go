@@ -207,7 +207,7 @@ func waitStandardsSubscriptionCreated(ctx context.Context, conn *securityhub.Cli
 	const (
 		timeout = 3 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.StandardsStatusPending),
 		Target:  enum.Slice(types.StandardsStatusReady, types.StandardsStatusIncomplete),
 		Refresh: statusStandardsSubscriptionCreate(ctx, conn, arn),
@@ -231,7 +231,7 @@ func waitStandardsSubscriptionDeleted(ctx context.Context, conn *securityhub.Cli
 	const (
 		timeout = 3 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.StandardsStatusDeleting),
 		Target:  []string{},
 		Refresh: statusStandardsSubscriptionDelete(ctx, conn, arn),
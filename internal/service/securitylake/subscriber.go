@@ -449,7 +449,7 @@ func statusSubscriber(ctx context.Context, conn *securitylake.Client, id string)
 }
 
 func waitSubscriberCreated(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SubscriberStatusPending),
 		Target:  enum.Slice(awstypes.SubscriberStatusActive, awstypes.SubscriberStatusReady),
 		Refresh: statusSubscriber(ctx, conn, id),
@@ -466,7 +466,7 @@ func waitSubscriberCreated(ctx context.Context, conn *securitylake.Client, id st
 }
 
 func waitSubscriberUpdated(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SubscriberStatusPending),
 		Target:  enum.Slice(awstypes.SubscriberStatusActive, awstypes.SubscriberStatusReady),
 		Refresh: statusSubscriber(ctx, conn, id),
@@ -483,7 +483,7 @@ func waitSubscriberUpdated(ctx context.Context, conn *securitylake.Client, id st
 }
 
 func waitSubscriberDeleted(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.SubscriberStatusActive, awstypes.SubscriberStatusReady, awstypes.SubscriberStatusDeactivated),
 		Target:  []string{},
 		Refresh: statusSubscriber(ctx, conn, id),
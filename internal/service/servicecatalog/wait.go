@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -76,7 +77,7 @@ const (
 )
 
 func waitProductReady(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, productID string, timeout time.Duration) (*servicecatalog.DescribeProductAsAdminOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.StatusCreating, statusNotFound, statusUnavailable),
 		Target:                    enum.Slice(awstypes.StatusAvailable, statusCreated),
 		Refresh:                   statusProduct(ctx, conn, acceptLanguage, productID),
@@ -96,7 +97,7 @@ func waitProductReady(ctx context.Context, conn *servicecatalog.Client, acceptLa
 }
 
 func waitProductDeleted(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, productID string, timeout time.Duration) (*servicecatalog.DescribeProductAsAdminOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.StatusCreating, awstypes.StatusAvailable, statusCreated, statusUnavailable),
 		Target:  []string{statusNotFound},
 		Refresh: statusProduct(ctx, conn, acceptLanguage, productID),
@@ -117,7 +118,7 @@ func waitProductDeleted(ctx context.Context, conn *servicecatalog.Client, accept
 }
 
 func waitTagOptionReady(ctx context.Context, conn *servicecatalog.Client, id string, timeout time.Duration) (*awstypes.TagOptionDetail, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{statusNotFound, statusUnavailable},
 		Target:  enum.Slice(awstypes.StatusAvailable),
 		Refresh: statusTagOption(ctx, conn, id),
@@ -134,7 +135,7 @@ func waitTagOptionReady(ctx context.Context, conn *servicecatalog.Client, id str
 }
 
 func waitTagOptionDeleted(ctx context.Context, conn *servicecatalog.Client, id string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.StatusAvailable),
 		Target:  []string{statusNotFound, statusUnavailable},
 		Refresh: statusTagOption(ctx, conn, id),
@@ -157,7 +158,7 @@ func waitPortfolioShareReady(ctx context.Context, conn *servicecatalog.Client, p
 		targets = append(targets, string(awstypes.ShareStatusInProgress))
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ShareStatusNotStarted, awstypes.ShareStatusInProgress, statusNotFound, statusUnavailable),
 		Target:  targets,
 		Refresh: statusPortfolioShare(ctx, conn, portfolioID, shareType, principalID),
@@ -180,7 +181,7 @@ func waitPortfolioShareCreatedWithToken(ctx context.Context, conn *servicecatalo
 		targets = append(targets, string(awstypes.ShareStatusInProgress))
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ShareStatusNotStarted, awstypes.ShareStatusInProgress, statusNotFound, statusUnavailable),
 		Target:  targets,
 		Refresh: statusPortfolioShareWithToken(ctx, conn, token),
@@ -197,7 +198,7 @@ func waitPortfolioShareCreatedWithToken(ctx context.Context, conn *servicecatalo
 }
 
 func waitPortfolioShareDeleted(ctx context.Context, conn *servicecatalog.Client, portfolioID, shareType, principalID string, timeout time.Duration) (*awstypes.PortfolioShareDetail, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ShareStatusNotStarted, awstypes.ShareStatusInProgress, awstypes.ShareStatusCompleted, statusUnavailable),
 		Target:  []string{},
 		Refresh: statusPortfolioShare(ctx, conn, portfolioID, shareType, principalID),
@@ -214,7 +215,7 @@ func waitPortfolioShareDeleted(ctx context.Context, conn *servicecatalog.Client,
 }
 
 func waitPortfolioShareDeletedWithToken(ctx context.Context, conn *servicecatalog.Client, token string, timeout time.Duration) (*servicecatalog.DescribePortfolioShareStatusOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ShareStatusNotStarted, awstypes.ShareStatusInProgress, statusNotFound, statusUnavailable),
 		Target:  enum.Slice(awstypes.ShareStatusCompleted),
 		Refresh: statusPortfolioShareWithToken(ctx, conn, token),
@@ -231,7 +232,7 @@ func waitPortfolioShareDeletedWithToken(ctx context.Context, conn *servicecatalo
 }
 
 func waitOrganizationsAccessStable(ctx context.Context, conn *servicecatalog.Client, timeout time.Duration) (string, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.AccessStatusUnderChange, statusNotFound, statusUnavailable),
 		Target:  enum.Slice(awstypes.AccessStatusEnabled, awstypes.AccessStatusDisabled),
 		Refresh: statusOrganizationsAccess(ctx, conn),
@@ -248,7 +249,7 @@ func waitOrganizationsAccessStable(ctx context.Context, conn *servicecatalog.Cli
 }
 
 func waitConstraintReady(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, id string, timeout time.Duration) (*servicecatalog.DescribeConstraintOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(statusNotFound, awstypes.StatusCreating, statusUnavailable),
 		Target:                    enum.Slice(awstypes.StatusAvailable),
 		Refresh:                   statusConstraint(ctx, conn, acceptLanguage, id),
@@ -268,7 +269,7 @@ func waitConstraintReady(ctx context.Context, conn *servicecatalog.Client, accep
 }
 
 func waitConstraintDeleted(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, id string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.StatusAvailable, awstypes.StatusCreating),
 		Target:  []string{statusNotFound},
 		Refresh: statusConstraint(ctx, conn, acceptLanguage, id),
@@ -281,7 +282,7 @@ func waitConstraintDeleted(ctx context.Context, conn *servicecatalog.Client, acc
 }
 
 func waitProductPortfolioAssociationReady(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, portfolioID, productID string, timeout time.Duration) (*awstypes.PortfolioDetail, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{statusNotFound, statusUnavailable},
 		Target:                    enum.Slice(awstypes.StatusAvailable),
 		Refresh:                   statusProductPortfolioAssociation(ctx, conn, acceptLanguage, portfolioID, productID),
@@ -301,7 +302,7 @@ func waitProductPortfolioAssociationReady(ctx context.Context, conn *servicecata
 }
 
 func waitProductPortfolioAssociationDeleted(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, portfolioID, productID string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.StatusAvailable),
 		Target:  []string{statusNotFound, statusUnavailable},
 		Refresh: statusProductPortfolioAssociation(ctx, conn, acceptLanguage, portfolioID, productID),
@@ -314,7 +315,7 @@ func waitProductPortfolioAssociationDeleted(ctx context.Context, conn *serviceca
 }
 
 func waitServiceActionReady(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, id string, timeout time.Duration) (*awstypes.ServiceActionDetail, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{statusNotFound, statusUnavailable},
 		Target:  enum.Slice(awstypes.StatusAvailable),
 		Refresh: statusServiceAction(ctx, conn, acceptLanguage, id),
@@ -331,7 +332,7 @@ func waitServiceActionReady(ctx context.Context, conn *servicecatalog.Client, ac
 }
 
 func waitServiceActionDeleted(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, id string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.StatusAvailable),
 		Target:  []string{statusNotFound, statusUnavailable},
 		Refresh: statusServiceAction(ctx, conn, acceptLanguage, id),
@@ -348,7 +349,7 @@ func waitServiceActionDeleted(ctx context.Context, conn *servicecatalog.Client,
 }
 
 func waitBudgetResourceAssociationReady(ctx context.Context, conn *servicecatalog.Client, budgetName, resourceID string, timeout time.Duration) (*awstypes.BudgetDetail, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{statusNotFound, statusUnavailable},
 		Target:  enum.Slice(awstypes.StatusAvailable),
 		Refresh: statusBudgetResourceAssociation(ctx, conn, budgetName, resourceID),
@@ -365,7 +366,7 @@ func waitBudgetResourceAssociationReady(ctx context.Context, conn *servicecatalo
 }
 
 func waitBudgetResourceAssociationDeleted(ctx context.Context, conn *servicecatalog.Client, budgetName, resourceID string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.StatusAvailable),
 		Target:  []string{statusNotFound, statusUnavailable},
 		Refresh: statusBudgetResourceAssociation(ctx, conn, budgetName, resourceID),
@@ -378,7 +379,7 @@ func waitBudgetResourceAssociationDeleted(ctx context.Context, conn *servicecata
 }
 
 func waitTagOptionResourceAssociationReady(ctx context.Context, conn *servicecatalog.Client, tagOptionID, resourceID string, timeout time.Duration) (*awstypes.ResourceDetail, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{statusNotFound, statusUnavailable},
 		Target:  enum.Slice(awstypes.StatusAvailable),
 		Refresh: statusTagOptionResourceAssociation(ctx, conn, tagOptionID, resourceID),
@@ -395,7 +396,7 @@ func waitTagOptionResourceAssociationReady(ctx context.Context, conn *servicecat
 }
 
 func waitTagOptionResourceAssociationDeleted(ctx context.Context, conn *servicecatalog.Client, tagOptionID, resourceID string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.StatusAvailable),
 		Target:  []string{statusNotFound, statusUnavailable},
 		Refresh: statusTagOptionResourceAssociation(ctx, conn, tagOptionID, resourceID),
@@ -408,7 +409,7 @@ func waitTagOptionResourceAssociationDeleted(ctx context.Context, conn *servicec
 }
 
 func waitProvisioningArtifactReady(ctx context.Context, conn *servicecatalog.Client, id, productID string, timeout time.Duration) (*servicecatalog.DescribeProvisioningArtifactOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.StatusCreating, statusNotFound, statusUnavailable),
 		Target:                    enum.Slice(awstypes.StatusAvailable, statusCreated),
 		Refresh:                   statusProvisioningArtifact(ctx, conn, id, productID),
@@ -428,7 +429,7 @@ func waitProvisioningArtifactReady(ctx context.Context, conn *servicecatalog.Cli
 }
 
 func waitProvisioningArtifactDeleted(ctx context.Context, conn *servicecatalog.Client, id, productID string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.StatusCreating, awstypes.StatusAvailable, statusCreated, statusUnavailable),
 		Target:  []string{statusNotFound},
 		Refresh: statusProvisioningArtifact(ctx, conn, id, productID),
@@ -445,7 +446,7 @@ func waitProvisioningArtifactDeleted(ctx context.Context, conn *servicecatalog.C
 }
 
 func waitLaunchPathsReady(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, productID string, timeout time.Duration) ([]awstypes.LaunchPathSummary, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{statusNotFound},
 		Target:                    enum.Slice(awstypes.StatusAvailable),
 		Refresh:                   statusLaunchPaths(ctx, conn, acceptLanguage, productID),
@@ -465,7 +466,7 @@ func waitLaunchPathsReady(ctx context.Context, conn *servicecatalog.Client, acce
 }
 
 func waitProvisionedProductReady(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, id, name string, timeout time.Duration) (*servicecatalog.DescribeProvisionedProductOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.ProvisionedProductStatusUnderChange, awstypes.ProvisionedProductStatusPlanInProgress),
 		Target:                    enum.Slice(awstypes.ProvisionedProductStatusAvailable),
 		Refresh:                   statusProvisionedProduct(ctx, conn, acceptLanguage, id, name),
@@ -496,7 +497,7 @@ func waitProvisionedProductReady(ctx context.Context, conn *servicecatalog.Clien
 }
 
 func waitProvisionedProductTerminated(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, id, name string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ProvisionedProductStatusAvailable, awstypes.ProvisionedProductStatusUnderChange),
 		Target:  []string{},
 		Refresh: statusProvisionedProduct(ctx, conn, acceptLanguage, id, name),
@@ -509,7 +510,7 @@ func waitProvisionedProductTerminated(ctx context.Context, conn *servicecatalog.
 }
 
 func waitPortfolioConstraintsReady(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, portfolioID, productID string, timeout time.Duration) ([]awstypes.ConstraintDetail, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{statusNotFound},
 		Target:  enum.Slice(awstypes.StatusAvailable),
 		Refresh: statusPortfolioConstraints(ctx, conn, acceptLanguage, portfolioID, productID),
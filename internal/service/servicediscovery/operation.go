@@ -21,7 +21,7 @@ func waitOperationSucceeded(ctx context.Context, conn *servicediscovery.Client,
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.OperationStatusSubmitted, awstypes.OperationStatusPending),
 		Target:  enum.Slice(awstypes.OperationStatusSuccess),
 		Refresh: statusOperation(ctx, conn, operationID),
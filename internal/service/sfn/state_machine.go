@@ -451,7 +451,7 @@ func statusStateMachine(ctx context.Context, conn *sfn.Client, stateMachineArn s
 }
 
 func waitStateMachineDeleted(ctx context.Context, conn *sfn.Client, stateMachineArn string, timeout time.Duration) (*sfn.DescribeStateMachineOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.StateMachineStatusActive, awstypes.StateMachineStatusDeleting),
 		Target:  []string{},
 		Refresh: statusStateMachine(ctx, conn, stateMachineArn),
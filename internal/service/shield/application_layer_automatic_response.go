@@ -285,7 +285,7 @@ func statusApplicationLayerAutomaticResponse(ctx context.Context, conn *shield.C
 }
 
 func waitApplicationLayerAutomaticResponseEnabled(ctx context.Context, conn *shield.Client, resourceARN string, timeout time.Duration) (*awstypes.ApplicationLayerAutomaticResponseConfiguration, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{},
 		Target:                    enum.Slice(awstypes.ApplicationLayerAutomaticResponseStatusEnabled),
 		Refresh:                   statusApplicationLayerAutomaticResponse(ctx, conn, resourceARN),
@@ -304,7 +304,7 @@ func waitApplicationLayerAutomaticResponseEnabled(ctx context.Context, conn *shi
 }
 
 func waitApplicationLayerAutomaticResponseDeleted(ctx context.Context, conn *shield.Client, resourceARN string, timeout time.Duration) (*awstypes.ApplicationLayerAutomaticResponseConfiguration, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ApplicationLayerAutomaticResponseStatusEnabled),
 		Target:  []string{},
 		Refresh: statusApplicationLayerAutomaticResponse(ctx, conn, resourceARN),
@@ -589,7 +589,7 @@ func statusQueueAttributeState(ctx context.Context, conn *sqs.Client, url string
 }
 
 func waitQueueAttributesPropagated(ctx context.Context, conn *sqs.Client, url string, expected map[types.QueueAttributeName]string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{queueAttributeStateNotEqual},
 		Target:                    []string{queueAttributeStateEqual},
 		Refresh:                   statusQueueAttributeState(ctx, conn, url, expected),
@@ -605,7 +605,7 @@ func waitQueueAttributesPropagated(ctx context.Context, conn *sqs.Client, url st
 }
 
 func waitQueueDeleted(ctx context.Context, conn *sqs.Client, url string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{queueStateExists},
 		Target:                    []string{},
 		Refresh:                   statusQueueState(ctx, conn, url),
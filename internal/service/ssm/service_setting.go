@@ -167,7 +167,7 @@ func statusServiceSetting(ctx context.Context, conn *ssm.Client, id string) retr
 }
 
 func waitServiceSettingUpdated(ctx context.Context, conn *ssm.Client, id string, timeout time.Duration) (*awstypes.ServiceSetting, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{"PendingUpdate", ""},
 		Target:  []string{"Customized", "Default"},
 		Refresh: statusServiceSetting(ctx, conn, id),
@@ -184,7 +184,7 @@ func waitServiceSettingUpdated(ctx context.Context, conn *ssm.Client, id string,
 }
 
 func waitServiceSettingReset(ctx context.Context, conn *ssm.Client, id string, timeout time.Duration) (*awstypes.ServiceSetting, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{"Customized", "PendingUpdate", ""},
 		Target:  []string{"Default"},
 		Refresh: statusServiceSetting(ctx, conn, id),
@@ -315,7 +315,7 @@ func (r *resourceConfigurationManager) ImportState(ctx context.Context, req reso
 }
 
 func waitConfigurationManagerCreated(ctx context.Context, conn *ssmquicksetup.Client, id string, timeout time.Duration) (*ssmquicksetup.GetConfigurationManagerOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.StatusInitializing, awstypes.StatusDeploying),
 		Target:  enum.Slice(awstypes.StatusSucceeded),
 		Refresh: statusConfigurationManager(ctx, conn, id),
@@ -331,7 +331,7 @@ func waitConfigurationManagerCreated(ctx context.Context, conn *ssmquicksetup.Cl
 }
 
 func waitConfigurationManagerUpdated(ctx context.Context, conn *ssmquicksetup.Client, id string, timeout time.Duration) (*ssmquicksetup.GetConfigurationManagerOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.StatusInitializing, awstypes.StatusDeploying),
 		Target:  enum.Slice(awstypes.StatusSucceeded),
 		Refresh: statusConfigurationManager(ctx, conn, id),
@@ -347,7 +347,7 @@ func waitConfigurationManagerUpdated(ctx context.Context, conn *ssmquicksetup.Cl
 }
 
 func waitConfigurationManagerDeleted(ctx context.Context, conn *ssmquicksetup.Client, id string, timeout time.Duration) (*ssmquicksetup.GetConfigurationManagerOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.StatusDeploying, awstypes.StatusStopping, awstypes.StatusDeleting),
 		Target:  []string{},
 		Refresh: statusConfigurationManager(ctx, conn, id),
@@ -371,7 +371,7 @@ func statusAccountAssignmentDeletion(ctx context.Context, conn *ssoadmin.Client,
 }
 
 func waitAccountAssignmentCreated(ctx context.Context, conn *ssoadmin.Client, instanceARN, requestID string, timeout time.Duration) (*awstypes.AccountAssignmentOperationStatus, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.StatusValuesInProgress),
 		Target:     enum.Slice(awstypes.StatusValuesSucceeded),
 		Refresh:    statusAccountAssignmentCreation(ctx, conn, instanceARN, requestID),
@@ -392,7 +392,7 @@ func waitAccountAssignmentCreated(ctx context.Context, conn *ssoadmin.Client, in
 }
 
 func waitAccountAssignmentDeleted(ctx context.Context, conn *ssoadmin.Client, instanceArn, requestID string, timeout time.Duration) (*awstypes.AccountAssignmentOperationStatus, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    enum.Slice(awstypes.StatusValuesInProgress),
 		Target:     enum.Slice(awstypes.StatusValuesSucceeded),
 		Refresh:    statusAccountAssignmentDeletion(ctx, conn, instanceArn, requestID),
@@ -350,7 +350,7 @@ func statusPermissionSetProvisioning(ctx context.Context, conn *ssoadmin.Client,
 }
 
 func waitPermissionSetProvisioned(ctx context.Context, conn *ssoadmin.Client, instanceARN, requestID string, timeout time.Duration) (*awstypes.PermissionSetProvisioningStatus, error) {
-	stateConf := retry.StateChangeConf{
+	stateConf := tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.StatusValuesInProgress),
 		Target:  enum.Slice(awstypes.StatusValuesSucceeded),
 		Refresh: statusPermissionSetProvisioning(ctx, conn, instanceARN, requestID),
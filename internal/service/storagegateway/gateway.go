@@ -864,7 +864,7 @@ func statusGatewayJoinDomain(ctx context.Context, conn *storagegateway.Client, g
 }
 
 func waitGatewayConnected(ctx context.Context, conn *storagegateway.Client, gatewayARN string, timeout time.Duration) (*storagegateway.DescribeGatewayInformationOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{gatewayStatusNotConnected},
 		Target:                    []string{gatewayStatusConnected},
 		Refresh:                   statusGatewayConnected(ctx, conn, gatewayARN),
@@ -886,7 +886,7 @@ func waitGatewayJoinDomainJoined(ctx context.Context, conn *storagegateway.Clien
 	const (
 		timeout = 5 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ActiveDirectoryStatusJoining),
 		Target:  enum.Slice(awstypes.ActiveDirectoryStatusJoined),
 		Refresh: statusGatewayJoinDomain(ctx, conn, gatewayARN),
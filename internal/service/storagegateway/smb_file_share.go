@@ -521,7 +521,7 @@ func statusSMBFileShare(ctx context.Context, conn *storagegateway.Client, arn st
 }
 
 func waitSMBFileShareCreated(ctx context.Context, conn *storagegateway.Client, arn string, timeout time.Duration) (*awstypes.SMBFileShareInfo, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{fileShareStatusCreating},
 		Target:  []string{fileShareStatusAvailable},
 		Refresh: statusSMBFileShare(ctx, conn, arn),
@@ -539,7 +539,7 @@ func waitSMBFileShareCreated(ctx context.Context, conn *storagegateway.Client, a
 }
 
 func waitSMBFileShareUpdated(ctx context.Context, conn *storagegateway.Client, arn string, timeout time.Duration) (*awstypes.SMBFileShareInfo, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: []string{fileShareStatusUpdating},
 		Target:  []string{fileShareStatusAvailable},
 		Refresh: statusSMBFileShare(ctx, conn, arn),
@@ -557,7 +557,7 @@ func waitSMBFileShareUpdated(ctx context.Context, conn *storagegateway.Client, a
 }
 
 func waitSMBFileShareDeleted(ctx context.Context, conn *storagegateway.Client, arn string, timeout time.Duration) (*awstypes.SMBFileShareInfo, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:        []string{fileShareStatusAvailable, fileShareStatusDeleting, fileShareStatusForceDeleting},
 		Target:         []string{},
 		Refresh:        statusSMBFileShare(ctx, conn, arn),
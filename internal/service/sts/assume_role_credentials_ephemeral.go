@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameAssumeRoleCredentials = "Ephemeral Resource Assume Role Credentials"
+)
+
+// @EphemeralResource(aws_sts_assume_role_credentials, name="Assume Role Credentials")
+func newEphemeralAssumeRoleCredentials(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralAssumeRoleCredentials{}, nil
+}
+
+type ephemeralAssumeRoleCredentials struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralAssumeRoleCredentials) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"assumed_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Computed:   true,
+			},
+			"assumed_role_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(900, 43200),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrExternalID: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrPolicy: schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Optional:   true,
+			},
+			"policy_arns": schema.SetAttribute{
+				CustomType: fwtypes.SetOfARNType,
+				Optional:   true,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"role_session_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"session_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"source_identity": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralAssumeRoleCredentials) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epAssumeRoleCredentialsData
+	conn := e.Meta().STSClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RoleSessionName.IsNull() || data.RoleSessionName.ValueString() == "" {
+		data.RoleSessionName = types.StringValue(sdkid.PrefixedUniqueId("terraform-"))
+	}
+
+	input := sts.AssumeRoleInput{
+		DurationSeconds: fwflex.Int32FromFrameworkInt64(ctx, data.DurationSeconds),
+		ExternalId:      fwflex.StringFromFramework(ctx, data.ExternalID),
+		Policy:          fwflex.StringFromFramework(ctx, data.Policy),
+		RoleArn:         fwflex.StringFromFramework(ctx, data.RoleARN),
+		RoleSessionName: fwflex.StringFromFramework(ctx, data.RoleSessionName),
+		SourceIdentity:  fwflex.StringFromFramework(ctx, data.SourceIdentity),
+	}
+	for _, v := range fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyARNs) {
+		input.PolicyArns = append(input.PolicyArns, awstypes.PolicyDescriptorType{
+			Arn: aws.String(v),
+		})
+	}
+
+	output, err := conn.AssumeRole(ctx, &input)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.STS, create.ErrActionOpening, ERNameAssumeRoleCredentials, data.RoleARN.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.Credentials, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if v := output.AssumedRoleUser; v != nil {
+		data.AssumedRoleARN = fwtypes.ARNValue(aws.ToString(v.Arn))
+		data.AssumedRoleID = fwflex.StringToFramework(ctx, v.AssumedRoleId)
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epAssumeRoleCredentialsData struct {
+	AccessKeyID     types.String      `tfsdk:"access_key_id"`
+	AssumedRoleARN  fwtypes.ARN       `tfsdk:"assumed_role_arn"`
+	AssumedRoleID   types.String      `tfsdk:"assumed_role_id"`
+	DurationSeconds types.Int64       `tfsdk:"duration_seconds"`
+	Expiration      timetypes.RFC3339 `tfsdk:"expiration"`
+	ExternalID      types.String      `tfsdk:"external_id"`
+	Policy          fwtypes.IAMPolicy `tfsdk:"policy"`
+	PolicyARNs      fwtypes.SetOfARN  `tfsdk:"policy_arns"`
+	RoleARN         fwtypes.ARN       `tfsdk:"role_arn"`
+	RoleSessionName types.String      `tfsdk:"role_session_name"`
+	SecretAccessKey types.String      `tfsdk:"secret_access_key"`
+	SessionToken    types.String      `tfsdk:"session_token"`
+	SourceIdentity  types.String      `tfsdk:"source_identity"`
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/synthetics"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/synthetics/types"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -24,7 +23,7 @@ const (
 )
 
 func waitCanaryReady(ctx context.Context, conn *synthetics.Client, name string) (*awstypes.Canary, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.CanaryStateUpdating, awstypes.CanaryStateCreating),
 		Target:  enum.Slice(awstypes.CanaryStateReady),
 		Refresh: statusCanaryState(ctx, conn, name),
@@ -45,7 +44,7 @@ func waitCanaryReady(ctx context.Context, conn *synthetics.Client, name string)
 }
 
 func waitCanaryStopped(ctx context.Context, conn *synthetics.Client, name string) (*awstypes.Canary, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.CanaryStateStopping,
 			awstypes.CanaryStateUpdating,
@@ -71,7 +70,7 @@ func waitCanaryStopped(ctx context.Context, conn *synthetics.Client, name string
 }
 
 func waitCanaryRunning(ctx context.Context, conn *synthetics.Client, name string) (*awstypes.Canary, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			awstypes.CanaryStateStarting,
 			awstypes.CanaryStateUpdating,
@@ -95,7 +94,7 @@ func waitCanaryRunning(ctx context.Context, conn *synthetics.Client, name string
 }
 
 func waitCanaryDeleted(ctx context.Context, conn *synthetics.Client, name string) (*awstypes.Canary, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.CanaryStateDeleting, awstypes.CanaryStateStopped),
 		Target:  []string{},
 		Refresh: statusCanaryState(ctx, conn, name),
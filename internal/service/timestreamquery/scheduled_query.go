@@ -750,7 +750,7 @@ func (r *resourceScheduledQuery) ImportState(ctx context.Context, req resource.I
 }
 
 func waitScheduledQueryCreated(ctx context.Context, conn *timestreamquery.Client, id string, timeout time.Duration) (*awstypes.ScheduledQueryDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   []string{},
 		Target:                    enum.Slice(awstypes.ScheduledQueryStateEnabled),
 		Refresh:                   statusScheduledQuery(ctx, conn, id),
@@ -768,7 +768,7 @@ func waitScheduledQueryCreated(ctx context.Context, conn *timestreamquery.Client
 }
 
 func waitScheduledQueryUpdated(ctx context.Context, conn *timestreamquery.Client, arn string, timeout time.Duration) (*awstypes.ScheduledQueryDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.ScheduledQueryStateDisabled),
 		Target:                    enum.Slice(awstypes.ScheduledQueryStateEnabled),
 		Refresh:                   statusScheduledQuery(ctx, conn, arn),
@@ -786,7 +786,7 @@ func waitScheduledQueryUpdated(ctx context.Context, conn *timestreamquery.Client
 }
 
 func waitScheduledQueryDeleted(ctx context.Context, conn *timestreamquery.Client, arn string, timeout time.Duration) (*awstypes.ScheduledQueryDescription, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ScheduledQueryStateEnabled, awstypes.ScheduledQueryStateDisabled),
 		Target:  []string{},
 		Refresh: statusScheduledQuery(ctx, conn, arn),
@@ -937,7 +937,7 @@ func statusServer(ctx context.Context, conn *transfer.Client, id string) retry.S
 }
 
 func waitServerCreated(ctx context.Context, conn *transfer.Client, id string, timeout time.Duration) (*awstypes.DescribedServer, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.StateStarting),
 		Target:  enum.Slice(awstypes.StateOnline),
 		Refresh: statusServer(ctx, conn, id),
@@ -957,7 +957,7 @@ func waitServerDeleted(ctx context.Context, conn *transfer.Client, id string) (*
 	const (
 		timeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.StateOffline, awstypes.StateOnline, awstypes.StateStarting, awstypes.StateStopping, awstypes.StateStartFailed, awstypes.StateStopFailed),
 		Target:  []string{},
 		Refresh: statusServer(ctx, conn, id),
@@ -974,7 +974,7 @@ func waitServerDeleted(ctx context.Context, conn *transfer.Client, id string) (*
 }
 
 func waitServerStarted(ctx context.Context, conn *transfer.Client, id string, timeout time.Duration) (*awstypes.DescribedServer, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.StateStarting, awstypes.StateOffline, awstypes.StateStopping),
 		Target:  enum.Slice(awstypes.StateOnline),
 		Refresh: statusServer(ctx, conn, id),
@@ -991,7 +991,7 @@ func waitServerStarted(ctx context.Context, conn *transfer.Client, id string, ti
 }
 
 func waitServerStopped(ctx context.Context, conn *transfer.Client, id string, timeout time.Duration) (*awstypes.DescribedServer, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.StateStarting, awstypes.StateOnline, awstypes.StateStopping),
 		Target:  enum.Slice(awstypes.StateOffline),
 		Refresh: statusServer(ctx, conn, id),
@@ -437,7 +437,7 @@ func statusResourceConfiguration(ctx context.Context, conn *vpclattice.Client, i
 }
 
 func waitResourceConfigurationCreated(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetResourceConfigurationOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.ResourceConfigurationStatusCreateInProgress),
 		Target:                    enum.Slice(awstypes.ResourceConfigurationStatusActive),
 		Refresh:                   statusResourceConfiguration(ctx, conn, id),
@@ -457,7 +457,7 @@ func waitResourceConfigurationCreated(ctx context.Context, conn *vpclattice.Clie
 }
 
 func waitResourceConfigurationUpdated(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetResourceConfigurationOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.ResourceConfigurationStatusUpdateInProgress),
 		Target:                    enum.Slice(awstypes.ResourceConfigurationStatusActive),
 		Refresh:                   statusResourceConfiguration(ctx, conn, id),
@@ -475,7 +475,7 @@ func waitResourceConfigurationUpdated(ctx context.Context, conn *vpclattice.Clie
 }
 
 func waitResourceConfigurationDeleted(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetResourceConfigurationOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResourceConfigurationStatusActive, awstypes.ResourceConfigurationStatusDeleteInProgress),
 		Target:  []string{},
 		Refresh: statusResourceConfiguration(ctx, conn, id),
@@ -318,7 +318,7 @@ func statusResourceGateway(ctx context.Context, conn *vpclattice.Client, id stri
 }
 
 func waitResourceGatewayActive(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetResourceGatewayOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.ResourceGatewayStatusCreateInProgress, awstypes.ResourceGatewayStatusUpdateInProgress),
 		Target:                    enum.Slice(awstypes.ResourceGatewayStatusActive),
 		Refresh:                   statusResourceGateway(ctx, conn, id),
@@ -336,7 +336,7 @@ func waitResourceGatewayActive(ctx context.Context, conn *vpclattice.Client, id
 }
 
 func waitResourceGatewayDeleted(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetResourceGatewayOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResourceGatewayStatusDeleteInProgress),
 		Target:  []string{},
 		Refresh: statusResourceGateway(ctx, conn, id),
@@ -231,7 +231,7 @@ func statusServiceNetworkServiceAssociation(ctx context.Context, conn *vpclattic
 }
 
 func waitServiceNetworkServiceAssociationCreated(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetServiceNetworkServiceAssociationOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(types.ServiceNetworkVpcAssociationStatusCreateInProgress),
 		Target:                    enum.Slice(types.ServiceNetworkVpcAssociationStatusActive),
 		Refresh:                   statusServiceNetworkServiceAssociation(ctx, conn, id),
@@ -253,7 +253,7 @@ func waitServiceNetworkServiceAssociationCreated(ctx context.Context, conn *vpcl
 }
 
 func waitServiceNetworkServiceAssociationDeleted(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetServiceNetworkServiceAssociationOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ServiceNetworkVpcAssociationStatusDeleteInProgress, types.ServiceNetworkVpcAssociationStatusActive),
 		Target:  []string{},
 		Refresh: statusServiceNetworkServiceAssociation(ctx, conn, id),
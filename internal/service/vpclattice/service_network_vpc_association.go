@@ -232,7 +232,7 @@ func statusServiceNetworkVPCAssociation(ctx context.Context, conn *vpclattice.Cl
 }
 
 func waitServiceNetworkVPCAssociationCreated(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(types.ServiceNetworkVpcAssociationStatusCreateInProgress),
 		Target:                    enum.Slice(types.ServiceNetworkVpcAssociationStatusActive),
 		Refresh:                   statusServiceNetworkVPCAssociation(ctx, conn, id),
@@ -254,7 +254,7 @@ func waitServiceNetworkVPCAssociationCreated(ctx context.Context, conn *vpclatti
 }
 
 func waitServiceNetworkVPCAssociationDeleted(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.ServiceNetworkVpcAssociationStatusDeleteInProgress, types.ServiceNetworkVpcAssociationStatusActive),
 		Target:  []string{},
 		Refresh: statusServiceNetworkVPCAssociation(ctx, conn, id),
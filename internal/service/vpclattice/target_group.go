@@ -374,7 +374,7 @@ func statusTargetGroup(ctx context.Context, conn *vpclattice.Client, id string)
 }
 
 func waitTargetGroupCreated(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetTargetGroupOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:                   enum.Slice(types.TargetGroupStatusCreateInProgress),
 		Target:                    enum.Slice(types.TargetGroupStatusActive),
 		Refresh:                   statusTargetGroup(ctx, conn, id),
@@ -396,7 +396,7 @@ func waitTargetGroupCreated(ctx context.Context, conn *vpclattice.Client, id str
 }
 
 func waitTargetGroupDeleted(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetTargetGroupOutput, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.TargetGroupStatusDeleteInProgress, types.TargetGroupStatusActive),
 		Target:  []string{},
 		Refresh: statusTargetGroup(ctx, conn, id),
@@ -12,7 +12,6 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/worklink"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -318,7 +317,7 @@ func testAccCheckFleetDisappears(ctx context.Context, resourceName string) resou
 			return err
 		}
 
-		stateConf := &retry.StateChangeConf{
+		stateConf := &tfresource.StateChangeConf{
 			Pending:    []string{"DELETING"},
 			Target:     []string{"DELETED"},
 			Refresh:    tfworklink.FleetStateRefresh(ctx, conn, rs.Primary.ID),
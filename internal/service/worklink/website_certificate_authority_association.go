@@ -135,7 +135,7 @@ func resourceWebsiteCertificateAuthorityAssociationDelete(ctx context.Context, d
 		return sdkdiag.AppendErrorf(diags, "deleting WorkLink Website Certificate Authority Association (%s): %s", d.Id(), err)
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending:    []string{"DELETING"},
 		Target:     []string{"DELETED"},
 		Refresh:    websiteCertificateAuthorityAssociationStateRefresh(ctx, conn, websiteCaID, fleetArn),
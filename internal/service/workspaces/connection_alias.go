@@ -239,7 +239,7 @@ func statusConnectionAlias(ctx context.Context, conn *workspaces.Client, id stri
 }
 
 func waitConnectionAliasCreated(ctx context.Context, conn *workspaces.Client, id string, timeout time.Duration) (*awstypes.ConnectionAlias, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ConnectionAliasStateCreating),
 		Target:  enum.Slice(awstypes.ConnectionAliasStateCreated),
 		Refresh: statusConnectionAlias(ctx, conn, id),
@@ -256,7 +256,7 @@ func waitConnectionAliasCreated(ctx context.Context, conn *workspaces.Client, id
 }
 
 func waitConnectionAliasDeleted(ctx context.Context, conn *workspaces.Client, id string, timeout time.Duration) (*awstypes.ConnectionAlias, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(awstypes.ConnectionAliasStateDeleting),
 		Target:  []string{},
 		Refresh: statusConnectionAlias(ctx, conn, id),
@@ -590,7 +590,7 @@ func waitDirectoryRegistered(ctx context.Context, conn *workspaces.Client, direc
 	const (
 		timeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.WorkspaceDirectoryStateRegistering),
 		Target:  enum.Slice(types.WorkspaceDirectoryStateRegistered),
 		Refresh: statusDirectory(ctx, conn, directoryID),
@@ -612,7 +612,7 @@ func waitDirectoryDeregistered(ctx context.Context, conn *workspaces.Client, dir
 	const (
 		timeout = 10 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(
 			types.WorkspaceDirectoryStateRegistering,
 			types.WorkspaceDirectoryStateRegistered,
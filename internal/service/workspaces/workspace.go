@@ -402,7 +402,7 @@ func statusWorkspace(ctx context.Context, conn *workspaces.Client, workspaceID s
 }
 
 func waitWorkspaceAvailable(ctx context.Context, conn *workspaces.Client, workspaceID string, timeout time.Duration) (*types.Workspace, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.WorkspaceStatePending, types.WorkspaceStateStarting),
 		Target:  enum.Slice(types.WorkspaceStateAvailable),
 		Refresh: statusWorkspace(ctx, conn, workspaceID),
@@ -419,7 +419,7 @@ func waitWorkspaceAvailable(ctx context.Context, conn *workspaces.Client, worksp
 }
 
 func waitWorkspaceUpdated(ctx context.Context, conn *workspaces.Client, workspaceID string, timeout time.Duration) (*types.Workspace, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.WorkspaceStateUpdating),
 		Target:  enum.Slice(types.WorkspaceStateAvailable, types.WorkspaceStateStopped),
 		Refresh: statusWorkspace(ctx, conn, workspaceID),
@@ -441,7 +441,7 @@ func waitWorkspaceUpdated(ctx context.Context, conn *workspaces.Client, workspac
 
 func waitWorkspaceTerminated(ctx context.Context, conn *workspaces.Client, workspaceID string, timeout time.Duration) (*types.Workspace, error) {
 	// https://docs.aws.amazon.com/workspaces/latest/api/API_TerminateWorkspaces.html
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		// You can terminate a WorkSpace that is in any state except SUSPENDED.
 		// After a WorkSpace is terminated, the TERMINATED state is returned only briefly before the WorkSpace directory metadata is cleaned up.
 		Pending: enum.Slice(tfslices.RemoveAll(enum.EnumValues[types.WorkspaceState](), types.WorkspaceStateSuspended)...),
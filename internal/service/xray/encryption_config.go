@@ -134,7 +134,7 @@ func waitEncryptionConfigAvailable(ctx context.Context, conn *xray.Client) (*typ
 	const (
 		timeout = 15 * time.Minute
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
 		Pending: enum.Slice(types.EncryptionStatusUpdating),
 		Target:  enum.Slice(types.EncryptionStatusActive),
 		Refresh: statusEncryptionConfig(ctx, conn),
@@ -17,5 +17,17 @@ func Context(region string) context.Context {
 
 	ctx = log.Logger(ctx, "sweeper", region)
 
+	ctx = context.WithValue(ctx, regionKey, region)
+
 	return ctx
 }
+
+// regionFromContext returns the sweeper Region from Context.
+func regionFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(regionKey).(string)
+	return v
+}
+
+type keyType int
+
+var regionKey keyType
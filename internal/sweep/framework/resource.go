@@ -5,15 +5,21 @@ package framework
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/report"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type attribute struct {
@@ -43,21 +49,95 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 }
 
 func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
-	resource, err := sr.factory(ctx)
+	resource, state, err := sr.resourceAndState(ctx)
 	if err != nil {
 		return err
 	}
 
+	for _, attr := range sr.attributes {
+		ctx = tflog.SetField(ctx, attr.path, attr.value)
+	}
+
+	tflog.Info(ctx, "Sweeping resource")
+
+	return deleteResource(ctx, state, resource)
+}
+
+// Describe reads the resource, returning its ID, tags and creation time.
+func (sr *sweepResource) Describe(ctx context.Context) (report.Resource, error) {
+	var r report.Resource
+
+	resource, state, err := sr.resourceAndState(ctx)
+	if err != nil {
+		return r, err
+	}
+
+	ids := make([]string, 0, len(sr.attributes))
+	for _, attr := range sr.attributes {
+		ctx = tflog.SetField(ctx, attr.path, attr.value)
+		if attr.path == names.AttrID {
+			ids = []string{fmt.Sprint(attr.value)}
+			break
+		}
+		ids = append(ids, fmt.Sprint(attr.value))
+	}
+	r.ID = strings.Join(ids, ",")
+
+	// Capture tags set via transparent tagging.
+	ctx = tftags.NewContext(ctx, nil, nil)
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+	if response.Diagnostics.HasError() {
+		return r, fwdiag.DiagnosticsError(response.Diagnostics)
+	}
+
+	if response.State.Raw.IsNull() {
+		return r, nil
+	}
+
+	if inContext, ok := tftags.FromContext(ctx); ok && inContext.TagsOut.IsSome() {
+		r.Tags = inContext.TagsOut.MustUnwrap().IgnoreAWS().Map()
+	} else if _, ok := state.Schema.GetAttributes()[names.AttrTags]; ok {
+		var tags map[string]string
+		if d := response.State.GetAttribute(ctx, path.Root(names.AttrTags), &tags); !d.HasError() {
+			r.Tags = tags
+		}
+	}
+
+	for _, k := range report.CreatedAtAttributeNames {
+		if _, ok := state.Schema.GetAttributes()[k]; !ok {
+			continue
+		}
+		var v types.String
+		if d := response.State.GetAttribute(ctx, path.Root(k), &v); d.HasError() {
+			continue
+		}
+		if t, ok := report.ParseCreatedAt(v.ValueString()); ok {
+			r.CreatedAt = t
+			break
+		}
+	}
+
+	return r, nil
+}
+
+// resourceAndState returns the configured resource and a state populated with the sweepable's attributes.
+func (sr *sweepResource) resourceAndState(ctx context.Context) (fwresource.ResourceWithConfigure, tfsdk.State, error) {
+	resource, err := sr.factory(ctx)
+	if err != nil {
+		return nil, tfsdk.State{}, err
+	}
+
 	var configureResp fwresource.ConfigureResponse
 	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &configureResp)
 	if configureResp.Diagnostics.HasError() {
-		return fwdiag.DiagnosticsError(configureResp.Diagnostics)
+		return nil, tfsdk.State{}, fwdiag.DiagnosticsError(configureResp.Diagnostics)
 	}
 
 	var schemaResp fwresource.SchemaResponse
 	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
 	if schemaResp.Diagnostics.HasError() {
-		return fwdiag.DiagnosticsError(schemaResp.Diagnostics)
+		return nil, tfsdk.State{}, fwdiag.DiagnosticsError(schemaResp.Diagnostics)
 	}
 
 	state := tfsdk.State{
@@ -67,14 +147,11 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	for _, attr := range sr.attributes {
 		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
 		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
+			return nil, tfsdk.State{}, fwdiag.DiagnosticsError(d)
 		}
-		ctx = tflog.SetField(ctx, attr.path, attr.value)
 	}
 
-	tflog.Info(ctx, "Sweeping resource")
-
-	return deleteResource(ctx, state, resource)
+	return resource, state, nil
 }
 
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
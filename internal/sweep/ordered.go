@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+)
+
+const defaultOrderedSweepParallelism = 10
+
+// OrderedSweepOptions configures a dependency-ordered sweeper run.
+type OrderedSweepOptions struct {
+	// AllowFailures continues sweeping subsequent levels after a sweeper fails.
+	AllowFailures bool
+	// DryRun prints the planned sweep order without deleting anything.
+	DryRun bool
+	// Filter is a comma-separated list of sweeper name substrings, as used by `-sweep-run`.
+	Filter string
+	// Out receives the planned sweep order. Defaults to io.Discard.
+	Out io.Writer
+	// Parallelism is the maximum number of sweepers run concurrently within a level.
+	Parallelism int
+	// Regions are swept in order.
+	Regions []string
+}
+
+// RunOrderedSweepers runs the registered sweepers level-by-level in dependency order.
+// All sweepers in a level are run in parallel; a level is only started once all sweepers in the previous level have completed.
+func RunOrderedSweepers(opts OrderedSweepOptions) error {
+	sweepers := registeredSweepers()
+
+	levels, err := sweeperLevels(sweepers, opts.Filter)
+	if err != nil {
+		return err
+	}
+
+	out := opts.Out
+	if out == nil {
+		out = io.Discard
+	}
+	for i, level := range levels {
+		fmt.Fprintf(out, "Level %d: %s\n", i+1, strings.Join(level, ", "))
+	}
+
+	if opts.DryRun {
+		return nil
+	}
+
+	parallelism := opts.Parallelism
+	if parallelism < 1 {
+		parallelism = defaultOrderedSweepParallelism
+	}
+
+	var errs []error
+	for _, region := range opts.Regions {
+		log.Printf("[DEBUG] Running Sweepers for region (%s):\n", region)
+
+		for i, level := range levels {
+			log.Printf("[DEBUG] Running Sweeper level %d for region (%s): %s\n", i+1, region, strings.Join(level, ", "))
+
+			err := runSweeperLevel(sweepers, level, region, parallelism)
+			if err != nil {
+				errs = append(errs, err)
+
+				if !opts.AllowFailures {
+					return errors.Join(errs...)
+				}
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func runSweeperLevel(sweepers map[string]*resource.Sweeper, level []string, region string, parallelism int) error {
+	semaphore := tfsync.NewSemaphore(parallelism)
+	var g multierror.Group
+
+	for _, name := range level {
+		g.Go(func() error {
+			semaphore.Wait()
+			defer semaphore.Notify()
+
+			start := time.Now()
+			err := sweepers[name].F(region)
+			elapsed := time.Since(start)
+
+			if err != nil {
+				log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", name, region, err)
+				return fmt.Errorf("sweeper (%s) in region (%s): %w", name, region, err)
+			}
+
+			log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", name, region, elapsed)
+
+			return nil
+		})
+	}
+
+	return g.Wait().ErrorOrNil()
+}
+
+// sweeperLevels returns the names of the sweepers matching the filter, and their transitive dependencies, grouped into levels.
+// Sweepers in a level depend only on sweepers in earlier levels.
+func sweeperLevels(sweepers map[string]*resource.Sweeper, filter string) ([][]string, error) {
+	selected := filterSweepers(sweepers, filter)
+
+	g := depgraph.New()
+	names := slices.Sorted(maps.Keys(selected))
+	for _, name := range names {
+		g.AddNode(name)
+	}
+	for _, name := range names {
+		for _, dependency := range selected[name].Dependencies {
+			if !g.HasNode(dependency) {
+				continue
+			}
+
+			if err := g.AddDependency(name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	depths := make(map[string]int, len(order))
+	var levels [][]string
+	for _, name := range order {
+		dependencies, err := g.DirectDependenciesOf(name)
+		if err != nil {
+			return nil, err
+		}
+
+		// Dependencies precede dependents in the overall order.
+		depth := 0
+		for _, dependency := range dependencies {
+			depth = max(depth, depths[dependency]+1)
+		}
+		depths[name] = depth
+
+		if depth == len(levels) {
+			levels = append(levels, nil)
+		}
+		levels[depth] = append(levels[depth], name)
+	}
+
+	for _, level := range levels {
+		slices.Sort(level)
+	}
+
+	return levels, nil
+}
+
+// filterSweepers returns the sweepers whose names contain any of the comma-separated filter values, and their transitive dependencies.
+// This matches the Terraform Plugin Testing `-sweep-run` semantics.
+func filterSweepers(sweepers map[string]*resource.Sweeper, filter string) map[string]*resource.Sweeper {
+	filters := strings.Split(strings.ToLower(filter), ",")
+	if len(filters) == 1 && filters[0] == "" {
+		filters = nil
+	}
+
+	selected := make(map[string]*resource.Sweeper)
+
+	var add func(string)
+	add = func(name string) {
+		if _, ok := selected[name]; ok {
+			return
+		}
+
+		sweeper, ok := sweepers[name]
+		if !ok {
+			return
+		}
+		selected[name] = sweeper
+
+		for _, dependency := range sweeper.Dependencies {
+			if _, ok := sweepers[dependency]; !ok {
+				log.Printf("[WARN] Sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+				continue
+			}
+
+			add(dependency)
+		}
+	}
+
+	for name := range sweepers {
+		if len(filters) == 0 || slices.ContainsFunc(filters, func(f string) bool {
+			return strings.Contains(strings.ToLower(name), f)
+		}) {
+			add(name)
+		}
+	}
+
+	return selected
+}
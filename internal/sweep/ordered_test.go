@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestSweeperLevels(t *testing.T) {
+	t.Parallel()
+
+	sweepers := map[string]*resource.Sweeper{
+		"aws_vpc": {
+			Name: "aws_vpc",
+			Dependencies: []string{
+				"aws_subnet",
+				"aws_vpc_endpoint",
+				"aws_internet_gateway",
+			},
+		},
+		"aws_subnet": {
+			Name: "aws_subnet",
+			Dependencies: []string{
+				"aws_network_interface",
+				"aws_lb",
+			},
+		},
+		"aws_network_interface": {
+			Name: "aws_network_interface",
+			Dependencies: []string{
+				"aws_instance",
+			},
+		},
+		"aws_instance":         {Name: "aws_instance"},
+		"aws_internet_gateway": {Name: "aws_internet_gateway"},
+		"aws_lb":               {Name: "aws_lb"},
+		"aws_vpc_endpoint": {
+			Name: "aws_vpc_endpoint",
+			Dependencies: []string{
+				"aws_not_registered",
+			},
+		},
+		"aws_s3_bucket": {Name: "aws_s3_bucket"},
+	}
+
+	testCases := map[string]struct {
+		filter         string
+		expectedLevels [][]string
+	}{
+		"no filter": {
+			expectedLevels: [][]string{
+				{"aws_instance", "aws_internet_gateway", "aws_lb", "aws_s3_bucket", "aws_vpc_endpoint"},
+				{"aws_network_interface"},
+				{"aws_subnet"},
+				{"aws_vpc"},
+			},
+		},
+		"filter includes dependencies": {
+			filter: "aws_subnet",
+			expectedLevels: [][]string{
+				{"aws_instance", "aws_lb"},
+				{"aws_network_interface"},
+				{"aws_subnet"},
+			},
+		},
+		"multiple filters": {
+			filter: "AWS_S3,gateway",
+			expectedLevels: [][]string{
+				{"aws_internet_gateway", "aws_s3_bucket"},
+			},
+		},
+		"no match": {
+			filter: "aws_dynamodb",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := sweeperLevels(sweepers, testCase.filter)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedLevels); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSweeperLevelsCycle(t *testing.T) {
+	t.Parallel()
+
+	sweepers := map[string]*resource.Sweeper{
+		"aws_a": {Name: "aws_a", Dependencies: []string{"aws_b"}},
+		"aws_b": {Name: "aws_b", Dependencies: []string{"aws_a"}},
+	}
+
+	if _, err := sweeperLevels(sweepers, ""); err == nil {
+		t.Fatal("expected dependency cycle error")
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"maps"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// sweepers is a registry of all sweepers, used for dependency-ordered sweeper runs.
+var sweepers = &struct {
+	lock  sync.Mutex
+	store map[string]*resource.Sweeper
+}{
+	store: make(map[string]*resource.Sweeper),
+}
+
+// AddTestSweepers registers a sweeper with both the Terraform Plugin Testing sweeper runner and
+// the provider's dependency-ordered sweeper runner.
+// Sweepers declare dependencies on other resource types via the `Dependencies` field; dependencies are swept first.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	sweepers.lock.Lock()
+	defer sweepers.lock.Unlock()
+
+	sweepers.store[name] = s
+
+	resource.AddTestSweepers(name, s)
+}
+
+func registeredSweepers() map[string]*resource.Sweeper {
+	sweepers.lock.Lock()
+	defer sweepers.lock.Unlock()
+
+	return maps.Clone(sweepers.store)
+}
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/report"
+)
+
+// ReportOptions configures a list-only sweeper run.
+type ReportOptions struct {
+	// Filter is a comma-separated list of sweeper name substrings, as used by `-sweep-run`.
+	Filter string
+	// Format is the report format, either "json" (the default) or "csv".
+	Format string
+	// Out receives the report.
+	Out io.Writer
+	// Regions are listed in parallel.
+	Regions []string
+	// TagFilter filters reported resources by tag.
+	TagFilter report.TagFilter
+}
+
+// reporter is non-nil when sweepers are run in list-only mode.
+var reporter *sweepReporter
+
+type sweepReporter struct {
+	current map[string]string // Sweeper name, keyed by Region.
+	entries []report.Entry
+	filter  report.TagFilter
+	lock    sync.Mutex
+	now     time.Time
+}
+
+func (r *sweepReporter) setCurrentSweeper(region, name string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.current[region] = name
+}
+
+func (r *sweepReporter) currentSweeper(region string) string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.current[region]
+}
+
+func (r *sweepReporter) add(entry report.Entry) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.entries = append(r.entries, entry)
+}
+
+// report records the sweepables instead of deleting them.
+func (r *sweepReporter) report(ctx context.Context, sweepables []Sweepable) error {
+	region := regionFromContext(ctx)
+	typeName := r.currentSweeper(region)
+
+	var g multierror.Group
+
+	for _, sweepable := range sweepables {
+		g.Go(func() error {
+			var resource report.Resource
+
+			if v, ok := sweepable.(report.Describer); ok {
+				var err error
+				resource, err = v.Describe(ctx)
+				if err != nil {
+					tflog.Warn(ctx, "Describing resource", map[string]any{
+						"error": err.Error(),
+					})
+				}
+			} else {
+				tflog.Warn(ctx, "Sweepable cannot be described", map[string]any{
+					"sweepable_type": fmt.Sprintf("%T", sweepable),
+				})
+			}
+
+			if r.filter.Match(resource.Tags) {
+				r.add(report.NewEntry(typeName, region, resource, r.now))
+			}
+
+			return nil
+		})
+	}
+
+	return g.Wait().ErrorOrNil()
+}
+
+// RunReport runs the registered sweepers in list-only mode, writing a report of all sweepable resources instead of deleting them.
+// AWS API clients used by sweepers are restricted to read-only operations.
+func RunReport(opts ReportOptions) error {
+	r := &sweepReporter{
+		current: make(map[string]string),
+		filter:  opts.TagFilter,
+		now:     time.Now(),
+	}
+	reporter = r
+	defer func() {
+		reporter = nil
+	}()
+
+	sweepers := filterSweepers(registeredSweepers(), opts.Filter)
+	names := slices.Sorted(maps.Keys(sweepers))
+
+	var g multierror.Group
+
+	for _, region := range opts.Regions {
+		g.Go(func() error {
+			var errs []error
+
+			// Sweepers are run sequentially within a Region so that sweepables can be attributed to a sweeper.
+			for _, name := range names {
+				r.setCurrentSweeper(region, name)
+
+				if err := sweepers[name].F(region); err != nil {
+					log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", name, region, err)
+					errs = append(errs, fmt.Errorf("sweeper (%s) in region (%s): %w", name, region, err))
+				}
+			}
+
+			return errors.Join(errs...)
+		})
+	}
+
+	err := g.Wait().ErrorOrNil()
+
+	return errors.Join(err, report.Write(opts.Out, opts.Format, r.entries))
+}
+
+var errMutatingOperation = errors.New("mutating AWS API operation not allowed in sweeper list-only mode")
+
+// readOnlyOperationPrefixes are the AWS API operation name prefixes allowed in list-only mode.
+var readOnlyOperationPrefixes = []string{
+	"BatchDescribe",
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+}
+
+func isReadOnlyOperation(name string) bool {
+	return slices.ContainsFunc(readOnlyOperationPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(name, prefix)
+	})
+}
+
+// readOnlyAPIOption adds AWS SDK for Go v2 middleware that rejects mutating operations.
+func readOnlyAPIOption(stack *middleware.Stack) error {
+	return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("TF_AWS_SweepReadOnly", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		if name := awsmiddleware.GetOperationName(ctx); !isReadOnlyOperation(name) {
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, fmt.Errorf("%w: %s", errMutatingOperation, name)
+		}
+
+		return next.HandleFinalize(ctx, in)
+	}), middleware.Before)
+}
+
+// addReadOnlyHandler adds an AWS SDK for Go v1 handler that rejects mutating operations.
+func addReadOnlyHandler(ctx context.Context, client *conns.AWSClient) {
+	if session := client.AwsSession(ctx); session != nil {
+		session.Handlers.Validate.PushFront(func(r *request.Request) {
+			if name := r.Operation.Name; !isReadOnlyOperation(name) {
+				r.Error = fmt.Errorf("%w: %s", errMutatingOperation, name)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"cmp"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Resource describes a sweepable resource.
+type Resource struct {
+	ID        string
+	Tags      map[string]string // nil if the resource's tags are not known.
+	CreatedAt time.Time         // Zero if the resource's creation time is not known.
+}
+
+// Describer is implemented by sweepables that can describe the resource to be swept without deleting it.
+type Describer interface {
+	Describe(context.Context) (Resource, error)
+}
+
+// Entry is a single report line.
+type Entry struct {
+	Type      string            `json:"type"`
+	ID        string            `json:"id"`
+	Region    string            `json:"region"`
+	Tags      map[string]string `json:"tags,omitempty"`
+	CreatedAt *time.Time        `json:"created_at,omitempty"`
+	Age       string            `json:"age,omitempty"`
+}
+
+// NewEntry returns a report entry for the specified resource.
+// The resource's age is calculated relative to now.
+func NewEntry(typeName, region string, resource Resource, now time.Time) Entry {
+	entry := Entry{
+		Type:   typeName,
+		ID:     resource.ID,
+		Region: region,
+		Tags:   resource.Tags,
+	}
+
+	if v := resource.CreatedAt; !v.IsZero() {
+		v = v.UTC()
+		entry.CreatedAt = &v
+		entry.Age = now.Sub(v).Truncate(time.Second).String()
+	}
+
+	return entry
+}
+
+// TagFilter determines whether a resource is included in a report based on its tags.
+// A tag filter value of "" matches any value.
+type TagFilter struct {
+	// Include, if not empty, includes only resources that have any of the specified tags.
+	Include map[string]string
+	// Exclude excludes resources that have any of the specified tags.
+	Exclude map[string]string
+}
+
+// Match returns whether the specified tags pass the filter.
+func (f TagFilter) Match(tags map[string]string) bool {
+	if len(f.Include) > 0 && !hasAnyTag(tags, f.Include) {
+		return false
+	}
+
+	return !hasAnyTag(tags, f.Exclude)
+}
+
+func hasAnyTag(tags, filter map[string]string) bool {
+	for k, v := range filter {
+		if tv, ok := tags[k]; ok && (v == "" || v == tv) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseTagFilter parses a comma-separated list of `key` or `key=value` tag filters.
+func ParseTagFilter(s string) map[string]string {
+	filter := make(map[string]string)
+
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v == "" {
+			continue
+		}
+
+		k, v, _ := strings.Cut(v, "=")
+		filter[k] = v
+	}
+
+	return filter
+}
+
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+)
+
+// Write writes the entries to w in the specified format.
+// Entries are sorted by type, Region and ID.
+func Write(w io.Writer, format string, entries []Entry) error {
+	entries = slices.Clone(entries)
+	slices.SortFunc(entries, func(a, b Entry) int {
+		return cmp.Or(cmp.Compare(a.Type, b.Type), cmp.Compare(a.Region, b.Region), cmp.Compare(a.ID, b.ID))
+	})
+
+	switch format {
+	case FormatCSV:
+		return writeCSV(w, entries)
+	case FormatJSON, "":
+		return writeJSON(w, entries)
+	default:
+		return fmt.Errorf("unsupported report format: %s", format)
+	}
+}
+
+func writeJSON(w io.Writer, entries []Entry) error {
+	if entries == nil {
+		entries = []Entry{}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(entries)
+}
+
+func writeCSV(w io.Writer, entries []Entry) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write([]string{"type", "id", "region", "tags", "created_at", "age"}); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		var tags []string
+		for _, k := range slices.Sorted(maps.Keys(entry.Tags)) {
+			tags = append(tags, k+"="+entry.Tags[k])
+		}
+
+		var createdAt string
+		if entry.CreatedAt != nil {
+			createdAt = entry.CreatedAt.Format(time.RFC3339)
+		}
+
+		if err := writer.Write([]string{entry.Type, entry.ID, entry.Region, strings.Join(tags, ";"), createdAt, entry.Age}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// CreatedAtAttributeNames are the names of attributes commonly holding a resource's creation time.
+var CreatedAtAttributeNames = []string{
+	"create_date",
+	"create_time",
+	"created_at",
+	"created_date",
+	"created_time",
+	"creation_date",
+	"creation_time",
+	"launch_time",
+}
+
+// ParseCreatedAt parses an attribute value holding a resource's creation time.
+func ParseCreatedAt(v string) (time.Time, bool) {
+	if v == "" {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return t, true
+}
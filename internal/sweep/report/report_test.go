@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTagFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		filter   TagFilter
+		tags     map[string]string
+		expected bool
+	}{
+		"no filter": {
+			tags:     map[string]string{"Name": "tf-acc-test-1"},
+			expected: true,
+		},
+		"no filter unknown tags": {
+			expected: true,
+		},
+		"include key": {
+			filter:   TagFilter{Include: map[string]string{"Name": ""}},
+			tags:     map[string]string{"Name": "tf-acc-test-1"},
+			expected: true,
+		},
+		"include key value mismatch": {
+			filter:   TagFilter{Include: map[string]string{"Owner": "ci"}},
+			tags:     map[string]string{"Owner": "security"},
+			expected: false,
+		},
+		"include unknown tags": {
+			filter:   TagFilter{Include: map[string]string{"Owner": "ci"}},
+			expected: false,
+		},
+		"include any": {
+			filter:   TagFilter{Include: map[string]string{"Owner": "ci", "Name": ""}},
+			tags:     map[string]string{"Name": "tf-acc-test-1"},
+			expected: true,
+		},
+		"exclude key value": {
+			filter:   TagFilter{Exclude: map[string]string{"DoNotDelete": "true"}},
+			tags:     map[string]string{"DoNotDelete": "true"},
+			expected: false,
+		},
+		"include and exclude": {
+			filter: TagFilter{
+				Include: map[string]string{"Name": ""},
+				Exclude: map[string]string{"DoNotDelete": ""},
+			},
+			tags:     map[string]string{"Name": "tf-acc-test-1", "DoNotDelete": "yes"},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.filter.Match(testCase.tags), testCase.expected; got != want {
+				t.Errorf("Match() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestParseTagFilter(t *testing.T) {
+	t.Parallel()
+
+	got := ParseTagFilter("Name, Owner=ci,,Env=")
+	want := map[string]string{
+		"Name":  "",
+		"Owner": "ci",
+		"Env":   "",
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.March, 2, 12, 0, 0, 0, time.UTC)
+	entries := []Entry{
+		NewEntry("aws_vpc", "us-west-2", Resource{ID: "vpc-12345678"}, now), //lintignore:AWSAT003
+		NewEntry("aws_instance", "us-west-2", Resource{ //lintignore:AWSAT003
+			ID:        "i-12345678",
+			Tags:      map[string]string{"Owner": "ci", "Name": "tf-acc-test-1"},
+			CreatedAt: time.Date(2025, time.March, 1, 11, 30, 0, 0, time.UTC),
+		}, now),
+	}
+
+	testCases := map[string]struct {
+		format   string
+		expected string
+	}{
+		"csv": {
+			format: FormatCSV,
+			expected: `type,id,region,tags,created_at,age
+aws_instance,i-12345678,us-west-2,Name=tf-acc-test-1;Owner=ci,2025-03-01T11:30:00Z,24h30m0s
+aws_vpc,vpc-12345678,us-west-2,,,
+`, //lintignore:AWSAT003
+		},
+		"json": {
+			format: FormatJSON,
+			expected: `[
+  {
+    "type": "aws_instance",
+    "id": "i-12345678",
+    "region": "us-west-2",
+    "tags": {
+      "Name": "tf-acc-test-1",
+      "Owner": "ci"
+    },
+    "created_at": "2025-03-01T11:30:00Z",
+    "age": "24h30m0s"
+  },
+  {
+    "type": "aws_vpc",
+    "id": "vpc-12345678",
+    "region": "us-west-2"
+  }
+]
+`, //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			if err := Write(&buf, testCase.format, entries); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(buf.String(), testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -11,7 +11,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/report"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -39,6 +43,45 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return deleteResource(ctx, sr.resource, sr.d, sr.meta)
 }
 
+// Describe reads the resource, returning its ID, tags and creation time.
+func (sr *sweepResource) Describe(ctx context.Context) (report.Resource, error) {
+	ctx = tflog.SetField(ctx, "id", sr.d.Id())
+
+	r := report.Resource{
+		ID: sr.d.Id(),
+	}
+
+	if sr.resource.ReadContext == nil && sr.resource.ReadWithoutTimeout == nil && sr.resource.Read == nil {
+		return r, nil
+	}
+
+	// Capture tags set via transparent tagging.
+	ctx = tftags.NewContext(ctx, nil, nil)
+	if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return r, err
+	}
+
+	if inContext, ok := tftags.FromContext(ctx); ok && inContext.TagsOut.IsSome() {
+		r.Tags = inContext.TagsOut.MustUnwrap().IgnoreAWS().Map()
+	} else if v, ok := sr.d.GetOk(names.AttrTags); ok {
+		r.Tags = flex.ExpandStringValueMap(v.(map[string]any))
+	}
+
+	for _, k := range report.CreatedAtAttributeNames {
+		if _, ok := sr.resource.SchemaMap()[k]; !ok {
+			continue
+		}
+		if v, ok := sr.d.Get(k).(string); ok {
+			if t, ok := report.ParseCreatedAt(v); ok {
+				r.CreatedAt = t
+				break
+			}
+		}
+	}
+
+	return r, nil
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
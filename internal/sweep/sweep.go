@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -31,8 +32,14 @@ var ServicePackages []conns.ServicePackage
 // This prevents client re-initialization for every resource with no benefit.
 var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
 
+// sweeperClientsLock guards sweeperClients as sweepers can be run in parallel.
+var sweeperClientsLock sync.Mutex
+
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	sweeperClientsLock.Lock()
+	defer sweeperClientsLock.Unlock()
+
 	if client, ok := sweeperClients[region]; ok {
 		return client, nil
 	}
@@ -63,6 +70,11 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		SuppressDebugLog: true,
 	}
 
+	// List-only mode.
+	if reporter != nil {
+		conf.APIOptions = append(conf.APIOptions, readOnlyAPIOption)
+	}
+
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
 		ar := awsbase.AssumeRole{
 			RoleARN:  role,
@@ -95,6 +107,10 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		return nil, fmt.Errorf("getting AWS client: %#v", diags)
 	}
 
+	if reporter != nil {
+		addReadOnlyHandler(ctx, client)
+	}
+
 	sweeperClients[region] = client
 
 	return client, nil
@@ -109,6 +125,11 @@ func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tf
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
+	// List-only mode.
+	if r := reporter; r != nil {
+		return r.report(ctx, sweepables)
+	}
+
 	var g multierror.Group
 
 	for _, sweepable := range sweepables {
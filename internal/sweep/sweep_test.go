@@ -5,10 +5,26 @@ package sweep_test
 
 import (
 	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/report"
+)
+
+var (
+	flagSweepOrdered     = flag.Bool("sweep-ordered", false, "run sweepers level-by-level in dependency order, with the sweepers in each level run in parallel")
+	flagSweepDryRun      = flag.Bool("sweep-dry-run", false, "print the planned dependency-ordered sweep without deleting anything")
+	flagSweepParallelism = flag.Int("sweep-parallelism", 0, "maximum number of sweepers run in parallel within a level when using -sweep-ordered")
+
+	flagSweepReport            = flag.String("sweep-report", "", "list sweepable resources without deleting anything, writing a report in the specified format (json or csv)")
+	flagSweepReportFile        = flag.String("sweep-report-file", "", "file to write the -sweep-report report to, defaults to stdout")
+	flagSweepReportIncludeTags = flag.String("sweep-report-include-tags", "", "comma-separated list of key or key=value tags; only resources with any of these tags are reported")
+	flagSweepReportExcludeTags = flag.String("sweep-report-exclude-tags", "", "comma-separated list of key or key=value tags; resources with any of these tags are not reported")
 )
 
 func TestMain(m *testing.M) {
@@ -18,5 +34,90 @@ func TestMain(m *testing.M) {
 
 	registerSweepers()
 
+	flag.Parse()
+	if *flagSweepReport != "" {
+		os.Exit(runSweepReport())
+	}
+	if *flagSweepOrdered || *flagSweepDryRun {
+		os.Exit(runOrderedSweepers())
+	}
+
 	resource.TestMain(m)
 }
+
+// runOrderedSweepers runs sweepers using the Terraform Plugin Testing `-sweep`, `-sweep-run` and `-sweep-allow-failures` flags.
+func runOrderedSweepers() int {
+	var regions []string
+	if v := flagValue("sweep"); v != "" {
+		regions = strings.Split(v, ",")
+	}
+
+	if len(regions) == 0 && !*flagSweepDryRun {
+		fmt.Fprintln(os.Stderr, "-sweep-ordered requires -sweep")
+		return 1
+	}
+
+	err := sweep.RunOrderedSweepers(sweep.OrderedSweepOptions{
+		AllowFailures: flagValue("sweep-allow-failures") == "true",
+		DryRun:        *flagSweepDryRun,
+		Filter:        flagValue("sweep-run"),
+		Out:           os.Stdout,
+		Parallelism:   *flagSweepParallelism,
+		Regions:       regions,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error running sweepers: %s\n", err)
+		return 1
+	}
+
+	return 0
+}
+
+// runSweepReport runs sweepers in list-only mode using the Terraform Plugin Testing `-sweep` and `-sweep-run` flags.
+func runSweepReport() int {
+	var regions []string
+	if v := flagValue("sweep"); v != "" {
+		regions = strings.Split(v, ",")
+	}
+
+	if len(regions) == 0 {
+		fmt.Fprintln(os.Stderr, "-sweep-report requires -sweep")
+		return 1
+	}
+
+	out := os.Stdout
+	if v := *flagSweepReportFile; v != "" {
+		f, err := os.Create(v)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "creating report file: %s\n", err)
+			return 1
+		}
+		defer f.Close()
+		out = f
+	}
+
+	err := sweep.RunReport(sweep.ReportOptions{
+		Filter:  flagValue("sweep-run"),
+		Format:  *flagSweepReport,
+		Out:     out,
+		Regions: regions,
+		TagFilter: report.TagFilter{
+			Include: report.ParseTagFilter(*flagSweepReportIncludeTags),
+			Exclude: report.ParseTagFilter(*flagSweepReportExcludeTags),
+		},
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error listing sweepable resources: %s\n", err)
+		return 1
+	}
+
+	return 0
+}
+
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
@@ -284,13 +284,13 @@ func RetryWhenNewResourceNotFound(ctx context.Context, timeout time.Duration, f
 
 type Options struct {
 	Delay                     time.Duration // Wait this time before starting checks
-	MinPollInterval           time.Duration // Smallest time to wait before refreshes (MinTimeout in retry.StateChangeConf)
+	MinPollInterval           time.Duration // Smallest time to wait before refreshes (MinTimeout in StateChangeConf)
 	PollInterval              time.Duration // Override MinPollInterval/backoff and only poll this often
 	NotFoundChecks            int           // Number of times to allow not found (nil result from Refresh)
 	ContinuousTargetOccurence int           // Number of times the Target state has to occur continuously
 }
 
-func (o Options) Apply(c *retry.StateChangeConf) {
+func (o Options) Apply(c *StateChangeConf) {
 	if o.Delay > 0 {
 		c.Delay = o.Delay
 	}
@@ -365,7 +365,7 @@ func Retry(ctx context.Context, timeout time.Duration, f retry.RetryFunc, optFns
 		fn(&options)
 	}
 
-	c := &retry.StateChangeConf{
+	c := &StateChangeConf{
 		Pending:    []string{"retryableerror"},
 		Target:     []string{"success"},
 		Timeout:    timeout,
@@ -319,17 +319,17 @@ func TestOptionsApply(t *testing.T) {
 
 	testCases := map[string]struct {
 		options  tfresource.Options
-		expected retry.StateChangeConf
+		expected tfresource.StateChangeConf
 	}{
 		"Nothing": {
 			options:  tfresource.Options{},
-			expected: retry.StateChangeConf{},
+			expected: tfresource.StateChangeConf{},
 		},
 		"Delay": {
 			options: tfresource.Options{
 				Delay: 1 * time.Minute,
 			},
-			expected: retry.StateChangeConf{
+			expected: tfresource.StateChangeConf{
 				Delay: 1 * time.Minute,
 			},
 		},
@@ -337,7 +337,7 @@ func TestOptionsApply(t *testing.T) {
 			options: tfresource.Options{
 				MinPollInterval: 1 * time.Minute,
 			},
-			expected: retry.StateChangeConf{
+			expected: tfresource.StateChangeConf{
 				MinTimeout: 1 * time.Minute,
 			},
 		},
@@ -345,7 +345,7 @@ func TestOptionsApply(t *testing.T) {
 			options: tfresource.Options{
 				PollInterval: 1 * time.Minute,
 			},
-			expected: retry.StateChangeConf{
+			expected: tfresource.StateChangeConf{
 				PollInterval: 1 * time.Minute,
 			},
 		},
@@ -353,7 +353,7 @@ func TestOptionsApply(t *testing.T) {
 			options: tfresource.Options{
 				NotFoundChecks: 10,
 			},
-			expected: retry.StateChangeConf{
+			expected: tfresource.StateChangeConf{
 				NotFoundChecks: 10,
 			},
 		},
@@ -361,7 +361,7 @@ func TestOptionsApply(t *testing.T) {
 			options: tfresource.Options{
 				ContinuousTargetOccurence: 3,
 			},
-			expected: retry.StateChangeConf{
+			expected: tfresource.StateChangeConf{
 				ContinuousTargetOccurence: 3,
 			},
 		},
@@ -371,7 +371,7 @@ func TestOptionsApply(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			conf := retry.StateChangeConf{}
+			conf := tfresource.StateChangeConf{}
 
 			testCase.options.Apply(&conf)
 
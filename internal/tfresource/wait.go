@@ -8,8 +8,30 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 )
 
+// StateChangeConf is the Plugin SDK's retry.StateChangeConf.
+// Waits between refreshes are skipped when replaying recorded AWS API interactions, as the recorded responses
+// already reflect any eventual consistency.
+type StateChangeConf retry.StateChangeConf
+
+// replayPollInterval is the interval between refreshes when replaying recorded AWS API interactions.
+const replayPollInterval = time.Millisecond
+
+// WaitForStateContext watches an object and waits for it to achieve the state specified in the configuration.
+func (conf *StateChangeConf) WaitForStateContext(ctx context.Context) (any, error) {
+	c := retry.StateChangeConf(*conf)
+
+	if vcr.IsReplaying() {
+		c.Delay = 0
+		c.MinTimeout = 0
+		c.PollInterval = replayPollInterval
+	}
+
+	return c.WaitForStateContext(ctx)
+}
+
 type WaitOpts struct {
 	ContinuousTargetOccurence int           // Number of times the target state has to occur continuously.
 	Delay                     time.Duration // Wait this time before starting checks.
@@ -42,7 +64,7 @@ func WaitUntil(ctx context.Context, timeout time.Duration, f func() (bool, error
 		return "", targetStateFalse, nil
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &StateChangeConf{
 		Pending:                   []string{targetStateFalse},
 		Target:                    []string{targetStateTrue},
 		Refresh:                   refresh,
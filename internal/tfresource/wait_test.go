@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 )
 
 func TestWaitUntil(t *testing.T) { //nolint:tparallel
@@ -70,3 +71,37 @@ func TestWaitUntil(t *testing.T) { //nolint:tparallel
 		})
 	}
 }
+
+func TestStateChangeConfWaitForStateContext_replaying(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	ctx := acctest.Context(t)
+	t.Setenv(vcr.EnvVarMode, vcr.ModeReplaying)
+	t.Setenv(vcr.EnvVarPath, t.TempDir())
+
+	var refreshCount int32
+	conf := &tfresource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"target"},
+		Refresh: func() (any, string, error) {
+			if atomic.AddInt32(&refreshCount, 1) < 5 {
+				return 42, "pending", nil
+			}
+
+			return 42, "target", nil
+		},
+		Timeout:    time.Minute,
+		Delay:      time.Minute,
+		MinTimeout: time.Minute,
+	}
+
+	start := time.Now()
+	if _, err := conf.WaitForStateContext(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("waited %s, expected no delays when replaying", elapsed)
+	}
+	if got, want := conf.Delay, time.Minute; got != want {
+		t.Errorf("Delay = %s, want %s", got, want)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -18,6 +19,14 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceIdentity represents resource-level identity information.
+// A resource is identified either by its ARN or by the AWS account ID, Region and a single identifying attribute.
+// When importing by identity, the resource's import ID is the value of the ARN or identifying attribute.
+type ServicePackageResourceIdentity struct {
+	ARN               bool   // Whether the resource is identified by its `arn` attribute.
+	IdentityAttribute string // The attribute that, together with the AWS account ID and Region, identifies the resource.
+}
+
 // ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
 // implemented by a service package.
 type ServicePackageEphemeralResource struct {
@@ -42,6 +51,7 @@ type ServicePackageFrameworkResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Identity *ServicePackageResourceIdentity
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -53,6 +63,14 @@ type ServicePackageSDKDataSource struct {
 	Tags     *ServicePackageResourceTags
 }
 
+// ServicePackageSDKListResource represents a Terraform Plugin Framework list resource
+// for a Terraform Plugin SDK resource implemented by a service package.
+type ServicePackageSDKListResource struct {
+	Factory  func(context.Context) (list.ListResourceWithConfigure, error)
+	TypeName string
+	Name     string
+}
+
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
 // implemented by a service package.
 type ServicePackageSDKResource struct {
@@ -60,4 +78,5 @@ type ServicePackageSDKResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Identity *ServicePackageResourceIdentity
 }
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"os"
+)
+
+const (
+	EnvVarMode = "VCR_MODE"
+	EnvVarPath = "VCR_PATH"
+)
+
+const (
+	ModeRecording = "RECORDING"
+	ModeReplaying = "REPLAYING"
+)
+
+// IsEnabled returns whether acceptance tests are recording or replaying AWS API interactions.
+func IsEnabled() bool {
+	return os.Getenv(EnvVarMode) != "" && os.Getenv(EnvVarPath) != ""
+}
+
+// IsReplaying returns whether acceptance tests are replaying previously recorded AWS API interactions.
+// When replaying there is no need to wait for eventual consistency or to back off between retries.
+func IsReplaying() bool {
+	return IsEnabled() && os.Getenv(EnvVarMode) == ModeReplaying
+}
@@ -0,0 +1,406 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// iamPolicyCatalogJSON is the catalog of IAM service actions, condition keys and ARN formats used to lint IAM policies.
+// Only services present in the catalog have their actions, condition keys and ARNs checked.
+//
+//go:embed iam_policy_catalog.json
+var iamPolicyCatalogJSON []byte
+
+type iamPolicyCatalog struct {
+	Services map[string]iamPolicyCatalogService `json:"services"`
+}
+
+type iamPolicyCatalogService struct {
+	Actions       []string `json:"actions,omitempty"`
+	ARNRegex      string   `json:"arnRegex,omitempty"`
+	ConditionKeys []string `json:"conditionKeys,omitempty"`
+
+	arnRegex *regexp.Regexp
+}
+
+var loadIAMPolicyCatalog = sync.OnceValue(func() *iamPolicyCatalog {
+	var catalog iamPolicyCatalog
+
+	if err := json.Unmarshal(iamPolicyCatalogJSON, &catalog); err != nil {
+		panic(fmt.Sprintf("parsing IAM policy catalog: %s", err))
+	}
+
+	for prefix, service := range catalog.Services {
+		if service.ARNRegex != "" {
+			service.arnRegex = regexp.MustCompile(service.ARNRegex)
+		}
+		catalog.Services[prefix] = service
+	}
+
+	return &catalog
+})
+
+var (
+	// iamPolicyConditionOperators are the base condition operators.
+	// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html.
+	iamPolicyConditionOperators = []string{
+		"ArnEquals",
+		"ArnLike",
+		"ArnNotEquals",
+		"ArnNotLike",
+		"BinaryEquals",
+		"Bool",
+		"DateEquals",
+		"DateGreaterThan",
+		"DateGreaterThanEquals",
+		"DateLessThan",
+		"DateLessThanEquals",
+		"DateNotEquals",
+		"IpAddress",
+		"NotIpAddress",
+		"Null",
+		"NumericEquals",
+		"NumericGreaterThan",
+		"NumericGreaterThanEquals",
+		"NumericLessThan",
+		"NumericLessThanEquals",
+		"NumericNotEquals",
+		"StringEquals",
+		"StringEqualsIgnoreCase",
+		"StringLike",
+		"StringNotEquals",
+		"StringNotEqualsIgnoreCase",
+		"StringNotLike",
+	}
+
+	// iamPolicyGlobalConditionKeys are the AWS global condition context keys.
+	// Keys ending in "*" take a tag key or other suffix, as in the catalog.
+	// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_condition-keys.html.
+	iamPolicyGlobalConditionKeys = []string{
+		"aws:AssumedRoot",
+		"aws:CalledVia",
+		"aws:CalledViaFirst",
+		"aws:CalledViaLast",
+		"aws:CurrentTime",
+		"aws:Ec2InstanceSourcePrivateIPv4",
+		"aws:Ec2InstanceSourceVpc",
+		"aws:EpochTime",
+		"aws:FederatedProvider",
+		"aws:MultiFactorAuthAge",
+		"aws:MultiFactorAuthPresent",
+		"aws:PrincipalAccount",
+		"aws:PrincipalArn",
+		"aws:PrincipalIsAWSService",
+		"aws:PrincipalOrgID",
+		"aws:PrincipalOrgPaths",
+		"aws:PrincipalServiceName",
+		"aws:PrincipalServiceNamesList",
+		"aws:PrincipalTag/*",
+		"aws:PrincipalType",
+		"aws:Referer",
+		"aws:RequestedRegion",
+		"aws:RequestTag/*",
+		"aws:ResourceAccount",
+		"aws:ResourceOrgID",
+		"aws:ResourceOrgPaths",
+		"aws:ResourceTag/*",
+		"aws:SecureTransport",
+		"aws:SourceAccount",
+		"aws:SourceArn",
+		"aws:SourceIdentity",
+		"aws:SourceIp",
+		"aws:SourceOrgID",
+		"aws:SourceOrgPaths",
+		"aws:SourceOwner",
+		"aws:SourceVpc",
+		"aws:SourceVpcArn",
+		"aws:SourceVpce",
+		"aws:TagKeys",
+		"aws:TokenIssueTime",
+		"aws:UserAgent",
+		"aws:userid",
+		"aws:username",
+		"aws:ViaAWSService",
+		"aws:VpcSourceIp",
+	}
+
+	// iamPolicySensitiveActions are actions that should not normally be allowed on all resources,
+	// as they allow privilege escalation or access to secrets.
+	iamPolicySensitiveActions = []string{
+		"iam:AddUserToGroup",
+		"iam:AttachGroupPolicy",
+		"iam:AttachRolePolicy",
+		"iam:AttachUserPolicy",
+		"iam:CreateAccessKey",
+		"iam:CreateLoginProfile",
+		"iam:CreatePolicyVersion",
+		"iam:PassRole",
+		"iam:PutGroupPolicy",
+		"iam:PutRolePolicy",
+		"iam:PutUserPolicy",
+		"iam:SetDefaultPolicyVersion",
+		"iam:UpdateAssumeRolePolicy",
+		"iam:UpdateLoginProfile",
+		"kms:CreateGrant",
+		"kms:Decrypt",
+		"kms:PutKeyPolicy",
+		"lambda:AddPermission",
+		"s3:PutBucketPolicy",
+		"secretsmanager:GetSecretValue",
+		"secretsmanager:PutResourcePolicy",
+		"ssm:GetParameter",
+		"ssm:GetParameters",
+		"sts:AssumeRole",
+	}
+)
+
+// IAMPolicyLintFinding is a potential problem found in an IAM policy document.
+type IAMPolicyLintFinding struct {
+	// Path is the location of the offending element in the policy document, e.g. `Statement[1].Action[0]`.
+	Path    string
+	Message string
+}
+
+func (f IAMPolicyLintFinding) String() string {
+	return fmt.Sprintf("%s: %s", f.Path, f.Message)
+}
+
+// LintIAMPolicy checks an IAM policy document for mistakes that IAM only reports when the policy is used, or never.
+// Actions, condition keys and resource ARNs are checked against the embedded catalog.
+// Condition operators are checked, and allowing sensitive actions on all resources is reported.
+// Documents that are not valid JSON objects are not checked.
+func LintIAMPolicy(policy string) []IAMPolicyLintFinding {
+	var doc map[string]any
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil
+	}
+
+	l := &iamPolicyLinter{catalog: loadIAMPolicyCatalog()}
+
+	switch v := doc["Statement"].(type) {
+	case map[string]any:
+		l.lintStatement("Statement", v)
+	case []any:
+		for i, v := range v {
+			if v, ok := v.(map[string]any); ok {
+				l.lintStatement(fmt.Sprintf("Statement[%d]", i), v)
+			}
+		}
+	}
+
+	return l.findings
+}
+
+type iamPolicyLinter struct {
+	catalog  *iamPolicyCatalog
+	findings []IAMPolicyLintFinding
+}
+
+func (l *iamPolicyLinter) addFinding(path, format string, a ...any) {
+	l.findings = append(l.findings, IAMPolicyLintFinding{
+		Path:    path,
+		Message: fmt.Sprintf(format, a...),
+	})
+}
+
+func (l *iamPolicyLinter) lintStatement(path string, statement map[string]any) {
+	effect, _ := statement["Effect"].(string)
+	if effect != "Allow" && effect != "Deny" {
+		l.addFinding(path+".Effect", `effect %q is not "Allow" or "Deny"`, effect)
+	}
+
+	for _, k := range []string{"Action", "NotAction"} {
+		for _, e := range iamPolicyStringElements(path+"."+k, statement[k]) {
+			l.lintAction(e.path, e.value)
+		}
+	}
+
+	for _, k := range []string{"Resource", "NotResource"} {
+		for _, e := range iamPolicyStringElements(path+"."+k, statement[k]) {
+			l.lintResource(e.path, e.value)
+		}
+	}
+
+	if conditions, ok := statement["Condition"].(map[string]any); ok {
+		for _, operator := range slices.Sorted(maps.Keys(conditions)) {
+			operatorPath := path + ".Condition." + operator
+			l.lintConditionOperator(operatorPath, operator)
+
+			if keys, ok := conditions[operator].(map[string]any); ok {
+				for _, key := range slices.Sorted(maps.Keys(keys)) {
+					l.lintConditionKey(operatorPath+"."+key, key)
+				}
+			}
+		}
+	}
+
+	if effect == "Allow" {
+		l.lintWildcardResource(path, statement)
+	}
+}
+
+func (l *iamPolicyLinter) lintAction(path, action string) {
+	if action == "*" {
+		return
+	}
+
+	prefix, name, ok := strings.Cut(action, ":")
+	if !ok || prefix == "" || name == "" {
+		l.addFinding(path, "action %q is not of the form <service>:<action>", action)
+		return
+	}
+
+	service, ok := l.catalog.Services[strings.ToLower(prefix)]
+	if !ok || len(service.Actions) == 0 {
+		return
+	}
+
+	if !slices.ContainsFunc(service.Actions, func(v string) bool { return iamPolicyWildcardMatch(name, v) }) {
+		l.addFinding(path, "action %q does not match any %s action", action, prefix)
+	}
+}
+
+func (l *iamPolicyLinter) lintResource(path, resource string) {
+	if resource == "*" || strings.Contains(resource, "${") {
+		return
+	}
+
+	parts := strings.SplitN(resource, ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" {
+		l.addFinding(path, "resource %q is not an ARN or \"*\"", resource)
+		return
+	}
+
+	service, ok := l.catalog.Services[parts[2]]
+	if !ok || service.arnRegex == nil || strings.ContainsAny(resource, "*?") {
+		return
+	}
+
+	// Catalog ARN formats are for the "aws" partition.
+	parts[1] = "aws"
+	if !service.arnRegex.MatchString(strings.Join(parts, ":")) {
+		l.addFinding(path, "resource %q is not a valid %s ARN", resource, parts[2])
+	}
+}
+
+func (l *iamPolicyLinter) lintConditionOperator(path, operator string) {
+	base := operator
+	if v, ok := strings.CutPrefix(base, "ForAllValues:"); ok {
+		base = v
+	} else if v, ok := strings.CutPrefix(base, "ForAnyValue:"); ok {
+		base = v
+	}
+	if v, ok := strings.CutSuffix(base, "IfExists"); ok && v != "Null" {
+		base = v
+	}
+
+	if !slices.Contains(iamPolicyConditionOperators, base) {
+		l.addFinding(path, "condition operator %q is not valid", operator)
+	}
+}
+
+func (l *iamPolicyLinter) lintConditionKey(path, key string) {
+	prefix, name, ok := strings.Cut(key, ":")
+	if !ok || prefix == "" || name == "" {
+		l.addFinding(path, "condition key %q is not of the form <service>:<key>", key)
+		return
+	}
+
+	var keys []string
+	if strings.EqualFold(prefix, "aws") {
+		keys = iamPolicyGlobalConditionKeys
+	} else if service, ok := l.catalog.Services[strings.ToLower(prefix)]; ok {
+		keys = service.ConditionKeys
+	}
+
+	if len(keys) == 0 {
+		return
+	}
+
+	// Condition keys are case-insensitive.
+	if !slices.ContainsFunc(keys, func(v string) bool {
+		if v, ok := strings.CutSuffix(v, "*"); ok {
+			return len(key) > len(v) && strings.EqualFold(key[:len(v)], v)
+		}
+		return strings.EqualFold(key, v)
+	}) {
+		l.addFinding(path, "condition key %q is not a known %s condition key", key, prefix)
+	}
+}
+
+func (l *iamPolicyLinter) lintWildcardResource(path string, statement map[string]any) {
+	if _, ok := statement["NotAction"]; ok {
+		return
+	}
+
+	// In resource-based policies, such as KMS key policies, "*" is the resource the policy is attached to.
+	for _, k := range []string{"Principal", "NotPrincipal"} {
+		if _, ok := statement[k]; ok {
+			return
+		}
+	}
+
+	if !slices.ContainsFunc(iamPolicyStringElements(path+".Resource", statement["Resource"]), func(e iamPolicyElement) bool { return e.value == "*" }) {
+		return
+	}
+
+	for _, e := range iamPolicyStringElements(path+".Action", statement["Action"]) {
+		if e.value == "*" {
+			l.addFinding(e.path, `all actions are allowed on all resources ("*")`)
+			continue
+		}
+
+		for _, sensitive := range iamPolicySensitiveActions {
+			if iamPolicyWildcardMatch(e.value, sensitive) {
+				l.addFinding(e.path, "action %q allows sensitive action %q on all resources (\"*\")", e.value, sensitive)
+				break
+			}
+		}
+	}
+}
+
+type iamPolicyElement struct {
+	path  string
+	value string
+}
+
+// iamPolicyStringElements returns the string values, with their paths, of a policy element which may be a string or an array of strings.
+func iamPolicyStringElements(path string, v any) []iamPolicyElement {
+	var elements []iamPolicyElement
+
+	switch v := v.(type) {
+	case string:
+		elements = append(elements, iamPolicyElement{path: path, value: v})
+	case []any:
+		for i, v := range v {
+			if v, ok := v.(string); ok {
+				elements = append(elements, iamPolicyElement{path: fmt.Sprintf("%s[%d]", path, i), value: v})
+			}
+		}
+	}
+
+	return elements
+}
+
+// iamPolicyWildcardMatch returns whether the IAM policy pattern, which may contain "*" and "?" wildcards, matches s.
+// Matching is case-insensitive, as for IAM actions.
+func iamPolicyWildcardMatch(pattern, s string) bool {
+	// path.Match treats "[" and "\" specially, neither of which is valid in an IAM action.
+	if strings.ContainsAny(pattern, `[\`) {
+		return false
+	}
+
+	ok, _ := path.Match(strings.ToLower(pattern), strings.ToLower(s))
+
+	return ok
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLintIAMPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy string
+		want   []string
+	}{
+		"invalid JSON": {
+			policy: `{"Statement":`,
+		},
+		"no statements": {
+			policy: `{"Version":"2012-10-17"}`,
+		},
+		"valid": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": ["kms:Decrypt", "kms:GenerateDataKey*", "sqs:send*"],
+    "Resource": [
+      "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+      "arn:aws-us-gov:sqs:us-gov-west-1:123456789012:queue",
+      "arn:aws:sqs:*:123456789012:queue-*",
+      "arn:${data.aws_partition.current.partition}:sqs:us-west-2:123456789012:queue"
+    ],
+    "Condition": {
+      "StringEquals": {"aws:ResourceTag/Environment": "production"},
+      "ForAnyValue:StringLikeIfExists": {"aws:TagKeys": "env*"},
+      "Null": {"kms:ViaService": "false"}
+    }
+  }]
+}`, //lintignore:AWSAT003,AWSAT005
+		},
+		"single statement": {
+			policy: `{"Statement": {"Effect": "allow", "Action": "sqs:SendMessages", "Resource": "*"}}`,
+			want: []string{
+				`Statement.Effect: effect "allow" is not "Allow" or "Deny"`,
+				`Statement.Action: action "sqs:SendMessages" does not match any sqs action`,
+			},
+		},
+		"invalid action": {
+			policy: `{"Statement": [{"Effect": "Deny", "NotAction": ["kms:Encrypt", "kms", "secretsmanager:Get*Value", "secretsmanager:GetSecret"], "Resource": "*"}]}`,
+			want: []string{
+				`Statement[0].NotAction[1]: action "kms" is not of the form <service>:<action>`,
+				`Statement[0].NotAction[3]: action "secretsmanager:GetSecret" does not match any secretsmanager action`,
+			},
+		},
+		"unknown action": {
+			policy: `{"Statement": [{"Effect": "Allow", "Action": ["s3:GetObjectt", "s3:ListBucket", "iam:passrole", "ec2:DescribeInstance", "lambda:InvokeFunction"], "Resource": "arn:aws:s3:::bucket/*"}]}`,
+			want: []string{
+				`Statement[0].Action[0]: action "s3:GetObjectt" does not match any s3 action`,
+				`Statement[0].Action[3]: action "ec2:DescribeInstance" does not match any ec2 action`,
+			},
+		},
+		"uncataloged service": {
+			policy: `{"Statement": [{"Effect": "Deny", "Action": "example:DoAnything", "Resource": "arn:aws:example:us-west-2:123456789012:anything"}]}`, //lintignore:AWSAT003,AWSAT005
+		},
+		"invalid resource": {
+			policy: `{"Statement": [{"Effect": "Deny", "Action": "sqs:SendMessage", "Resource": ["queue", "arn:aws:sqs:us-west-2:123456789012:queue/name", "arn:aws:kms:us-west-2:123456789012:keys/1234"]}]}`, //lintignore:AWSAT003,AWSAT005
+			want: []string{
+				`Statement[0].Resource[0]: resource "queue" is not an ARN or "*"`,
+				`Statement[0].Resource[1]: resource "arn:aws:sqs:us-west-2:123456789012:queue/name" is not a valid sqs ARN`, //lintignore:AWSAT003,AWSAT005
+				`Statement[0].Resource[2]: resource "arn:aws:kms:us-west-2:123456789012:keys/1234" is not a valid kms ARN`,  //lintignore:AWSAT003,AWSAT005
+			},
+		},
+		"invalid condition": {
+			policy: `{"Statement": [{"Effect": "Deny", "Action": "sqs:SendMessage", "Resource": "*", "Condition": {"StringEqual": {"aws:SourceVpc": "vpc-1"}, "NullIfExists": {"aws:SourceVPCE": "true"}, "Bool": {"aws:SecureTransports": "false", "SecureTransport": "false"}}}]}`,
+			want: []string{
+				`Statement[0].Condition.Bool.SecureTransport: condition key "SecureTransport" is not of the form <service>:<key>`,
+				`Statement[0].Condition.Bool.aws:SecureTransports: condition key "aws:SecureTransports" is not a known aws condition key`,
+				`Statement[0].Condition.NullIfExists: condition operator "NullIfExists" is not valid`,
+				`Statement[0].Condition.StringEqual: condition operator "StringEqual" is not valid`,
+			},
+		},
+		"resource-based policy": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "Enable IAM User Permissions",
+    "Effect": "Allow",
+    "Principal": {"AWS": "arn:aws:iam::123456789012:root"},
+    "Action": "kms:*",
+    "Resource": "*"
+  }, {
+    "Effect": "Allow",
+    "NotPrincipal": {"AWS": "arn:aws:iam::123456789012:role/example"},
+    "Action": "*",
+    "Resource": "*",
+    "Condition": {"StringEquals": {"kms:EncryptionContext:Department": "IT", "aws:PrincipalTag/team": "example"}}
+  }]
+}`, //lintignore:AWSAT005
+		},
+		"sensitive actions on all resources": {
+			policy: `{"Statement": [{"Effect": "Allow", "Action": ["iam:PassRole", "iam:Get*", "secretsmanager:*"], "Resource": "*"}, {"Effect": "Allow", "Action": "*", "Resource": ["*"]}, {"Effect": "Deny", "Action": "*", "Resource": "*"}]}`,
+			want: []string{
+				`Statement[0].Action[0]: action "iam:PassRole" allows sensitive action "iam:PassRole" on all resources ("*")`,
+				`Statement[0].Action[2]: action "secretsmanager:*" allows sensitive action "secretsmanager:GetSecretValue" on all resources ("*")`,
+				`Statement[1].Action: all actions are allowed on all resources ("*")`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, finding := range LintIAMPolicy(testCase.policy) {
+				got = append(got, finding.String())
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyCatalogSensitiveActions(t *testing.T) {
+	t.Parallel()
+
+	catalog := loadIAMPolicyCatalog()
+
+	for _, action := range iamPolicySensitiveActions {
+		prefix, name, _ := strings.Cut(action, ":")
+
+		service, ok := catalog.Services[prefix]
+		if !ok {
+			t.Errorf("service %q of sensitive action %q is not in the catalog", prefix, action)
+			continue
+		}
+
+		if !slices.Contains(service.Actions, name) {
+			t.Errorf("sensitive action %q is not in the catalog", action)
+		}
+	}
+}
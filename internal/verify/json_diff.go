@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+)
+
+// JSONDiffs returns the semantic differences between two JSON documents, one per line, identified by JSON path.
+// Object keys are compared irrespective of order and the empty string is treated as an empty object.
+// Lines are prefixed by "+" for additions, "-" for removals and "~" for changes.
+func JSONDiffs(old, new string) ([]string, error) {
+	o, err := unmarshalJSONDocument(old)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := unmarshalJSONDocument(new)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []string
+	diffJSONValues("", o, n, &diffs)
+
+	return diffs, nil
+}
+
+// PolicyDiffs returns the semantic differences between two IAM policy documents, in the same form as JSONDiffs.
+// Single values are treated as single-element arrays, the order of values is ignored
+// and statements are matched by content or by Sid.
+func PolicyDiffs(old, new string) ([]string, error) {
+	o, err := unmarshalJSONDocument(old)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := unmarshalJSONDocument(new)
+	if err != nil {
+		return nil, err
+	}
+
+	o, n = normalizePolicyDocument(o), normalizePolicyDocument(n)
+
+	om, ok1 := o.(map[string]any)
+	nm, ok2 := n.(map[string]any)
+	if !ok1 || !ok2 {
+		var diffs []string
+		diffJSONValues("", o, n, &diffs)
+
+		return diffs, nil
+	}
+
+	os, _ := om["Statement"].([]any)
+	ns, _ := nm["Statement"].([]any)
+	delete(om, "Statement")
+	delete(nm, "Statement")
+
+	var diffs []string
+	diffJSONValues("", om, nm, &diffs)
+	diffPolicyStatements(os, ns, &diffs)
+
+	return diffs, nil
+}
+
+func unmarshalJSONDocument(s string) (any, error) {
+	if strings.TrimSpace(s) == "" {
+		return map[string]any{}, nil
+	}
+
+	var v any
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+func diffJSONValues(path string, old, new any, diffs *[]string) {
+	switch old := old.(type) {
+	case map[string]any:
+		if new, ok := new.(map[string]any); ok {
+			diffJSONObjects(path, old, new, diffs)
+			return
+		}
+
+	case []any:
+		if new, ok := new.([]any); ok {
+			diffJSONArrays(path, old, new, diffs)
+			return
+		}
+	}
+
+	if !reflect.DeepEqual(old, new) {
+		*diffs = append(*diffs, fmt.Sprintf("~ %s: %s => %s", jsonPathString(path), jsonValueString(old), jsonValueString(new)))
+	}
+}
+
+func diffJSONObjects(path string, old, new map[string]any, diffs *[]string) {
+	keys := make(map[string]struct{}, len(old)+len(new))
+	for k := range old {
+		keys[k] = struct{}{}
+	}
+	for k := range new {
+		keys[k] = struct{}{}
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(keys)) {
+		path := jsonPathAtKey(path, k)
+		ov, inOld := old[k]
+		nv, inNew := new[k]
+
+		switch {
+		case !inOld:
+			*diffs = append(*diffs, fmt.Sprintf("+ %s: %s", path, jsonValueString(nv)))
+		case !inNew:
+			*diffs = append(*diffs, fmt.Sprintf("- %s: %s", path, jsonValueString(ov)))
+		default:
+			diffJSONValues(path, ov, nv, diffs)
+		}
+	}
+}
+
+// diffJSONArrays compares arrays element by element if they are the same length.
+// Otherwise the elements removed from, and added to, the array are reported.
+func diffJSONArrays(path string, old, new []any, diffs *[]string) {
+	if len(old) == len(new) {
+		for i := range old {
+			diffJSONValues(jsonPathAtIndex(path, i), old[i], new[i], diffs)
+		}
+
+		return
+	}
+
+	matched := make([]bool, len(old))
+	var added []string
+	for j, nv := range new {
+		if i := indexOfUnmatched(old, matched, func(ov any) bool { return reflect.DeepEqual(ov, nv) }); i != -1 {
+			matched[i] = true
+			continue
+		}
+
+		added = append(added, fmt.Sprintf("+ %s: %s", jsonPathAtIndex(path, j), jsonValueString(nv)))
+	}
+
+	for i, ov := range old {
+		if !matched[i] {
+			*diffs = append(*diffs, fmt.Sprintf("- %s: %s", jsonPathAtIndex(path, i), jsonValueString(ov)))
+		}
+	}
+	*diffs = append(*diffs, added...)
+}
+
+// diffPolicyStatements pairs identical statements, then statements with the same Sid, and compares each pair.
+// Unpaired statements are reported as removed or added.
+func diffPolicyStatements(old, new []any, diffs *[]string) {
+	const path = "Statement"
+
+	matched := make([]bool, len(old))
+	pairs := make([]int, len(new))
+	for j, ns := range new {
+		pairs[j] = indexOfUnmatched(old, matched, func(os any) bool { return reflect.DeepEqual(os, ns) })
+		if pairs[j] != -1 {
+			matched[pairs[j]] = true
+		}
+	}
+	for j, ns := range new {
+		if pairs[j] != -1 {
+			continue
+		}
+
+		if sid := policyStatementSid(ns); sid != "" {
+			pairs[j] = indexOfUnmatched(old, matched, func(os any) bool { return policyStatementSid(os) == sid })
+			if pairs[j] != -1 {
+				matched[pairs[j]] = true
+			}
+		}
+	}
+
+	for i, os := range old {
+		if !matched[i] {
+			*diffs = append(*diffs, fmt.Sprintf("- %s: %s", jsonPathAtIndex(path, i), jsonValueString(os)))
+		}
+	}
+	for j, ns := range new {
+		if pairs[j] == -1 {
+			*diffs = append(*diffs, fmt.Sprintf("+ %s: %s", jsonPathAtIndex(path, j), jsonValueString(ns)))
+			continue
+		}
+
+		diffJSONValues(jsonPathAtIndex(path, j), old[pairs[j]], ns, diffs)
+	}
+}
+
+func indexOfUnmatched(s []any, matched []bool, f func(any) bool) int {
+	for i, v := range s {
+		if !matched[i] && f(v) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func policyStatementSid(v any) string {
+	if statement, ok := v.(map[string]any); ok {
+		if sid, ok := statement["Sid"].(string); ok {
+			return sid
+		}
+	}
+
+	return ""
+}
+
+// normalizePolicyDocument converts single statements and single values to arrays and sorts values.
+func normalizePolicyDocument(v any) any {
+	doc, ok := v.(map[string]any)
+	if !ok {
+		return v
+	}
+
+	if statement, ok := doc["Statement"].(map[string]any); ok {
+		doc["Statement"] = []any{statement}
+	}
+
+	statements, _ := doc["Statement"].([]any)
+	for _, statement := range statements {
+		statement, ok := statement.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for _, k := range []string{"Action", "NotAction", "Resource", "NotResource"} {
+			if v, ok := statement[k]; ok {
+				statement[k] = normalizePolicyValues(v)
+			}
+		}
+
+		for _, k := range []string{"Principal", "NotPrincipal"} {
+			if principals, ok := statement[k].(map[string]any); ok {
+				for k, v := range principals {
+					principals[k] = normalizePolicyValues(v)
+				}
+			}
+		}
+
+		if conditions, ok := statement["Condition"].(map[string]any); ok {
+			for _, v := range conditions {
+				if keys, ok := v.(map[string]any); ok {
+					for k, v := range keys {
+						keys[k] = normalizePolicyValues(v)
+					}
+				}
+			}
+		}
+	}
+
+	return doc
+}
+
+func normalizePolicyValues(v any) any {
+	switch v := v.(type) {
+	case []any:
+		slices.SortFunc(v, func(a, b any) int {
+			return strings.Compare(jsonValueString(a), jsonValueString(b))
+		})
+		return v
+
+	case map[string]any:
+		return v
+
+	default:
+		return []any{v}
+	}
+}
+
+var jsonPathIdentifier = regexache.MustCompile(`^[A-Za-z_][0-9A-Za-z_]*$`)
+
+func jsonPathAtKey(path, k string) string {
+	if !jsonPathIdentifier.MatchString(k) {
+		return fmt.Sprintf("%s[%q]", path, k)
+	}
+
+	if path == "" {
+		return k
+	}
+
+	return path + "." + k
+}
+
+func jsonPathAtIndex(path string, i int) string {
+	return fmt.Sprintf("%s[%d]", path, i)
+}
+
+func jsonPathString(path string) string {
+	if path == "" {
+		return "(document)"
+	}
+
+	return path
+}
+
+func jsonValueString(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(b)
+}
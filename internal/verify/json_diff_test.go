@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestJSONDiffs(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		old, new  string
+		want      []string
+		wantError bool
+	}{
+		"equivalent": {
+			old: `{"a": 1, "b": [1, 2]}`,
+			new: `{"b":[1,2],"a":1}`,
+		},
+		"empty": {
+			old:  ``,
+			new:  `{"a": 1}`,
+			want: []string{`+ a: 1`},
+		},
+		"changed keys": {
+			old: `{"a": 1, "b": {"c": "x", "d e": true}, "f": null}`,
+			new: `{"a": 2, "b": {"c": "y", "d e": false}, "g": [1]}`,
+			want: []string{
+				`~ a: 1 => 2`,
+				`~ b.c: "x" => "y"`,
+				`~ b["d e"]: true => false`,
+				`- f: null`,
+				`+ g: [1]`,
+			},
+		},
+		"array elements": {
+			old: `{"States": [{"Name": "a"}, {"Name": "b"}]}`,
+			new: `{"States": [{"Name": "a"}, {"Name": "c"}, {"Name": "b"}]}`,
+			want: []string{
+				`+ States[1]: {"Name":"c"}`,
+			},
+		},
+		"different types": {
+			old:  `[1]`,
+			new:  `{"a": 1}`,
+			want: []string{`~ (document): [1] => {"a":1}`},
+		},
+		"invalid": {
+			old:       `{`,
+			new:       `{}`,
+			wantError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := JSONDiffs(testCase.old, testCase.new)
+
+			if got, want := err != nil, testCase.wantError; got != want {
+				t.Fatalf("JSONDiffs() err %t, want %t", got, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyDiffs(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		old, new string
+		want     []string
+	}{
+		"equivalent": {
+			old: `{"Version": "2012-10-17", "Statement": {"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}}`,
+			new: `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": ["s3:GetObject"], "Resource": ["*"]}]}`,
+		},
+		"reordered statements and values": {
+			old: `{"Statement": [{"Sid": "A", "Effect": "Allow", "Action": ["s3:PutObject", "s3:GetObject"], "Resource": "*"}, {"Sid": "B", "Effect": "Deny", "Action": "s3:*", "Resource": "*"}]}`,
+			new: `{"Statement": [{"Sid": "B", "Effect": "Deny", "Action": "s3:*", "Resource": "*"}, {"Sid": "A", "Effect": "Allow", "Action": ["s3:GetObject", "s3:PutObject"], "Resource": "*"}]}`,
+		},
+		"changed statement": {
+			old: `{"Version": "2008-10-17", "Statement": [{"Sid": "A", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}, {"Sid": "B", "Effect": "Deny", "Action": "s3:*", "Resource": "*"}]}`,
+			new: `{"Version": "2012-10-17", "Statement": [{"Sid": "B", "Effect": "Deny", "Action": "s3:*", "Resource": "*"}, {"Sid": "A", "Effect": "Allow", "Action": ["s3:GetObject", "s3:ListBucket"], "Resource": "*"}]}`,
+			want: []string{
+				`~ Version: "2008-10-17" => "2012-10-17"`,
+				`+ Statement[1].Action[1]: "s3:ListBucket"`,
+			},
+		},
+		"added and removed statements": {
+			old: `{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`,
+			new: `{"Statement": [{"Effect": "Allow", "Action": "s3:PutObject", "Resource": "*"}]}`,
+			want: []string{
+				`- Statement[0]: {"Action":["s3:GetObject"],"Effect":"Allow","Resource":["*"]}`,
+				`+ Statement[0]: {"Action":["s3:PutObject"],"Effect":"Allow","Resource":["*"]}`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := PolicyDiffs(testCase.old, testCase.new)
+			if err != nil {
+				t.Fatalf("PolicyDiffs() err %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
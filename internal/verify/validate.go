@@ -229,6 +229,10 @@ func ValidIAMPolicyJSON(v any, k string) (ws []string, errors []error) {
 		return //nolint:nakedret // Naked return due to legacy, non-idiomatic Go function, error handling
 	}
 
+	for _, finding := range LintIAMPolicy(value) {
+		ws = append(ws, fmt.Sprintf("%q: %s", k, finding))
+	}
+
 	return //nolint:nakedret // Just a long function.
 }
 
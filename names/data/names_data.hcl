@@ -45,6 +45,7 @@ service "account" {
   provider_package_correct = "account"
   doc_prefix               = ["account_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "acm" {
@@ -885,6 +886,7 @@ service "billing" {
   provider_package_correct = "billing"
   doc_prefix               = ["billing_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "billingconductor" {
@@ -959,6 +961,7 @@ service "ce" {
   provider_package_correct = "ce"
   doc_prefix               = ["ce_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "chatbot" {
@@ -1303,6 +1306,7 @@ service "cloudfront" {
   provider_package_correct = "cloudfront"
   doc_prefix               = ["cloudfront_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "cloudfrontkeyvaluestore" {
@@ -2360,6 +2364,7 @@ service "cur" {
   provider_package_correct = "cur"
   doc_prefix               = ["cur_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "dataexchange" {
@@ -3733,6 +3738,7 @@ service "globalaccelerator" {
   provider_package_correct = "globalaccelerator"
   doc_prefix               = ["globalaccelerator_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "glue" {
@@ -3916,6 +3922,7 @@ service "iam" {
   provider_package_correct = "iam"
   doc_prefix               = ["iam_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "inspector" {
@@ -5623,6 +5630,7 @@ service "networkmanager" {
   provider_package_correct = "networkmanager"
   doc_prefix               = ["networkmanager_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "nimble" {
@@ -5820,6 +5828,7 @@ service "organizations" {
   provider_package_correct = "organizations"
   doc_prefix               = ["organizations_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "outposts" {
@@ -6711,6 +6720,7 @@ service "route53" {
   provider_package_correct = "route53"
   doc_prefix               = ["route53_cidr_", "route53_delegation_", "route53_health_", "route53_hosted_", "route53_key_", "route53_query_", "route53_record", "route53_traffic_", "route53_vpc_", "route53_zone"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "route53domains" {
@@ -6737,6 +6747,7 @@ service "route53domains" {
   provider_package_correct = "route53domains"
   doc_prefix               = ["route53domains_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "route53profiles" {
@@ -6816,6 +6827,7 @@ service "route53recoverycontrolconfig" {
   provider_package_correct = "route53recoverycontrolconfig"
   doc_prefix               = ["route53recoverycontrolconfig_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "route53recoveryreadiness" {
@@ -6847,6 +6859,7 @@ service "route53recoveryreadiness" {
   provider_package_correct = "route53recoveryreadiness"
   doc_prefix               = ["route53recoveryreadiness_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "route53resolver" {
@@ -7500,6 +7513,7 @@ service "shield" {
   provider_package_correct = "shield"
   doc_prefix               = ["shield_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "signer" {
@@ -8269,6 +8283,7 @@ service "waf" {
   provider_package_correct = "waf"
   doc_prefix               = ["waf_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "wafregional" {
@@ -8321,6 +8336,7 @@ service "budgets" {
   provider_package_correct = "budgets"
   doc_prefix               = ["budgets_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "wellarchitected" {
@@ -173,6 +173,10 @@ func (sr ServiceRecord) Exclude() bool {
 	return sr.service.Exclude
 }
 
+func (sr ServiceRecord) IsGlobal() bool {
+	return sr.service.IsGlobal
+}
+
 func (sr ServiceRecord) NotImplemented() bool {
 	return sr.service.NotImplemented
 }
@@ -341,6 +345,7 @@ type Service struct {
 	DocPrefix                     []string `hcl:"doc_prefix,optional"`
 	Brand                         string   `hcl:"brand,optional"`
 	Exclude                       bool     `hcl:"exclude,optional"`
+	IsGlobal                      bool     `hcl:"is_global,optional"`
 	NotImplemented                bool     `hcl:"not_implemented,optional"`
 	AllowedSubcategory            bool     `hcl:"allowed_subcategory,optional"`
 	Note                          string   `hcl:"note,optional"`
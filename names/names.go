@@ -167,6 +167,7 @@ type serviceDatum struct {
 	aliases           []string
 	brand             string
 	humanFriendly     string
+	isGlobal          bool
 	providerNameUpper string
 }
 
@@ -205,6 +206,7 @@ func readHCLIntoServiceData() error {
 		sd := serviceDatum{
 			brand:             l.Brand(),
 			humanFriendly:     l.HumanFriendly(),
+			isGlobal:          l.IsGlobal(),
 			providerNameUpper: l.ProviderNameUpper(),
 		}
 
@@ -262,6 +264,15 @@ func ProviderNameUpper(service string) (string, error) {
 	return "", fmt.Errorf("no service data found for %s", service)
 }
 
+// IsGlobal returns whether the service's resources are global rather than Regional.
+func IsGlobal(service string) bool {
+	if v, ok := serviceData[service]; ok {
+		return v.isGlobal
+	}
+
+	return false
+}
+
 func FullHumanFriendly(service string) (string, error) {
 	if v, ok := serviceData[service]; ok {
 		if v.brand == "" {
@@ -4,7 +4,12 @@
 package cmd
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
 	"github.com/hashicorp/terraform-provider-aws/skaff/resource"
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdkmodel"
 	"github.com/spf13/cobra"
 )
 
@@ -15,13 +20,42 @@ var (
 	force         bool
 	pluginSDKV2   bool
 	includeTags   bool
+	sdkPackage    string
+	sdkOperations sdkmodel.Operations
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return resource.Create(name, snakeName, !clearComments, force, !pluginSDKV2, includeTags)
+		if sdkOperations.Create == "" {
+			return resource.Create(name, snakeName, !clearComments, force, !pluginSDKV2, includeTags)
+		}
+
+		if pluginSDKV2 {
+			return errors.New("resources generated from the AWS SDK model use Terraform Plugin Framework")
+		}
+
+		steps, err := resource.CreateFromSDK(context.Background(), resource.SDKOptions{
+			Name:       name,
+			SnakeName:  snakeName,
+			Comments:   !clearComments,
+			Force:      force,
+			SDKPackage: sdkPackage,
+			Operations: sdkOperations,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(steps) > 0 {
+			fmt.Fprintln(cmd.OutOrStdout(), "Remaining steps:")
+			for _, v := range steps {
+				fmt.Fprintf(cmd.OutOrStdout(), "  - %s\n", v)
+			}
+		}
+
+		return nil
 	},
 }
 
@@ -33,4 +67,10 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
 	resourceCmd.Flags().BoolVarP(&pluginSDKV2, "plugin-sdkv2", "p", false, "generate for Terraform Plugin SDK V2")
 	resourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	resourceCmd.Flags().StringVar(&sdkPackage, "sdk-package", "", "AWS SDK for Go v2 service package, if different from the service's default (e.g., mq)")
+	resourceCmd.Flags().StringVar(&sdkOperations.Create, "sdk-create", "", "generate the resource from the AWS SDK model, using this create operation (e.g., CreateBroker)")
+	resourceCmd.Flags().StringVar(&sdkOperations.Read, "sdk-read", "", "read operation used with --sdk-create (e.g., DescribeBroker)")
+	resourceCmd.Flags().StringVar(&sdkOperations.Update, "sdk-update", "", "update operation used with --sdk-create (e.g., UpdateBroker)")
+	resourceCmd.Flags().StringVar(&sdkOperations.Delete, "sdk-delete", "", "delete operation used with --sdk-create (e.g., DeleteBroker)")
+	resourceCmd.Flags().StringVar(&sdkOperations.List, "sdk-list", "", "list operation used with --sdk-create to generate a sweeper (e.g., ListBrokers)")
 }
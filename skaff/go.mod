@@ -4,6 +4,7 @@ go 1.23.7
 
 require (
 	github.com/YakDriver/regexache v0.24.0
+	github.com/google/go-cmp v0.7.0
 	github.com/hashicorp/terraform-provider-aws v1.60.1-0.20220322001452-8f7a597d0c24
 	github.com/spf13/cobra v1.9.1
 )
@@ -11,7 +12,6 @@ require (
 require (
 	github.com/agext/levenshtein v1.2.3 // indirect
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
-	github.com/google/go-cmp v0.7.0 // indirect
 	github.com/google/uuid v1.6.0 // indirect
 	github.com/hashicorp/aws-sdk-go-base/v2 v2.0.0-beta.63 // indirect
 	github.com/hashicorp/hcl/v2 v2.23.0 // indirect
@@ -117,7 +117,7 @@ func Create(resName, snakeName string, comments, force, pluginFramework, tags bo
 	return nil
 }
 
-func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+func writeTemplate(templateName, filename, tmpl string, force bool, td any) error {
 	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
 		return fmt.Errorf("file (%s) already exists and force is not set", filename)
 	}
@@ -0,0 +1,1202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	namesgen "github.com/hashicorp/terraform-provider-aws/names/generate"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdkmodel"
+)
+
+//go:embed resourcesdk.gtpl
+var resourceSDKTmpl string
+
+//go:embed resourcesdktest.gtpl
+var resourceSDKTestTmpl string
+
+//go:embed resourcesdktags.gtpl
+var resourceSDKTagsTmpl string
+
+// SDKTemplateData is the template data for a resource generated from an AWS SDK for Go v2 model.
+type SDKTemplateData struct {
+	TemplateData
+
+	CreateOp string
+	ReadOp   string
+	UpdateOp string
+	DeleteOp string
+
+	Imports           []string
+	Unsupported       []string
+	Attributes        []*SchemaEntry
+	Blocks            []*SchemaEntry
+	ModelFields       []*ModelField
+	Models            []*NestedModel
+	ClientToken       bool
+	CreateOutputField string
+	Identifier        *Identifier
+	Finder            *Finder
+	Status            *Status
+	Sweeper           *Sweeper
+	TagsIdentifier    string
+	ExistsType        string
+	TestConfig        string
+	TagsConfig        string
+}
+
+// SchemaEntry is an attribute or block in a schema map.
+type SchemaEntry struct {
+	Name string
+	Key  string
+	Code string
+}
+
+// ModelField is a field of a resource or nested model struct.
+type ModelField struct {
+	GoName string
+	Type   string
+	TFName string
+}
+
+// NestedModel is the model struct for a nested SDK structure.
+type NestedModel struct {
+	Name   string
+	Fields []*ModelField
+}
+
+// Identifier describes the attribute that identifies the resource in AWS API calls.
+type Identifier struct {
+	Attr        string
+	Name        string
+	ModelField  string
+	SDKField    string
+	DeleteField string
+}
+
+// Finder describes the find function built on the read operation.
+type Finder struct {
+	ResultType  string
+	OutputField string
+	List        bool
+	NotFound    string
+}
+
+// Status describes the status function and waiters built on an enum field of the read result.
+type Status struct {
+	Field         string
+	CreatePending string
+	CreateTarget  string
+	UpdatePending string
+	UpdateTarget  string
+	DeletePending string
+}
+
+// Sweeper describes the sweeper built on the list operation.
+type Sweeper struct {
+	ListOp         string
+	Paginated      bool
+	ItemsField     string
+	ItemIdentifier string
+}
+
+// SDKOptions configures CreateFromSDK.
+type SDKOptions struct {
+	Name       string
+	SnakeName  string
+	Comments   bool
+	Force      bool
+	SDKPackage string
+	Operations sdkmodel.Operations
+}
+
+// CreateFromSDK generates a complete Plugin Framework resource, its acceptance tests and documentation
+// from the input and output structures of AWS SDK for Go v2 operations.
+// It returns follow-up steps that must be completed by hand.
+func CreateFromSDK(ctx context.Context, opts SDKOptions) ([]string, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	if opts.Name == "" {
+		return nil, fmt.Errorf("error checking: no name given")
+	}
+
+	if opts.Name == strings.ToLower(opts.Name) {
+		return nil, fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+	}
+
+	if opts.SnakeName != "" && opts.SnakeName != strings.ToLower(opts.SnakeName) {
+		return nil, fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+	}
+
+	snakeName := opts.SnakeName
+	if snakeName == "" {
+		snakeName = names.ToSnakeCase(opts.Name)
+	}
+
+	service, err := data.LookupService(servicePackage)
+	if err != nil {
+		return nil, fmt.Errorf("error looking up service package data for %q: %w", servicePackage, err)
+	}
+
+	sdkPackage := opts.SDKPackage
+	if sdkPackage == "" {
+		sdkPackage = service.GoV2Package()
+	}
+
+	model, err := sdkmodel.Load(ctx, wd, sdkPackage, opts.Operations)
+	if err != nil {
+		return nil, fmt.Errorf("loading SDK model: %w", err)
+	}
+
+	templateData := TemplateData{
+		Resource:             opts.Name,
+		ResourceLower:        strings.ToLower(opts.Name),
+		ResourceSnake:        snakeName,
+		HumanFriendlyService: service.HumanFriendly(),
+		IncludeComments:      opts.Comments,
+		SDKPackage:           sdkPackage,
+		ServicePackage:       servicePackage,
+		Service:              service.ProviderNameUpper(),
+		ServiceLower:         strings.ToLower(service.ProviderNameUpper()),
+		AWSServiceName:       service.FullHumanFriendly(),
+		PluginFramework:      true,
+		HumanResourceName:    convert.ToHumanResName(opts.Name),
+		ProviderResourceName: convert.ToProviderResourceName(servicePackage, snakeName),
+	}
+
+	sdkData, err := newSDKTemplateData(templateData, model)
+	if err != nil {
+		return nil, err
+	}
+
+	f := fmt.Sprintf("%s.go", snakeName)
+	if err = writeGoTemplate("newres", f, resourceSDKTmpl, opts.Force, sdkData, resourceImports(sdkPackage)); err != nil {
+		return nil, fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", snakeName)
+	if err = writeGoTemplate("restest", tf, resourceSDKTestTmpl, opts.Force, sdkData, testImports(sdkPackage, servicePackage)); err != nil {
+		return nil, fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	if sdkData.IncludeTags {
+		tagsf := filepath.Join("testdata", "tmpl", fmt.Sprintf("%s_tags.gtpl", snakeName))
+		if err := os.MkdirAll(filepath.Dir(tagsf), 0755); err != nil {
+			return nil, fmt.Errorf("creating tags test template directory: %w", err)
+		}
+		if err = writeTemplate("restags", tagsf, resourceSDKTagsTmpl, opts.Force, sdkData); err != nil {
+			return nil, fmt.Errorf("writing resource tags test template: %w", err)
+		}
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, opts.Force, templateData); err != nil {
+		return nil, fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	steps := []string{
+		fmt.Sprintf("add `var Resource%[1]s = newResource%[1]s` and `Find%[1]sByID = find%[1]sByID` to exports_test.go", opts.Name),
+		"run `go generate` in the service package to register the resource",
+	}
+	if sdkData.Sweeper != nil {
+		steps = append(steps, fmt.Sprintf("register the sweeper in sweep.go: `awsv2.Register(%q, sweep%ss)`", templateData.ProviderResourceName, opts.Name))
+	}
+	for _, v := range sdkData.Unsupported {
+		steps = append(steps, "add unsupported field "+v)
+	}
+
+	return steps, nil
+}
+
+// writeGoTemplate renders a Go source template, adds the imports it uses and formats the result.
+// If the result cannot be formatted, it is written unformatted so that the problem can be inspected.
+func writeGoTemplate(templateName, filename, tmpl string, force bool, td *SDKTemplateData, imports []goImport) error {
+	if _, err := os.Stat(filename); err == nil && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	body, err := renderSDKTemplate(templateName, tmpl, td, imports)
+	if err != nil {
+		return err
+	}
+
+	contents, formatErr := format.Source(body)
+	if formatErr != nil {
+		contents = body
+	}
+
+	if err := os.WriteFile(filename, contents, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	if formatErr != nil {
+		return fmt.Errorf("error formatting generated file (%s): %w", filename, formatErr)
+	}
+
+	return nil
+}
+
+func renderSDKTemplate(templateName, tmpl string, td *SDKTemplateData, imports []goImport) ([]byte, error) {
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %s", err)
+	}
+
+	// The first pass determines which imports the generated code uses.
+	data := *td
+	data.Imports = nil
+	var buffer bytes.Buffer
+	if err := tplate.Execute(&buffer, &data); err != nil {
+		return nil, fmt.Errorf("error executing template: %s", err)
+	}
+
+	data.Imports = usedImports(buffer.String(), imports)
+	buffer.Reset()
+	if err := tplate.Execute(&buffer, &data); err != nil {
+		return nil, fmt.Errorf("error executing template: %s", err)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+type goImport struct {
+	selector string
+	path     string
+	alias    string
+}
+
+func (i goImport) String() string {
+	if i.alias != "" {
+		return fmt.Sprintf("%s %q", i.alias, i.path)
+	}
+	return strconv.Quote(i.path)
+}
+
+func (i goImport) standard() bool {
+	return !strings.Contains(strings.Split(i.path, "/")[0], ".")
+}
+
+func resourceImports(sdkPackage string) []goImport {
+	return []goImport{
+		{selector: "context", path: "context"},
+		{selector: "errors", path: "errors"},
+		{selector: "fmt", path: "fmt"},
+		{selector: "time", path: "time"},
+		{selector: "aws", path: "github.com/aws/aws-sdk-go-v2/aws"},
+		{selector: sdkPackage, path: "github.com/aws/aws-sdk-go-v2/service/" + sdkPackage},
+		{selector: "awstypes", path: "github.com/aws/aws-sdk-go-v2/service/" + sdkPackage + "/types", alias: "awstypes"},
+		{selector: "timeouts", path: "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"},
+		{selector: "timetypes", path: "github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"},
+		{selector: "listvalidator", path: "github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"},
+		{selector: "path", path: "github.com/hashicorp/terraform-plugin-framework/path"},
+		{selector: "resource", path: "github.com/hashicorp/terraform-plugin-framework/resource"},
+		{selector: "schema", path: "github.com/hashicorp/terraform-plugin-framework/resource/schema"},
+		{selector: "boolplanmodifier", path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"},
+		{selector: "float32planmodifier", path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/float32planmodifier"},
+		{selector: "float64planmodifier", path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"},
+		{selector: "int32planmodifier", path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"},
+		{selector: "int64planmodifier", path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"},
+		{selector: "listplanmodifier", path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"},
+		{selector: "mapplanmodifier", path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"},
+		{selector: "planmodifier", path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"},
+		{selector: "stringplanmodifier", path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"},
+		{selector: "validator", path: "github.com/hashicorp/terraform-plugin-framework/schema/validator"},
+		{selector: "types", path: "github.com/hashicorp/terraform-plugin-framework/types"},
+		{selector: "sdkid", path: "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id", alias: "sdkid"},
+		{selector: "retry", path: "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"},
+		{selector: "conns", path: "github.com/hashicorp/terraform-provider-aws/internal/conns"},
+		{selector: "create", path: "github.com/hashicorp/terraform-provider-aws/internal/create"},
+		{selector: "enum", path: "github.com/hashicorp/terraform-provider-aws/internal/enum"},
+		{selector: "errs", path: "github.com/hashicorp/terraform-provider-aws/internal/errs"},
+		{selector: "fwdiag", path: "github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"},
+		{selector: "framework", path: "github.com/hashicorp/terraform-provider-aws/internal/framework"},
+		{selector: "fwflex", path: "github.com/hashicorp/terraform-provider-aws/internal/framework/flex", alias: "fwflex"},
+		{selector: "fwtypes", path: "github.com/hashicorp/terraform-provider-aws/internal/framework/types", alias: "fwtypes"},
+		{selector: "sweep", path: "github.com/hashicorp/terraform-provider-aws/internal/sweep"},
+		{selector: "sweepfw", path: "github.com/hashicorp/terraform-provider-aws/internal/sweep/framework", alias: "sweepfw"},
+		{selector: "tftags", path: "github.com/hashicorp/terraform-provider-aws/internal/tags", alias: "tftags"},
+		{selector: "tfresource", path: "github.com/hashicorp/terraform-provider-aws/internal/tfresource"},
+		{selector: "names", path: "github.com/hashicorp/terraform-provider-aws/names"},
+	}
+}
+
+func testImports(sdkPackage, servicePackage string) []goImport {
+	return []goImport{
+		{selector: "context", path: "context"},
+		{selector: "errors", path: "errors"},
+		{selector: "fmt", path: "fmt"},
+		{selector: "testing", path: "testing"},
+		{selector: sdkPackage, path: "github.com/aws/aws-sdk-go-v2/service/" + sdkPackage},
+		{selector: "awstypes", path: "github.com/aws/aws-sdk-go-v2/service/" + sdkPackage + "/types", alias: "awstypes"},
+		{selector: "sdkacctest", path: "github.com/hashicorp/terraform-plugin-testing/helper/acctest", alias: "sdkacctest"},
+		{selector: "resource", path: "github.com/hashicorp/terraform-plugin-testing/helper/resource"},
+		{selector: "terraform", path: "github.com/hashicorp/terraform-plugin-testing/terraform"},
+		{selector: "acctest", path: "github.com/hashicorp/terraform-provider-aws/internal/acctest"},
+		{selector: "conns", path: "github.com/hashicorp/terraform-provider-aws/internal/conns"},
+		{selector: "create", path: "github.com/hashicorp/terraform-provider-aws/internal/create"},
+		{selector: "tf" + servicePackage, path: "github.com/hashicorp/terraform-provider-aws/internal/service/" + servicePackage, alias: "tf" + servicePackage},
+		{selector: "tfresource", path: "github.com/hashicorp/terraform-provider-aws/internal/tfresource"},
+		{selector: "names", path: "github.com/hashicorp/terraform-provider-aws/names"},
+	}
+}
+
+// usedImports returns the import specs, standard library first, for the package selectors used in src.
+// An empty string separates the standard library and other import groups.
+func usedImports(src string, imports []goImport) []string {
+	var std, other []string
+	for _, i := range imports {
+		re := regexache.MustCompile(`(^|[^A-Za-z0-9_."])` + regexp.QuoteMeta(i.selector) + `\.[A-Z]`)
+		if !re.MatchString(src) {
+			continue
+		}
+		if i.standard() {
+			std = append(std, i.String())
+		} else {
+			other = append(other, i.String())
+		}
+	}
+
+	if len(std) > 0 && len(other) > 0 {
+		std = append(std, "")
+	}
+
+	return append(std, other...)
+}
+
+// skippedInputFields are SDK input fields that are never exposed as attributes.
+var skippedInputFields = []string{
+	"ClientToken",
+	"DryRun",
+	"MaxResults",
+	"NextToken",
+	"Tags",
+}
+
+// member is an SDK field that becomes a schema attribute or block.
+type member struct {
+	field    *sdkmodel.Field
+	sdkName  string
+	name     string
+	goName   string
+	required bool
+	computed bool
+	forceNew bool
+}
+
+type generator struct {
+	sdkPackage  string
+	models      map[string]*NestedModel
+	unsupported []string
+}
+
+func newSDKTemplateData(td TemplateData, model *sdkmodel.Model) (*SDKTemplateData, error) {
+	if model.Create == nil || model.Read == nil || model.Delete == nil {
+		return nil, errors.New("create, read and delete operations are required")
+	}
+
+	g := &generator{
+		sdkPackage: td.SDKPackage,
+		models:     make(map[string]*NestedModel),
+	}
+
+	d := &SDKTemplateData{
+		TemplateData: td,
+		CreateOp:     model.Create.Name,
+		ReadOp:       model.Read.Name,
+		DeleteOp:     model.Delete.Name,
+		ClientToken:  model.Create.Input.Field("ClientToken") != nil,
+	}
+	if model.Update != nil {
+		d.UpdateOp = model.Update.Name
+	}
+	if f := model.Create.Input.Field("Tags"); f != nil {
+		d.IncludeTags = true
+	}
+
+	result, finder := g.finder(td.Resource, model)
+	d.Finder = finder
+
+	identifier, err := g.identifier(model)
+	if err != nil {
+		return nil, err
+	}
+
+	members := g.members(td.Resource, model, result, identifier)
+	for _, m := range members {
+		if m.sdkName == identifier.SDKField {
+			identifier.Name = m.name
+			identifier.Attr = namesgen.ConstOrQuote(m.name)
+			identifier.ModelField = m.goName
+		}
+	}
+	if f := model.Delete.Input.Field(identifier.SDKField); f != nil {
+		identifier.DeleteField = f.Name
+	}
+	d.Identifier = identifier
+
+	if f := createOutputField(model.Create.Output, result); f != "" {
+		d.CreateOutputField = f
+	}
+
+	for _, m := range members {
+		if code, ok := g.attribute(m); ok {
+			d.Attributes = append(d.Attributes, &SchemaEntry{Name: m.name, Key: namesgen.ConstOrQuote(m.name), Code: code})
+		} else if code, ok := g.block(m); ok {
+			d.Blocks = append(d.Blocks, &SchemaEntry{Name: m.name, Key: namesgen.ConstOrQuote(m.name), Code: code})
+		} else {
+			continue
+		}
+
+		d.ModelFields = append(d.ModelFields, &ModelField{GoName: m.goName, Type: g.modelType(m.field, isARN(m)), TFName: m.name})
+	}
+
+	if d.IncludeTags {
+		d.Attributes = append(d.Attributes,
+			&SchemaEntry{Name: names.AttrTags, Key: namesgen.ConstOrQuote(names.AttrTags), Code: "tftags.TagsAttribute()"},
+			&SchemaEntry{Name: names.AttrTagsAll, Key: namesgen.ConstOrQuote(names.AttrTagsAll), Code: "tftags.TagsAttributeComputedOnly()"},
+		)
+		d.ModelFields = append(d.ModelFields,
+			&ModelField{GoName: "Tags", Type: "tftags.Map", TFName: names.AttrTags},
+			&ModelField{GoName: "TagsAll", Type: "tftags.Map", TFName: names.AttrTagsAll},
+		)
+
+		d.TagsIdentifier = identifier.Name
+		for _, m := range members {
+			if m.name == names.AttrARN {
+				d.TagsIdentifier = m.name
+			}
+		}
+	}
+
+	d.Status = g.status(td.Resource, result, model.Update != nil)
+	if d.Status != nil {
+		d.Blocks = append(d.Blocks, &SchemaEntry{
+			Name: names.AttrTimeouts,
+			Key:  namesgen.ConstOrQuote(names.AttrTimeouts),
+			Code: fmt.Sprintf("timeouts.Block(ctx, timeouts.Opts{\nCreate: true,\nUpdate: %t,\nDelete: true,\n})", model.Update != nil),
+		})
+		d.ModelFields = append(d.ModelFields, &ModelField{GoName: "Timeouts", Type: "timeouts.Value", TFName: names.AttrTimeouts})
+	}
+
+	d.Sweeper = g.sweeper(model, identifier)
+
+	slices.SortFunc(d.Attributes, func(a, b *SchemaEntry) int { return strings.Compare(a.Name, b.Name) })
+	slices.SortFunc(d.Blocks, func(a, b *SchemaEntry) int { return strings.Compare(a.Name, b.Name) })
+	slices.SortFunc(d.ModelFields, func(a, b *ModelField) int { return strings.Compare(a.GoName, b.GoName) })
+
+	for _, v := range g.models {
+		slices.SortFunc(v.Fields, func(a, b *ModelField) int { return strings.Compare(a.GoName, b.GoName) })
+		d.Models = append(d.Models, v)
+	}
+	slices.SortFunc(d.Models, func(a, b *NestedModel) int { return strings.Compare(a.Name, b.Name) })
+
+	d.ExistsType = existsType(td.SDKPackage, finder.ResultType)
+	d.TestConfig = testConfig(members, `%[1]q`, "  ")
+	d.TagsConfig = testConfig(members, "var.rName", "  ")
+	d.Unsupported = g.unsupported
+
+	return d, nil
+}
+
+// finder returns the SDK structure that describes the resource and how to extract it from the read operation's output.
+func (g *generator) finder(resourceName string, model *sdkmodel.Model) (*sdkmodel.Shape, *Finder) {
+	output := model.Read.Output
+	finder := &Finder{
+		NotFound: notFoundCondition(model.NotFoundErrors),
+	}
+
+	var structs, lists []*sdkmodel.Field
+	for _, f := range output.Fields {
+		switch {
+		case f.Kind == sdkmodel.KindStruct:
+			structs = append(structs, f)
+		case f.Kind == sdkmodel.KindList && f.Elem.Kind == sdkmodel.KindStruct:
+			lists = append(lists, f)
+		}
+	}
+
+	var field *sdkmodel.Field
+	for _, f := range structs {
+		if f.Name == resourceName || f.TypeName == resourceName {
+			field = f
+		}
+	}
+	if field == nil {
+		for _, f := range lists {
+			if f.Name == resourceName+"s" || f.Elem.TypeName == resourceName {
+				field = f
+			}
+		}
+	}
+	if field == nil && len(output.Fields) == 1 && (len(structs) == 1 || len(lists) == 1) {
+		field = output.Fields[0]
+	}
+
+	if field == nil {
+		finder.ResultType = fmt.Sprintf("%s.%sOutput", g.sdkPackage, model.Read.Name)
+		return output, finder
+	}
+
+	finder.OutputField = field.Name
+	shape := field.Shape
+	if field.Kind == sdkmodel.KindList {
+		finder.List = true
+		shape = field.Elem.Shape
+	}
+	finder.ResultType = g.goTypeName(shape.PkgPath, shape.TypeName)
+
+	return shape, finder
+}
+
+func notFoundCondition(notFoundErrors []string) string {
+	if len(notFoundErrors) == 0 {
+		notFoundErrors = []string{"ResourceNotFoundException"}
+	}
+
+	var conds []string
+	for _, v := range notFoundErrors {
+		conds = append(conds, fmt.Sprintf("errs.IsA[*awstypes.%s](err)", v))
+	}
+
+	return strings.Join(conds, " || ")
+}
+
+// identifier returns the field of the read operation's input that identifies the resource.
+func (g *generator) identifier(model *sdkmodel.Model) (*Identifier, error) {
+	var candidates []*sdkmodel.Field
+	for _, f := range model.Read.Input.Fields {
+		if f.Kind == sdkmodel.KindString && f.Required {
+			candidates = append(candidates, f)
+		}
+	}
+	if len(candidates) == 0 {
+		for _, f := range model.Read.Input.Fields {
+			if f.Kind == sdkmodel.KindString {
+				candidates = append(candidates, f)
+				break
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no identifier field found in %sInput", model.Read.Name)
+	}
+
+	for _, f := range candidates[1:] {
+		g.unsupported = append(g.unsupported, fmt.Sprintf("%sInput.%s (additional identifier)", model.Read.Name, f.Name))
+	}
+
+	return &Identifier{SDKField: candidates[0].Name}, nil
+}
+
+// members returns the top-level schema members: arguments from the create and update inputs,
+// and computed attributes from the read result.
+func (g *generator) members(resourceName string, model *sdkmodel.Model, result *sdkmodel.Shape, identifier *Identifier) []*member {
+	var members []*member
+	seen := make(map[string]bool)
+	add := func(shape string, f *sdkmodel.Field, m *member) {
+		if seen[f.Name] || slices.Contains(skippedInputFields, f.Name) {
+			return
+		}
+		seen[f.Name] = true
+
+		if !supported(f) {
+			g.unsupported = append(g.unsupported, fmt.Sprintf("%s.%s (%s)", shape, f.Name, unsupportedReason(f)))
+			return
+		}
+
+		m.field = f
+		m.sdkName = f.Name
+		members = append(members, m)
+	}
+
+	for _, f := range model.Create.Input.Fields {
+		forceNew := model.Update == nil || model.Update.Input.Field(f.Name) == nil || f.Name == identifier.SDKField
+		add(model.Create.Input.TypeName, f, &member{required: f.Required, forceNew: forceNew})
+	}
+	if model.Update != nil {
+		for _, f := range model.Update.Input.Fields {
+			if f.Name == identifier.SDKField {
+				continue
+			}
+			add(model.Update.Input.TypeName, f, &member{})
+		}
+	}
+	for _, f := range result.Fields {
+		add(result.TypeName, f, &member{computed: true})
+	}
+	if !seen[identifier.SDKField] {
+		add(model.Read.Input.TypeName, &sdkmodel.Field{Name: identifier.SDKField, Kind: sdkmodel.KindString}, &member{computed: true})
+	}
+
+	// Fields such as "BrokerArn" and "BrokerId" become "arn" and "id" unless that would clash with another field.
+	for _, m := range members {
+		name := m.sdkName
+		if v := strings.TrimPrefix(name, resourceName); v != name && slices.Contains([]string{"Arn", "Id", "Name"}, v) && !seen[v] {
+			name = v
+		}
+		m.name = names.ToSnakeCase(name)
+		m.goName = goFieldName(name)
+	}
+
+	return members
+}
+
+func createOutputField(output, result *sdkmodel.Shape) string {
+	for _, f := range output.Fields {
+		if f.Kind == sdkmodel.KindStruct && f.TypeName == result.TypeName && f.PkgPath == result.PkgPath {
+			return f.Name
+		}
+	}
+
+	return ""
+}
+
+// statusFieldNames are the names of fields that hold a resource's lifecycle status, in order of preference.
+var statusFieldNames = []string{
+	"Status",
+	"State",
+	"%sStatus",
+	"%sState",
+}
+
+var (
+	targetStatuses = []string{
+		"ACTIVE",
+		"AVAILABLE",
+		"COMPLETE",
+		"COMPLETED",
+		"CREATED",
+		"DEPLOYED",
+		"ENABLED",
+		"HEALTHY",
+		"IN_SERVICE",
+		"OK",
+		"READY",
+		"RUNNING",
+		"STABLE",
+		"SUCCEEDED",
+		"SUCCESS",
+		"UPDATED",
+	}
+	createPendingStatuses = []string{"CREAT", "INITIALIZ", "IN_PROGRESS", "PENDING", "PROVISION", "REBOOT", "STARTING"}
+	updatePendingStatuses = []string{"CONFIGUR", "IN_PROGRESS", "MODIF", "PENDING", "REBOOT", "UPDAT"}
+	deletePendingStatuses = []string{"DELET"}
+)
+
+// status returns the status function and waiter configuration for the resource's status field, if any.
+func (g *generator) status(resourceName string, result *sdkmodel.Shape, update bool) *Status {
+	var field *sdkmodel.Field
+	for _, v := range statusFieldNames {
+		if strings.Contains(v, "%s") {
+			v = fmt.Sprintf(v, resourceName)
+		}
+		if f := result.Field(v); f != nil && f.Kind == sdkmodel.KindEnum {
+			field = f
+			break
+		}
+	}
+	if field == nil {
+		return nil
+	}
+
+	var createPending, updatePending, deletePending, target []string
+	for _, v := range field.EnumValues {
+		u := strings.ToUpper(strings.NewReplacer("-", "_", " ", "_").Replace(v))
+		switch {
+		case containsAny(u, deletePendingStatuses...):
+			deletePending = append(deletePending, v)
+		case slices.Contains(targetStatuses, u):
+			target = append(target, v)
+		default:
+			if containsAny(u, createPendingStatuses...) {
+				createPending = append(createPending, v)
+			}
+			if containsAny(u, updatePendingStatuses...) {
+				updatePending = append(updatePending, v)
+			}
+		}
+	}
+	if len(target) == 0 {
+		return nil
+	}
+
+	s := &Status{
+		Field:         field.Name,
+		CreatePending: g.enumSlice(field, createPending),
+		CreateTarget:  g.enumSlice(field, target),
+		DeletePending: g.enumSlice(field, append(deletePending, target...)),
+	}
+	if update {
+		s.UpdatePending = g.enumSlice(field, updatePending)
+		s.UpdateTarget = s.CreateTarget
+	}
+
+	return s
+}
+
+func containsAny(s string, substrs ...string) bool {
+	return slices.ContainsFunc(substrs, func(v string) bool {
+		return strings.Contains(s, v)
+	})
+}
+
+// enumSlice returns a Go expression for a []string of the specified enum values.
+func (g *generator) enumSlice(f *sdkmodel.Field, values []string) string {
+	if len(values) == 0 {
+		return "[]string{}"
+	}
+
+	var exprs []string
+	for _, v := range values {
+		if c, ok := f.EnumConstants[v]; ok {
+			exprs = append(exprs, "awstypes."+c)
+		} else {
+			exprs = append(exprs, fmt.Sprintf("awstypes.%s(%q)", f.TypeName, v))
+		}
+	}
+
+	return fmt.Sprintf("enum.Slice(%s)", strings.Join(exprs, ", "))
+}
+
+func (g *generator) sweeper(model *sdkmodel.Model, identifier *Identifier) *Sweeper {
+	if model.List == nil {
+		return nil
+	}
+
+	for _, f := range model.List.Output.Fields {
+		if f.Kind != sdkmodel.KindList || f.Elem.Kind != sdkmodel.KindStruct {
+			continue
+		}
+
+		if item := f.Elem.Shape.Field(identifier.SDKField); item != nil && item.Kind == sdkmodel.KindString {
+			return &Sweeper{
+				ListOp:         model.List.Name,
+				Paginated:      slices.Contains(model.Paginators, "New"+model.List.Name+"Paginator"),
+				ItemsField:     f.Name,
+				ItemIdentifier: item.Name,
+			}
+		}
+	}
+
+	g.unsupported = append(g.unsupported, fmt.Sprintf("%sOutput (no list of items with %s for the sweeper)", model.List.Name, identifier.SDKField))
+
+	return nil
+}
+
+func supported(f *sdkmodel.Field) bool {
+	switch f.Kind {
+	case sdkmodel.KindUnsupported:
+		return false
+	case sdkmodel.KindList:
+		switch f.Elem.Kind {
+		case sdkmodel.KindList, sdkmodel.KindMap, sdkmodel.KindTime, sdkmodel.KindUnsupported:
+			return false
+		}
+	case sdkmodel.KindMap:
+		return f.Elem.Kind == sdkmodel.KindString
+	}
+
+	return true
+}
+
+func unsupportedReason(f *sdkmodel.Field) string {
+	switch {
+	case f.Reason != "":
+		return f.Reason
+	case f.Elem != nil && f.Elem.Reason != "":
+		return string(f.Kind) + " of " + f.Elem.Reason
+	case f.Elem != nil:
+		return string(f.Kind) + " of " + string(f.Elem.Kind)
+	}
+
+	return string(f.Kind)
+}
+
+func isARN(m *member) bool {
+	return !m.computed && m.field.Kind == sdkmodel.KindString && strings.HasSuffix(m.sdkName, "Arn")
+}
+
+// scalarType describes the Plugin Framework representation of a non-nested SDK field.
+type scalarType struct {
+	schema       string
+	model        string
+	customType   string
+	elementType  string
+	planModifier string
+}
+
+func (g *generator) scalarType(f *sdkmodel.Field, arn bool) (scalarType, bool) {
+	switch f.Kind {
+	case sdkmodel.KindBool:
+		return scalarType{schema: "schema.BoolAttribute", model: "types.Bool", planModifier: "bool"}, true
+	case sdkmodel.KindEnum:
+		return scalarType{
+			schema:       "schema.StringAttribute",
+			model:        fmt.Sprintf("fwtypes.StringEnum[%s]", g.goTypeName(f.PkgPath, f.TypeName)),
+			customType:   fmt.Sprintf("fwtypes.StringEnumType[%s]()", g.goTypeName(f.PkgPath, f.TypeName)),
+			planModifier: "string",
+		}, true
+	case sdkmodel.KindFloat32:
+		return scalarType{schema: "schema.Float32Attribute", model: "types.Float32", planModifier: "float32"}, true
+	case sdkmodel.KindFloat64:
+		return scalarType{schema: "schema.Float64Attribute", model: "types.Float64", planModifier: "float64"}, true
+	case sdkmodel.KindInt32:
+		return scalarType{schema: "schema.Int32Attribute", model: "types.Int32", planModifier: "int32"}, true
+	case sdkmodel.KindInt64:
+		return scalarType{schema: "schema.Int64Attribute", model: "types.Int64", planModifier: "int64"}, true
+	case sdkmodel.KindString:
+		if arn {
+			return scalarType{schema: "schema.StringAttribute", model: "fwtypes.ARN", customType: "fwtypes.ARNType", planModifier: "string"}, true
+		}
+		return scalarType{schema: "schema.StringAttribute", model: "types.String", planModifier: "string"}, true
+	case sdkmodel.KindTime:
+		return scalarType{schema: "schema.StringAttribute", model: "timetypes.RFC3339", customType: "timetypes.RFC3339Type{}", planModifier: "string"}, true
+	case sdkmodel.KindList:
+		t := scalarType{schema: "schema.ListAttribute", model: "types.List", planModifier: "list"}
+		switch f.Elem.Kind {
+		case sdkmodel.KindString:
+			t.model, t.customType, t.elementType = "fwtypes.ListOfString", "fwtypes.ListOfStringType", "types.StringType"
+		case sdkmodel.KindEnum:
+			typeName := g.goTypeName(f.Elem.PkgPath, f.Elem.TypeName)
+			t.model = fmt.Sprintf("fwtypes.ListValueOf[fwtypes.StringEnum[%s]]", typeName)
+			t.customType = fmt.Sprintf("fwtypes.ListOfStringEnumType[%s]()", typeName)
+			t.elementType = fmt.Sprintf("fwtypes.StringEnumType[%s]()", typeName)
+		case sdkmodel.KindBool:
+			t.elementType = "types.BoolType"
+		case sdkmodel.KindFloat32:
+			t.elementType = "types.Float32Type"
+		case sdkmodel.KindFloat64:
+			t.elementType = "types.Float64Type"
+		case sdkmodel.KindInt32:
+			t.elementType = "types.Int32Type"
+		case sdkmodel.KindInt64:
+			t.elementType = "types.Int64Type"
+		default:
+			return scalarType{}, false
+		}
+		return t, true
+	case sdkmodel.KindMap:
+		if f.Elem.Kind != sdkmodel.KindString {
+			return scalarType{}, false
+		}
+		return scalarType{schema: "schema.MapAttribute", model: "fwtypes.MapOfString", customType: "fwtypes.MapOfStringType", elementType: "types.StringType", planModifier: "map"}, true
+	}
+
+	return scalarType{}, false
+}
+
+func nested(f *sdkmodel.Field) (*sdkmodel.Field, bool) {
+	switch {
+	case f.Kind == sdkmodel.KindStruct:
+		return f, true
+	case f.Kind == sdkmodel.KindList && f.Elem.Kind == sdkmodel.KindStruct:
+		return f.Elem, false
+	}
+
+	return nil, false
+}
+
+// attribute returns the schema attribute code for a member, or false if the member is a block.
+func (g *generator) attribute(m *member) (string, bool) {
+	if m.computed {
+		switch m.name {
+		case names.AttrARN:
+			if m.field.Kind == sdkmodel.KindString {
+				return "framework.ARNAttributeComputedOnly()", true
+			}
+		case names.AttrID:
+			if m.field.Kind == sdkmodel.KindString {
+				return "framework.IDAttribute()", true
+			}
+		}
+
+		if s, _ := nested(m.field); s != nil {
+			return fmt.Sprintf("framework.ResourceComputedListOfObjectsAttribute[%s](ctx, listplanmodifier.UseStateForUnknown())", g.model(s.Shape)), true
+		}
+	}
+
+	t, ok := g.scalarType(m.field, isARN(m))
+	if !ok {
+		return "", false
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s{\n", t.schema)
+	if t.customType != "" {
+		fmt.Fprintf(&sb, "CustomType: %s,\n", t.customType)
+	}
+	if t.elementType != "" {
+		fmt.Fprintf(&sb, "ElementType: %s,\n", t.elementType)
+	}
+	switch {
+	case m.computed:
+		sb.WriteString("Computed: true,\n")
+	case m.required:
+		sb.WriteString("Required: true,\n")
+	default:
+		sb.WriteString("Optional: true,\n")
+	}
+	switch {
+	case m.computed:
+		writePlanModifiers(&sb, t.planModifier, "UseStateForUnknown")
+	case m.forceNew:
+		writePlanModifiers(&sb, t.planModifier, "RequiresReplace")
+	}
+	sb.WriteString("}")
+
+	return sb.String(), true
+}
+
+func writePlanModifiers(sb *strings.Builder, kind, modifier string) {
+	fmt.Fprintf(sb, "PlanModifiers: []planmodifier.%s{\n%splanmodifier.%s(),\n},\n", strings.ToUpper(kind[:1])+kind[1:], kind, modifier)
+}
+
+// block returns the schema block code for a nested structure member.
+func (g *generator) block(m *member) (string, bool) {
+	s, single := nested(m.field)
+	if s == nil {
+		return "", false
+	}
+
+	var sb strings.Builder
+	sb.WriteString("schema.ListNestedBlock{\n")
+	fmt.Fprintf(&sb, "CustomType: fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", g.model(s.Shape))
+	if single || m.required {
+		sb.WriteString("Validators: []validator.List{\n")
+		if single {
+			sb.WriteString("listvalidator.SizeAtMost(1),\n")
+		}
+		if m.required {
+			sb.WriteString("listvalidator.IsRequired(),\n")
+		}
+		sb.WriteString("},\n")
+	}
+	if m.forceNew {
+		writePlanModifiers(&sb, "list", "RequiresReplace")
+	}
+	sb.WriteString("NestedObject: schema.NestedBlockObject{\n")
+
+	var attributes, blocks []*SchemaEntry
+	for _, f := range s.Shape.Fields {
+		if !supported(f) {
+			g.unsupported = append(g.unsupported, fmt.Sprintf("%s.%s (%s)", s.TypeName, f.Name, unsupportedReason(f)))
+			continue
+		}
+
+		nm := &member{
+			field:    f,
+			sdkName:  f.Name,
+			name:     names.ToSnakeCase(f.Name),
+			goName:   goFieldName(f.Name),
+			required: f.Required,
+		}
+		if code, ok := g.attribute(nm); ok {
+			attributes = append(attributes, &SchemaEntry{Name: nm.name, Key: namesgen.ConstOrQuote(nm.name), Code: code})
+		} else if code, ok := g.block(nm); ok {
+			blocks = append(blocks, &SchemaEntry{Name: nm.name, Key: namesgen.ConstOrQuote(nm.name), Code: code})
+		}
+	}
+	writeSchemaMap(&sb, "Attributes", "schema.Attribute", attributes)
+	writeSchemaMap(&sb, "Blocks", "schema.Block", blocks)
+	sb.WriteString("},\n}")
+
+	return sb.String(), true
+}
+
+func writeSchemaMap(sb *strings.Builder, field, typ string, entries []*SchemaEntry) {
+	if len(entries) == 0 {
+		return
+	}
+
+	slices.SortFunc(entries, func(a, b *SchemaEntry) int { return strings.Compare(a.Name, b.Name) })
+
+	fmt.Fprintf(sb, "%s: map[string]%s{\n", field, typ)
+	for _, e := range entries {
+		fmt.Fprintf(sb, "%s: %s,\n", e.Key, e.Code)
+	}
+	sb.WriteString("},\n")
+}
+
+// model returns the name of the model struct for a nested SDK structure, creating it if necessary.
+func (g *generator) model(s *sdkmodel.Shape) string {
+	name := convert.ToLowercasePrefix(s.TypeName) + "Model"
+	if _, ok := g.models[name]; ok {
+		return name
+	}
+
+	m := &NestedModel{Name: name}
+	g.models[name] = m
+
+	for _, f := range s.Fields {
+		if !supported(f) {
+			continue
+		}
+
+		goName := goFieldName(f.Name)
+		m.Fields = append(m.Fields, &ModelField{
+			GoName: goName,
+			Type:   g.modelType(f, f.Kind == sdkmodel.KindString && strings.HasSuffix(f.Name, "Arn")),
+			TFName: names.ToSnakeCase(f.Name),
+		})
+	}
+
+	return name
+}
+
+func (g *generator) modelType(f *sdkmodel.Field, arn bool) string {
+	if s, _ := nested(f); s != nil {
+		return fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", g.model(s.Shape))
+	}
+
+	t, _ := g.scalarType(f, arn)
+
+	return t.model
+}
+
+// goTypeName returns the Go expression for an SDK type, qualified by the appropriate package name.
+func (g *generator) goTypeName(pkgPath, typeName string) string {
+	if strings.HasSuffix(pkgPath, "/types") {
+		return "awstypes." + typeName
+	}
+
+	return g.sdkPackage + "." + typeName
+}
+
+// existsType returns the @Testing existsType annotation value for the find result type.
+func existsType(sdkPackage, resultType string) string {
+	const sdkModulePrefix = "github.com/aws/aws-sdk-go-v2/service/"
+
+	if pkg, _, _ := strings.Cut(resultType, "."); pkg == "awstypes" {
+		return fmt.Sprintf("%s%s/types;%s", sdkModulePrefix, sdkPackage, resultType)
+	}
+
+	return fmt.Sprintf("%s%s;%s", sdkModulePrefix, sdkPackage, resultType)
+}
+
+// initialisms maps words in SDK field names to the capitalization used in provider Go code.
+var initialisms = map[string]string{
+	"Acl":   "ACL",
+	"Api":   "API",
+	"Arn":   "ARN",
+	"Arns":  "ARNs",
+	"Db":    "DB",
+	"Dns":   "DNS",
+	"Http":  "HTTP",
+	"Https": "HTTPS",
+	"Iam":   "IAM",
+	"Id":    "ID",
+	"Ids":   "IDs",
+	"Ip":    "IP",
+	"Json":  "JSON",
+	"Kms":   "KMS",
+	"Sns":   "SNS",
+	"Sqs":   "SQS",
+	"Ssl":   "SSL",
+	"Tls":   "TLS",
+	"Uri":   "URI",
+	"Url":   "URL",
+	"Vpc":   "VPC",
+}
+
+// goFieldName returns the provider Go field name for an SDK field name, e.g. "KmsKeyId" becomes "KMSKeyID".
+func goFieldName(sdkName string) string {
+	words := regexache.MustCompile(`[A-Z]+[a-z0-9]*|[a-z0-9]+`).FindAllString(sdkName, -1)
+	for i, w := range words {
+		if v, ok := initialisms[w]; ok {
+			words[i] = v
+		}
+	}
+
+	return strings.Join(words, "")
+}
+
+// testConfig returns the HCL arguments required to create the resource.
+// String values are rendered as nameValue.
+func testConfig(members []*member, nameValue, indent string) string {
+	var lines []string
+	var width int
+	var blocks []*member
+	var attributes []*member
+	for _, m := range members {
+		if !m.required {
+			continue
+		}
+		if s, _ := nested(m.field); s != nil {
+			blocks = append(blocks, m)
+			continue
+		}
+		attributes = append(attributes, m)
+		width = max(width, len(m.name))
+	}
+
+	for _, m := range attributes {
+		lines = append(lines, fmt.Sprintf("%s%-*s = %s", indent, width, m.name, hclValue(m.field, nameValue)))
+	}
+
+	for _, m := range blocks {
+		s, _ := nested(m.field)
+		var nestedMembers []*member
+		for _, f := range s.Shape.Fields {
+			if supported(f) {
+				nestedMembers = append(nestedMembers, &member{field: f, name: names.ToSnakeCase(f.Name), required: f.Required})
+			}
+		}
+
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, fmt.Sprintf("%s%s {", indent, m.name))
+		if body := testConfig(nestedMembers, nameValue, indent+"  "); body != "" {
+			lines = append(lines, body)
+		}
+		lines = append(lines, indent+"}")
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func hclValue(f *sdkmodel.Field, nameValue string) string {
+	switch f.Kind {
+	case sdkmodel.KindBool:
+		return "false"
+	case sdkmodel.KindEnum:
+		if len(f.EnumValues) > 0 {
+			return strconv.Quote(f.EnumValues[0])
+		}
+	case sdkmodel.KindFloat32, sdkmodel.KindFloat64, sdkmodel.KindInt32, sdkmodel.KindInt64:
+		return "1"
+	case sdkmodel.KindList:
+		return "[" + hclValue(f.Elem, nameValue) + "]"
+	case sdkmodel.KindMap:
+		return "{}"
+	case sdkmodel.KindString:
+		return nameValue
+	case sdkmodel.KindTime:
+		return strconv.Quote("2025-01-01T00:00:00Z")
+	}
+
+	return `""`
+}
@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"go/format"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdkmodel"
+)
+
+const testTypesPkgPath = "github.com/aws/aws-sdk-go-v2/service/example/types"
+
+func testWidgetModel(update, list bool) *sdkmodel.Model {
+	state := &sdkmodel.Field{
+		Name:       "State",
+		Kind:       sdkmodel.KindEnum,
+		TypeName:   "WidgetState",
+		PkgPath:    testTypesPkgPath,
+		EnumValues: []string{"ACTIVE", "CREATING", "DELETING", "FAILED", "UPDATING"},
+		EnumConstants: map[string]string{
+			"ACTIVE":   "WidgetStateActive",
+			"CREATING": "WidgetStateCreating",
+			"DELETING": "WidgetStateDeleting",
+			"FAILED":   "WidgetStateFailed",
+			"UPDATING": "WidgetStateUpdating",
+		},
+	}
+	configuration := &sdkmodel.Shape{
+		TypeName: "Configuration",
+		PkgPath:  testTypesPkgPath,
+		Fields: []*sdkmodel.Field{
+			{Name: "Mode", Kind: sdkmodel.KindString, Required: true},
+			{Name: "Size", Kind: sdkmodel.KindInt32},
+		},
+	}
+	widget := &sdkmodel.Shape{
+		TypeName: "Widget",
+		PkgPath:  testTypesPkgPath,
+		Fields: []*sdkmodel.Field{
+			{Name: "Configuration", Kind: sdkmodel.KindStruct, TypeName: "Configuration", PkgPath: testTypesPkgPath, Shape: configuration},
+			{Name: "CreatedAt", Kind: sdkmodel.KindTime},
+			{Name: "Description", Kind: sdkmodel.KindString},
+			{Name: "KmsKeyId", Kind: sdkmodel.KindString},
+			state,
+			{Name: "WidgetArn", Kind: sdkmodel.KindString},
+			{Name: "WidgetId", Kind: sdkmodel.KindString},
+			{Name: "WidgetName", Kind: sdkmodel.KindString},
+		},
+	}
+
+	model := &sdkmodel.Model{
+		Package: "example",
+		Create: &sdkmodel.Operation{
+			Name: "CreateWidget",
+			Input: &sdkmodel.Shape{TypeName: "CreateWidgetInput", Fields: []*sdkmodel.Field{
+				{Name: "ClientToken", Kind: sdkmodel.KindString},
+				{Name: "Configuration", Kind: sdkmodel.KindStruct, TypeName: "Configuration", PkgPath: testTypesPkgPath, Shape: configuration, Required: true},
+				{Name: "Description", Kind: sdkmodel.KindString},
+				{Name: "Document", Kind: sdkmodel.KindUnsupported, Reason: "document"},
+				{Name: "KmsKeyId", Kind: sdkmodel.KindString},
+				{Name: "Tags", Kind: sdkmodel.KindMap, Elem: &sdkmodel.Field{Kind: sdkmodel.KindString}},
+				{Name: "WidgetName", Kind: sdkmodel.KindString, Required: true},
+			}},
+			Output: &sdkmodel.Shape{TypeName: "CreateWidgetOutput", Fields: []*sdkmodel.Field{
+				{Name: "WidgetArn", Kind: sdkmodel.KindString},
+				{Name: "WidgetId", Kind: sdkmodel.KindString},
+			}},
+		},
+		Read: &sdkmodel.Operation{
+			Name: "GetWidget",
+			Input: &sdkmodel.Shape{TypeName: "GetWidgetInput", Fields: []*sdkmodel.Field{
+				{Name: "WidgetId", Kind: sdkmodel.KindString, Required: true},
+			}},
+			Output: &sdkmodel.Shape{TypeName: "GetWidgetOutput", Fields: []*sdkmodel.Field{
+				{Name: "Widget", Kind: sdkmodel.KindStruct, TypeName: "Widget", PkgPath: testTypesPkgPath, Shape: widget},
+			}},
+		},
+		Delete: &sdkmodel.Operation{
+			Name: "DeleteWidget",
+			Input: &sdkmodel.Shape{TypeName: "DeleteWidgetInput", Fields: []*sdkmodel.Field{
+				{Name: "WidgetId", Kind: sdkmodel.KindString, Required: true},
+			}},
+			Output: &sdkmodel.Shape{TypeName: "DeleteWidgetOutput"},
+		},
+		NotFoundErrors: []string{"ResourceNotFoundException"},
+		Paginators:     []string{"NewListWidgetsPaginator"},
+	}
+	if update {
+		model.Update = &sdkmodel.Operation{
+			Name: "UpdateWidget",
+			Input: &sdkmodel.Shape{TypeName: "UpdateWidgetInput", Fields: []*sdkmodel.Field{
+				{Name: "Configuration", Kind: sdkmodel.KindStruct, TypeName: "Configuration", PkgPath: testTypesPkgPath, Shape: configuration},
+				{Name: "Description", Kind: sdkmodel.KindString},
+				{Name: "WidgetId", Kind: sdkmodel.KindString, Required: true},
+			}},
+			Output: &sdkmodel.Shape{TypeName: "UpdateWidgetOutput"},
+		}
+	}
+	if list {
+		model.List = &sdkmodel.Operation{
+			Name:  "ListWidgets",
+			Input: &sdkmodel.Shape{TypeName: "ListWidgetsInput"},
+			Output: &sdkmodel.Shape{TypeName: "ListWidgetsOutput", Fields: []*sdkmodel.Field{
+				{Name: "Widgets", Kind: sdkmodel.KindList, Elem: &sdkmodel.Field{Kind: sdkmodel.KindStruct, TypeName: "WidgetSummary", PkgPath: testTypesPkgPath, Shape: &sdkmodel.Shape{
+					TypeName: "WidgetSummary",
+					PkgPath:  testTypesPkgPath,
+					Fields: []*sdkmodel.Field{
+						{Name: "WidgetId", Kind: sdkmodel.KindString},
+					},
+				}}},
+			}},
+		}
+	}
+
+	return model
+}
+
+func testWidgetTemplateData() TemplateData {
+	return TemplateData{
+		Resource:             "Widget",
+		ResourceLower:        "widget",
+		ResourceSnake:        "widget",
+		HumanFriendlyService: "Example",
+		IncludeComments:      true,
+		SDKPackage:           "example",
+		ServicePackage:       "example",
+		Service:              "Example",
+		ServiceLower:         "example",
+		AWSServiceName:       "Amazon Example",
+		PluginFramework:      true,
+		HumanResourceName:    "Widget",
+		ProviderResourceName: "aws_example_widget",
+	}
+}
+
+func TestNewSDKTemplateData(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		update bool
+		list   bool
+	}{
+		"create only": {},
+		"with update": {
+			update: true,
+		},
+		"with list": {
+			update: true,
+			list:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d, err := newSDKTemplateData(testWidgetTemplateData(), testWidgetModel(testCase.update, testCase.list))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := d.Identifier.Name, "id"; got != want {
+				t.Errorf("Identifier.Name = %q, want %q", got, want)
+			}
+			if got, want := d.Identifier.DeleteField, "WidgetId"; got != want {
+				t.Errorf("Identifier.DeleteField = %q, want %q", got, want)
+			}
+			if got, want := d.Finder.ResultType, "awstypes.Widget"; got != want {
+				t.Errorf("Finder.ResultType = %q, want %q", got, want)
+			}
+			if got, want := d.TagsIdentifier, "arn"; got != want {
+				t.Errorf("TagsIdentifier = %q, want %q", got, want)
+			}
+			if !d.ClientToken {
+				t.Error("ClientToken = false, want true")
+			}
+			if d.Status == nil {
+				t.Fatal("Status = nil, want status")
+			}
+			if got, want := d.Status.CreatePending, "enum.Slice(awstypes.WidgetStateCreating)"; got != want {
+				t.Errorf("Status.CreatePending = %q, want %q", got, want)
+			}
+			if got, want := d.Status.CreateTarget, "enum.Slice(awstypes.WidgetStateActive)"; got != want {
+				t.Errorf("Status.CreateTarget = %q, want %q", got, want)
+			}
+			if got, want := len(d.Unsupported), 1; got != want {
+				t.Errorf("len(Unsupported) = %d, want %d", got, want)
+			}
+			if got, want := d.Sweeper != nil, testCase.list; got != want {
+				t.Errorf("Sweeper = %v, want sweeper: %t", d.Sweeper, want)
+			}
+
+			for _, tmpl := range []struct {
+				name    string
+				source  string
+				imports []goImport
+			}{
+				{"resource", resourceSDKTmpl, resourceImports(d.SDKPackage)},
+				{"test", resourceSDKTestTmpl, testImports(d.SDKPackage, d.ServicePackage)},
+			} {
+				body, err := renderSDKTemplate(tmpl.name, tmpl.source, d, tmpl.imports)
+				if err != nil {
+					t.Fatalf("rendering %s template: %s", tmpl.name, err)
+				}
+
+				if _, err := format.Source(body); err != nil {
+					t.Errorf("formatting %s template: %s\n%s", tmpl.name, err, body)
+				}
+			}
+		})
+	}
+}
+
+func TestGoFieldName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"Name":             "Name",
+		"KmsKeyId":         "KMSKeyID",
+		"SecurityGroupIds": "SecurityGroupIDs",
+		"VpcEndpointArn":   "VPCEndpointARN",
+		"DNSName":          "DNSName",
+		"Ipv6Address":      "Ipv6Address",
+	}
+
+	for input, want := range testCases {
+		if got := goFieldName(input); got != want {
+			t.Errorf("goFieldName(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestUsedImports(t *testing.T) {
+	t.Parallel()
+
+	imports := []goImport{
+		{selector: "context", path: "context"},
+		{selector: "fmt", path: "fmt"},
+		{selector: "aws", path: "github.com/aws/aws-sdk-go-v2/aws"},
+		{selector: "awstypes", path: "github.com/aws/aws-sdk-go-v2/service/example/types", alias: "awstypes"},
+		{selector: "types", path: "github.com/hashicorp/terraform-plugin-framework/types"},
+	}
+	src := `func f(ctx context.Context) { _ = awstypes.WidgetStateActive; _ = "fmt.Sprintf" }`
+
+	got := usedImports(src, imports)
+	want := []string{`"context"`, "", `awstypes "github.com/aws/aws-sdk-go-v2/service/example/types"`}
+
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("usedImports = %q, want %q", got, want)
+	}
+}
+
+func TestTestConfig(t *testing.T) {
+	t.Parallel()
+
+	members := []*member{
+		{field: &sdkmodel.Field{Kind: sdkmodel.KindString}, name: "widget_name", required: true},
+		{field: &sdkmodel.Field{Kind: sdkmodel.KindInt32}, name: "size", required: true},
+		{field: &sdkmodel.Field{Kind: sdkmodel.KindString}, name: "description"},
+		{field: &sdkmodel.Field{Kind: sdkmodel.KindStruct, Shape: &sdkmodel.Shape{Fields: []*sdkmodel.Field{
+			{Name: "Mode", Kind: sdkmodel.KindEnum, EnumValues: []string{"FAST", "SLOW"}, Required: true},
+		}}}, name: "configuration", required: true},
+	}
+
+	got := testConfig(members, "var.rName", "  ")
+	want := `  widget_name = var.rName
+  size        = 1
+
+  configuration {
+    mode = "FAST"
+  }`
+
+	if got != want {
+		t.Errorf("testConfig =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkmodel
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+//go:embed shape.go
+var shapeSource string
+
+//go:embed program.gtpl
+var programTmpl string
+
+const (
+	sdkModulePrefix = "github.com/aws/aws-sdk-go-v2/service/"
+)
+
+// Operations names the SDK operations that implement a resource's lifecycle.
+type Operations struct {
+	Create string
+	Read   string
+	Update string
+	Delete string
+	List   string
+}
+
+type programOperation struct {
+	Role string
+	Name string
+}
+
+type programData struct {
+	Package    string
+	Operations []programOperation
+}
+
+// Load returns a description of the specified operations of an AWS SDK for Go v2 service package.
+//
+// The SDK structures are described by building and running a helper program that uses reflection.
+// dir must be within the provider's Go module so that the helper program can resolve the SDK package.
+// Information not available via reflection, such as required fields and enum constant names,
+// is added from the SDK package's source on a best-effort basis.
+func Load(ctx context.Context, dir, sdkPackage string, ops Operations) (*Model, error) {
+	if sdkPackage == "" {
+		return nil, errors.New("no SDK package given")
+	}
+	if ops.Create == "" || ops.Read == "" || ops.Delete == "" {
+		return nil, errors.New("create, read and delete operations are required")
+	}
+
+	program, err := renderProgram(sdkPackage, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpDir, err := os.MkdirTemp(dir, "skaffsdkmodel")
+	if err != nil {
+		return nil, fmt.Errorf("creating temporary directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	if err := os.WriteFile(filepath.Join(tmpDir, "main.go"), program, 0644); err != nil {
+		return nil, fmt.Errorf("writing SDK reflection program: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "shape.go"), []byte(mainPackageSource(shapeSource)), 0644); err != nil {
+		return nil, fmt.Errorf("writing SDK reflection program: %w", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "go", "run", ".")
+	cmd.Dir = tmpDir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("running SDK reflection program: %w\n%s", err, stderr.String())
+	}
+
+	var model Model
+	if err := json.Unmarshal(stdout.Bytes(), &model); err != nil {
+		return nil, fmt.Errorf("decoding SDK model: %w", err)
+	}
+
+	if info, err := loadSourceInfo(ctx, dir, sdkPackage); err == nil {
+		info.annotate(&model)
+	}
+
+	return &model, nil
+}
+
+func renderProgram(sdkPackage string, ops Operations) ([]byte, error) {
+	data := programData{
+		Package: sdkPackage,
+	}
+	for _, v := range []programOperation{
+		{Role: "Create", Name: ops.Create},
+		{Role: "Read", Name: ops.Read},
+		{Role: "Update", Name: ops.Update},
+		{Role: "Delete", Name: ops.Delete},
+		{Role: "List", Name: ops.List},
+	} {
+		if v.Name != "" {
+			data.Operations = append(data.Operations, v)
+		}
+	}
+
+	tmpl, err := template.New("program").Parse(programTmpl)
+	if err != nil {
+		return nil, fmt.Errorf("parsing SDK reflection program template: %w", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, data); err != nil {
+		return nil, fmt.Errorf("executing SDK reflection program template: %w", err)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// mainPackageSource rewrites the package clause of Go source to "package main".
+func mainPackageSource(src string) string {
+	return strings.Replace(src, "\npackage sdkmodel\n", "\npackage main\n", 1)
+}
+
+func loadSourceInfo(ctx context.Context, dir, sdkPackage string) (*sourceInfo, error) {
+	pkgPath := sdkModulePrefix + sdkPackage
+
+	var stdout bytes.Buffer
+	cmd := exec.CommandContext(ctx, "go", "list", "-f", "{{.Dir}}", pkgPath)
+	cmd.Dir = dir
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		return nil, err
+	}
+	pkgDir := strings.TrimSpace(stdout.String())
+
+	info := newSourceInfo()
+	if err := info.parse(pkgPath, pkgDir); err != nil {
+		return nil, err
+	}
+	if err := info.parse(pkgPath+"/types", filepath.Join(pkgDir, "types")); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
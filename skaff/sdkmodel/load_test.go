@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkmodel
+
+import (
+	"context"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+func TestRenderProgram(t *testing.T) {
+	t.Parallel()
+
+	src, err := renderProgram("mq", Operations{
+		Create: "CreateBroker",
+		Read:   "DescribeBroker",
+		Delete: "DeleteBroker",
+	})
+	if err != nil {
+		t.Fatalf("rendering program: %s", err)
+	}
+
+	if _, err := parser.ParseFile(token.NewFileSet(), "main.go", src, 0); err != nil {
+		t.Fatalf("parsing rendered program: %s\n%s", err, src)
+	}
+
+	for _, want := range []string{
+		`"github.com/aws/aws-sdk-go-v2/service/mq"`,
+		`model.Create = OperationOf("CreateBroker", reflect.TypeFor[mq.CreateBrokerInput](), reflect.TypeFor[mq.CreateBrokerOutput]())`,
+		`model.Read = OperationOf("DescribeBroker", reflect.TypeFor[mq.DescribeBrokerInput](), reflect.TypeFor[mq.DescribeBrokerOutput]())`,
+		`model.Delete = OperationOf("DeleteBroker", reflect.TypeFor[mq.DeleteBrokerInput](), reflect.TypeFor[mq.DeleteBrokerOutput]())`,
+	} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("rendered program does not contain %q", want)
+		}
+	}
+	if strings.Contains(string(src), "model.Update") {
+		t.Error("rendered program contains unrequested Update operation")
+	}
+}
+
+func TestMainPackageSource(t *testing.T) {
+	t.Parallel()
+
+	f, err := parser.ParseFile(token.NewFileSet(), "shape.go", mainPackageSource(shapeSource), parser.ImportsOnly)
+	if err != nil {
+		t.Fatalf("parsing embedded source: %s", err)
+	}
+
+	if got, want := f.Name.Name, "main"; got != want {
+		t.Errorf("package = %q, want %q", got, want)
+	}
+	for _, imp := range f.Imports {
+		if path := strings.Trim(imp.Path.Value, `"`); strings.Contains(path, ".") {
+			t.Errorf("embedded source imports non-standard library package %q", path)
+		}
+	}
+}
+
+func TestLoadValidation(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Load(context.Background(), t.TempDir(), "", Operations{}); err == nil {
+		t.Error("expected error for missing SDK package")
+	}
+	if _, err := Load(context.Background(), t.TempDir(), "mq", Operations{Create: "CreateBroker"}); err == nil {
+		t.Error("expected error for missing operations")
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkmodel
+
+// This file is compiled into skaff and is also embedded, with its package
+// clause rewritten, into the helper program that reflects over AWS SDK for Go v2
+// structures. It must only import the standard library.
+
+import (
+	"reflect"
+	"time"
+)
+
+// Kind is the kind of value held by a structure field.
+type Kind string
+
+const (
+	KindBool        Kind = "bool"
+	KindEnum        Kind = "enum"
+	KindFloat32     Kind = "float32"
+	KindFloat64     Kind = "float64"
+	KindInt32       Kind = "int32"
+	KindInt64       Kind = "int64"
+	KindList        Kind = "list"
+	KindMap         Kind = "map"
+	KindString      Kind = "string"
+	KindStruct      Kind = "struct"
+	KindTime        Kind = "time"
+	KindUnsupported Kind = "unsupported"
+)
+
+// Field describes an exported field of an SDK structure, or the element of a list or map.
+type Field struct {
+	Name          string            `json:"name,omitempty"`
+	Kind          Kind              `json:"kind"`
+	TypeName      string            `json:"typeName,omitempty"`
+	PkgPath       string            `json:"pkgPath,omitempty"`
+	EnumValues    []string          `json:"enumValues,omitempty"`
+	EnumConstants map[string]string `json:"enumConstants,omitempty"`
+	Elem          *Field            `json:"elem,omitempty"`
+	Shape         *Shape            `json:"shape,omitempty"`
+	Reason        string            `json:"reason,omitempty"`
+	Required      bool              `json:"required,omitempty"`
+}
+
+// Shape describes an SDK structure.
+type Shape struct {
+	TypeName string   `json:"typeName"`
+	PkgPath  string   `json:"pkgPath"`
+	Fields   []*Field `json:"fields"`
+}
+
+// Field returns the named field, or nil if the structure has no such field.
+func (s *Shape) Field(name string) *Field {
+	if s == nil {
+		return nil
+	}
+
+	for _, f := range s.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+
+	return nil
+}
+
+// Operation describes the input and output structures of an SDK operation.
+type Operation struct {
+	Name   string `json:"name"`
+	Input  *Shape `json:"input"`
+	Output *Shape `json:"output"`
+}
+
+// Model describes the SDK operations that implement a resource's lifecycle.
+type Model struct {
+	Package        string     `json:"package"`
+	Create         *Operation `json:"create,omitempty"`
+	Read           *Operation `json:"read,omitempty"`
+	Update         *Operation `json:"update,omitempty"`
+	Delete         *Operation `json:"delete,omitempty"`
+	List           *Operation `json:"list,omitempty"`
+	NotFoundErrors []string   `json:"notFoundErrors,omitempty"`
+	Paginators     []string   `json:"paginators,omitempty"`
+}
+
+// OperationOf returns a description of the named operation's input and output structures.
+func OperationOf(name string, input, output reflect.Type) *Operation {
+	return &Operation{
+		Name:   name,
+		Input:  ShapeOf(input),
+		Output: ShapeOf(output),
+	}
+}
+
+// ShapeOf returns a description of the structure type t, or of the structure pointed to by t.
+func ShapeOf(t reflect.Type) *Shape {
+	w := walker{visiting: make(map[reflect.Type]bool)}
+
+	return w.shape(t)
+}
+
+var (
+	timeType = reflect.TypeFor[time.Time]()
+
+	// skippedFieldNames are the names of fields that are present in every operation's output.
+	skippedFieldNames = map[string]bool{
+		"ResultMetadata": true,
+	}
+)
+
+type walker struct {
+	visiting map[reflect.Type]bool
+}
+
+func (w walker) shape(t reflect.Type) *Shape {
+	t = indirect(t)
+	s := &Shape{
+		TypeName: t.Name(),
+		PkgPath:  t.PkgPath(),
+	}
+
+	w.visiting[t] = true
+	defer delete(w.visiting, t)
+
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		if !sf.IsExported() || sf.Anonymous || skippedFieldNames[sf.Name] {
+			continue
+		}
+
+		f := w.field(sf.Type)
+		f.Name = sf.Name
+		s.Fields = append(s.Fields, f)
+	}
+
+	return s
+}
+
+func (w walker) field(t reflect.Type) *Field {
+	t = indirect(t)
+
+	if t == timeType {
+		return &Field{Kind: KindTime}
+	}
+
+	if values, ok := enumValues(t); ok {
+		return &Field{
+			Kind:       KindEnum,
+			TypeName:   t.Name(),
+			PkgPath:    t.PkgPath(),
+			EnumValues: values,
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Field{Kind: KindBool}
+	case reflect.Float32:
+		return &Field{Kind: KindFloat32}
+	case reflect.Float64:
+		return &Field{Kind: KindFloat64}
+	case reflect.Int32:
+		return &Field{Kind: KindInt32}
+	case reflect.Int, reflect.Int64:
+		return &Field{Kind: KindInt64}
+	case reflect.String:
+		return &Field{Kind: KindString}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Field{Kind: KindUnsupported, Reason: "blob"}
+		}
+		return &Field{Kind: KindList, Elem: w.field(t.Elem())}
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return &Field{Kind: KindUnsupported, Reason: t.String()}
+		}
+		return &Field{Kind: KindMap, Elem: w.field(t.Elem())}
+	case reflect.Struct:
+		if w.visiting[t] {
+			return &Field{Kind: KindUnsupported, Reason: "recursive structure " + t.String()}
+		}
+		return &Field{
+			Kind:     KindStruct,
+			TypeName: t.Name(),
+			PkgPath:  t.PkgPath(),
+			Shape:    w.shape(t),
+		}
+	}
+
+	return &Field{Kind: KindUnsupported, Reason: t.String()}
+}
+
+// enumValues returns the values of an SDK enum type.
+// SDK enum types are named string types with a Values method returning all known values.
+func enumValues(t reflect.Type) ([]string, bool) {
+	if t.Kind() != reflect.String || t.Name() == "" || t.PkgPath() == "" {
+		return nil, false
+	}
+
+	m, ok := t.MethodByName("Values")
+	if !ok || m.Type.NumIn() != 1 || m.Type.NumOut() != 1 || m.Type.Out(0) != reflect.SliceOf(t) {
+		return nil, false
+	}
+
+	out := m.Func.Call([]reflect.Value{reflect.Zero(t)})[0]
+	values := make([]string, 0, out.Len())
+	for i := range out.Len() {
+		values = append(values, out.Index(i).String())
+	}
+
+	return values, true
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkmodel
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type testState string
+
+const (
+	testStateActive   testState = "ACTIVE"
+	testStateCreating testState = "CREATING"
+)
+
+func (testState) Values() []testState {
+	return []testState{
+		testStateActive,
+		testStateCreating,
+	}
+}
+
+type testEmbedded struct{}
+
+type testNested struct {
+	Value *string
+
+	testEmbedded
+}
+
+type testRecursive struct {
+	Children []testRecursive
+}
+
+type testMetadata struct{}
+
+type testOutput struct {
+	Blob           []byte
+	Count          *int32
+	Created        *time.Time
+	Document       any
+	Enabled        *bool
+	Labels         map[string]string
+	Names          []string
+	Nested         *testNested
+	NestedList     []testNested
+	Ratio          *float64
+	Recursive      *testRecursive
+	Size           *int64
+	State          testState
+	Weight         float32
+	ResultMetadata testMetadata
+}
+
+func TestShapeOf(t *testing.T) {
+	t.Parallel()
+
+	pkgPath := reflect.TypeFor[testOutput]().PkgPath()
+	nested := &Shape{
+		TypeName: "testNested",
+		PkgPath:  pkgPath,
+		Fields: []*Field{
+			{Name: "Value", Kind: KindString},
+		},
+	}
+	want := &Shape{
+		TypeName: "testOutput",
+		PkgPath:  pkgPath,
+		Fields: []*Field{
+			{Name: "Blob", Kind: KindUnsupported, Reason: "blob"},
+			{Name: "Count", Kind: KindInt32},
+			{Name: "Created", Kind: KindTime},
+			{Name: "Document", Kind: KindUnsupported, Reason: "interface {}"},
+			{Name: "Enabled", Kind: KindBool},
+			{Name: "Labels", Kind: KindMap, Elem: &Field{Kind: KindString}},
+			{Name: "Names", Kind: KindList, Elem: &Field{Kind: KindString}},
+			{Name: "Nested", Kind: KindStruct, TypeName: "testNested", PkgPath: pkgPath, Shape: nested},
+			{Name: "NestedList", Kind: KindList, Elem: &Field{Kind: KindStruct, TypeName: "testNested", PkgPath: pkgPath, Shape: nested}},
+			{Name: "Ratio", Kind: KindFloat64},
+			{Name: "Recursive", Kind: KindStruct, TypeName: "testRecursive", PkgPath: pkgPath, Shape: &Shape{
+				TypeName: "testRecursive",
+				PkgPath:  pkgPath,
+				Fields: []*Field{
+					{Name: "Children", Kind: KindList, Elem: &Field{Kind: KindUnsupported, Reason: "recursive structure sdkmodel.testRecursive"}},
+				},
+			}},
+			{Name: "Size", Kind: KindInt64},
+			{Name: "State", Kind: KindEnum, TypeName: "testState", PkgPath: pkgPath, EnumValues: []string{"ACTIVE", "CREATING"}},
+			{Name: "Weight", Kind: KindFloat32},
+		},
+	}
+
+	got := ShapeOf(reflect.TypeFor[*testOutput]())
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestShapeField(t *testing.T) {
+	t.Parallel()
+
+	s := ShapeOf(reflect.TypeFor[testNested]())
+
+	if got := s.Field("Value"); got == nil || got.Kind != KindString {
+		t.Errorf("Field(%q) = %v, want string field", "Value", got)
+	}
+	if got := s.Field("Missing"); got != nil {
+		t.Errorf("Field(%q) = %v, want nil", "Missing", got)
+	}
+
+	var nilShape *Shape
+	if got := nilShape.Field("Value"); got != nil {
+		t.Errorf("nil Field(%q) = %v, want nil", "Value", got)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkmodel
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	requiredMemberDoc = "This member is required."
+)
+
+// sourceInfo holds information about an SDK service package that is not available via reflection.
+type sourceInfo struct {
+	// required maps "<package path>.<type name>" to the names of the type's required fields.
+	required map[string]map[string]bool
+	// enumConstants maps "<package path>.<type name>" to a map of enum value to constant name.
+	enumConstants  map[string]map[string]string
+	notFoundErrors []string
+	paginators     []string
+}
+
+func newSourceInfo() *sourceInfo {
+	return &sourceInfo{
+		required:      make(map[string]map[string]bool),
+		enumConstants: make(map[string]map[string]string),
+	}
+}
+
+// parse adds information from the Go source files of the package with the specified import path in dir.
+func (info *sourceInfo) parse(pkgPath, dir string) error {
+	fset := token.NewFileSet()
+	filter := func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}
+	pkgs, err := parser.ParseDir(fset, dir, filter, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				switch decl := decl.(type) {
+				case *ast.FuncDecl:
+					if decl.Recv == nil && strings.HasPrefix(decl.Name.Name, "New") && strings.HasSuffix(decl.Name.Name, "Paginator") {
+						info.paginators = append(info.paginators, decl.Name.Name)
+					}
+				case *ast.GenDecl:
+					switch decl.Tok {
+					case token.CONST:
+						info.parseConstants(pkgPath, decl)
+					case token.TYPE:
+						info.parseTypes(pkgPath, decl)
+					}
+				}
+			}
+		}
+	}
+
+	slices.Sort(info.notFoundErrors)
+	slices.Sort(info.paginators)
+
+	return nil
+}
+
+func (info *sourceInfo) parseConstants(pkgPath string, decl *ast.GenDecl) {
+	for _, spec := range decl.Specs {
+		spec, ok := spec.(*ast.ValueSpec)
+		if !ok || len(spec.Names) != 1 || len(spec.Values) != 1 {
+			continue
+		}
+
+		typ, ok := spec.Type.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		lit, ok := spec.Values[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			continue
+		}
+
+		value, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			continue
+		}
+
+		key := pkgPath + "." + typ.Name
+		if info.enumConstants[key] == nil {
+			info.enumConstants[key] = make(map[string]string)
+		}
+		info.enumConstants[key][value] = spec.Names[0].Name
+	}
+}
+
+func (info *sourceInfo) parseTypes(pkgPath string, decl *ast.GenDecl) {
+	for _, spec := range decl.Specs {
+		spec, ok := spec.(*ast.TypeSpec)
+		if !ok {
+			continue
+		}
+
+		st, ok := spec.Type.(*ast.StructType)
+		if !ok {
+			continue
+		}
+
+		name := spec.Name.Name
+		if strings.Contains(name, "NotFound") && (strings.HasSuffix(name, "Exception") || strings.HasSuffix(name, "Fault")) {
+			info.notFoundErrors = append(info.notFoundErrors, name)
+		}
+
+		for _, field := range st.Fields.List {
+			if field.Doc == nil || !strings.Contains(field.Doc.Text(), requiredMemberDoc) {
+				continue
+			}
+
+			key := pkgPath + "." + name
+			if info.required[key] == nil {
+				info.required[key] = make(map[string]bool)
+			}
+			for _, ident := range field.Names {
+				info.required[key][ident.Name] = true
+			}
+		}
+	}
+}
+
+// annotate adds the source information to the model.
+func (info *sourceInfo) annotate(model *Model) {
+	for _, op := range []*Operation{model.Create, model.Read, model.Update, model.Delete, model.List} {
+		if op == nil {
+			continue
+		}
+
+		info.annotateShape(op.Input)
+		info.annotateShape(op.Output)
+	}
+
+	model.NotFoundErrors = info.notFoundErrors
+	model.Paginators = info.paginators
+}
+
+func (info *sourceInfo) annotateShape(s *Shape) {
+	if s == nil {
+		return
+	}
+
+	required := info.required[s.PkgPath+"."+s.TypeName]
+	for _, f := range s.Fields {
+		f.Required = required[f.Name]
+		info.annotateField(f)
+	}
+}
+
+func (info *sourceInfo) annotateField(f *Field) {
+	switch f.Kind {
+	case KindEnum:
+		f.EnumConstants = info.enumConstants[f.PkgPath+"."+f.TypeName]
+	case KindList, KindMap:
+		info.annotateField(f.Elem)
+	case KindStruct:
+		info.annotateShape(f.Shape)
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkmodel
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testServiceSource = `package example
+
+type CreateWidgetInput struct {
+	// The name of the widget.
+	//
+	// This member is required.
+	Name *string
+
+	// The widget's configuration.
+	Configuration *types.Configuration
+
+	noSmithyDocumentSerde
+}
+
+func NewListWidgetsPaginator(client ListWidgetsAPIClient, params *ListWidgetsInput) *ListWidgetsPaginator {
+	return nil
+}
+`
+
+const testTypesSource = `package types
+
+type WidgetState string
+
+const (
+	WidgetStateActive   WidgetState = "ACTIVE"
+	WidgetStateCreating WidgetState = "CREATING"
+)
+
+type Configuration struct {
+	// This member is required.
+	Mode WidgetState
+
+	Size *int32
+}
+
+type ResourceNotFoundException struct {
+	Message *string
+}
+
+type ValidationException struct {
+	Message *string
+}
+`
+
+func TestSourceInfoAnnotate(t *testing.T) {
+	t.Parallel()
+
+	const pkgPath = "example.com/service/example"
+	dir := t.TempDir()
+	typesDir := filepath.Join(dir, "types")
+	if err := os.Mkdir(typesDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "api_op_CreateWidget.go"), []byte(testServiceSource), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(typesDir, "types.go"), []byte(testTypesSource), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	info := newSourceInfo()
+	if err := info.parse(pkgPath, dir); err != nil {
+		t.Fatalf("parsing service package: %s", err)
+	}
+	if err := info.parse(pkgPath+"/types", typesDir); err != nil {
+		t.Fatalf("parsing types package: %s", err)
+	}
+
+	model := &Model{
+		Package: "example",
+		Create: &Operation{
+			Name: "CreateWidget",
+			Input: &Shape{
+				TypeName: "CreateWidgetInput",
+				PkgPath:  pkgPath,
+				Fields: []*Field{
+					{Name: "Configuration", Kind: KindStruct, TypeName: "Configuration", PkgPath: pkgPath + "/types", Shape: &Shape{
+						TypeName: "Configuration",
+						PkgPath:  pkgPath + "/types",
+						Fields: []*Field{
+							{Name: "Mode", Kind: KindEnum, TypeName: "WidgetState", PkgPath: pkgPath + "/types", EnumValues: []string{"ACTIVE", "CREATING"}},
+							{Name: "Size", Kind: KindInt32},
+						},
+					}},
+					{Name: "Name", Kind: KindString},
+				},
+			},
+		},
+	}
+
+	info.annotate(model)
+
+	want := &Model{
+		Package: "example",
+		Create: &Operation{
+			Name: "CreateWidget",
+			Input: &Shape{
+				TypeName: "CreateWidgetInput",
+				PkgPath:  pkgPath,
+				Fields: []*Field{
+					{Name: "Configuration", Kind: KindStruct, TypeName: "Configuration", PkgPath: pkgPath + "/types", Shape: &Shape{
+						TypeName: "Configuration",
+						PkgPath:  pkgPath + "/types",
+						Fields: []*Field{
+							{Name: "Mode", Kind: KindEnum, TypeName: "WidgetState", PkgPath: pkgPath + "/types", EnumValues: []string{"ACTIVE", "CREATING"}, Required: true, EnumConstants: map[string]string{
+								"ACTIVE":   "WidgetStateActive",
+								"CREATING": "WidgetStateCreating",
+							}},
+							{Name: "Size", Kind: KindInt32},
+						},
+					}},
+					{Name: "Name", Kind: KindString, Required: true},
+				},
+			},
+		},
+		NotFoundErrors: []string{"ResourceNotFoundException"},
+		Paginators:     []string{"NewListWidgetsPaginator"},
+	}
+
+	if diff := cmp.Diff(model, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
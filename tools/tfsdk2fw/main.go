@@ -11,11 +11,17 @@ import (
 	"io"
 	"os"
 	"path"
+	"reflect"
+	"runtime"
 	"strings"
+	"text/template"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
 	"golang.org/x/exp/slices"
 )
@@ -23,11 +29,13 @@ import (
 var (
 	dataSourceType = flag.String("data-source", "", "Data Source type")
 	resourceType   = flag.String("resource", "", "Resource type")
+	servicePackage = flag.String("service", "", "Service package name; migrates all of the package's resources and data sources")
 )
 
 func usage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n")
-	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] <package-name> <name> <generated-file>\n\n")
+	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] <package-name> <name> <generated-file>\n")
+	fmt.Fprintf(os.Stderr, "\ttfsdk2fw -service <package-name> <output-directory>\n\n")
 }
 
 func main() {
@@ -36,6 +44,16 @@ func main() {
 
 	args := flag.Args()
 
+	if v := *servicePackage; v != "" {
+		if len(args) < 1 {
+			flag.Usage()
+			os.Exit(2)
+		}
+
+		migrateServicePackage(v, args[0])
+		return
+	}
+
 	if len(args) < 3 || (*dataSourceType == "" && *resourceType == "") {
 		flag.Usage()
 		os.Exit(2)
@@ -57,42 +75,152 @@ func main() {
 		PackageName: packageName,
 	}
 
-	p, err := provider.New(context.Background())
-
-	if err != nil {
-		g.Fatalf(err.Error())
-	}
+	client := newClient(g)
 
 	if v := *dataSourceType; v != "" {
-		resource, ok := p.DataSourcesMap[v]
+		dataSource, ok := findSDKDataSource(client, v)
 
 		if !ok {
 			g.Fatalf("data source type %s not found", v)
 		}
 
 		migrator.IsDataSource = true
-		migrator.Resource = resource
+		migrator.HumanName = dataSource.Name
+		migrator.Resource = dataSource.Factory()
 		migrator.Template = datasourceImpl
 		migrator.TFTypeName = v
 	} else if v := *resourceType; v != "" {
-		resource, ok := p.ResourcesMap[v]
+		resource, ok := findSDKResource(client, v)
 
 		if !ok {
 			g.Fatalf("resource type %s not found", v)
 		}
 
-		migrator.Resource = resource
+		migrator.HumanName = resource.Name
+		migrator.Resource = resource.Factory()
 		migrator.Template = resourceImpl
 		migrator.TFTypeName = v
 	}
 
 	if err := migrator.migrate(outputFilename); err != nil {
-		g.Fatalf("error migrating Terraform %s schema: %s", *resourceType, err)
+		g.Fatalf("error migrating Terraform %s schema: %s", migrator.TFTypeName, err)
+	}
+}
+
+// newClient returns an AWS client whose service packages have been registered by the Plugin SDK v2 provider.
+// The service packages' factories return Plugin SDK v2 resources without the provider's interceptors,
+// so that functions such as CustomizeDiff and state upgraders can be identified.
+func newClient(g *common.Generator) *conns.AWSClient {
+	ctx := context.Background()
+
+	p, err := provider.New(ctx)
+
+	if err != nil {
+		g.Fatalf(err.Error())
+	}
+
+	return p.Meta().(*conns.AWSClient)
+}
+
+func findSDKResource(client *conns.AWSClient, typeName string) (*types.ServicePackageSDKResource, bool) {
+	ctx := context.Background()
+
+	for _, sp := range client.ServicePackages(ctx) {
+		for _, v := range sp.SDKResources(ctx) {
+			if v.TypeName == typeName {
+				return v, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+func findSDKDataSource(client *conns.AWSClient, typeName string) (*types.ServicePackageSDKDataSource, bool) {
+	ctx := context.Background()
+
+	for _, sp := range client.ServicePackages(ctx) {
+		for _, v := range sp.SDKDataSources(ctx) {
+			if v.TypeName == typeName {
+				return v, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// migrateServicePackage migrates all of a service package's Plugin SDK v2 resources and data sources
+// into the specified output directory.
+// Errors migrating individual resources or data sources are reported and the remainder are still migrated.
+func migrateServicePackage(packageName, outputDirectory string) {
+	ctx := context.Background()
+	g := common.NewGenerator()
+	client := newClient(g)
+
+	sp := client.ServicePackage(ctx, packageName)
+
+	if sp == nil {
+		g.Fatalf("service package %s not found", packageName)
+	}
+
+	var failed int
+
+	for _, v := range sp.SDKResources(ctx) {
+		migrator := &migrator{
+			Generator:   g,
+			HumanName:   v.Name,
+			Name:        naming.ToCamelCase(v.Name),
+			PackageName: packageName,
+			Resource:    v.Factory(),
+			Template:    resourceImpl,
+			TFTypeName:  v.TypeName,
+		}
+
+		if err := migrator.migrate(path.Join(outputDirectory, fileNameBase(packageName, v.TypeName)+".go")); err != nil {
+			g.Errorf("error migrating Terraform %s schema: %s", v.TypeName, err)
+			failed++
+		}
+	}
+
+	for _, v := range sp.SDKDataSources(ctx) {
+		migrator := &migrator{
+			Generator:    g,
+			HumanName:    v.Name,
+			IsDataSource: true,
+			Name:         naming.ToCamelCase(v.Name),
+			PackageName:  packageName,
+			Resource:     v.Factory(),
+			Template:     datasourceImpl,
+			TFTypeName:   v.TypeName,
+		}
+
+		if err := migrator.migrate(path.Join(outputDirectory, fileNameBase(packageName, v.TypeName)+"_data_source.go")); err != nil {
+			g.Errorf("error migrating Terraform %s schema: %s", v.TypeName, err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		g.Fatalf("%d resources or data sources could not be migrated", failed)
+	}
+}
+
+// fileNameBase returns the base name of the generated file for a resource or data source type,
+// e.g. "broker" for "aws_mq_broker" in package "mq".
+func fileNameBase(packageName, typeName string) string {
+	s := strings.TrimPrefix(typeName, "aws_")
+
+	if v := strings.TrimPrefix(s, packageName+"_"); v != "" {
+		s = v
 	}
+
+	return s
 }
 
 type migrator struct {
 	Generator    *common.Generator
+	HumanName    string
 	IsDataSource bool
 	Name         string
 	PackageName  string
@@ -102,6 +230,7 @@ type migrator struct {
 }
 
 // migrate generates an identical schema into the specified output file.
+// For resources, a schema compatibility test is generated alongside the output file.
 func (m *migrator) migrate(outputFilename string) error {
 	m.infof("generating into %[1]q", outputFilename)
 
@@ -113,6 +242,13 @@ func (m *migrator) migrate(outputFilename string) error {
 		return fmt.Errorf("creating target directory %s: %w", dirname, err)
 	}
 
+	// Capture the Plugin SDK v2 state type before the emitter's compatibility hacks modify the schema.
+	sdkType, err := m.Resource.CoreConfigSchema().ImpliedType().MarshalJSON()
+
+	if err != nil {
+		return fmt.Errorf("marshaling Plugin SDK v2 state type: %w", err)
+	}
+
 	templateData, err := m.generateTemplateData()
 
 	if err != nil {
@@ -121,7 +257,37 @@ func (m *migrator) migrate(outputFilename string) error {
 
 	d := m.Generator.NewGoFileDestination(outputFilename)
 
-	if err := d.BufferTemplate("schema", m.Template, templateData); err != nil {
+	if err := d.BufferTemplate("schema", m.Template, templateData, templateFuncs); err != nil {
+		return err
+	}
+
+	if err := d.Write(); err != nil {
+		return err
+	}
+
+	if m.IsDataSource {
+		return nil
+	}
+
+	// Plugin SDK v2 state fixtures are recorded under testdata/tfsdk2fw/<resource-type>/state.
+	testdataDirname := path.Join(dirname, "testdata", "tfsdk2fw", m.TFTypeName)
+	if err := os.MkdirAll(path.Join(testdataDirname, "state"), 0755); err != nil {
+		return fmt.Errorf("creating testdata directory %s: %w", testdataDirname, err)
+	}
+
+	d = m.Generator.NewUnformattedFileDestination(path.Join(testdataDirname, "sdkv2_type.json"))
+
+	if err := d.BufferBytes(append(sdkType, '\n')); err != nil {
+		return err
+	}
+
+	if err := d.Write(); err != nil {
+		return err
+	}
+
+	d = m.Generator.NewGoFileDestination(strings.TrimSuffix(outputFilename, ".go") + "_compat_test.go")
+
+	if err := d.BufferTemplate("compat", compatTestImpl, templateData); err != nil {
 		return err
 	}
 
@@ -134,9 +300,13 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 	emitter := &emitter{
 		Generator:    m.Generator,
 		IsDataSource: m.IsDataSource,
+		ModelPrefix:  naming.ToLowerCamelCase(m.Name),
 		SchemaWriter: &sbSchema,
 		StructWriter: &sbStruct,
 	}
+	if m.IsDataSource {
+		emitter.ModelPrefix = "dataSource" + m.Name
+	}
 
 	err := emitter.emitSchemaForResource(m.Resource)
 
@@ -150,11 +320,13 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		DefaultUpdateTimeout:         emitter.DefaultUpdateTimeout,
 		DefaultDeleteTimeout:         emitter.DefaultDeleteTimeout,
 		EmitResourceImportState:      m.Resource.Importer != nil,
-		EmitResourceModifyPlan:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
+		EmitResourceModifyPlan:       !m.IsDataSource && m.Resource.CustomizeDiff != nil,
 		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
 		HasTimeouts:                  emitter.HasTimeouts,
+		HumanName:                    m.HumanName,
 		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
 		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
+		Models:                       strings.Join(emitter.Models, "\n"),
 		Name:                         m.Name,
 		PackageName:                  m.PackageName,
 		Schema:                       sbSchema.String(),
@@ -162,6 +334,27 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		TFTypeName:                   m.TFTypeName,
 	}
 
+	if v := m.Resource.CustomizeDiff; v != nil {
+		templateData.CustomizeDiff = funcName(v)
+	}
+
+	if v := m.Resource.Importer; v != nil {
+		var f any
+		if v.StateContext != nil {
+			f = v.StateContext
+		} else if v.State != nil { //nolint:staticcheck // Deprecated importers must still be migrated.
+			f = v.State //nolint:staticcheck // Deprecated importers must still be migrated.
+		}
+
+		if f != nil {
+			if name := funcName(f); name != funcName(schema.ImportStatePassthroughContext) {
+				templateData.Importer = name
+			}
+		}
+	}
+
+	templateData.StateUpgraders = stateUpgraders(m.PackageName, m.Resource)
+
 	for _, v := range emitter.FrameworkPlanModifierPackages {
 		if !slices.Contains(templateData.FrameworkPlanModifierPackages, v) {
 			templateData.FrameworkPlanModifierPackages = append(templateData.FrameworkPlanModifierPackages, v)
@@ -185,6 +378,82 @@ func (m *migrator) infof(format string, a ...any) {
 	m.Generator.Infof(format, a...)
 }
 
+// stateUpgraders returns the Plugin Framework state upgraders equivalent to a Plugin SDK v2 resource's StateUpgraders.
+// The Plugin SDK v2 upgraders each upgrade by one version, whereas a Plugin Framework upgrader
+// must upgrade directly to the current version, so each framework upgrader chains the remaining SDK upgraders.
+func stateUpgraders(packageName string, resource *schema.Resource) []stateUpgrader {
+	var upgraders []stateUpgrader
+
+	for i, v := range resource.StateUpgraders {
+		upgrader := stateUpgrader{
+			Version: int64(v.Version),
+		}
+
+		for _, v := range resource.StateUpgraders[i:] {
+			name := funcName(v.Upgrade)
+
+			if ident, ok := localFuncIdent(packageName, name); ok {
+				upgrader.Funcs = append(upgrader.Funcs, ident)
+			} else {
+				upgrader.TODOs = append(upgrader.TODOs, fmt.Sprintf("Port Version %d state upgrader %s", v.Version, name))
+			}
+		}
+
+		upgraders = append(upgraders, upgrader)
+	}
+
+	return upgraders
+}
+
+// funcName returns the fully qualified name of the specified function.
+func funcName(f any) string {
+	v := reflect.ValueOf(f)
+
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return ""
+	}
+
+	if f := runtime.FuncForPC(v.Pointer()); f != nil {
+		return f.Name()
+	}
+
+	return ""
+}
+
+// localFuncIdent returns the identifier by which the named function can be referenced from the specified service package.
+// Closures and functions declared in other packages cannot be referenced.
+func localFuncIdent(packageName, name string) (string, bool) {
+	pkgPath, ident, ok := strings.Cut(path.Base(name), ".")
+
+	if !ok || pkgPath != packageName || strings.Contains(ident, ".") {
+		return "", false
+	}
+
+	if !strings.HasSuffix(path.Dir(name), "/internal/service") {
+		return "", false
+	}
+
+	return ident, true
+}
+
+// goDuration returns a Go expression for the specified duration, e.g. "20 * time.Minute".
+func goDuration(d int64) string {
+	switch v := time.Duration(d); {
+	case v%time.Hour == 0:
+		return fmt.Sprintf("%d * time.Hour", v/time.Hour)
+	case v%time.Minute == 0:
+		return fmt.Sprintf("%d * time.Minute", v/time.Minute)
+	case v%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", v/time.Second)
+	default:
+		return fmt.Sprintf("%d * time.Nanosecond", d)
+	}
+}
+
+var templateFuncs = template.FuncMap{
+	"GoDuration": goDuration,
+}
+
 type emitter struct {
 	DefaultCreateTimeout          int64
 	DefaultReadTimeout            int64
@@ -200,8 +469,10 @@ type emitter struct {
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
 	IsDataSource                  bool
+	ModelPrefix                   string   // Prefix for nested block model type names, e.g. "broker".
+	Models                        []string // Nested block model type declarations, outermost first.
 	SchemaWriter                  io.Writer
-	StructWriter                  io.Writer
+	StructWriter                  io.Writer // Writer for the fields of the model of the attributes and blocks currently being emitted.
 }
 
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
@@ -294,10 +565,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			}
 		}
 		fprintf(e.SchemaWriter, "%q:", name)
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
 
 		if name == "id" && isTopLevelAttribute {
 			fprintf(e.SchemaWriter, "framework.IDAttribute()")
@@ -307,9 +575,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			}
 		}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
-		}
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
 
 		fprintf(e.SchemaWriter, ",\n")
 	}
@@ -365,9 +631,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		fprintf(e.StructWriter, "types.Bool")
 
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
@@ -375,9 +639,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		fprintf(e.StructWriter, "types.Float64")
 
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
@@ -385,9 +647,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		fprintf(e.StructWriter, "types.Int64")
 
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
@@ -400,15 +660,11 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			fprintf(e.StructWriter, "fwtypes.ARN")
 		} else {
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			fprintf(e.StructWriter, "types.String")
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -425,9 +681,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.ListAttribute{"
 			typeName = "list"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
+			fprintf(e.StructWriter, "types.List")
 
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
@@ -438,9 +692,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.MapAttribute{"
 			typeName = "map"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
+			fprintf(e.StructWriter, "types.Map")
 
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
@@ -451,9 +703,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.SetAttribute{"
 			typeName = "set"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
+			fprintf(e.StructWriter, "types.Set")
 
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
@@ -617,6 +867,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 // emitBlockProperty generates the Plugin Framework code for a Plugin SDK Block's property
 // and emits the generated code to the emitter's Writer.
 func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) error {
+	attributeName := path[len(path)-1]
 	var planModifiers []string
 	var fwPlanModifierPackage, fwPlanModifierType, fwValidatorsPackage, fwValidatorType string
 
@@ -633,10 +884,18 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "listvalidator"
 			fwValidatorType = "List"
 
+			modelName := e.modelName(path)
+			e.ImportProviderFrameworkTypes = true
+
+			fprintf(e.StructWriter, "%s fwtypes.ListNestedObjectValueOf[%s] `tfsdk:%q`\n", naming.ToCamelCase(attributeName), modelName, attributeName)
+
 			fprintf(e.SchemaWriter, "schema.ListNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err := e.emitModel(modelName, func() error {
+				return e.emitAttributesAndBlocks(path, v.Schema)
+			})
 
 			if err != nil {
 				return err
@@ -656,10 +915,18 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "setvalidator"
 			fwValidatorType = "Set"
 
+			modelName := e.modelName(path)
+			e.ImportProviderFrameworkTypes = true
+
+			fprintf(e.StructWriter, "%s fwtypes.SetNestedObjectValueOf[%s] `tfsdk:%q`\n", naming.ToCamelCase(attributeName), modelName, attributeName)
+
 			fprintf(e.SchemaWriter, "schema.SetNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewSetNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err := e.emitModel(modelName, func() error {
+				return e.emitAttributesAndBlocks(path, v.Schema)
+			})
 
 			if err != nil {
 				return err
@@ -731,6 +998,43 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 	return nil
 }
 
+// emitModel emits the declaration of a nested block's model.
+// The model's fields are written by the specified function.
+func (e *emitter) emitModel(name string, f func() error) error {
+	sbStruct := strings.Builder{}
+	structWriter := e.StructWriter
+	e.StructWriter = &sbStruct
+	defer func() {
+		e.StructWriter = structWriter
+	}()
+
+	// Reserve the model's position so that outer models are declared before inner ones.
+	i := len(e.Models)
+	e.Models = append(e.Models, "")
+
+	if err := f(); err != nil {
+		return err
+	}
+
+	e.Models[i] = fmt.Sprintf("type %s struct {\n%s}\n", name, sbStruct.String())
+
+	return nil
+}
+
+// modelName returns the name of the model type for the nested block at the specified path,
+// e.g. "brokerMaintenanceWindowStartTimeModel".
+func (e *emitter) modelName(path []string) string {
+	var sb strings.Builder
+
+	sb.WriteString(e.ModelPrefix)
+	for _, v := range path {
+		sb.WriteString(naming.ToCamelCase(v))
+	}
+	sb.WriteString("Model")
+
+	return sb.String()
+}
+
 // emitComputedOnlyBlock generates the Plugin Framework code for a Plugin SDK Computed-only nested block
 // and emits the generated code to the emitter's Writer.
 // See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
@@ -902,6 +1206,7 @@ func unsupportedTypeError(path []string, typ string) error {
 }
 
 type templateData struct {
+	CustomizeDiff                 string // Fully qualified name of the Plugin SDK v2 CustomizeDiff function.
 	DefaultCreateTimeout          int64
 	DefaultReadTimeout            int64
 	DefaultUpdateTimeout          int64
@@ -913,21 +1218,35 @@ type templateData struct {
 	FrameworkValidatorsPackages   []string
 	GoImports                     []goImport
 	HasTimeouts                   bool
+	HumanName                     string // e.g. Instance
+	Importer                      string // Fully qualified name of a Plugin SDK v2 importer other than ImportStatePassthroughContext.
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
+	Models                        string
 	Name                          string // e.g. Instance
 	PackageName                   string // e.g. ec2
 	Schema                        string
+	StateUpgraders                []stateUpgrader
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
 }
 
+// stateUpgrader upgrades state from a prior schema version to the current version.
+type stateUpgrader struct {
+	Funcs   []string // Plugin SDK v2 state upgrade functions to apply, in order.
+	TODOs   []string // Plugin SDK v2 state upgrade functions that must be ported by hand.
+	Version int64    // Prior schema version.
+}
+
 //go:embed datasource.gtpl
 var datasourceImpl string
 
 //go:embed resource.gtpl
 var resourceImpl string
 
+//go:embed compat_test.gtpl
+var compatTestImpl string
+
 type goImport struct {
 	Path  string
 	Alias string
@@ -62,3 +62,28 @@ func toCapitalLetter(ch byte) byte {
 	ch -= 'a'
 	return ch
 }
+
+// ToLowerCamelCase converts a string to lowerCamelCase.
+// A leading initialism is lower cased in its entirety, e.g. "DBInstance" becomes "dbInstance".
+func ToLowerCamelCase(s string) string {
+	b := []byte(ToCamelCase(s))
+
+	for i, ch := range b {
+		if !isCapitalLetter(ch) {
+			break
+		}
+		// Keep the capital letter that starts the next word.
+		if i > 0 && i+1 < len(b) && isLowercaseLetter(b[i+1]) {
+			break
+		}
+		b[i] = toLowercaseLetter(ch)
+	}
+
+	return string(b)
+}
+
+func toLowercaseLetter(ch byte) byte {
+	ch += 'a'
+	ch -= 'A'
+	return ch
+}
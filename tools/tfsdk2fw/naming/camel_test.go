@@ -67,3 +67,47 @@ func TestToCamelCase(t *testing.T) {
 		})
 	}
 }
+
+func TestToLowerCamelCase(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Value         string
+		ExpectedValue string
+	}{
+		{
+			TestName:      "empty",
+			Value:         "",
+			ExpectedValue: "",
+		},
+		{
+			TestName:      "single word",
+			Value:         "Broker",
+			ExpectedValue: "broker",
+		},
+		{
+			TestName:      "multiple words",
+			Value:         "Flow Log",
+			ExpectedValue: "flowLog",
+		},
+		{
+			TestName:      "leading initialism",
+			Value:         "DBInstance",
+			ExpectedValue: "dbInstance",
+		},
+		{
+			TestName:      "initialism only",
+			Value:         "VPC",
+			ExpectedValue: "vpc",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := naming.ToLowerCamelCase(testCase.Value)
+
+			if got != testCase.ExpectedValue {
+				t.Errorf("expected: %s, got: %s", testCase.ExpectedValue, got)
+			}
+		})
+	}
+}